@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+)
+
+// cropScaleMargin is the margin SolveCentralCrop applies to the crop's
+// angular size for its scale bounds.
+const cropScaleMargin = 1.2
+
+// SolveCentralCrop solves the central cropDegrees × cropDegrees of an image
+// too wide to solve whole, such as a 180° all-sky meteor camera frame that
+// Locate rejects with ErrFieldTooWide. The crop's size in pixels comes
+// from the focal length, sensor and width in the image's EXIF or FITS
+// header (see fov.ImageInfo.CentralPixelsPerDegree), and the scale search
+// is narrowed to the crop unless opts sets ScaleLow or ScaleHigh. Keep
+// cropDegrees within the installed indexes, at most 11° for the 4100
+// series.
+//
+// The result has PartialField set and Crop giving the crop's pixels; its
+// WCS and field size apply to the crop only.
+//
+// Example:
+//
+//	result, err := c.SolveCentralCrop(ctx, "allsky.jpg", 10, nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Zenith area at RA %.2f°, Dec %.2f° (crop %+v)\n", result.RA, result.Dec, result.Crop)
+func (c *Client) SolveCentralCrop(ctx context.Context, imagePath string, cropDegrees float64, opts *SolveOptions) (*Result, error) {
+	return solveCentralCrop(ctx, c.Solve, imagePath, cropDegrees, opts)
+}
+
+// solveCentralCrop implements SolveCentralCrop with solve in place of
+// Client.Solve.
+func solveCentralCrop(ctx context.Context, solve solveFunc, imagePath string, cropDegrees float64, opts *SolveOptions) (*Result, error) {
+	if cropDegrees <= 0 {
+		return nil, fmt.Errorf("%w: cropDegrees must be positive", ErrInvalidInput)
+	}
+	info, err := fov.AnalyzeImage(imagePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot size a %.1f° crop: %v", ErrInvalidInput, cropDegrees, err)
+	}
+	pixelsPerDegree, ok := info.CentralPixelsPerDegree()
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot size a %.1f° crop without the focal length, sensor and width "+
+			"in the image metadata; set SolveOptions.CropPixels and call Solve instead", ErrInvalidInput, cropDegrees)
+	}
+
+	if opts == nil {
+		opts = DefaultSolveOptions()
+	}
+	cropOpts := *opts
+	cropOpts.CropPixels = int(math.Round(cropDegrees * pixelsPerDegree))
+	if cropOpts.ScaleLow == 0 && cropOpts.ScaleHigh == 0 {
+		cropOpts.ScaleLow, cropOpts.ScaleHigh = cropDegrees/cropScaleMargin, cropDegrees*cropScaleMargin
+		cropOpts.ScaleUnits = "degwidth"
+	}
+	return solve(ctx, imagePath, &cropOpts)
+}
@@ -49,31 +49,73 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"sync"
 
 	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
 )
 
+// Solver is the set of core operations implemented by Client. Code that
+// composes solving (queues, batch runners, servers) accepts a Solver, so
+// callers can wrap a Client with their own decorators for tracing, caching
+// or circuit breaking, or substitute a fake from the solvertest package.
+//
+// Example decorator:
+//
+//	type timedSolver struct{ client.Solver }
+//
+//	func (s timedSolver) Solve(ctx context.Context, path string, opts *client.SolveOptions) (*client.Result, error) {
+//		start := time.Now()
+//		defer func() { log.Printf("solve %s took %v", path, time.Since(start)) }()
+//		return s.Solver.Solve(ctx, path, opts)
+//	}
+//
+// Embedding the interface forwards every method that is not overridden, so
+// the decorator keeps compiling as methods are added.
+type Solver interface {
+	// Solve plate-solves an image file.
+	Solve(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error)
+
+	// SolveBytes plate-solves image data held in memory.
+	SolveBytes(ctx context.Context, data []byte, format string, opts *SolveOptions) (*Result, error)
+
+	// ExtractSources detects stars in an image without solving it.
+	ExtractSources(ctx context.Context, imagePath string, opts *ExtractOptions) (*SourceList, error)
+}
+
+var _ Solver = (*Client)(nil)
+
 // Client is the unified interface for all astrometry.net operations.
 type Client struct {
+	mu           sync.RWMutex // Guards config; the ClientConfig it points to is never mutated
 	config       *ClientConfig
 	solverClient *solver.Client
 }
 
 // NewClient creates a new astrometry Client with the given configuration.
+// The config is copied, so the caller's struct is never modified and later
+// changes to it have no effect; use UpdateConfig to reconfigure the client.
 func NewClient(config *ClientConfig) (*Client, error) {
 	if config == nil {
 		config = DefaultClientConfig()
 	}
+	config = config.clone()
 
 	// Validate required fields
-	if config.IndexPath == "" {
+	if config.IndexPath == "" && len(config.IndexPaths) == 0 {
 		return nil, fmt.Errorf("%w: IndexPath is required", ErrInvalidInput)
 	}
 
-	// Check that index path exists
-	if _, err := os.Stat(config.IndexPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("%w: IndexPath does not exist: %s", ErrInvalidInput, config.IndexPath)
+	// Check that every index path exists
+	for _, indexPath := range append([]string{config.IndexPath}, config.IndexPaths...) {
+		if _, err := os.Stat(indexPath); indexPath != "" && os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: IndexPath does not exist: %s", ErrInvalidInput, indexPath)
+		}
+	}
+
+	if config.UseDockerExec && config.ContainerName == "" {
+		return nil, fmt.Errorf("%w: ContainerName is required when UseDockerExec is true", ErrInvalidInput)
 	}
 
 	// Set defaults
@@ -86,19 +128,18 @@ func NewClient(config *ClientConfig) (*Client, error) {
 	if config.TempDir == "" {
 		config.TempDir = os.TempDir()
 	}
-
-	// Convert to internal solver config
-	solverCfg := &solver.ClientConfig{
-		DockerImage:   config.DockerImage,
-		IndexPath:     config.IndexPath,
-		TempDir:       config.TempDir,
-		Timeout:       config.Timeout,
-		UseDockerExec: config.UseDockerExec,
-		ContainerName: config.ContainerName,
+	if config.MaxOutputBytes == 0 {
+		config.MaxOutputBytes = DefaultMaxOutputBytes
+	}
+	if config.DataMountPath == "" {
+		config.DataMountPath = DefaultDataMountPath
+	}
+	if config.IndexMountPath == "" {
+		config.IndexMountPath = DefaultIndexMountPath
 	}
 
 	// Create solver client
-	solverClient, err := solver.NewClient(solverCfg)
+	solverClient, err := solver.NewClient(config.solverConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -109,10 +150,105 @@ func NewClient(config *ClientConfig) (*Client, error) {
 	}, nil
 }
 
+// Config returns a copy of the client's current configuration.
+func (c *Client) Config() ClientConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return *c.config.clone()
+}
+
+// UpdateConfig applies update to a copy of the current configuration,
+// validates it, and swaps it in atomically. Operations already running keep
+// the configuration they started with; later operations use the new one.
+// If validation fails the configuration is left unchanged.
+//
+// Example:
+//
+//	err := c.UpdateConfig(func(cfg *client.ClientConfig) {
+//		cfg.DockerImage = "diarmuidk/astrometry-dockerised-solver:v2"
+//		cfg.Timeout = 10 * time.Minute
+//	})
+func (c *Client) UpdateConfig(update func(*ClientConfig)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	next := c.config.clone()
+	update(next)
+
+	solverCfg := next.solverConfig()
+	if err := c.solverClient.UpdateConfig(func(cfg *solver.ClientConfig) { *cfg = *solverCfg }); err != nil {
+		return err
+	}
+
+	// Record the defaults the solver filled in
+	applied := c.solverClient.Config()
+	next.DockerImage, next.Timeout, next.TempDir = applied.DockerImage, applied.Timeout, applied.TempDir
+	next.MaxOutputBytes = applied.MaxOutputBytes
+	next.DataMountPath, next.IndexMountPath = applied.DataMountPath, applied.IndexMountPath
+	c.config = next
+	return nil
+}
+
+// clone returns a deep copy of the config.
+func (cfg *ClientConfig) clone() *ClientConfig {
+	cp := *cfg
+	cp.FallbackImages = append([]string(nil), cfg.FallbackImages...)
+	cp.ScratchDirs = append([]string(nil), cfg.ScratchDirs...)
+	cp.IndexPaths = append([]string(nil), cfg.IndexPaths...)
+	cp.ArgTemplate = append([]string(nil), cfg.ArgTemplate...)
+	cp.PostSolveHooks = append([]Hook(nil), cfg.PostSolveHooks...)
+	cp.AllowedFormats = append([]string(nil), cfg.AllowedFormats...)
+	return &cp
+}
+
+// solverConfig converts the config to the internal solver config.
+func (cfg *ClientConfig) solverConfig() *solver.ClientConfig {
+	return &solver.ClientConfig{
+		DockerImage:         cfg.DockerImage,
+		FallbackImages:      cfg.FallbackImages,
+		IndexPath:           cfg.IndexPath,
+		IndexPaths:          cfg.IndexPaths,
+		TempDir:             cfg.TempDir,
+		Timeout:             cfg.Timeout,
+		UseDockerExec:       cfg.UseDockerExec,
+		ContainerName:       cfg.ContainerName,
+		WarmPoolSize:        cfg.WarmPoolSize,
+		WarmPoolMaxUses:     cfg.WarmPoolMaxUses,
+		WarmPoolIdleTimeout: cfg.WarmPoolIdleTimeout,
+		DataMountPath:       cfg.DataMountPath,
+		IndexMountPath:      cfg.IndexMountPath,
+		ReuseWorkDir:        cfg.ReuseWorkDir,
+		ContainerLogLevel:   cfg.ContainerLogLevel,
+		AllowContainerRead:  cfg.AllowContainerRead,
+		ArgTemplate:         cfg.ArgTemplate,
+		Extractor:           cfg.Extractor,
+
+		ScratchDirs:         cfg.ScratchDirs,
+		ScratchMinFreeBytes: cfg.ScratchMinFreeBytes,
+		ValidateIndexes:     cfg.ValidateIndexes,
+		Metrics:             cfg.Metrics,
+		MaxOutputBytes:      cfg.MaxOutputBytes,
+		PostSolveHooks:      cfg.PostSolveHooks,
+		AllowedFormats:      cfg.AllowedFormats,
+		MaxImageBytes:       cfg.MaxImageBytes,
+		MaxImagePixels:      cfg.MaxImagePixels,
+		MaxFieldDegrees:     cfg.MaxFieldDegrees,
+	}
+}
+
 // Solve performs plate-solving on the given image file.
 //
 // This wraps the astrometry.net solve-field command, which identifies
 // celestial coordinates and orientation by matching star patterns.
+//
+// An image that does not solve returns an unsolved Result. When the output
+// shows a setup problem instead, such as no index files or scale bounds
+// outside every index, Solve returns a *SolveError with a remediation hint.
+// So it does when the exit status shows docker failing to run solve-field
+// (125, 126 or 127) or solve-field failing without one of its own failure
+// messages, wrapping ErrDockerFailed with the status in ExitCode. A
+// solution rejected by SolveOptions.RotationTolerance is a *SolveError
+// wrapping ErrNoSolution.
 func (c *Client) Solve(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
 	return c.solverClient.Solve(ctx, imagePath, opts)
 }
@@ -124,8 +260,73 @@ func (c *Client) SolveBytes(ctx context.Context, data []byte, format string, opt
 	return c.solverClient.SolveBytes(ctx, data, format, opts)
 }
 
+// SolveSequence solves a time-ordered sequence of frames, using each solved
+// frame's position and scale as the hint for the next. Rotation is never
+// hinted, and frames rotated ~180° from the previous solution (a meridian
+// flip) are marked with Result.FlipDetected.
+func (c *Client) SolveSequence(ctx context.Context, imagePaths []string, opts *SolveOptions) ([]*Result, error) {
+	return c.solverClient.SolveSequence(ctx, imagePaths, opts)
+}
+
+// SolveXYList solves a multi-field xylist FITS file (one binary table
+// extension of X/Y star positions per field) in a single solve-field run,
+// returning one Result per field in extension order.
+func (c *Client) SolveXYList(ctx context.Context, xylistPath string, opts *SolveOptions) ([]*Result, error) {
+	return c.solverClient.SolveXYList(ctx, xylistPath, opts)
+}
+
+// StreamContainerLogs follows the exec-mode container's output with
+// docker logs -f, writing each line (filtered by ContainerLogLevel) to w
+// until ctx is cancelled. It blocks, so run it in a goroutine.
+func (c *Client) StreamContainerLogs(ctx context.Context, w io.Writer) error {
+	return c.solverClient.StreamContainerLogs(ctx, w)
+}
+
+// EnsureImage pulls the configured DockerImage unless it is present
+// locally, reporting each line of docker pull output to progress.
+func (c *Client) EnsureImage(ctx context.Context, progress func(PullProgress)) error {
+	return c.solverClient.EnsureImage(ctx, progress)
+}
+
+// StartContainer starts the exec-mode container named ContainerName,
+// pulling the image first if needed, and returns once solve-field runs in
+// it and its index directory holds index files. Failures wrap
+// ErrImagePull, ErrContainerStart or ErrContainerNotReady.
+func (c *Client) StartContainer(ctx context.Context, opts *StartOptions) error {
+	return c.solverClient.StartContainer(ctx, opts)
+}
+
+// StopContainer force-removes the exec-mode container named ContainerName.
+func (c *Client) StopContainer(ctx context.Context) error {
+	return c.solverClient.StopContainer(ctx)
+}
+
+// WarmPool starts run-mode warm pool containers until WarmPoolSize are
+// running, so the first solves skip docker run's start-up cost.
+func (c *Client) WarmPool(ctx context.Context) error {
+	return c.solverClient.WarmPool(ctx)
+}
+
+// Close removes the warm pool's containers once the solves running in them
+// finish. Later solves use docker run. It is a no-op without a warm pool.
+func (c *Client) Close() error {
+	return c.solverClient.Close()
+}
+
+// ExtractSources detects stars in an image using image2xy.
+//
+// Non-FITS images are converted to grayscale FITS before extraction.
+func (c *Client) ExtractSources(ctx context.Context, imagePath string, opts *ExtractOptions) (*SourceList, error) {
+	return c.solverClient.ExtractSources(ctx, imagePath, opts)
+}
+
+// MeasureStars detects stars with image2xy and returns their count, median
+// FWHM, flux and background without solving, for autofocus and guiding.
+func (c *Client) MeasureStars(ctx context.Context, imagePath string, opts *ExtractOptions) (StarStats, error) {
+	return c.solverClient.MeasureStars(ctx, imagePath, opts)
+}
+
 // Future methods to be added:
-// - ExtractSources(ctx, imagePath) - wraps image2xy
 // - FitWCS(ctx, xyList) - wraps fit-wcs
 // - XYToRaDec(ctx, wcsFile, x, y) - wraps wcs-xy2rd
 // - RaDecToXY(ctx, wcsFile, ra, dec) - wraps wcs-rd2xy
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewClient_CopiesConfig(t *testing.T) {
+	config := &ClientConfig{IndexPath: t.TempDir()}
+
+	c, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if config.DockerImage != "" || config.Timeout != 0 {
+		t.Errorf("NewClient modified the caller's config: %+v", config)
+	}
+	if got := c.Config(); got.DockerImage != DefaultDockerImage || got.Timeout != 5*time.Minute {
+		t.Errorf("defaults not applied to client config: %+v", got)
+	}
+}
+
+func TestNewClient_ExecModeRequiresContainerName(t *testing.T) {
+	_, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), UseDockerExec: true})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("NewClient error = %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestUpdateConfig(t *testing.T) {
+	c, err := NewClient(&ClientConfig{IndexPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if err := c.UpdateConfig(func(cfg *ClientConfig) {
+		cfg.DockerImage = "dm90/astrometry"
+		cfg.Timeout = time.Minute
+	}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if got := c.Config(); got.DockerImage != "dm90/astrometry" || got.Timeout != time.Minute {
+		t.Errorf("root config not updated: %+v", got)
+	}
+	if got := c.solverClient.Config(); got.DockerImage != "dm90/astrometry" || got.Timeout != time.Minute {
+		t.Errorf("solver config not updated: %+v", got)
+	}
+
+	// Clearing a defaulted field restores the default
+	if err := c.UpdateConfig(func(cfg *ClientConfig) { cfg.DockerImage = "" }); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if got := c.Config().DockerImage; got != DefaultDockerImage {
+		t.Errorf("DockerImage = %q, want default %q", got, DefaultDockerImage)
+	}
+
+	// Invalid updates are rejected and leave both configs untouched
+	err = c.UpdateConfig(func(cfg *ClientConfig) { cfg.UseDockerExec = true })
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+	if c.Config().UseDockerExec || c.solverClient.Config().UseDockerExec {
+		t.Error("rejected update was applied")
+	}
+}
+
+func TestNewClient_MountPaths(t *testing.T) {
+	c, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), DataMountPath: "/work"})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if got := c.Config(); got.DataMountPath != "/work" || got.IndexMountPath != DefaultIndexMountPath {
+		t.Errorf("root mounts = %q, %q", got.DataMountPath, got.IndexMountPath)
+	}
+	if got := c.solverClient.Config(); got.DataMountPath != "/work" || got.IndexMountPath != DefaultIndexMountPath {
+		t.Errorf("solver mounts = %q, %q", got.DataMountPath, got.IndexMountPath)
+	}
+
+	if err := c.UpdateConfig(func(cfg *ClientConfig) { cfg.DataMountPath = "" }); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if got := c.Config().DataMountPath; got != DefaultDataMountPath {
+		t.Errorf("DataMountPath = %q, want default %q", got, DefaultDataMountPath)
+	}
+}
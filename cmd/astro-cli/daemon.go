@@ -0,0 +1,460 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	solver "github.com/DiarmuidKelly/astrometry-go-client"
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+)
+
+// listenFD is the file descriptor systemd passes a socket-activated
+// service its socket in. Tests point it at a socket of their own.
+var listenFD uintptr = 3
+
+// maxRequestBytes bounds one request line.
+const maxRequestBytes = 1 << 20
+
+// Daemon request operations.
+const (
+	opSolve    = "solve"
+	opAnalyze  = "analyze"
+	opHealth   = "health"
+	opShutdown = "shutdown"
+)
+
+// daemonRequest is one line a client sends to the daemon.
+type daemonRequest struct {
+	ID      string          `json:"id,omitempty"`
+	Op      string          `json:"op"`
+	Image   string          `json:"image,omitempty"`   // Absolute path, for solve and analyze
+	Options json.RawMessage `json:"options,omitempty"` // SolveOptions, overlaid on the defaults
+}
+
+// daemonResponse is the line the daemon answers each request with. OK is
+// false when the request failed; an unsolved image is a successful solve
+// request with Solve.Solved false.
+type daemonResponse struct {
+	ID      string         `json:"id,omitempty"`
+	OK      bool           `json:"ok"`
+	Error   string         `json:"error,omitempty"`
+	Solve   *solveOutput   `json:"solve,omitempty"`
+	Analyze *fov.ImageInfo `json:"analyze,omitempty"`
+	Health  *daemonHealth  `json:"health,omitempty"`
+}
+
+// daemonHealth is the daemon's answer to a health request.
+type daemonHealth struct {
+	InFlight      int     `json:"in_flight"`
+	MaxConcurrent int     `json:"max_concurrent"`
+	UptimeSeconds float64 `json:"uptime_seconds"`
+}
+
+// daemon serves solve requests from local clients on solver, at most
+// cap(slots) solves at a time. Shutdown stops new requests and lets the ones already
+// running finish.
+type daemon struct {
+	solver  solver.Solver
+	analyze func(imagePath string) (*fov.ImageInfo, error)
+	slots   chan struct{}
+	started time.Time
+
+	mu       sync.Mutex
+	draining bool
+	active   int
+	conns    map[net.Conn]struct{}
+	inFlight sync.WaitGroup // Requests being handled
+	connWG   sync.WaitGroup // serveConn goroutines
+	done     chan struct{}  // Closed when shutdown starts
+}
+
+// newDaemon returns a daemon running at most maxConcurrent solves at once
+// on s, usually a *Client, possibly decorated.
+func newDaemon(s solver.Solver, analyze func(string) (*fov.ImageInfo, error), maxConcurrent int) *daemon {
+	return &daemon{
+		solver:  s,
+		analyze: analyze,
+		slots:   make(chan struct{}, maxConcurrent),
+		started: time.Now(),
+		conns:   make(map[net.Conn]struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// serve accepts connections on l until shutdown, then drains. It returns
+// nil after a shutdown and the accept error otherwise.
+func (d *daemon) serve(l net.Listener) error {
+	go func() {
+		<-d.done
+		_ = l.Close() //nolint:errcheck // Unblocks Accept; nothing to do on failure
+	}()
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			select {
+			case <-d.done:
+				d.drain()
+				return nil
+			default:
+				d.shutdown()
+				d.drain()
+				return err
+			}
+		}
+		go d.serveConn(conn)
+	}
+}
+
+// serveConn answers the newline-delimited requests on conn in order until
+// the client disconnects or the daemon has drained.
+func (d *daemon) serveConn(conn net.Conn) {
+	d.mu.Lock()
+	if d.draining {
+		d.mu.Unlock()
+		_ = conn.Close() //nolint:errcheck // Refusing the connection
+		return
+	}
+	d.conns[conn] = struct{}{}
+	d.connWG.Add(1)
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		delete(d.conns, conn)
+		d.mu.Unlock()
+		_ = conn.Close() //nolint:errcheck // Connection done
+		d.connWG.Done()
+	}()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 64*1024), maxRequestBytes)
+	encoder := json.NewEncoder(conn)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var req daemonRequest
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			if encoder.Encode(&daemonResponse{Error: fmt.Sprintf("invalid request: %v", err)}) != nil {
+				return
+			}
+			continue
+		}
+		if !d.begin() {
+			if encoder.Encode(&daemonResponse{ID: req.ID, Error: "daemon is shutting down"}) != nil {
+				return
+			}
+			continue
+		}
+		// The request stays in flight until its response is written, so
+		// drain cannot close the connection before then
+		resp := d.handle(req)
+		err := encoder.Encode(resp)
+		d.inFlight.Done()
+		if req.Op == opShutdown && resp.OK {
+			d.shutdown()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// begin registers a request unless the daemon is draining.
+func (d *daemon) begin() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inFlight.Add(1)
+	return true
+}
+
+// handle runs one request.
+func (d *daemon) handle(req daemonRequest) *daemonResponse {
+	resp := &daemonResponse{ID: req.ID}
+	switch req.Op {
+	case opSolve:
+		output, err := d.handleSolve(req)
+		if err != nil {
+			resp.Error = err.Error()
+			return resp
+		}
+		resp.Solve = output
+	case opAnalyze:
+		if !filepath.IsAbs(req.Image) {
+			resp.Error = fmt.Sprintf("image path must be absolute, got %q", req.Image)
+			return resp
+		}
+		info, err := d.analyze(req.Image)
+		if err != nil {
+			resp.Error = err.Error()
+			return resp
+		}
+		resp.Analyze = info
+	case opHealth:
+		d.mu.Lock()
+		active := d.active
+		d.mu.Unlock()
+		resp.Health = &daemonHealth{
+			InFlight:      active,
+			MaxConcurrent: cap(d.slots),
+			UptimeSeconds: time.Since(d.started).Seconds(),
+		}
+	case opShutdown:
+	default:
+		resp.Error = fmt.Sprintf("unknown op %q", req.Op)
+		return resp
+	}
+	resp.OK = true
+	return resp
+}
+
+// handleSolve waits for a free slot and solves the requested image.
+func (d *daemon) handleSolve(req daemonRequest) (*solveOutput, error) {
+	if !filepath.IsAbs(req.Image) {
+		return nil, fmt.Errorf("image path must be absolute, got %q", req.Image)
+	}
+	opts := solver.DefaultSolveOptions()
+	if len(req.Options) > 0 {
+		if err := json.Unmarshal(req.Options, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	d.slots <- struct{}{}
+	d.mu.Lock()
+	d.active++
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		d.active--
+		d.mu.Unlock()
+		<-d.slots
+	}()
+
+	result, err := d.solver.Solve(context.Background(), req.Image, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, hookErr := range result.HookErrors {
+		log.Printf("warning: %s: %v", req.Image, hookErr)
+	}
+	return newSolveOutput(result), nil
+}
+
+// shutdown stops the daemon accepting requests. It can be called more than
+// once.
+func (d *daemon) shutdown() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.draining {
+		d.draining = true
+		close(d.done)
+	}
+}
+
+// drain waits for the requests in flight when shutdown started, then
+// closes the remaining idle connections.
+func (d *daemon) drain() {
+	d.inFlight.Wait()
+	d.mu.Lock()
+	for conn := range d.conns {
+		_ = conn.Close() //nolint:errcheck // Ends the idle serveConn
+	}
+	d.mu.Unlock()
+	d.connWG.Wait()
+}
+
+// daemonListener returns the listener systemd passed through socket
+// activation, or else a new Unix socket at socketPath with mode and, when
+// not empty, group ownership.
+func daemonListener(socketPath string, mode os.FileMode, group string) (net.Listener, error) {
+	if l, ok, err := inheritedListener(); ok || err != nil {
+		return l, err
+	}
+	if socketPath == "" {
+		return nil, errors.New("--socket is required unless started by systemd socket activation")
+	}
+
+	// A socket left by a daemon that crashed is replaced; a live one is not
+	if info, err := os.Lstat(socketPath); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", socketPath)
+		}
+		if conn, err := net.Dial("unix", socketPath); err == nil {
+			_ = conn.Close() //nolint:errcheck // Probe connection only
+			return nil, fmt.Errorf("another daemon is listening on %s", socketPath)
+		}
+		if err := os.Remove(socketPath); err != nil {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
+	}
+
+	l, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := setSocketAccess(socketPath, mode, group); err != nil {
+		_ = l.Close() //nolint:errcheck // Already failing
+		return nil, err
+	}
+	return l, nil
+}
+
+// setSocketAccess sets the socket's mode and, when not empty, its group,
+// given by name or ID.
+func setSocketAccess(socketPath string, mode os.FileMode, group string) error {
+	if group != "" {
+		gid, err := strconv.Atoi(group)
+		if err != nil {
+			g, lookupErr := user.LookupGroup(group)
+			if lookupErr != nil {
+				return fmt.Errorf("unknown --socket-group: %w", lookupErr)
+			}
+			if gid, err = strconv.Atoi(g.Gid); err != nil {
+				return fmt.Errorf("group %s has non-numeric ID %q", group, g.Gid)
+			}
+		}
+		if err := os.Chown(socketPath, -1, gid); err != nil {
+			return fmt.Errorf("failed to set socket group: %w", err)
+		}
+	}
+	if err := os.Chmod(socketPath, mode); err != nil {
+		return fmt.Errorf("failed to set socket mode: %w", err)
+	}
+	return nil
+}
+
+// inheritedListener returns the socket passed by systemd socket
+// activation (LISTEN_PID and LISTEN_FDS), if any. The variables are
+// cleared so child processes do not see them.
+func inheritedListener() (net.Listener, bool, error) {
+	if os.Getenv("LISTEN_PID") != strconv.Itoa(os.Getpid()) {
+		return nil, false, nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n < 1 {
+		return nil, false, nil
+	}
+	for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+		_ = os.Unsetenv(key) //nolint:errcheck // Cannot fail for valid keys
+	}
+	if n != 1 {
+		return nil, true, fmt.Errorf("socket activation passed %d sockets, want 1", n)
+	}
+
+	f := os.NewFile(listenFD, "LISTEN_FDS")
+	defer func() {
+		_ = f.Close() //nolint:errcheck // The listener holds its own copy
+	}()
+	l, err := net.FileListener(f)
+	if err != nil {
+		return nil, true, fmt.Errorf("inherited socket: %w", err)
+	}
+	return l, true, nil
+}
+
+// runDaemon implements "astro-cli daemon": it starts an exec-mode solver
+// container and serves solve requests on a Unix socket until SIGINT,
+// SIGTERM or a shutdown request. It returns the exit code.
+func runDaemon(args []string) int {
+	fs := flag.NewFlagSet("daemon", flag.ExitOnError)
+	socketPath := fs.String("socket", "", "Path of the Unix socket to listen on (required unless socket-activated)")
+	socketMode := fs.String("socket-mode", "0660", "Permissions of the socket file, in octal")
+	socketGroup := fs.String("socket-group", "", "Group, by name or ID, to own the socket file (optional)")
+	indexPath := fs.String("index-path", "", "Path to astrometry index files (required)")
+	dockerImage := fs.String("docker-image", "", "Solver image (default: "+solver.DefaultDockerImage+")")
+	containerName := fs.String("container-name", "astro-cli-daemon", "Name of the solver container")
+	existing := fs.Bool("existing-container", false, "Use an already running --container-name instead of starting one")
+	maxConcurrent := fs.Int("max-concurrent", 2, "Maximum number of solves run at once; more wait their turn")
+	_ = fs.Parse(args) //nolint:errcheck // ExitOnError exits on failure
+
+	if *indexPath == "" {
+		fmt.Fprintln(os.Stderr, "Error: --index-path is required")
+		fs.Usage()
+		return 1
+	}
+	if *maxConcurrent < 1 {
+		fmt.Fprintln(os.Stderr, "Error: --max-concurrent must be at least 1")
+		return 1
+	}
+	mode, err := strconv.ParseUint(*socketMode, 8, 32)
+	if err != nil || mode > 0o777 {
+		fmt.Fprintf(os.Stderr, "Error: invalid --socket-mode %q\n", *socketMode)
+		return 1
+	}
+
+	client, err := solver.NewClient(&solver.ClientConfig{
+		DockerImage:   *dockerImage,
+		IndexPath:     *indexPath,
+		UseDockerExec: true,
+		ContainerName: *containerName,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating client: %v\n", err)
+		return 1
+	}
+
+	l, err := daemonListener(*socketPath, os.FileMode(mode), *socketGroup)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	if !*existing {
+		log.Printf("starting container %s", *containerName)
+		err := client.StartContainer(ctx, &solver.StartOptions{
+			Progress: func(p solver.PullProgress) {
+				log.Printf("pulling image: %s", p.Line)
+			},
+		})
+		if err != nil {
+			_ = l.Close() //nolint:errcheck // Already failing
+			fmt.Fprintf(os.Stderr, "Error starting container: %v\n", err)
+			return 1
+		}
+	}
+
+	d := newDaemon(client, fov.AnalyzeImage, *maxConcurrent)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Printf("received %v, finishing solves in progress", sig)
+		d.shutdown()
+	}()
+
+	log.Printf("listening on %s", l.Addr())
+	serveErr := d.serve(l)
+	signal.Stop(signals)
+
+	code := 0
+	if serveErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", serveErr)
+		code = 1
+	}
+	if !*existing {
+		if err := client.StopContainer(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "Error stopping container: %v\n", err)
+			code = 1
+		}
+	}
+	log.Printf("daemon stopped")
+	return code
+}
@@ -0,0 +1,336 @@
+//go:build unix
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	solver "github.com/DiarmuidKelly/astrometry-go-client"
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+	"github.com/DiarmuidKelly/astrometry-go-client/solvertest"
+)
+
+// socketPair returns the two ends of a connected Unix socket pair.
+func socketPair(t *testing.T) (client, server net.Conn) {
+	t.Helper()
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatalf("socketpair failed: %v", err)
+	}
+	conns := make([]net.Conn, 2)
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		conns[i], err = net.FileConn(f)
+		_ = f.Close()
+		if err != nil {
+			t.Fatalf("FileConn failed: %v", err)
+		}
+	}
+	t.Cleanup(func() {
+		_ = conns[0].Close()
+		_ = conns[1].Close()
+	})
+	return conns[0], conns[1]
+}
+
+// daemonConn is the client end of a connection served by a daemon.
+type daemonConn struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// connect serves one end of a new socket pair with d and returns the other.
+func connect(t *testing.T, d *daemon) *daemonConn {
+	t.Helper()
+	client, server := socketPair(t)
+	go d.serveConn(server)
+	return &daemonConn{t: t, conn: client, r: bufio.NewReader(client)}
+}
+
+func (c *daemonConn) send(req *daemonRequest) *daemonResponse {
+	c.t.Helper()
+	resp, err := roundTrip(c.conn, c.r, req)
+	if err != nil {
+		c.t.Fatalf("request %+v failed: %v", req, err)
+	}
+	return resp
+}
+
+func solvedResult(ctx context.Context, imagePath string, opts *solver.SolveOptions) (*solver.Result, error) {
+	return &solver.Result{Solved: true, RA: 83.8, Dec: -5.4}, nil
+}
+
+func TestDaemon_Requests(t *testing.T) {
+	image := filepath.Join(t.TempDir(), "m42.jpg")
+	var gotOpts *solver.SolveOptions
+	d := newDaemon(solvertest.SolverFunc(func(ctx context.Context, imagePath string, opts *solver.SolveOptions) (*solver.Result, error) {
+		if imagePath != image {
+			return &solver.Result{}, nil
+		}
+		gotOpts = opts
+		return solvedResult(ctx, imagePath, opts)
+	}), fov.AnalyzeImage, 2)
+	c := connect(t, d)
+
+	sf := solveFlags{scaleLow: 1, scaleHigh: 3, scaleUnits: "degwidth", downsample: 4}
+	req, err := clientRequest(opSolve, []string{"--image", image, "--scale-low", "1", "--scale-high", "3",
+		"--scale-units", "degwidth", "--downsample", "4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.ID = "m42"
+	resp := c.send(req)
+	if !resp.OK || resp.ID != "m42" || resp.Solve == nil || !resp.Solve.Solved || resp.Solve.RA != 83.8 {
+		t.Fatalf("solve response = %+v, want solved m42", resp)
+	}
+	if want, _ := sf.options(); gotOpts.ScaleLow != want.ScaleLow || gotOpts.ScaleHigh != want.ScaleHigh ||
+		gotOpts.ScaleUnits != want.ScaleUnits || gotOpts.DownsampleFactor != want.DownsampleFactor {
+		t.Errorf("daemon solved with %+v, want the client's options %+v", gotOpts, want)
+	}
+
+	tests := []struct {
+		name    string
+		req     *daemonRequest
+		wantErr string
+	}{
+		{"health", &daemonRequest{Op: opHealth}, ""},
+		{"relative image", &daemonRequest{Op: opSolve, Image: "m42.jpg"}, "must be absolute"},
+		{"invalid options", &daemonRequest{Op: opSolve, Image: image, Options: []byte(`[1]`)}, "invalid solve options"},
+		{"analyze missing file", &daemonRequest{Op: opAnalyze, Image: image}, "failed to open image"},
+		{"unknown op", &daemonRequest{Op: "restart"}, `unknown op "restart"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := c.send(tt.req)
+			if tt.wantErr == "" {
+				if !resp.OK || resp.Error != "" {
+					t.Errorf("response = %+v, want OK", resp)
+				}
+				return
+			}
+			if resp.OK || !strings.Contains(resp.Error, tt.wantErr) {
+				t.Errorf("response = %+v, want error containing %q", resp, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := c.conn.Write([]byte("not json\n")); err != nil {
+		t.Fatal(err)
+	}
+	line, err := c.r.ReadString('\n')
+	if err != nil || !strings.Contains(line, "invalid request") {
+		t.Errorf("malformed request answered %q, %v; want invalid request", line, err)
+	}
+
+	if health := c.send(&daemonRequest{Op: opHealth}).Health; health == nil || health.MaxConcurrent != 2 || health.InFlight != 0 {
+		t.Errorf("health = %+v, want max_concurrent 2 and nothing in flight", health)
+	}
+}
+
+func TestDaemon_ConcurrencyLimit(t *testing.T) {
+	var mu sync.Mutex
+	running, peak := 0, 0
+	d := newDaemon(solvertest.SolverFunc(func(ctx context.Context, imagePath string, opts *solver.SolveOptions) (*solver.Result, error) {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return solvedResult(ctx, imagePath, opts)
+	}), fov.AnalyzeImage, 2)
+
+	image := filepath.Join(t.TempDir(), "frame.fits")
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		c := connect(t, d)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := roundTrip(c.conn, c.r, &daemonRequest{ID: strconv.Itoa(i), Op: opSolve, Image: image})
+			if err != nil || !resp.OK || !resp.Solve.Solved {
+				t.Errorf("solve %d = %+v, %v", i, resp, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if peak != 2 {
+		t.Errorf("%d solves ran at once, want the limit of 2", peak)
+	}
+}
+
+func TestDaemon_ShutdownDrains(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	d := newDaemon(solvertest.SolverFunc(func(ctx context.Context, imagePath string, opts *solver.SolveOptions) (*solver.Result, error) {
+		close(started)
+		<-release
+		return solvedResult(ctx, imagePath, opts)
+	}), fov.AnalyzeImage, 1)
+
+	solving := connect(t, d)
+	solved := make(chan *daemonResponse, 1)
+	go func() {
+		resp, err := roundTrip(solving.conn, solving.r, &daemonRequest{Op: opSolve, Image: filepath.Join(t.TempDir(), "a.jpg")})
+		if err != nil {
+			t.Errorf("in-flight solve failed: %v", err)
+		}
+		solved <- resp
+	}()
+	<-started
+
+	control := connect(t, d)
+	idle := connect(t, d)
+	if resp := control.send(&daemonRequest{Op: opShutdown}); !resp.OK {
+		t.Fatalf("shutdown response = %+v", resp)
+	}
+	if resp := control.send(&daemonRequest{Op: opHealth}); resp.OK || !strings.Contains(resp.Error, "shutting down") {
+		t.Errorf("request after shutdown = %+v, want refused", resp)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		d.drain()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		t.Fatal("drain returned with a solve in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if resp := <-solved; resp == nil || !resp.OK || !resp.Solve.Solved {
+		t.Errorf("in-flight solve = %+v, want its result", resp)
+	}
+	select {
+	case <-drained:
+	case <-time.After(5 * time.Second):
+		t.Fatal("drain did not return after the solve finished")
+	}
+	if _, err := idle.r.ReadByte(); err == nil {
+		t.Error("idle connection still open after drain")
+	}
+}
+
+func TestDaemon_Solver(t *testing.T) {
+	fake := &solvertest.Recorder{Result: &solver.Result{Solved: true, RA: 83.8, Dec: -5.4}}
+	c := connect(t, newDaemon(fake, fov.AnalyzeImage, 1))
+
+	image := filepath.Join(t.TempDir(), "m42.jpg")
+	if resp := c.send(&daemonRequest{Op: opSolve, Image: image}); !resp.OK || resp.Solve == nil || !resp.Solve.Solved {
+		t.Fatalf("solve response = %+v, want solved", resp)
+	}
+	if calls := fake.Calls(); len(calls) != 1 || calls[0].Method != "Solve" || calls[0].ImagePath != image {
+		t.Errorf("solver calls = %+v, want one Solve of %s", calls, image)
+	}
+}
+
+func TestDaemon_ServeStopsOnShutdown(t *testing.T) {
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "d.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := newDaemon(solvertest.SolverFunc(solvedResult), fov.AnalyzeImage, 1)
+	served := make(chan error, 1)
+	go func() { served <- d.serve(l) }()
+
+	conn, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if resp, err := roundTrip(conn, bufio.NewReader(conn), &daemonRequest{Op: opShutdown}); err != nil || !resp.OK {
+		t.Fatalf("shutdown = %+v, %v", resp, err)
+	}
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("serve returned %v after shutdown, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not return after shutdown")
+	}
+}
+
+func TestDaemonListener(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "d.sock")
+	l, err := daemonListener(socketPath, 0o660, "")
+	if err != nil {
+		t.Fatalf("daemonListener failed: %v", err)
+	}
+	info, err := os.Stat(socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o660 {
+		t.Errorf("socket mode = %v, want 0660", info.Mode().Perm())
+	}
+	if _, err := daemonListener(socketPath, 0o660, ""); err == nil || !strings.Contains(err.Error(), "another daemon") {
+		t.Errorf("second listener error = %v, want another daemon listening", err)
+	}
+
+	// A socket left behind by a crashed daemon is replaced
+	ul := l.(*net.UnixListener)
+	ul.SetUnlinkOnClose(false)
+	_ = l.Close()
+	l, err = daemonListener(socketPath, 0o600, "")
+	if err != nil {
+		t.Fatalf("daemonListener over a stale socket failed: %v", err)
+	}
+	_ = l.Close()
+
+	if _, err := daemonListener(socketPath, 0o660, "no-such-group-astro"); err == nil {
+		t.Error("unknown --socket-group accepted")
+	}
+}
+
+func TestDaemonListener_SocketActivation(t *testing.T) {
+	inherited, err := net.Listen("unix", filepath.Join(t.TempDir(), "activated.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inherited.Close()
+	f, err := inherited.(*net.UnixListener).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	saved := listenFD
+	listenFD = f.Fd()
+	t.Cleanup(func() { listenFD = saved })
+	t.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+	t.Setenv("LISTEN_FDS", "1")
+
+	l, err := daemonListener("", 0o660, "")
+	if err != nil {
+		t.Fatalf("daemonListener with socket activation failed: %v", err)
+	}
+	defer l.Close()
+	if l.Addr().String() != inherited.Addr().String() {
+		t.Errorf("listening on %s, want the inherited %s", l.Addr(), inherited.Addr())
+	}
+	if os.Getenv("LISTEN_FDS") != "" {
+		t.Error("LISTEN_FDS not cleared")
+	}
+
+	t.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()+1))
+	t.Setenv("LISTEN_FDS", "1")
+	if _, err := daemonListener("", 0o660, ""); err == nil || !strings.Contains(err.Error(), "--socket is required") {
+		t.Errorf("socket activation for another process: error %v, want --socket required", err)
+	}
+}
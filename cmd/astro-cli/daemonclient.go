@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// runClient implements "astro-cli client": it sends one request to a
+// running "astro-cli daemon" and prints the answer as JSON, the solve
+// result in the same form as the solve command. It returns the exit code.
+func runClient(args []string) int {
+	fs := flag.NewFlagSet("client", flag.ExitOnError)
+	socketPath := fs.String("socket", "", "Path of the daemon's Unix socket (required)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: astro-cli client --socket PATH solve|analyze|health|shutdown [flags]")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args) //nolint:errcheck // ExitOnError exits on failure
+
+	if *socketPath == "" || fs.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "Error: --socket and an operation are required")
+		fs.Usage()
+		return 1
+	}
+
+	req, err := clientRequest(fs.Arg(0), fs.Args()[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	conn, err := net.Dial("unix", *socketPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error connecting to daemon: %v\n", err)
+		return 1
+	}
+	defer func() {
+		_ = conn.Close() //nolint:errcheck // Response fully read
+	}()
+	resp, err := roundTrip(conn, bufio.NewReader(conn), req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if !resp.OK {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", resp.Error)
+		return 1
+	}
+
+	var output any = struct{}{}
+	switch {
+	case resp.Solve != nil:
+		output = resp.Solve
+	case resp.Analyze != nil:
+		output = resp.Analyze
+	case resp.Health != nil:
+		output = resp.Health
+	}
+	if err := writeJSON(os.Stdout, output); err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding JSON: %v\n", err)
+		return 1
+	}
+	if resp.Solve != nil && !resp.Solve.Solved {
+		return 1
+	}
+	return 0
+}
+
+// clientRequest builds the request for op from its flags.
+func clientRequest(op string, args []string) (*daemonRequest, error) {
+	fs := flag.NewFlagSet(op, flag.ExitOnError)
+	var imagePath *string
+	var sf solveFlags
+	switch op {
+	case opSolve:
+		imagePath = fs.String("image", "", "Path to the image file to solve (required)")
+		sf.register(fs)
+	case opAnalyze:
+		imagePath = fs.String("image", "", "Path to the image file to analyze (required)")
+	case opHealth, opShutdown:
+	default:
+		return nil, fmt.Errorf("unknown operation %q; want solve, analyze, health or shutdown", op)
+	}
+	_ = fs.Parse(args) //nolint:errcheck // ExitOnError exits on failure
+
+	req := &daemonRequest{Op: op}
+	if imagePath != nil {
+		if *imagePath == "" {
+			return nil, errors.New("--image is required")
+		}
+		// The daemon runs in another directory
+		abs, err := filepath.Abs(*imagePath)
+		if err != nil {
+			return nil, err
+		}
+		req.Image = abs
+	}
+	if op == opSolve {
+		opts, err := sf.options()
+		if err != nil {
+			return nil, err
+		}
+		if req.Options, err = json.Marshal(opts); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// roundTrip sends req to w and reads the daemon's response from r.
+func roundTrip(w io.Writer, r *bufio.Reader, req *daemonRequest) (*daemonResponse, error) {
+	if err := json.NewEncoder(w).Encode(req); err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	var resp daemonResponse
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	solver "github.com/DiarmuidKelly/astrometry-go-client"
+)
+
+// runDiagnose implements "astro-cli diagnose", writing a diagnostics bundle
+// for a support request. It returns the exit code.
+func runDiagnose(args []string) int {
+	fs := flag.NewFlagSet("diagnose", flag.ExitOnError)
+	imagePath := fs.String("image", "", "Path to the image file that does not solve (required)")
+	indexPath := fs.String("index-path", "", "Path to astrometry index files (required)")
+	out := fs.String("out", "diagnostics.zip", "Path of the zip bundle to write")
+	scaleLow := fs.Float64("scale-low", 0, "Lower bound of image scale")
+	scaleHigh := fs.Float64("scale-high", 0, "Upper bound of image scale")
+	scaleUnits := fs.String("scale-units", "arcminwidth", "Units for scale (degwidth, arcminwidth, arcsecperpix)")
+	downsample := fs.Int("downsample", 2, "Downsample factor")
+	includeImage := fs.Bool("include-image", false, "Include the image itself in the bundle")
+	keepHomePaths := fs.Bool("keep-home-paths", false, "Do not replace the home directory with ~ in the bundle")
+	_ = fs.Parse(args) //nolint:errcheck // ExitOnError exits on failure
+
+	if *imagePath == "" || *indexPath == "" {
+		fmt.Fprintln(os.Stderr, "Error: --image and --index-path are required")
+		fs.Usage()
+		return 1
+	}
+
+	client, err := solver.NewClient(&solver.ClientConfig{IndexPath: *indexPath})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating client: %v\n", err)
+		return 1
+	}
+
+	opts := solver.DefaultSolveOptions()
+	opts.ScaleLow = *scaleLow
+	opts.ScaleHigh = *scaleHigh
+	opts.ScaleUnits = solver.ScaleUnits(*scaleUnits)
+	opts.DownsampleFactor = *downsample
+
+	f, err := os.Create(*out)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating bundle: %v\n", err)
+		return 1
+	}
+	err = client.DiagnosticsBundle(context.Background(), *imagePath, opts, f, &solver.DiagnosticsOptions{
+		IncludeImage:  *includeImage,
+		KeepHomePaths: *keepHomePaths,
+	})
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing bundle: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %s\n", *out)
+	return 0
+}
@@ -4,27 +4,42 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	solver "github.com/DiarmuidKelly/astrometry-go-client"
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
 )
 
 const version = "0.1.0"
 
+// sensorScaleMargin widens the scale range computed from --sensor and
+// --focal-length, as fov.AnalyzeImage does.
+const sensorScaleMargin = 1.2
+
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diagnose":
+			os.Exit(runDiagnose(os.Args[2:]))
+		case "daemon":
+			os.Exit(runDaemon(os.Args[2:]))
+		case "client":
+			os.Exit(runClient(os.Args[2:]))
+		}
+	}
+
 	// Define flags
 	imagePath := flag.String("image", "", "Path to the image file to solve (required)")
 	indexPath := flag.String("index-path", "", "Path to astrometry index files (required)")
-	scaleLow := flag.Float64("scale-low", 0, "Lower bound of image scale")
-	scaleHigh := flag.Float64("scale-high", 0, "Upper bound of image scale")
-	scaleUnits := flag.String("scale-units", "arcminwidth", "Units for scale (degwidth, arcminwidth, arcsecperpix)")
-	downsample := flag.Int("downsample", 2, "Downsample factor")
-	ra := flag.Float64("ra", 0, "RA hint in degrees (optional)")
-	dec := flag.Float64("dec", 0, "Dec hint in degrees (optional)")
-	radius := flag.Float64("radius", 0, "Search radius in degrees (optional)")
-	verbose := flag.Bool("verbose", false, "Enable verbose output")
+	var sf solveFlags
+	sf.register(flag.CommandLine)
+	postCopyDir := flag.String("post-copy-dir", "", "Copy solve output files to this directory after a successful solve (optional)")
+	postWebhook := flag.String("post-webhook", "", "POST the result as JSON to this URL after a successful solve (optional)")
 	showVersion := flag.Bool("version", false, "Show version")
 
 	flag.Parse()
@@ -47,10 +62,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	opts, err := sf.options()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Create client config
 	config := &solver.ClientConfig{
 		IndexPath: *indexPath,
 	}
+	if *postCopyDir != "" {
+		config.PostSolveHooks = append(config.PostSolveHooks,
+			solver.NamedHook{Name: "post-copy-dir", Hook: solver.CopyArtifactsHook(*postCopyDir)})
+	}
+	if *postWebhook != "" {
+		config.PostSolveHooks = append(config.PostSolveHooks,
+			solver.NamedHook{Name: "post-webhook", Hook: solver.WebhookHook(*postWebhook)})
+	}
 
 	client, err := solver.NewClient(config)
 	if err != nil {
@@ -58,17 +87,6 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Create solve options
-	opts := solver.DefaultSolveOptions()
-	opts.ScaleLow = *scaleLow
-	opts.ScaleHigh = *scaleHigh
-	opts.ScaleUnits = *scaleUnits
-	opts.DownsampleFactor = *downsample
-	opts.RA = *ra
-	opts.Dec = *dec
-	opts.Radius = *radius
-	opts.Verbose = *verbose
-
 	// Solve the image
 	ctx := context.Background()
 	result, err := client.Solve(ctx, *imagePath, opts)
@@ -77,19 +95,94 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Output result as JSON
-	output := struct {
-		Solved      bool              `json:"solved"`
-		RA          float64           `json:"ra,omitempty"`
-		Dec         float64           `json:"dec,omitempty"`
-		PixelScale  float64           `json:"pixel_scale,omitempty"`
-		Rotation    float64           `json:"rotation,omitempty"`
-		FieldWidth  float64           `json:"field_width,omitempty"`
-		FieldHeight float64           `json:"field_height,omitempty"`
-		SolveTime   float64           `json:"solve_time,omitempty"`
-		OutputFiles []string          `json:"output_files,omitempty"`
-		WCSHeader   map[string]string `json:"wcs_header,omitempty"`
-	}{
+	for _, hookErr := range result.HookErrors {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", hookErr)
+	}
+
+	if err := writeJSON(os.Stdout, newSolveOutput(result)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding JSON: %v\n", err)
+		os.Exit(1)
+	}
+
+	if !result.Solved {
+		os.Exit(1)
+	}
+}
+
+// solveFlags are the solve option flags shared by the solve command and
+// "astro-cli client solve".
+type solveFlags struct {
+	scaleLow, scaleHigh float64
+	scaleUnits          string
+	sensorName          string
+	focalLength         float64
+	downsample          int
+	ra, dec, radius     float64
+	verbose             bool
+}
+
+// register defines the flags on fs.
+func (sf *solveFlags) register(fs *flag.FlagSet) {
+	fs.Float64Var(&sf.scaleLow, "scale-low", 0, "Lower bound of image scale")
+	fs.Float64Var(&sf.scaleHigh, "scale-high", 0, "Upper bound of image scale")
+	fs.StringVar(&sf.scaleUnits, "scale-units", "arcminwidth", "Units for scale (degwidth, arcminwidth, arcsecperpix)")
+	fs.StringVar(&sf.sensorName, "sensor", "", "Sensor preset (e.g. full-frame, apsc-canon, aps-h, gfx, m43, 1/1.8\"); with --focal-length sets the scale range when --scale-low/--scale-high are not given")
+	fs.Float64Var(&sf.focalLength, "focal-length", 0, "Lens focal length in mm, used with --sensor")
+	fs.IntVar(&sf.downsample, "downsample", 2, "Downsample factor")
+	fs.Float64Var(&sf.ra, "ra", 0, "RA hint in degrees (optional)")
+	fs.Float64Var(&sf.dec, "dec", 0, "Dec hint in degrees (optional)")
+	fs.Float64Var(&sf.radius, "radius", 0, "Search radius in degrees (optional)")
+	fs.BoolVar(&sf.verbose, "verbose", false, "Enable verbose output")
+}
+
+// options returns the solve options the flags describe, deriving the scale
+// range from --sensor and --focal-length when no scale bounds are given.
+func (sf *solveFlags) options() (*solver.SolveOptions, error) {
+	scaleLow, scaleHigh := sf.scaleLow, sf.scaleHigh
+	if sf.sensorName != "" && scaleLow == 0 && scaleHigh == 0 {
+		sensor, ok := fov.SensorByName(sf.sensorName)
+		if !ok {
+			return nil, fmt.Errorf("unknown --sensor %q; known sensors: %s", sf.sensorName, strings.Join(fov.SensorNames(), ", "))
+		}
+		if sf.focalLength <= 0 {
+			return nil, errors.New("--sensor requires --focal-length")
+		}
+		low, high, err := fov.CalculateFOV(sf.focalLength, sensor).SolveScaleBounds(sensorScaleMargin, sf.scaleUnits)
+		if err != nil {
+			return nil, err
+		}
+		scaleLow, scaleHigh = low, high
+	}
+
+	opts := solver.DefaultSolveOptions()
+	opts.ScaleLow = scaleLow
+	opts.ScaleHigh = scaleHigh
+	opts.ScaleUnits = solver.ScaleUnits(sf.scaleUnits)
+	opts.DownsampleFactor = sf.downsample
+	opts.RA = sf.ra
+	opts.Dec = sf.dec
+	opts.Radius = sf.radius
+	opts.Verbose = sf.verbose
+	return opts, nil
+}
+
+// solveOutput is the JSON astro-cli prints for a solve.
+type solveOutput struct {
+	Solved      bool              `json:"solved"`
+	RA          float64           `json:"ra,omitempty"`
+	Dec         float64           `json:"dec,omitempty"`
+	PixelScale  float64           `json:"pixel_scale,omitempty"`
+	Rotation    float64           `json:"rotation,omitempty"`
+	FieldWidth  float64           `json:"field_width,omitempty"`
+	FieldHeight float64           `json:"field_height,omitempty"`
+	SolveTime   float64           `json:"solve_time,omitempty"`
+	OutputFiles []string          `json:"output_files,omitempty"`
+	WCSHeader   map[string]string `json:"wcs_header,omitempty"`
+}
+
+// newSolveOutput returns the printed form of result.
+func newSolveOutput(result *solver.Result) *solveOutput {
+	return &solveOutput{
 		Solved:      result.Solved,
 		RA:          result.RA,
 		Dec:         result.Dec,
@@ -97,19 +190,15 @@ func main() {
 		Rotation:    result.Rotation,
 		FieldWidth:  result.FieldWidth,
 		FieldHeight: result.FieldHeight,
-		SolveTime:   result.SolveTime,
+		SolveTime:   result.TotalTime,
 		OutputFiles: result.OutputFiles,
 		WCSHeader:   result.WCSHeader,
 	}
+}
 
-	encoder := json.NewEncoder(os.Stdout)
+// writeJSON writes v to w as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
 	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(output); err != nil {
-		fmt.Fprintf(os.Stderr, "Error encoding JSON: %v\n", err)
-		os.Exit(1)
-	}
-
-	if !result.Solved {
-		os.Exit(1)
-	}
+	return encoder.Encode(v)
 }
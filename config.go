@@ -1,11 +1,26 @@
 package client
 
-import "time"
+import (
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
 
 const (
 	// DefaultDockerImage is the default Docker image used for plate-solving
 	// Compatible images: "dm90/astrometry", "diarmuidk/astrometry-dockerised-solver", "ghcr.io/diarmuidkelly/astrometry-dockerised-solver"
 	DefaultDockerImage = "diarmuidk/astrometry-dockerised-solver"
+
+	// DefaultMaxOutputBytes is the default cap on captured solver output.
+	DefaultMaxOutputBytes = solver.DefaultMaxOutputBytes
+
+	// DefaultDataMountPath is where docker run mounts the working directory
+	// in the container.
+	DefaultDataMountPath = solver.DefaultDataMountPath
+
+	// DefaultIndexMountPath is where docker run mounts IndexPath in the
+	// container.
+	DefaultIndexMountPath = solver.DefaultIndexMountPath
 )
 
 // ClientConfig holds configuration for the Astrometry client.
@@ -15,11 +30,31 @@ type ClientConfig struct {
 	// Default: "diarmuidk/astrometry-dockerised-solver"
 	DockerImage string
 
+	// FallbackImages are solver images Solve tries in turn, in run mode,
+	// when DockerImage finds no solution or fails with ErrDockerFailed, as a
+	// workaround for a bug in one image version. The first image to solve
+	// wins and is recorded in Result.DockerImage. Ignored with
+	// UseDockerExec, whose container is fixed.
+	// Default: nil (DockerImage only)
+	FallbackImages []string
+
 	// IndexPath is the host path to the astrometry index files.
 	// This directory will be mounted into the Docker container.
-	// Required.
+	// Required unless IndexPaths is set.
 	IndexPath string
 
+	// IndexPaths lists further index directories, such as the 4100 and
+	// 5200 series kept apart, searched along with IndexPath. The first
+	// directory is mounted at IndexMountPath and the others at
+	// IndexMountPath-2, -3 and so on. With more than one directory,
+	// solve-field runs with a generated config listing every mount in
+	// place of the image's astrometry.cfg, so settings made there, such
+	// as inparallel or cpulimit, no longer apply; ArgTemplate command
+	// lines do not get it. In exec mode the container must mount them at
+	// those paths, as StartContainer does.
+	// Default: nil (IndexPath only)
+	IndexPaths []string
+
 	// TempDir is the working directory for images and output files.
 	// If empty, the system temporary directory will be used.
 	TempDir string
@@ -30,13 +65,148 @@ type ClientConfig struct {
 
 	// UseDockerExec enables using docker exec on an existing container
 	// instead of spawning new containers with docker run.
-	// When true, ContainerName must be specified.
+	// When true, ContainerName must be specified; NewClient returns
+	// ErrInvalidInput otherwise.
 	// Default: false
 	UseDockerExec bool
 
 	// ContainerName is the name of the running container to exec commands in.
 	// Only used when UseDockerExec is true.
 	ContainerName string
+
+	// WarmPoolSize, in run mode, keeps up to this many solver containers
+	// idling for Solve to docker exec into, saving docker run's start-up
+	// cost on each solve. Containers are started by WarmPool or on first
+	// use, mount IndexPath, TempDir and ScratchDirs like StartContainer,
+	// and are removed by Close. A solve that finds every container leased
+	// runs in a plain docker run container. Only the DockerImage attempt of
+	// Solve uses the pool. Ignored with UseDockerExec.
+	// Default: 0 (no pool)
+	WarmPoolSize int
+
+	// WarmPoolMaxUses replaces a pooled container after this many solves.
+	// A container is also replaced after a solve that timed out, was
+	// cancelled or failed with ErrDockerFailed.
+	// Default: 0 (no limit)
+	WarmPoolMaxUses int
+
+	// WarmPoolIdleTimeout removes pooled containers left idle this long.
+	// Default: 0 (kept until Close)
+	WarmPoolIdleTimeout time.Duration
+
+	// DataMountPath and IndexMountPath are the container paths docker run
+	// mounts the working directory and the first index directory at, for
+	// forks of the solver image with a different layout. IndexMountPath
+	// must be searched by the image's astrometry.cfg.
+	// Only used when UseDockerExec is false, except that StartContainer
+	// also mounts the index directories there.
+	// Default: "/data" and "/usr/local/astrometry/data"
+	DataMountPath  string
+	IndexMountPath string
+
+	// ContainerLogLevel filters StreamContainerLogs output to lines that
+	// start with this prefix. Only used when UseDockerExec is true.
+	// Default: "" (all lines)
+	ContainerLogLevel string
+
+	// Extractor replaces solve-field's built-in star detection in Solve
+	// with your own, e.g. &ThresholdExtractor{} or a wrapper around
+	// source-extractor. Result.Extractor records which one ran. It cannot
+	// be combined with ArgTemplate.
+	// Default: nil (solve-field detects stars with simplexy)
+	Extractor Extractor
+
+	// ArgTemplate replaces the generated solve-field command line with
+	// the caller's own, e.g.
+	//
+	//	[]string{"solve-field", "-L", "{scale_low}", "-H", "{scale_high}",
+	//		"-u", "{scale_units}", "--cpulimit", "30", "--dir", "{dir}", "{image}"}
+	//
+	// Placeholders: {image} (required), {dir}, {scale_low}, {scale_high},
+	// {scale_units}, {downsample}, {depth_low}, {depth_high}, {ra}, {dec}
+	// and {radius}, filled from the working directory and SolveOptions.
+	// Other SolveOptions fields are ignored, and SolveXYList does not use
+	// the template.
+	// Default: nil (command line built from SolveOptions)
+	ArgTemplate []string
+
+	// ReuseWorkDir makes every operation in exec mode share a single
+	// working directory, isolating calls by filename prefix instead of a
+	// directory per call, to avoid mkdir/rmdir churn on network volumes.
+	// Files are only isolated by name, so nothing else may write into the
+	// shared directory, and leftovers from crashed runs must be cleaned up
+	// by hand.
+	// Only used when UseDockerExec is true.
+	// Default: false
+	ReuseWorkDir bool
+
+	// AllowContainerRead makes staged images world-readable and workspace
+	// directories searchable by everyone, for containers running as a
+	// different UID (e.g. user-namespace remapped Docker). By default
+	// staged files are at most 0600 and workspaces 0700.
+	// Default: false
+	AllowContainerRead bool
+
+	// ScratchDirs lists fast local directories for per-solve workspaces,
+	// tried in order. A directory is skipped when its free space is below
+	// ScratchMinFreeBytes (or twice the image size, whichever is larger).
+	// If none qualify, solving fails with ErrNoScratchSpace.
+	// When empty, TempDir is used without a free-space check.
+	ScratchDirs []string
+
+	// ScratchMinFreeBytes is the minimum free space required in a scratch directory.
+	// Default: twice the size of the image being solved
+	ScratchMinFreeBytes uint64
+
+	// ValidateIndexes makes NewClient check that every index directory
+	// (IndexPath and IndexPaths) contains at least one index-*.fits file,
+	// failing early with ErrInvalidInput instead of deep inside the first
+	// solve.
+	// Default: false
+	ValidateIndexes bool
+
+	// Metrics receives the duration and outcome of every Solve call.
+	// See Metrics for wiring it to Prometheus.
+	// Default: nil (no metrics are recorded)
+	Metrics Metrics
+
+	// MaxOutputBytes caps how much solver output is kept in memory and in
+	// Result.RawOutput. Beyond it, the start and end of the output are kept
+	// with a truncation marker in between.
+	// Default: 4 MiB
+	MaxOutputBytes int
+
+	// PostSolveHooks run in order after every successful Solve, such as
+	// CopyArtifactsHook or WebhookHook. Wrap a hook in NamedHook to name it,
+	// set its timeout or also run it for unsolved results. Hook failures are
+	// recorded in Result.HookErrors and never fail the solve.
+	// Default: nil (no hooks)
+	PostSolveHooks []Hook
+
+	// AllowedFormats restricts Solve and SolveBytes to these image formats,
+	// such as []string{"jpg", "png", "fits"}, detected from the contents
+	// rather than the name. Other images fail with ErrInvalidInput before
+	// any work is done. Supported: jpg, png, tiff, gif, bmp and fits.
+	// Default: nil (any format)
+	AllowedFormats []string
+
+	// MaxImageBytes rejects larger image files with ErrInvalidInput before
+	// solving. For compressed FITS it limits the compressed file.
+	// Default: 0 (no limit)
+	MaxImageBytes int64
+
+	// MaxImagePixels rejects images with more pixels (width × height) with
+	// ErrInvalidInput before solving, read from the header without decoding.
+	// Images whose dimensions cannot be read are rejected while it is set.
+	// Default: 0 (no limit)
+	MaxImagePixels int64
+
+	// MaxFieldDegrees is the widest field solved whole. Locate returns
+	// ErrFieldTooWide for fisheye/all-sky images or EXIF fields wider than
+	// this, and Solve for scale bounds that only search wider fields. Use
+	// SolveCentralCrop for such images.
+	// Default: 120
+	MaxFieldDegrees float64
 }
 
 // DefaultClientConfig returns a ClientConfig with sensible defaults.
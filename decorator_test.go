@@ -0,0 +1,70 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/DiarmuidKelly/astrometry-go-client"
+	"github.com/DiarmuidKelly/astrometry-go-client/solvertest"
+)
+
+// timedSolver is an example decorator: it logs the duration and outcome of
+// every solve and forwards everything else to the wrapped Solver.
+type timedSolver struct {
+	client.Solver
+	log *log.Logger
+}
+
+func (s timedSolver) Solve(ctx context.Context, imagePath string, opts *client.SolveOptions) (*client.Result, error) {
+	start := time.Now()
+	result, err := s.Solver.Solve(ctx, imagePath, opts)
+	s.log.Printf("solve %s: solved=%v err=%v in %v", imagePath, err == nil && result.Solved, err, time.Since(start))
+	return result, err
+}
+
+func TestSolverDecorator(t *testing.T) {
+	fake := &solvertest.Recorder{Result: &client.Result{Solved: true, RA: 83.8, Dec: -5.4}}
+	var logs bytes.Buffer
+	var s client.Solver = timedSolver{Solver: fake, log: log.New(&logs, "", 0)}
+
+	// The decorator composes with anything that takes a Solver
+	q := client.NewPriorityQueue(s, 1)
+	resp := <-q.Submit(context.Background(), client.SolveRequest{ImagePath: "m42.jpg"})
+	q.Close()
+	if resp.Err != nil || !resp.Result.Solved || resp.Result.RA != 83.8 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if !strings.Contains(logs.String(), "solve m42.jpg: solved=true err=<nil>") {
+		t.Errorf("decorator did not log the solve: %q", logs.String())
+	}
+
+	// Methods the decorator does not override are forwarded
+	if _, err := s.ExtractSources(context.Background(), "m42.jpg", nil); err != nil {
+		t.Fatalf("ExtractSources failed: %v", err)
+	}
+	calls := fake.Calls()
+	if len(calls) != 2 || calls[0].Method != "Solve" || calls[1].Method != "ExtractSources" {
+		t.Errorf("unexpected calls: %+v", calls)
+	}
+}
+
+func TestSolverDecorator_Error(t *testing.T) {
+	var logs bytes.Buffer
+	fake := solvertest.SolverFunc(func(ctx context.Context, imagePath string, opts *client.SolveOptions) (*client.Result, error) {
+		return nil, fmt.Errorf("%w: docker not running", client.ErrDockerFailed)
+	})
+	s := timedSolver{Solver: fake, log: log.New(&logs, "", 0)}
+
+	if _, err := s.Solve(context.Background(), "frame.fits", nil); !errors.Is(err, client.ErrDockerFailed) {
+		t.Fatalf("expected ErrDockerFailed, got %v", err)
+	}
+	if !strings.Contains(logs.String(), "solved=false err=docker command failed") {
+		t.Errorf("decorator did not log the failure: %q", logs.String())
+	}
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"io"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
+
+// DiagnosticsOptions controls what DiagnosticsBundle writes.
+type DiagnosticsOptions = solver.DiagnosticsOptions
+
+// DiagnosticsBundle solves imagePath with verbose output and writes a zip
+// archive to w for attaching to support requests: the sanitized config and
+// options, every command run and its output, the WCS and other small output
+// files, the index directory listing, the image's EXIF/FOV analysis, and
+// the docker, solve-field and host versions. The image itself is only
+// included with diag.IncludeImage, and the home directory is replaced with
+// "~" unless diag.KeepHomePaths. diag may be nil for the defaults.
+//
+// A solve that fails is recorded in the bundle rather than returned.
+//
+// Example:
+//
+//	f, err := os.Create("bundle.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer f.Close()
+//	if err := c.DiagnosticsBundle(ctx, "IMG_2820.JPG", opts, f, nil); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) DiagnosticsBundle(ctx context.Context, imagePath string, opts *SolveOptions, w io.Writer, diag *DiagnosticsOptions) error {
+	bundle := DiagnosticsOptions{}
+	if diag != nil {
+		bundle = *diag
+	}
+	extra := map[string]string{}
+	for name, text := range bundle.Extra {
+		extra[name] = text
+	}
+	if info, err := fov.AnalyzeImage(imagePath); err != nil {
+		extra["image-analysis.txt"] = "analysis failed: " + err.Error() + "\n"
+	} else {
+		extra["image-analysis.txt"] = info.String() + "\n"
+	}
+	bundle.Extra = extra
+	return c.solverClient.DiagnosticsBundle(ctx, imagePath, opts, w, &bundle)
+}
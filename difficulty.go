@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+)
+
+const (
+	// minSolvableSources is the fewest detected stars solve-field can
+	// realistically build enough quads from.
+	minSolvableSources = 10
+
+	// comfortableSources is the star count above which source count is
+	// no longer a concern.
+	comfortableSources = 30
+
+	// minStarDensity is the star density (per megapixel) below which
+	// detection is likely being swamped by noise, cloud or trailing.
+	minStarDensity = 1.0
+)
+
+// Difficulty is a quick pre-solve assessment of how likely an image is to solve.
+type Difficulty struct {
+	// Score ranges from 0 (hopeless) to 1 (no problems found).
+	Score float64
+
+	// SourceCount is the number of stars detected by image2xy.
+	SourceCount int
+
+	// StarDensity is the number of detected stars per megapixel.
+	StarDensity float64
+
+	// Reasons describes each factor that lowered the score.
+	Reasons []string
+}
+
+// Solvable reports whether the image is worth submitting to the solver.
+func (d Difficulty) Solvable() bool {
+	return d.Score >= 0.5
+}
+
+// EstimateDifficulty runs a fast source extraction and estimates whether the
+// image is likely to solve with the given options, without running a full solve.
+//
+// The estimate considers the number and density of detected stars and whether
+// the scale bounds in opts fall within the FOV range of the installed indexes.
+// Pipelines can use Solvable to skip hopeless frames.
+func (c *Client) EstimateDifficulty(ctx context.Context, imagePath string, opts *SolveOptions) (Difficulty, error) {
+	if opts == nil {
+		opts = DefaultSolveOptions()
+	}
+
+	list, err := c.ExtractSources(ctx, imagePath, nil)
+	if err != nil {
+		return Difficulty{}, err
+	}
+
+	config := c.Config()
+	var installed []string
+	for _, dir := range append([]string{config.IndexPath}, config.IndexPaths...) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return Difficulty{}, fmt.Errorf("failed to list index directory: %w", err)
+		}
+		for _, entry := range entries {
+			if match, _ := filepath.Match("index-*.fits", entry.Name()); match {
+				installed = append(installed, entry.Name())
+			}
+		}
+	}
+
+	return assessDifficulty(len(list.Sources), list.ImageWidth, list.ImageHeight, opts, installed), nil
+}
+
+// assessDifficulty scores a frame from its source count, dimensions, scale
+// bounds and installed index filenames.
+func assessDifficulty(sourceCount, width, height int, opts *SolveOptions, installed []string) Difficulty {
+	d := Difficulty{Score: 1.0, SourceCount: sourceCount}
+
+	// Source count
+	switch {
+	case sourceCount < minSolvableSources:
+		d.Score *= 0.1
+		d.Reasons = append(d.Reasons, fmt.Sprintf("too few stars: %d detected, need at least %d", sourceCount, minSolvableSources))
+	case sourceCount < comfortableSources:
+		d.Score *= 0.6
+		d.Reasons = append(d.Reasons, fmt.Sprintf("few stars: %d detected", sourceCount))
+	}
+
+	// Star density
+	if width > 0 && height > 0 {
+		megapixels := float64(width) * float64(height) / 1e6
+		d.StarDensity = float64(sourceCount) / megapixels
+		if sourceCount >= minSolvableSources && d.StarDensity < minStarDensity {
+			d.Score *= 0.8
+			d.Reasons = append(d.Reasons, fmt.Sprintf("low star density: %.2f stars per megapixel", d.StarDensity))
+		}
+	}
+
+	// Index coverage
+	if len(installed) == 0 {
+		d.Score = 0
+		d.Reasons = append(d.Reasons, "no index files found in index directory")
+		return d
+	}
+
+	low, high, ok := fieldWidthRange(opts, width)
+	if !ok {
+		d.Score *= 0.8
+		d.Reasons = append(d.Reasons, "no scale bounds given: blind solve across all indexes will be slow")
+		return d
+	}
+
+	var known []fov.IndexFile
+	for _, name := range installed {
+		for _, idx := range fov.IndexCatalog() {
+			if strings.HasPrefix(name, idx.Name) {
+				known = append(known, idx)
+				break
+			}
+		}
+	}
+	if len(known) == 0 {
+		// Coverage of unrecognised index series can't be assessed
+		return d
+	}
+
+	minFOV, maxFOV := known[0].MinFOV, known[0].MaxFOV
+	for _, idx := range known {
+		if idx.MaxFOV >= low && idx.MinFOV <= high {
+			return d
+		}
+		minFOV = min(minFOV, idx.MinFOV)
+		maxFOV = max(maxFOV, idx.MaxFOV)
+	}
+	d.Score *= 0.1
+	d.Reasons = append(d.Reasons, fmt.Sprintf("field width %.2f°-%.2f° not covered by installed indexes (%.2f°-%.2f°)",
+		low, high, minFOV, maxFOV))
+	return d
+}
+
+// fieldWidthRange converts the scale bounds in opts to a field width range
+// in degrees. arcsecperpix bounds need the image width to convert.
+func fieldWidthRange(opts *SolveOptions, width int) (low, high float64, ok bool) {
+	if opts.ScaleLow <= 0 || opts.ScaleHigh <= 0 {
+		return 0, 0, false
+	}
+	switch opts.ScaleUnits {
+	case "degwidth":
+		return opts.ScaleLow, opts.ScaleHigh, true
+	case "arcminwidth":
+		return opts.ScaleLow / 60, opts.ScaleHigh / 60, true
+	case "arcsecperpix":
+		if width <= 0 {
+			return 0, 0, false
+		}
+		return opts.ScaleLow * float64(width) / 3600, opts.ScaleHigh * float64(width) / 3600, true
+	}
+	return 0, 0, false
+}
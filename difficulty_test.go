@@ -0,0 +1,132 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAssessDifficulty(t *testing.T) {
+	scaled := &SolveOptions{ScaleLow: 180, ScaleHigh: 240, ScaleUnits: "arcminwidth"} // 3-4°
+	indexes := []string{"index-4110.fits", "index-4111.fits"}
+
+	tests := []struct {
+		name         string
+		sources      int
+		width        int
+		height       int
+		opts         *SolveOptions
+		installed    []string
+		wantSolvable bool
+		wantReason   string
+	}{
+		{
+			name:         "plenty of stars and covered FOV",
+			sources:      400,
+			width:        6000,
+			height:       4000,
+			opts:         scaled,
+			installed:    indexes,
+			wantSolvable: true,
+		},
+		{
+			name:         "too few stars",
+			sources:      4,
+			width:        6000,
+			height:       4000,
+			opts:         scaled,
+			installed:    indexes,
+			wantSolvable: false,
+			wantReason:   "too few stars",
+		},
+		{
+			name:         "few stars is still worth trying",
+			sources:      20,
+			width:        1000,
+			height:       1000,
+			opts:         scaled,
+			installed:    indexes,
+			wantSolvable: true,
+			wantReason:   "few stars",
+		},
+		{
+			name:         "FOV not covered by indexes",
+			sources:      400,
+			width:        6000,
+			height:       4000,
+			opts:         &SolveOptions{ScaleLow: 20, ScaleHigh: 30, ScaleUnits: "degwidth"},
+			installed:    indexes,
+			wantSolvable: false,
+			wantReason:   "not covered by installed indexes",
+		},
+		{
+			name:         "arcsecperpix converted using image width",
+			sources:      400,
+			width:        3000,
+			height:       2000,
+			opts:         &SolveOptions{ScaleLow: 4, ScaleHigh: 5, ScaleUnits: "arcsecperpix"}, // 3.3-4.2°
+			installed:    indexes,
+			wantSolvable: true,
+		},
+		{
+			name:         "no index files",
+			sources:      400,
+			width:        6000,
+			height:       4000,
+			opts:         scaled,
+			installed:    nil,
+			wantSolvable: false,
+			wantReason:   "no index files",
+		},
+		{
+			name:         "blind solve",
+			sources:      400,
+			width:        6000,
+			height:       4000,
+			opts:         DefaultSolveOptions(),
+			installed:    indexes,
+			wantSolvable: true,
+			wantReason:   "no scale bounds",
+		},
+		{
+			name:         "unknown index series skips coverage check",
+			sources:      400,
+			width:        6000,
+			height:       4000,
+			opts:         &SolveOptions{ScaleLow: 20, ScaleHigh: 30, ScaleUnits: "degwidth"},
+			installed:    []string{"index-5206-00.fits"},
+			wantSolvable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := assessDifficulty(tt.sources, tt.width, tt.height, tt.opts, tt.installed)
+
+			if d.Solvable() != tt.wantSolvable {
+				t.Errorf("Solvable() = %v (score %.2f, reasons %v), want %v",
+					d.Solvable(), d.Score, d.Reasons, tt.wantSolvable)
+			}
+			if d.SourceCount != tt.sources {
+				t.Errorf("SourceCount = %d, want %d", d.SourceCount, tt.sources)
+			}
+
+			joined := strings.Join(d.Reasons, "; ")
+			if tt.wantReason == "" && len(d.Reasons) != 0 {
+				t.Errorf("expected no reasons, got %v", d.Reasons)
+			}
+			if tt.wantReason != "" && !strings.Contains(joined, tt.wantReason) {
+				t.Errorf("reasons %q do not mention %q", joined, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestAssessDifficulty_StarDensity(t *testing.T) {
+	d := assessDifficulty(12, 6000, 4000, DefaultSolveOptions(), []string{"index-4110.fits"})
+	if d.StarDensity != 0.5 {
+		t.Errorf("StarDensity = %.2f, want 0.50", d.StarDensity)
+	}
+	if !strings.Contains(strings.Join(d.Reasons, "; "), "low star density") {
+		t.Errorf("expected low star density reason, got %v", d.Reasons)
+	}
+}
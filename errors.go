@@ -1,21 +1,55 @@
 // Package client provides a unified Go client for astrometry.net operations
 package client
 
-import "errors"
+import "github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
 
+// Errors are shared with the internal solver so errors.Is works on any
+// error returned by the client.
 var (
 	// ErrNoSolution indicates that astrometry.net could not solve the image.
-	ErrNoSolution = errors.New("no solution found")
+	ErrNoSolution = solver.ErrNoSolution
 
 	// ErrTimeout indicates that the solve operation exceeded the timeout.
-	ErrTimeout = errors.New("solve operation timed out")
+	ErrTimeout = solver.ErrTimeout
 
 	// ErrDockerFailed indicates that the Docker command failed.
-	ErrDockerFailed = errors.New("docker command failed")
+	ErrDockerFailed = solver.ErrDockerFailed
 
 	// ErrInvalidInput indicates invalid input parameters.
-	ErrInvalidInput = errors.New("invalid input parameters")
+	ErrInvalidInput = solver.ErrInvalidInput
 
 	// ErrWCSParseFailed indicates failure to parse WCS output.
-	ErrWCSParseFailed = errors.New("failed to parse WCS output")
+	ErrWCSParseFailed = solver.ErrWCSParseFailed
+
+	// ErrNoScratchSpace indicates that no configured scratch directory has enough free space.
+	ErrNoScratchSpace = solver.ErrNoScratchSpace
+
+	// ErrUnsupportedCompression indicates a compressed image that needs a
+	// tool the solver image does not have.
+	ErrUnsupportedCompression = solver.ErrUnsupportedCompression
+
+	// ErrMissingOutputs indicates a solve that did not write every output
+	// file a solve must produce, as reported by Result.CheckOutputs.
+	ErrMissingOutputs = solver.ErrMissingOutputs
+
+	// ErrFieldTooWide indicates an all-sky or fisheye field wider than any
+	// index covers or the TAN projection can fit. Use SolveCentralCrop.
+	ErrFieldTooWide = solver.ErrFieldTooWide
+
+	// ErrUnsupportedProjection indicates a WCS whose CTYPE projection the
+	// coordinate conversions do not implement (only TAN, SIN and ARC).
+	ErrUnsupportedProjection = solver.ErrUnsupportedProjection
+
+	// ErrImagePull indicates that the solver image could not be pulled.
+	// It wraps ErrDockerFailed.
+	ErrImagePull = solver.ErrImagePull
+
+	// ErrContainerStart indicates that StartContainer's docker run failed.
+	// It wraps ErrDockerFailed.
+	ErrContainerStart = solver.ErrContainerStart
+
+	// ErrContainerNotReady indicates a started container that could not
+	// run solve-field in time or has no index files mounted. It wraps
+	// ErrDockerFailed.
+	ErrContainerNotReady = solver.ErrContainerNotReady
 )
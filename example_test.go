@@ -0,0 +1,144 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	client "github.com/DiarmuidKelly/astrometry-go-client"
+	"github.com/DiarmuidKelly/astrometry-go-client/solvertest"
+)
+
+// fakeDockerScript stands in for docker: a solve-field run copies the WCS
+// named by FAKE_WCS into the /data mount as the image's .wcs output.
+const fakeDockerScript = `#!/bin/sh
+[ "$1" = run ] || exit 0
+for arg; do
+	case "$arg" in
+	*:/data) data="${arg%:/data}" ;;
+	esac
+	image="$arg"
+done
+name="${image##*/}"
+cp "$FAKE_WCS" "$data/${name%.*}.wcs"
+echo "Field 1: solved with index index-4113.fits."
+`
+
+// fakeSolveField puts a fake docker on PATH that answers every solve with
+// testdata/wcs.fits, nova.astrometry.net's solution of images/IMG_2820.JPG,
+// so the Solve examples run without Docker or index files. It returns an
+// empty index directory and a function undoing the setup.
+func fakeSolveField() (indexPath string, cleanup func()) {
+	dir, err := os.MkdirTemp("", "fake-docker")
+	if err != nil {
+		log.Fatal(err)
+	}
+	wcs, err := filepath.Abs("testdata/wcs.fits")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "docker"), []byte(fakeDockerScript), 0o755); err != nil {
+		log.Fatal(err)
+	}
+	indexPath = filepath.Join(dir, "indexes")
+	if err := os.Mkdir(indexPath, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	path := os.Getenv("PATH")
+	_ = os.Setenv("PATH", dir+string(os.PathListSeparator)+path) //nolint:errcheck // Valid key and value
+	_ = os.Setenv("FAKE_WCS", wcs)                               //nolint:errcheck // Valid key and value
+	return indexPath, func() {
+		_ = os.Setenv("PATH", path) //nolint:errcheck // Valid key and value
+		_ = os.Unsetenv("FAKE_WCS") //nolint:errcheck // Valid key
+		_ = os.RemoveAll(dir)       //nolint:errcheck // Temporary directory
+	}
+}
+
+// A fake docker answers with a recorded solution, so this example runs
+// without Docker; against a real solver only the IndexPath changes.
+func ExampleClient_Solve() {
+	indexPath, cleanup := fakeSolveField()
+	defer cleanup()
+
+	c, err := client.NewClient(&client.ClientConfig{IndexPath: indexPath})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := client.DefaultSolveOptions()
+	opts.ScaleLow = 360 // arcminutes across the image, e.g. from fov.AnalyzeImage
+	opts.ScaleHigh = 420
+
+	result, err := c.Solve(context.Background(), "images/IMG_2820.JPG", opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !result.Solved {
+		log.Fatal("image did not solve")
+	}
+	fmt.Printf("RA %.4f°, Dec %.4f°, %.2f\"/px\n", result.RA, result.Dec, result.PixelScale)
+	// Output:
+	// RA 83.4230°, Dec -5.8924°, 3.95"/px
+}
+
+// SolveBytes suits images that never touch the disk, such as HTTP uploads.
+// Like ExampleClient_Solve, it runs against a fake docker.
+func ExampleClient_SolveBytes() {
+	indexPath, cleanup := fakeSolveField()
+	defer cleanup()
+
+	c, err := client.NewClient(&client.ClientConfig{IndexPath: indexPath})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	data, err := os.ReadFile("images/IMG_2820.JPG")
+	if err != nil {
+		log.Fatal(err)
+	}
+	result, err := c.SolveBytes(context.Background(), data, "jpg", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(result.Solved, result.ImageWidth, result.ImageHeight)
+	// Output:
+	// true 6000 4000
+}
+
+// A solvertest.Recorder stands in for a Client, so the queue runs without
+// Docker.
+func ExampleNewPriorityQueue() {
+	fake := &solvertest.Recorder{Result: &client.Result{Solved: true, RA: 83.82, Dec: -5.39}}
+	q := client.NewPriorityQueue(fake, 1)
+	defer q.Close()
+
+	resp := <-q.Submit(context.Background(), client.SolveRequest{
+		ImagePath: "m42.jpg",
+		Priority:  client.PriorityHigh,
+	})
+	if resp.Err != nil {
+		log.Fatal(resp.Err)
+	}
+	fmt.Printf("solved %v at RA %.2f°, Dec %.2f°\n", resp.Result.Solved, resp.Result.RA, resp.Result.Dec)
+	fmt.Println(fake.Calls()[0].ImagePath)
+	// Output:
+	// solved true at RA 83.82°, Dec -5.39°
+	// m42.jpg
+}
+
+func ExampleParseWCSFile() {
+	// The nova.astrometry.net solution for the Orion integration test image
+	result, err := client.ParseWCSFile("testdata/wcs.fits")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("RA %.4f°, Dec %.4f°\n", result.RA, result.Dec)
+	fmt.Printf("%.3f\"/px, %.2f° x %.2f°, parity %s\n",
+		result.PixelScale, result.FieldWidth, result.FieldHeight, result.Parity)
+	// Output:
+	// RA 83.4230°, Dec -5.8924°
+	// 3.946"/px, 6.57° x 4.41°, parity negative
+}
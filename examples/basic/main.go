@@ -51,7 +51,7 @@ func main() {
 	fmt.Printf("Rotation:         %.2f degrees\n", result.Rotation)
 	fmt.Printf("Field Width:      %.4f degrees\n", result.FieldWidth)
 	fmt.Printf("Field Height:     %.4f degrees\n", result.FieldHeight)
-	fmt.Printf("Solve Time:       %.2f seconds\n", result.SolveTime)
+	fmt.Printf("Solve Time:       %.2f seconds\n", result.TotalTime)
 	fmt.Printf("\nOutput files:\n")
 	for _, file := range result.OutputFiles {
 		fmt.Printf("  - %s\n", file)
@@ -0,0 +1,77 @@
+// Command batch solves every image in a directory through a PriorityQueue,
+// using the scale estimated from each image's EXIF where there is one.
+//
+//	go run ./examples/batch -indexes /path/to/indexes -workers 2 /photos/astro
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	solver "github.com/DiarmuidKelly/astrometry-go-client"
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+)
+
+func main() {
+	indexPath := flag.String("indexes", "/path/to/astrometry/indexes", "directory of index files")
+	workers := flag.Int("workers", 2, "concurrent solves")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		log.Fatal("usage: batch [-indexes dir] [-workers n] image-dir")
+	}
+
+	client, err := solver.NewClient(&solver.ClientConfig{IndexPath: *indexPath})
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	defer client.Close() //nolint:errcheck // Nothing left to clean up on exit
+
+	// Ctrl-C drops the jobs still queued
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Bulk work runs at low priority, leaving room for interactive solves
+	// submitted to the same queue
+	queue := solver.NewPriorityQueue(client, *workers)
+	defer queue.Close()
+
+	survey, err := fov.SurveyDirectory(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to read directory: %v", err)
+	}
+	pending := make(map[string]<-chan solver.SolveResponse)
+	for _, img := range survey.Images {
+		opts := solver.DefaultSolveOptions()
+		if img.Err == nil && img.Info.ScaleLow > 0 {
+			opts.ScaleLow = img.Info.ScaleLow
+			opts.ScaleHigh = img.Info.ScaleHigh
+		}
+		pending[img.Path] = queue.Submit(ctx, solver.SolveRequest{
+			ImagePath: img.Path,
+			Options:   opts,
+			Priority:  solver.PriorityLow,
+		})
+	}
+
+	solved := 0
+	for _, img := range survey.Images {
+		resp := <-pending[img.Path]
+		name := filepath.Base(img.Path)
+		switch {
+		case resp.Err != nil:
+			fmt.Printf("%-30s error: %v\n", name, resp.Err)
+		case !resp.Result.Solved:
+			fmt.Printf("%-30s did not solve\n", name)
+		default:
+			solved++
+			fmt.Printf("%-30s RA %10.5f  Dec %+9.5f  %.2f\"/px\n",
+				name, resp.Result.RA, resp.Result.Dec, resp.Result.PixelScale)
+		}
+	}
+	fmt.Printf("\nSolved %d of %d images\n", solved, len(survey.Images))
+}
@@ -0,0 +1,74 @@
+// Command exec-container solves images in one long-running container with
+// docker exec: it starts the container, streams its logs, solves each image
+// given on the command line and removes the container on exit.
+//
+//	go run ./examples/exec-container -indexes /path/to/indexes m42.jpg m31.jpg
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	solver "github.com/DiarmuidKelly/astrometry-go-client"
+)
+
+func main() {
+	indexPath := flag.String("indexes", "/path/to/astrometry/indexes", "directory of index files")
+	name := flag.String("container", "astrometry-solver", "name of the solver container")
+	flag.Parse()
+
+	client, err := solver.NewClient(&solver.ClientConfig{
+		IndexPath:     *indexPath,
+		UseDockerExec: true,
+		ContainerName: *name,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+
+	// Pull the image if needed and wait until solve-field runs in the
+	// container and can see the index files
+	err = client.StartContainer(ctx, &solver.StartOptions{
+		Progress: func(p solver.PullProgress) {
+			fmt.Printf("\rPulling image: %d/%d layers", p.LayersDone, p.LayersTotal)
+		},
+		ReadyTimeout: time.Minute,
+	})
+	if errors.Is(err, solver.ErrContainerNotReady) {
+		log.Fatalf("Container started but is not usable, check the index path: %v", err)
+	} else if err != nil {
+		log.Fatalf("Failed to start container: %v", err)
+	}
+	defer func() {
+		if err := client.StopContainer(context.Background()); err != nil {
+			log.Printf("Failed to remove container: %v", err)
+		}
+	}()
+
+	// Follow the container's output until the solves are done
+	logCtx, stopLogs := context.WithCancel(ctx)
+	defer stopLogs()
+	go func() {
+		_ = client.StreamContainerLogs(logCtx, os.Stderr)
+	}()
+
+	for _, imagePath := range flag.Args() {
+		result, err := client.Solve(ctx, imagePath, nil)
+		if err != nil {
+			fmt.Printf("%s: %v\n", imagePath, err)
+			continue
+		}
+		if !result.Solved {
+			fmt.Printf("%s: did not solve\n", imagePath)
+			continue
+		}
+		fmt.Printf("%s: RA %.5f, Dec %.5f in %.1fs\n", imagePath, result.RA, result.Dec, result.TotalTime)
+	}
+}
@@ -0,0 +1,94 @@
+// Command http-server embeds the client in a web service: POST an image to
+// /solve and the solution comes back as JSON.
+//
+//	go run ./examples/http-server -indexes /path/to/indexes -addr :8080
+//	curl --data-binary @m42.jpg 'localhost:8080/solve?format=jpg'
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+
+	solver "github.com/DiarmuidKelly/astrometry-go-client"
+)
+
+// maxUpload bounds the request body.
+const maxUpload = 64 << 20
+
+// solution is the JSON response for a solved image.
+type solution struct {
+	Solved      bool    `json:"solved"`
+	RA          float64 `json:"ra,omitempty"`
+	Dec         float64 `json:"dec,omitempty"`
+	PixelScale  float64 `json:"pixel_scale,omitempty"`
+	Rotation    float64 `json:"rotation,omitempty"`
+	FieldWidth  float64 `json:"field_width,omitempty"`
+	FieldHeight float64 `json:"field_height,omitempty"`
+}
+
+func main() {
+	indexPath := flag.String("indexes", "/path/to/astrometry/indexes", "directory of index files")
+	addr := flag.String("addr", ":8080", "listen address")
+	workers := flag.Int("workers", 2, "concurrent solves")
+	flag.Parse()
+
+	client, err := solver.NewClient(&solver.ClientConfig{IndexPath: *indexPath})
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+
+	http.Handle("POST /solve", solveHandler(client, *workers))
+	log.Printf("Listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
+
+// solveHandler solves the request body and writes the solution, running at
+// most workers solves at once. The upload is held in memory and handed to
+// SolveBytes.
+func solveHandler(client solver.Solver, workers int) http.Handler {
+	slots := make(chan struct{}, max(workers, 1))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUpload))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = "jpg"
+		}
+
+		// Wait for a free slot, giving up if the client disconnects
+		select {
+		case slots <- struct{}{}:
+			defer func() { <-slots }()
+		case <-r.Context().Done():
+			return
+		}
+
+		result, err := client.SolveBytes(r.Context(), data, format, nil)
+		switch {
+		case errors.Is(err, solver.ErrInvalidInput):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(solution{
+			Solved:      result.Solved,
+			RA:          result.RA,
+			Dec:         result.Dec,
+			PixelScale:  result.PixelScale,
+			Rotation:    result.Rotation,
+			FieldWidth:  result.FieldWidth,
+			FieldHeight: result.FieldHeight,
+		})
+	})
+}
@@ -0,0 +1,117 @@
+// Command watch-folder solves images as they appear in a directory, such as
+// a capture program's output folder, and writes each solution next to the
+// image as a .wcs file. It polls, so it needs nothing beyond the standard
+// library.
+//
+//	go run ./examples/watch-folder -indexes /path/to/indexes /captures
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	solver "github.com/DiarmuidKelly/astrometry-go-client"
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+)
+
+// imageExtensions are the files picked up from the watched directory.
+var imageExtensions = map[string]bool{".jpg": true, ".jpeg": true, ".png": true, ".tif": true, ".tiff": true, ".fits": true, ".fit": true}
+
+func main() {
+	indexPath := flag.String("indexes", "/path/to/astrometry/indexes", "directory of index files")
+	interval := flag.Duration("interval", 2*time.Second, "how often to look for new images")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		log.Fatal("usage: watch-folder [-indexes dir] [-interval d] dir")
+	}
+	dir := flag.Arg(0)
+
+	client, err := solver.NewClient(&solver.ClientConfig{IndexPath: *indexPath})
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	defer client.Close() //nolint:errcheck // Nothing left to clean up on exit
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// sizes tracks images until their size stops changing, so a file still
+	// being written is not solved half-finished
+	sizes := make(map[string]int64)
+	done := make(map[string]bool)
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", dir, err)
+		}
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			if done[path] || entry.IsDir() || !imageExtensions[strings.ToLower(filepath.Ext(path))] {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			if last, seen := sizes[path]; !seen || last != info.Size() {
+				sizes[path] = info.Size()
+				continue
+			}
+			delete(sizes, path)
+			done[path] = true
+			solve(ctx, client, path)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// solve solves one image, hinting the scale from its EXIF when possible,
+// and writes the solution to a .wcs file beside it.
+func solve(ctx context.Context, client *solver.Client, imagePath string) {
+	opts := solver.DefaultSolveOptions()
+	if info, err := fov.AnalyzeImage(imagePath); err == nil && info.ScaleLow > 0 {
+		opts.ScaleLow = info.ScaleLow
+		opts.ScaleHigh = info.ScaleHigh
+	}
+
+	result, err := client.Solve(ctx, imagePath, opts)
+	if err != nil {
+		log.Printf("%s: %v", filepath.Base(imagePath), err)
+		return
+	}
+	if !result.Solved {
+		log.Printf("%s: did not solve", filepath.Base(imagePath))
+		return
+	}
+
+	wcsPath := strings.TrimSuffix(imagePath, filepath.Ext(imagePath)) + ".wcs"
+	file, err := os.Create(wcsPath)
+	if err != nil {
+		log.Printf("%s: %v", filepath.Base(imagePath), err)
+		return
+	}
+	defer file.Close() //nolint:errcheck // Closed explicitly below on success
+	if err := result.WriteWCS(file); err != nil {
+		log.Printf("%s: failed to write WCS: %v", filepath.Base(imagePath), err)
+		return
+	}
+	if err := file.Close(); err != nil {
+		log.Printf("%s: failed to write WCS: %v", filepath.Base(imagePath), err)
+		return
+	}
+	fmt.Printf("%s: RA %.5f, Dec %.5f -> %s\n", filepath.Base(imagePath), result.RA, result.Dec, filepath.Base(wcsPath))
+}
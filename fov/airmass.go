@@ -0,0 +1,79 @@
+package fov
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// astronomicalTwilight is the Sun's altitude in degrees below which the
+// sky is fully dark.
+const astronomicalTwilight = -18.0
+
+// Airmass returns the relative air mass along the line of sight at apparent
+// altitude altitudeDeg, from Pickering (2002): 1 at the zenith, 2 at 30°
+// and about 38 at the horizon. Higher airmass means more extinction and
+// worse seeing, so fewer stars for solving. It returns +Inf below the
+// horizon.
+func Airmass(altitudeDeg float64) float64 {
+	if altitudeDeg < 0 {
+		return math.Inf(1)
+	}
+	h := altitudeDeg
+	return 1 / math.Sin((h+244/(165+47*math.Pow(h, 1.1)))*math.Pi/180)
+}
+
+// AirmassAtTime returns the airmass of an object at ra, dec (degrees) for an
+// observer at latitude and longitude (degrees, north and east positive) at
+// time t, and the object's true altitude in degrees. Refraction is applied
+// before computing the airmass.
+func AirmassAtTime(ra, dec, latitude, longitude float64, t time.Time) (airmass, altitude float64) {
+	altitude, _ = HourAngleToDec(localSiderealTime(longitude, t)-ra, dec, latitude)
+	return Airmass(ApparentAltitude(altitude)), altitude
+}
+
+// OptimalObservingTime finds when during the astronomical night (Sun more
+// than 18° below the horizon) an object at ra, dec is lowest in airmass,
+// for an observer at latitude and longitude. The night searched is the one
+// starting on night's date, from noon to noon in night's location. Times
+// are sampled every minute. It fails when the night has no astronomical
+// darkness, such as near midsummer at high latitudes, when the object is
+// never above the horizon while dark, or when its best airmass exceeds
+// maxAirmass (0 for no limit). The Sun's position limits dates to
+// 2020-2035.
+//
+// Example:
+//
+//	night := time.Date(2025, 1, 15, 0, 0, 0, 0, time.Local)
+//	best, airmass, err := fov.OptimalObservingTime(83.82, -5.39, 51.5, -0.1, night, 2)
+func OptimalObservingTime(ra, dec, latitude, longitude float64, night time.Time, maxAirmass float64) (best time.Time, minAirmass float64, err error) {
+	y, m, d := night.Date()
+	start := time.Date(y, m, d, 12, 0, 0, 0, night.Location())
+	end := start.AddDate(0, 0, 1)
+
+	dark := false
+	minAirmass = math.Inf(1)
+	for t := start; t.Before(end); t = t.Add(time.Minute) {
+		sunRA, sunDec, err := SolarSystemCoords("sun", t)
+		if err != nil {
+			return time.Time{}, 0, err
+		}
+		if sunAlt, _ := HourAngleToDec(localSiderealTime(longitude, t)-sunRA, sunDec, latitude); sunAlt > astronomicalTwilight {
+			continue
+		}
+		dark = true
+		if airmass, _ := AirmassAtTime(ra, dec, latitude, longitude, t); airmass < minAirmass {
+			best, minAirmass = t, airmass
+		}
+	}
+
+	switch {
+	case !dark:
+		return time.Time{}, 0, fmt.Errorf("no astronomical night at latitude %.1f° on %s", latitude, start.Format(time.DateOnly))
+	case math.IsInf(minAirmass, 1):
+		return time.Time{}, 0, fmt.Errorf("object at RA %.2f° Dec %.2f° is below the horizon all night", ra, dec)
+	case maxAirmass > 0 && minAirmass > maxAirmass:
+		return time.Time{}, 0, fmt.Errorf("best airmass %.2f exceeds the limit %.2f", minAirmass, maxAirmass)
+	}
+	return best, minAirmass, nil
+}
@@ -0,0 +1,72 @@
+package fov
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAirmass(t *testing.T) {
+	tests := []struct {
+		altitude, want, tolerance float64
+	}{
+		{90, 1.0, 1e-4},
+		{30, 2.0, 0.01},
+		{0, 38.7, 0.1},
+	}
+	for _, tt := range tests {
+		if got := Airmass(tt.altitude); math.Abs(got-tt.want) > tt.tolerance {
+			t.Errorf("Airmass(%v) = %.4f, want %.4f ±%v", tt.altitude, got, tt.want, tt.tolerance)
+		}
+	}
+	if got := Airmass(-1); !math.IsInf(got, 1) {
+		t.Errorf("Airmass(-1) = %v, want +Inf below the horizon", got)
+	}
+}
+
+func TestAirmassAtTime(t *testing.T) {
+	// An object at the local sidereal time and the observer's latitude is
+	// at the zenith
+	lst := localSiderealTime(0, j2000)
+	airmass, altitude := AirmassAtTime(lst, 51, 51, 0, j2000)
+	if math.Abs(altitude-90) > 1e-6 || math.Abs(airmass-1) > 1e-4 {
+		t.Errorf("zenith: airmass %.4f at %.4f°, want 1 at 90°", airmass, altitude)
+	}
+}
+
+func TestOptimalObservingTime_M42(t *testing.T) {
+	night := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	best, airmass, err := OptimalObservingTime(83.82, -5.39, 51, 0, night, 2)
+	if err != nil {
+		t.Fatalf("OptimalObservingTime failed: %v", err)
+	}
+	// M42 transits at 21:52:53 UTC from Greenwich that night
+	transit := time.Date(2025, 1, 15, 21, 52, 53, 0, time.UTC)
+	if diff := best.Sub(transit); diff.Abs() > 5*time.Minute {
+		t.Errorf("best = %s, want within 5 minutes of transit %s", best.Format(time.TimeOnly), transit.Format(time.TimeOnly))
+	}
+	// At transit M42 is 90 - 51 - 5.39 = 33.6° up
+	if want := Airmass(ApparentAltitude(33.61)); math.Abs(airmass-want) > 0.01 {
+		t.Errorf("airmass = %.3f, want %.3f", airmass, want)
+	}
+}
+
+func TestOptimalObservingTime_Errors(t *testing.T) {
+	tests := []struct {
+		name               string
+		dec, latitude, max float64
+		night              time.Time
+	}{
+		{"midsummer at 60°N", 0, 60, 0, time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC)},
+		{"never rises", -80, 51, 0, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"above airmass limit", -5.39, 51, 1.2, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"outside ephemeris", -5.39, 51, 0, time.Date(2040, 1, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := OptimalObservingTime(83.82, tt.dec, tt.latitude, 0, tt.night, tt.max); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
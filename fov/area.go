@@ -0,0 +1,56 @@
+package fov
+
+import (
+	"math"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
+
+// squareDegreesPerSteradian converts a solid angle in steradians to square
+// degrees.
+const squareDegreesPerSteradian = (180 / math.Pi) * (180 / math.Pi)
+
+// SphericalPolygonArea returns the solid angle in square degrees enclosed
+// by the great-circle polygon through vertices, each an RA/Dec pair in
+// degrees, in order around the polygon. It is the spherical excess of the
+// triangles fanned from the first vertex, so the polygon must be convex
+// (any solved field is). It returns 0 for fewer than three vertices.
+func SphericalPolygonArea(vertices [][2]float64) float64 {
+	if len(vertices) < 3 {
+		return 0
+	}
+	a := unitVector(vertices[0])
+	var excess float64
+	for i := 1; i+1 < len(vertices); i++ {
+		b, c := unitVector(vertices[i]), unitVector(vertices[i+1])
+		// Van Oosterom and Strackee: tan(E/2) = a·(b×c) / (1 + a·b + b·c + c·a)
+		triple := a[0]*(b[1]*c[2]-b[2]*c[1]) + a[1]*(b[2]*c[0]-b[0]*c[2]) + a[2]*(b[0]*c[1]-b[1]*c[0])
+		excess += 2 * math.Atan2(triple, 1+dot(a, b)+dot(b, c)+dot(c, a))
+	}
+	return math.Abs(excess) * squareDegreesPerSteradian
+}
+
+// SphericalFieldArea returns the solid angle in square degrees covered by a
+// solved field, the area of the great-circle quadrilateral through its four
+// corners (Result.CornerCoords). The planar approximation FieldWidth ×
+// FieldHeight agrees to well under 1% below 5°, but overstates the area of
+// a 30° field by about 2% and of a 60° field by about 8%. It returns 0 when
+// the result has no WCS solution.
+func SphericalFieldArea(r *solver.Result) float64 {
+	corners, ok := r.CornerCoords()
+	if !ok {
+		return 0
+	}
+	return SphericalPolygonArea(corners[:])
+}
+
+// unitVector returns the Cartesian unit vector of an RA/Dec in degrees.
+func unitVector(radec [2]float64) [3]float64 {
+	ra, dec := radec[0]*math.Pi/180, radec[1]*math.Pi/180
+	return [3]float64{math.Cos(dec) * math.Cos(ra), math.Cos(dec) * math.Sin(ra), math.Sin(dec)}
+}
+
+// dot returns the dot product of a and b.
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
@@ -0,0 +1,68 @@
+package fov
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
+
+// squareField returns a solved TAN result for a 1000x1000 pixel field
+// widthDeg degrees across between its edge midpoints.
+func squareField(t *testing.T, widthDeg float64) *solver.Result {
+	t.Helper()
+	// The tangent plane spans ±tan(width/2), in degrees per pixel
+	cd := strconv.FormatFloat(2*math.Tan(widthDeg/2*math.Pi/180)*180/math.Pi/1000, 'g', -1, 64)
+	r, err := solver.ResultFromWCSHeader(map[string]string{
+		"CTYPE1": "RA---TAN", "CTYPE2": "DEC--TAN",
+		"CRVAL1": "150", "CRVAL2": "20",
+		"CRPIX1": "500", "CRPIX2": "500",
+		"CD1_1": "-" + cd, "CD1_2": "0", "CD2_1": "0", "CD2_2": cd,
+	}, 1000, 1000)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	return r
+}
+
+func TestSphericalPolygonArea(t *testing.T) {
+	// One octant of the sphere is 4π/8 steradians
+	octant := SphericalPolygonArea([][2]float64{{0, 0}, {90, 0}, {0, 90}})
+	if want := 4 * math.Pi / 8 * squareDegreesPerSteradian; math.Abs(octant-want) > 1e-9 {
+		t.Errorf("octant = %v, want %v", octant, want)
+	}
+	// Winding order does not matter
+	if reversed := SphericalPolygonArea([][2]float64{{0, 90}, {90, 0}, {0, 0}}); math.Abs(reversed-octant) > 1e-9 {
+		t.Errorf("reversed octant = %v, want %v", reversed, octant)
+	}
+	if got := SphericalPolygonArea([][2]float64{{0, 0}, {1, 0}}); got != 0 {
+		t.Errorf("two vertices = %v, want 0", got)
+	}
+}
+
+func TestSphericalFieldArea(t *testing.T) {
+	tests := []struct {
+		widthDeg         float64
+		minDiff, maxDiff float64 // planar overstatement, as a fraction
+	}{
+		{1, 0, 1e-4},
+		{30, 0.015, 0.03},
+	}
+	for _, tt := range tests {
+		r := squareField(t, tt.widthDeg)
+		if math.Abs(r.FieldWidth-tt.widthDeg) > 1e-6 {
+			t.Fatalf("synthetic field width = %v, want %v", r.FieldWidth, tt.widthDeg)
+		}
+		spherical := SphericalFieldArea(r)
+		planar := r.FieldWidth * r.FieldHeight
+		if diff := (planar - spherical) / planar; diff < tt.minDiff || diff > tt.maxDiff {
+			t.Errorf("%v° field: spherical %.4f deg², planar %.4f deg², difference %.4f%%, want %g-%g%%",
+				tt.widthDeg, spherical, planar, diff*100, tt.minDiff*100, tt.maxDiff*100)
+		}
+	}
+
+	if got := SphericalFieldArea(&solver.Result{}); got != 0 {
+		t.Errorf("unsolved area = %v, want 0", got)
+	}
+}
@@ -0,0 +1,119 @@
+package fov
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// AstrometryConfig is the parsed content of an astrometry.net astrometry.cfg file.
+type AstrometryConfig struct {
+	Paths      []string // Directories from add_path lines, in order
+	InParallel bool     // Whether inparallel is set
+	Indexes    []string // Index names from index lines, in order
+}
+
+// ParseAstrometryCfg reads an astrometry.cfg file.
+//
+// Recognised directives are add_path, inparallel and index; other
+// directives (autoindex, cpulimit, minwidth, ...) and # comments are ignored.
+//
+// Example:
+//
+//	cfg, err := fov.ParseAstrometryCfg("/etc/astrometry.cfg")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(cfg.ToIndexRecommendation().String())
+func ParseAstrometryCfg(path string) (*AstrometryConfig, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open astrometry config: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	cfg := &AstrometryConfig{}
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx != -1 {
+			line = line[:idx]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "add_path":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: add_path requires a directory", lineNo)
+			}
+			cfg.Paths = append(cfg.Paths, strings.Join(fields[1:], " "))
+		case "inparallel":
+			cfg.InParallel = true
+		case "index":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: index requires a name", lineNo)
+			}
+			cfg.Indexes = append(cfg.Indexes, fields[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read astrometry config: %w", err)
+	}
+	return cfg, nil
+}
+
+// ToIndexRecommendation maps the configured index names to IndexCatalog
+// entries. Names may be bare ("index-4110"), carry a .fits suffix, or be a
+// path. Indexes not in the catalog are skipped.
+func (c *AstrometryConfig) ToIndexRecommendation() IndexRecommendation {
+	var recommended []IndexFile
+	seen := make(map[string]bool)
+	for _, name := range c.Indexes {
+		base := name[strings.LastIndex(name, "/")+1:]
+		base = strings.TrimSuffix(base, ".fits")
+		for _, idx := range IndexCatalog() {
+			if idx.Name == base && !seen[idx.Name] {
+				seen[idx.Name] = true
+				recommended = append(recommended, idx)
+			}
+		}
+	}
+
+	// Sort by MinFOV (narrowest to widest)
+	sort.Slice(recommended, func(i, j int) bool {
+		return recommended[i].MinFOV < recommended[j].MinFOV
+	})
+
+	// Calculate total size
+	var totalSize float64
+	for _, idx := range recommended {
+		totalSize += idx.SizeMB
+	}
+
+	// Generate download script
+	script := "#!/bin/bash\n# Download index files listed in astrometry.cfg\n\n"
+	script += fmt.Sprintf("# Total download size: %.1f MB\n\n", totalSize)
+	script += "mkdir -p astrometry-data && cd astrometry-data\n\n"
+	for _, idx := range recommended {
+		script += fmt.Sprintf("wget %s  # %.2f° - %.2f° (%.1f MB)\n",
+			idx.DownloadURL, idx.MinFOV, idx.MaxFOV, idx.SizeMB)
+	}
+
+	rec := IndexRecommendation{
+		Indexes:        recommended,
+		TotalSizeMB:    totalSize,
+		DownloadScript: script,
+	}
+	if len(recommended) > 0 {
+		widest := recommended[len(recommended)-1].MaxFOV
+		rec.TargetFOV = FieldOfView{WidthDegrees: widest, WidthArcmin: widest * 60}
+	}
+	return rec
+}
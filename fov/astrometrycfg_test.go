@@ -0,0 +1,72 @@
+package fov
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testAstrometryCfg = `# Astrometry.net configuration
+inparallel
+
+# Where to find indexes
+add_path /data/indexes
+add_path /mnt/astro data   # path with a space
+autoindex
+
+index index-4110
+index index-4108.fits
+index /data/indexes/index-4112.fits
+index index-5200-00
+`
+
+func TestParseAstrometryCfg(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "astrometry.cfg")
+	if err := os.WriteFile(path, []byte(testAstrometryCfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := ParseAstrometryCfg(path)
+	if err != nil {
+		t.Fatalf("ParseAstrometryCfg failed: %v", err)
+	}
+
+	if want := []string{"/data/indexes", "/mnt/astro data"}; !reflect.DeepEqual(cfg.Paths, want) {
+		t.Errorf("Paths = %q, want %q", cfg.Paths, want)
+	}
+	wantIndexes := []string{"index-4110", "index-4108.fits", "/data/indexes/index-4112.fits", "index-5200-00"}
+	if !reflect.DeepEqual(cfg.Indexes, wantIndexes) {
+		t.Errorf("Indexes = %q, want %q", cfg.Indexes, wantIndexes)
+	}
+	if !cfg.InParallel {
+		t.Error("InParallel = false, want true")
+	}
+
+	rec := cfg.ToIndexRecommendation()
+	var names []string
+	for _, idx := range rec.Indexes {
+		names = append(names, idx.Name)
+	}
+	// Sorted narrowest first; index-5200-00 is not in AllIndexFiles
+	if want := []string{"index-4112", "index-4110", "index-4108"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("recommended indexes = %q, want %q", names, want)
+	}
+	if rec.TotalSizeMB != 5.3+25+95 {
+		t.Errorf("TotalSizeMB = %.1f, want %.1f", rec.TotalSizeMB, 5.3+25+95)
+	}
+}
+
+func TestParseAstrometryCfg_Errors(t *testing.T) {
+	if _, err := ParseAstrometryCfg(filepath.Join(t.TempDir(), "missing.cfg")); err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "bad.cfg")
+	if err := os.WriteFile(path, []byte("add_path\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseAstrometryCfg(path); err == nil {
+		t.Error("expected error for add_path without a directory")
+	}
+}
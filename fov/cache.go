@@ -0,0 +1,127 @@
+package fov
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultRecommendationCacheSize is the number of recommendations kept by default.
+const defaultRecommendationCacheSize = 256
+
+// indexSeries identifies the index catalogue recommendations are drawn from.
+// Only the 4100 series is catalogued in AllIndexFiles today.
+const indexSeries = "4100"
+
+// recommendationKey identifies a cached RecommendIndexes call.
+type recommendationKey struct {
+	fovDegrees float64
+	margin     float64
+	maxTotalMB float64
+	series     string
+}
+
+type recommendationEntry struct {
+	rec      IndexRecommendation
+	lastUsed atomic.Uint64
+}
+
+// clone returns a copy of rec whose Indexes can be modified without
+// affecting rec, so callers never share a slice with the cache.
+func (rec IndexRecommendation) clone() IndexRecommendation {
+	rec.Indexes = slices.Clone(rec.Indexes)
+	return rec
+}
+
+// recommendationCache is a goroutine-safe LRU cache of index recommendations.
+// Hits only take the read lock: each entry records a logical timestamp when
+// read, and the least recently used entry is evicted when an insert
+// overflows the cache.
+type recommendationCache struct {
+	mu      sync.RWMutex
+	size    int
+	entries map[recommendationKey]*recommendationEntry
+	clock   atomic.Uint64
+}
+
+var recommendations = &recommendationCache{
+	size:    defaultRecommendationCacheSize,
+	entries: make(map[recommendationKey]*recommendationEntry),
+}
+
+func (c *recommendationCache) get(key recommendationKey) (IndexRecommendation, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return IndexRecommendation{}, false
+	}
+	entry.lastUsed.Store(c.clock.Add(1))
+	return entry.rec.clone(), true
+}
+
+func (c *recommendationCache) put(key recommendationKey, rec IndexRecommendation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+	entry := &recommendationEntry{rec: rec.clone()}
+	entry.lastUsed.Store(c.clock.Add(1))
+	c.entries[key] = entry
+	c.evict()
+}
+
+func (c *recommendationCache) resize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size = size
+	c.evict()
+}
+
+func (c *recommendationCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
+
+func (c *recommendationCache) len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.entries)
+}
+
+// evict drops least recently used entries until the cache fits. Callers
+// hold the write lock. The scan is linear, but only runs on inserts into a
+// full cache.
+func (c *recommendationCache) evict() {
+	for len(c.entries) > max(c.size, 0) {
+		var oldestKey recommendationKey
+		oldest := uint64(math.MaxUint64)
+		for key, entry := range c.entries {
+			if used := entry.lastUsed.Load(); used < oldest {
+				oldest, oldestKey = used, key
+			}
+		}
+		delete(c.entries, oldestKey)
+	}
+}
+
+// SetRecommendationCacheSize sets how many RecommendIndexes results are
+// cached. Setting n <= 0 disables caching. Shrinking the cache evicts the
+// least recently used entries.
+func SetRecommendationCacheSize(n int) {
+	recommendations.resize(n)
+}
+
+// ClearRecommendationCache removes all cached recommendations. Call it after
+// modifying AllIndexFiles; SetIndexCatalog clears the cache itself.
+func ClearRecommendationCache() {
+	recommendations.clear()
+}
@@ -0,0 +1,132 @@
+package fov
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRecommendIndexes_Cached(t *testing.T) {
+	ClearRecommendationCache()
+	defer ClearRecommendationCache()
+
+	first := RecommendIndexes(3.5, 1.5)
+	if n := recommendations.len(); n != 1 {
+		t.Fatalf("cache has %d entries, want 1", n)
+	}
+
+	// Modifying a returned recommendation must not affect the cached copy
+	n := len(first.Indexes)
+	first.Indexes[0].SizeMB = 0
+	first.Indexes[0].Name = "modified"
+	_ = append(first.Indexes[:1], IndexFile{Name: "appended"})
+
+	second := RecommendIndexes(3.5, 1.5)
+	if len(second.Indexes) != n {
+		t.Errorf("cached Indexes has %d entries, want %d", len(second.Indexes), n)
+	}
+	want := recommendIndexes(3.5, 1.5, 0)
+	if !reflect.DeepEqual(second, want) {
+		t.Errorf("cached recommendation differs from computed:\ngot  %+v\nwant %+v", second, want)
+	}
+	// Nor must modifying one returned from a hit
+	second.Indexes[n-1].MaxFOV = -1
+	if third := RecommendIndexes(3.5, 1.5); !reflect.DeepEqual(third, want) {
+		t.Errorf("cached recommendation changed after modifying a hit:\ngot  %+v\nwant %+v", third, want)
+	}
+	if n := recommendations.len(); n != 1 {
+		t.Errorf("cache has %d entries after repeated call, want 1", n)
+	}
+}
+
+func TestSetRecommendationCacheSize(t *testing.T) {
+	ClearRecommendationCache()
+	defer func() {
+		SetRecommendationCacheSize(defaultRecommendationCacheSize)
+		ClearRecommendationCache()
+	}()
+
+	SetRecommendationCacheSize(2)
+	RecommendIndexes(1, 1.2)
+	RecommendIndexes(2, 1.2)
+	RecommendIndexes(1, 1.2) // Touch 1 so 2 is least recently used
+	RecommendIndexes(3, 1.2)
+
+	if n := recommendations.len(); n != 2 {
+		t.Fatalf("cache has %d entries, want 2", n)
+	}
+	if _, ok := recommendations.get(recommendationKey{fovDegrees: 2, margin: 1.2, series: indexSeries}); ok {
+		t.Error("least recently used entry was not evicted")
+	}
+	if _, ok := recommendations.get(recommendationKey{fovDegrees: 1, margin: 1.2, series: indexSeries}); !ok {
+		t.Error("recently used entry was evicted")
+	}
+
+	SetRecommendationCacheSize(0)
+	if n := recommendations.len(); n != 0 {
+		t.Errorf("cache has %d entries after disabling, want 0", n)
+	}
+	RecommendIndexes(4, 1.2)
+	if n := recommendations.len(); n != 0 {
+		t.Errorf("disabled cache stored %d entries", n)
+	}
+}
+
+func TestRecommendIndexes_Concurrent(t *testing.T) {
+	ClearRecommendationCache()
+	defer func() {
+		SetRecommendationCacheSize(defaultRecommendationCacheSize)
+		ClearRecommendationCache()
+	}()
+
+	var wg sync.WaitGroup
+	for g := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				fovDeg := float64(i%20) * 0.5
+				rec := RecommendIndexes(fovDeg, 1.3)
+				if len(rec.Indexes) != len(recommendIndexes(fovDeg, 1.3, 0).Indexes) {
+					t.Errorf("goroutine %d: wrong recommendation for %.1f°", g, fovDeg)
+					return
+				}
+				if i%50 == 0 {
+					SetRecommendationCacheSize(8 + g)
+				}
+				if g == 0 && i%75 == 0 {
+					ClearRecommendationCache()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// BenchmarkRecommendIndexes compares computing a recommendation with a
+// cache hit. A hit copies the Indexes slice for the caller, so it is about
+// 15-20× faster than computing, not free: roughly 3.6 µs against 220 ns.
+func BenchmarkRecommendIndexes(b *testing.B) {
+	b.Run("uncached", func(b *testing.B) {
+		for b.Loop() {
+			recommendIndexes(3.5, 1.5, 0)
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		ClearRecommendationCache()
+		RecommendIndexes(3.5, 1.5)
+		for b.Loop() {
+			RecommendIndexes(3.5, 1.5)
+		}
+	})
+	b.Run("distinct", func(b *testing.B) {
+		ClearRecommendationCache()
+		keys := make([]float64, 64)
+		for i := range keys {
+			keys[i] = 0.2 + float64(i)*0.15
+		}
+		for i := 0; b.Loop(); i++ {
+			RecommendIndexes(keys[i%len(keys)], 1.5)
+		}
+	})
+}
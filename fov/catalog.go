@@ -0,0 +1,257 @@
+package fov
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/lockedfile"
+)
+
+// DefaultIndexCatalogURL is the directory listing of the 4100-series index
+// files on data.astrometry.net.
+const DefaultIndexCatalogURL = "http://data.astrometry.net/4100/"
+
+// IndexCatalogTTL is how long FetchIndexCatalog reuses a catalog cached on
+// disk before downloading it again.
+const IndexCatalogTTL = 7 * 24 * time.Hour
+
+// maxListingBytes bounds the directory listing FetchIndexCatalog reads.
+const maxListingBytes = 4 << 20
+
+// indexCatalogCacheDir returns the directory FetchIndexCatalog caches
+// catalogs in. Tests point it at a temporary directory.
+var indexCatalogCacheDir = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "astrometry-go-client"), nil
+}
+
+// listingEntry matches a .fits link in an Apache or nginx directory
+// listing, followed by its modification date and time and its size, either
+// in bytes or abbreviated with a K, M or G suffix as Apache writes it.
+var listingEntry = regexp.MustCompile(`<a href="([^"]*?(index-[0-9-]+)\.fits)">[^<]*</a>` +
+	`(?:\s|&nbsp;|<[^>]*>)*(?:\d{4}-\d{2}-\d{2}|\d{2}-[A-Za-z]{3}-\d{4})\s+\d{2}:\d{2}(?::\d{2})?` +
+	`(?:\s|&nbsp;|<[^>]*>)*([0-9.]+)([KMG]?)`)
+
+// activeCatalog is the table set by SetIndexCatalog, nil for AllIndexFiles.
+var activeCatalog atomic.Pointer[[]IndexFile]
+
+// cachedCatalog is the on-disk form of a fetched listing. It holds the
+// parsed listing rather than the merged catalog, so a newer AllIndexFiles
+// still wins for FOV ranges while the cache is fresh.
+type cachedCatalog struct {
+	URL     string      `json:"url"`
+	Fetched time.Time   `json:"fetched"`
+	Listing []IndexFile `json:"listing"`
+}
+
+// FetchIndexCatalog downloads the directory listing of index files at
+// catalogURL (DefaultIndexCatalogURL when empty) and merges it with
+// AllIndexFiles, so recommendations follow files that have been resized
+// or moved on the server. The built-in table wins for FOV ranges and the
+// listing for sizes and download URLs. Built-in indexes missing from the
+// listing are kept unchanged, and listed files the built-in table does not
+// know are dropped, since their FOV range is unknown.
+//
+// The parsed listing is cached on disk under os.UserCacheDir for
+// IndexCatalogTTL, so only the first call in that time needs the network;
+// it is merged with AllIndexFiles on every call.
+// Nothing changes until the catalog is passed to SetIndexCatalog; without
+// it recommendations use AllIndexFiles, exactly as offline.
+//
+// Example:
+//
+//	catalog, err := fov.FetchIndexCatalog(ctx, "")
+//	if err != nil {
+//		log.Printf("using built-in index table: %v", err)
+//	} else {
+//		fov.SetIndexCatalog(catalog)
+//	}
+//	rec := fov.RecommendIndexes(3.5, 1.5)
+func FetchIndexCatalog(ctx context.Context, catalogURL string) ([]IndexFile, error) {
+	if catalogURL == "" {
+		catalogURL = DefaultIndexCatalogURL
+	}
+	cachePath := indexCatalogCachePath(catalogURL)
+	if listed, ok := readCachedCatalog(cachePath, catalogURL); ok {
+		return mergeIndexCatalog(AllIndexFiles, listed), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, catalogURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create index catalog request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("index catalog download failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // Response fully read, close error not critical
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxListingBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read index catalog: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("index catalog download failed: %s", resp.Status)
+	}
+
+	listed, err := parseIndexListing(body, catalogURL)
+	if err != nil {
+		return nil, err
+	}
+	writeCachedCatalog(cachePath, cachedCatalog{URL: catalogURL, Fetched: time.Now(), Listing: listed})
+	return mergeIndexCatalog(AllIndexFiles, listed), nil
+}
+
+// parseIndexListing extracts the index files from an HTML directory
+// listing, resolving links against listingURL. It fails when the listing
+// has no index files, as when the server's format has changed.
+func parseIndexListing(body []byte, listingURL string) ([]IndexFile, error) {
+	base, err := url.Parse(listingURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index catalog URL %q: %w", listingURL, err)
+	}
+
+	var files []IndexFile
+	for _, m := range listingEntry.FindAllStringSubmatch(string(body), -1) {
+		href, err := url.Parse(m[1])
+		if err != nil {
+			continue
+		}
+		size, err := strconv.ParseFloat(m[3], 64)
+		if err != nil {
+			continue
+		}
+		idx := IndexFile{Name: m[2], DownloadURL: base.ResolveReference(href).String()}
+		switch m[4] {
+		case "K":
+			idx.SizeMB = size / 1024
+		case "M":
+			idx.SizeMB = size
+		case "G":
+			idx.SizeMB = size * 1024
+		default:
+			idx.SizeBytes = int64(size)
+			idx.SizeMB = size / (1 << 20)
+		}
+		files = append(files, idx)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no index files found in the listing at %s; its format may have changed", listingURL)
+	}
+	return files, nil
+}
+
+// mergeIndexCatalog returns builtin with the sizes and download URLs of the
+// same-named files in listed.
+func mergeIndexCatalog(builtin, listed []IndexFile) []IndexFile {
+	byName := make(map[string]IndexFile, len(listed))
+	for _, idx := range listed {
+		byName[idx.Name] = idx
+	}
+
+	merged := make([]IndexFile, len(builtin))
+	for i, idx := range builtin {
+		if remote, ok := byName[idx.Name]; ok {
+			idx.SizeMB = remote.SizeMB
+			idx.DownloadURL = remote.DownloadURL
+			if remote.SizeBytes > 0 && remote.SizeBytes != idx.SizeBytes {
+				// The file changed, so a built-in checksum no longer applies
+				idx.SizeBytes, idx.SHA256 = remote.SizeBytes, ""
+			}
+			idx.SizeMBPerDegree = 0
+			idx.SizeMBPerDegree = idx.sizePerDegree()
+		}
+		merged[i] = idx
+	}
+	return merged
+}
+
+// indexCatalogCachePath returns the cache file for catalogURL, or "" when
+// there is no cache directory.
+func indexCatalogCachePath(catalogURL string) string {
+	dir, err := indexCatalogCacheDir()
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(catalogURL))
+	return filepath.Join(dir, "index-catalog-"+hex.EncodeToString(sum[:8])+".json")
+}
+
+// readCachedCatalog returns the listing cached at path if it was fetched
+// from catalogURL within IndexCatalogTTL.
+func readCachedCatalog(path, catalogURL string) ([]IndexFile, bool) {
+	if path == "" {
+		return nil, false
+	}
+	data, err := lockedfile.Read(path)
+	if err != nil {
+		return nil, false
+	}
+	var cached cachedCatalog
+	if err := json.Unmarshal(data, &cached); err != nil || cached.URL != catalogURL ||
+		time.Since(cached.Fetched) > IndexCatalogTTL || len(cached.Listing) == 0 {
+		return nil, false
+	}
+	return cached.Listing, true
+}
+
+// writeCachedCatalog stores a fetched listing at path, safely against
+// other processes refreshing it too. Failures only cost a download next
+// time, so they are ignored.
+func writeCachedCatalog(path string, cached cachedCatalog) {
+	if path == "" {
+		return
+	}
+	data, err := json.MarshalIndent(cached, "", "  ")
+	if err != nil {
+		return
+	}
+	_ = lockedfile.Update(path, func([]byte) ([]byte, error) { //nolint:errcheck // Best effort cache write
+		return data, nil
+	})
+}
+
+// SetIndexCatalog makes the Recommend* functions, ToIndexRecommendation
+// and ImageInfo.TooWide use files, typically from FetchIndexCatalog, in
+// place of AllIndexFiles. Passing nil restores AllIndexFiles. Cached
+// recommendations are cleared.
+func SetIndexCatalog(files []IndexFile) {
+	if files == nil {
+		activeCatalog.Store(nil)
+	} else {
+		catalog := make([]IndexFile, len(files))
+		for i, idx := range files {
+			idx.SizeMBPerDegree = idx.sizePerDegree()
+			catalog[i] = idx
+		}
+		activeCatalog.Store(&catalog)
+	}
+	recommendations.clear()
+}
+
+// IndexCatalog returns the index table recommendations are drawn from:
+// the one set by SetIndexCatalog, or AllIndexFiles. The slice must be
+// treated as read-only.
+func IndexCatalog() []IndexFile {
+	if catalog := activeCatalog.Load(); catalog != nil {
+		return *catalog
+	}
+	return AllIndexFiles
+}
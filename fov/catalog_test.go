@@ -0,0 +1,196 @@
+package fov
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// serveListing serves testdata/index-4100-listing.html, laid out like the
+// Apache listing data.astrometry.net serves for /4100/ but with index-4107
+// resized to 158M, under /4100/ and caches
+// catalogs in a temporary directory for the duration of t. It returns the
+// listing URL and a count of requests.
+func serveListing(t *testing.T) (string, *atomic.Int32) {
+	t.Helper()
+	listing, err := os.ReadFile("testdata/index-4100-listing.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/4100/" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(listing)
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	orig := indexCatalogCacheDir
+	indexCatalogCacheDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { indexCatalogCacheDir = orig })
+	return server.URL + "/4100/", &requests
+}
+
+func TestParseIndexListing(t *testing.T) {
+	listing, err := os.ReadFile("testdata/index-4100-listing.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := parseIndexListing(listing, DefaultIndexCatalogURL)
+	if err != nil {
+		t.Fatalf("parseIndexListing failed: %v", err)
+	}
+	if len(files) != len(AllIndexFiles) {
+		t.Fatalf("parsed %d files, want %d", len(files), len(AllIndexFiles))
+	}
+
+	tests := []struct {
+		i      int
+		name   string
+		sizeMB float64
+	}{
+		{0, "index-4107", 158},
+		{5, "index-4112", 5.3},
+		{12, "index-4119", 147.0 / 1024},
+	}
+	for _, tt := range tests {
+		got := files[tt.i]
+		if got.Name != tt.name || math.Abs(got.SizeMB-tt.sizeMB) > 1e-9 ||
+			got.DownloadURL != DefaultIndexCatalogURL+tt.name+".fits" {
+			t.Errorf("files[%d] = %+v, want %s of %g MB at %s%s.fits", tt.i, got, tt.name, tt.sizeMB, DefaultIndexCatalogURL, tt.name)
+		}
+	}
+}
+
+func TestParseIndexListing_Nginx(t *testing.T) {
+	// nginx autoindex lists exact byte sizes in a <pre> block
+	listing := `<html><head><title>Index of /4100/</title></head><body><h1>Index of /4100/</h1><hr><pre><a href="../">../</a>
+<a href="index-4110.fits">index-4110.fits</a>                                    11-Apr-2015 20:19            26214400
+<a href="/mirror/4100/index-4111.fits">index-4111.fits</a>                                    11-Apr-2015 20:19            10485760
+</pre><hr></body></html>`
+	files, err := parseIndexListing([]byte(listing), "https://mirror.example/4100/")
+	if err != nil {
+		t.Fatalf("parseIndexListing failed: %v", err)
+	}
+	if len(files) != 2 || files[0].SizeBytes != 26214400 || files[0].SizeMB != 25 ||
+		files[1].DownloadURL != "https://mirror.example/mirror/4100/index-4111.fits" {
+		t.Errorf("files = %+v", files)
+	}
+}
+
+func TestParseIndexListing_FormatChanged(t *testing.T) {
+	_, err := parseIndexListing([]byte(`{"files": ["index-4107.fits"]}`), DefaultIndexCatalogURL)
+	if err == nil || !strings.Contains(err.Error(), "format may have changed") {
+		t.Errorf("err = %v, want a format change error", err)
+	}
+}
+
+func TestMergeIndexCatalog(t *testing.T) {
+	builtin := []IndexFile{
+		{Name: "index-4107", MinFOV: 8, MaxFOV: 11, SizeMB: 165, DownloadURL: "http://old/index-4107.fits", SizeBytes: 1, SHA256: "aa"},
+		{Name: "index-4108", MinFOV: 5.6, MaxFOV: 8, SizeMB: 95, DownloadURL: "http://old/index-4108.fits"},
+	}
+	listed := []IndexFile{
+		{Name: "index-4107", MinFOV: 99, MaxFOV: 100, SizeMB: 150, DownloadURL: "http://new/index-4107.fits", SizeBytes: 157286400},
+		{Name: "index-5200", SizeMB: 1000, DownloadURL: "http://new/index-5200.fits"},
+	}
+	merged := mergeIndexCatalog(builtin, listed)
+
+	want := []IndexFile{
+		{Name: "index-4107", MinFOV: 8, MaxFOV: 11, SizeMB: 150, DownloadURL: "http://new/index-4107.fits", SizeBytes: 157286400, SizeMBPerDegree: 50},
+		builtin[1],
+	}
+	if len(merged) != len(want) || merged[0] != want[0] || merged[1] != want[1] {
+		t.Errorf("merged = %+v\nwant %+v", merged, want)
+	}
+	if builtin[0].SizeMB != 165 {
+		t.Error("merge modified the built-in table")
+	}
+}
+
+func TestFetchIndexCatalog(t *testing.T) {
+	listingURL, requests := serveListing(t)
+	ctx := context.Background()
+
+	catalog, err := FetchIndexCatalog(ctx, listingURL)
+	if err != nil {
+		t.Fatalf("FetchIndexCatalog failed: %v", err)
+	}
+	if len(catalog) != len(AllIndexFiles) {
+		t.Fatalf("catalog has %d indexes, want %d", len(catalog), len(AllIndexFiles))
+	}
+	if got := catalog[0]; got.SizeMB != 158 || got.MinFOV != 8 || got.MaxFOV != 11 || got.DownloadURL != listingURL+"index-4107.fits" {
+		t.Errorf("index-4107 = %+v, want the listing's size and URL with built-in FOV range", got)
+	}
+
+	// Within the TTL the disk cache answers, still merged with the
+	// current built-in table
+	builtin := AllIndexFiles
+	t.Cleanup(func() { AllIndexFiles = builtin })
+	AllIndexFiles = slices.Clone(builtin)
+	AllIndexFiles[0].MinFOV = 7.5
+	cached, err := FetchIndexCatalog(ctx, listingURL)
+	if err != nil {
+		t.Fatalf("cached FetchIndexCatalog failed: %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("%d requests, want 1 with a fresh cache", n)
+	}
+	if got := cached[0]; got.MinFOV != 7.5 || got.SizeMB != 158 {
+		t.Errorf("cached index-4107 = %+v, want the current built-in FOV range with the listing's size", got)
+	}
+	AllIndexFiles = builtin
+
+	// An expired cache is refreshed
+	path := indexCatalogCachePath(listingURL)
+	data, err := json.Marshal(cachedCatalog{URL: listingURL, Fetched: time.Now().Add(-IndexCatalogTTL - time.Hour), Listing: catalog})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FetchIndexCatalog(ctx, listingURL); err != nil {
+		t.Fatalf("FetchIndexCatalog after expiry failed: %v", err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("%d requests, want 2 after the cache expired", n)
+	}
+
+	if _, err := FetchIndexCatalog(ctx, strings.TrimSuffix(listingURL, "4100/")+"missing/"); err == nil ||
+		!strings.Contains(err.Error(), "404") {
+		t.Errorf("missing listing: err = %v, want 404", err)
+	}
+}
+
+func TestSetIndexCatalog(t *testing.T) {
+	t.Cleanup(func() { SetIndexCatalog(nil) })
+	before := RecommendIndexes(9, 1.2)
+
+	catalog := mergeIndexCatalog(AllIndexFiles, []IndexFile{
+		{Name: "index-4107", SizeMB: 158, DownloadURL: "https://mirror.example/4100/index-4107.fits"},
+	})
+	SetIndexCatalog(catalog)
+	after := RecommendIndexes(9, 1.2)
+	if !strings.Contains(after.DownloadScript, "https://mirror.example/4100/index-4107.fits") ||
+		after.TotalSizeMB != before.TotalSizeMB-165+158 {
+		t.Errorf("recommendation did not use the catalog: %.1f MB\n%s", after.TotalSizeMB, after.DownloadScript)
+	}
+
+	SetIndexCatalog(nil)
+	if restored := RecommendIndexes(9, 1.2); restored.DownloadScript != before.DownloadScript {
+		t.Errorf("SetIndexCatalog(nil) did not restore the built-in table:\n%s", restored.DownloadScript)
+	}
+}
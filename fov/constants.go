@@ -49,6 +49,13 @@ var (
 		{Pattern: "EOS 6D MARK II", Sensor: FullFrame},
 		{Pattern: "EOS 6D", Sensor: FullFrame},
 
+		// APS-H DSLR (after the full-frame 1D X, 1Ds and 1D C patterns,
+		// which also contain "EOS-1D")
+		{Pattern: "EOS-1D MARK IV", Sensor: APSHCanon},
+		{Pattern: "EOS-1D MARK III", Sensor: APSHCanon},
+		{Pattern: "EOS-1D MARK II", Sensor: APSHCanon},
+		{Pattern: "EOS-1D", Sensor: APSHCanon},
+
 		// APS-C models (verified by user)
 		{Pattern: "EOS M", Sensor: APSCCanon},
 		{Pattern: "EOS R7", Sensor: APSCCanon},
@@ -159,6 +166,35 @@ var (
 		{Pattern: "ZV-E10", Sensor: APSCNikon},
 	}
 
+	// fujifilmMappings contains Fujifilm camera model to sensor mappings
+	// Source: Wikipedia Fujifilm GFX and X-series articles
+	fujifilmMappings = []CameraMapping{
+		// Medium format
+		{Pattern: "GFX", Sensor: MediumFormatGFX},
+
+		// X-series interchangeable-lens and X100/X70 compacts (APS-C X-Trans)
+		{Pattern: "X-PRO", Sensor: APSCFuji},
+		{Pattern: "X-T", Sensor: APSCFuji},
+		{Pattern: "X-H", Sensor: APSCFuji},
+		{Pattern: "X-S10", Sensor: APSCFuji}, // not the 2/3" X-S1 bridge camera
+		{Pattern: "X-S20", Sensor: APSCFuji},
+		{Pattern: "X-E", Sensor: APSCFuji},
+		{Pattern: "X-A", Sensor: APSCFuji},
+		{Pattern: "X-M", Sensor: APSCFuji},
+		{Pattern: "X100", Sensor: APSCFuji},
+		{Pattern: "X70", Sensor: APSCFuji},
+	}
+
+	// sigmaMappings contains Sigma camera model to sensor mappings
+	// Source: Wikipedia Sigma SD and DP series articles
+	// NOTE: sd Quattro H must be checked before the APS-C Quattro models
+	sigmaMappings = []CameraMapping{
+		{Pattern: "SD QUATTRO H", Sensor: FoveonAPSH},
+		{Pattern: "QUATTRO", Sensor: FoveonAPSC},
+		{Pattern: "MERRILL", Sensor: FoveonAPSC},
+		{Pattern: "SD1", Sensor: FoveonAPSC},
+	}
+
 	// olympusMappings contains Olympus/OM System camera model to sensor mappings
 	// Source: Wikipedia 4/3-type digital cameras category
 	// All Olympus/OM System cameras use Micro Four Thirds sensor
@@ -0,0 +1,79 @@
+package fov
+
+import "fmt"
+
+// marginRange widens value by margin, a multiplier such as 1.2 for ±20%:
+// the range runs from value/margin to value*margin. Every FOV and scale
+// range in this package is derived through it.
+func marginRange(value, margin float64) (low, high float64) {
+	return value / margin, value * margin
+}
+
+// Covers reports whether a field fovDeg degrees wide lies within the
+// index's MinFOV-MaxFOV range, inclusive.
+func (idx IndexFile) Covers(fovDeg float64) bool {
+	return fovDeg >= idx.MinFOV && fovDeg <= idx.MaxFOV
+}
+
+// overlaps reports whether the index's range intersects lowDeg-highDeg.
+func (idx IndexFile) overlaps(lowDeg, highDeg float64) bool {
+	return idx.MaxFOV >= lowDeg && idx.MinFOV <= highDeg
+}
+
+// WithinIndex reports whether the field width falls inside idx's range.
+func (fov FieldOfView) WithinIndex(idx IndexFile) bool {
+	return idx.Covers(fov.WidthDegrees)
+}
+
+// CoveredBy reports whether any of indexes covers the field width.
+//
+// Example:
+//
+//	cfg, _ := fov.ParseAstrometryCfg("/etc/astrometry.cfg")
+//	if !f.CoveredBy(cfg.ToIndexRecommendation().Indexes) {
+//		fmt.Println(fov.RecommendIndexesForFOV(f, 1.2))
+//	}
+func (fov FieldOfView) CoveredBy(indexes []IndexFile) bool {
+	for _, idx := range indexes {
+		if fov.WithinIndex(idx) {
+			return true
+		}
+	}
+	return false
+}
+
+// SolveScaleBounds returns solve-field scale bounds (SolveOptions
+// ScaleLow/ScaleHigh) for the field width widened by margin, a multiplier
+// of at least 1 (1.2 allows the true width to be 20% either side). units is
+// one of solve-field's unit systems: "degwidth", "arcminwidth" or
+// "arcsecperpix", the last needing WidthPixels.
+//
+// Example:
+//
+//	opts.ScaleLow, opts.ScaleHigh, err = f.SolveScaleBounds(1.2, "arcminwidth")
+//	opts.ScaleUnits = "arcminwidth"
+func (fov FieldOfView) SolveScaleBounds(margin float64, units string) (low, high float64, err error) {
+	if margin < 1 {
+		return 0, 0, fmt.Errorf("scale margin %g is below 1", margin)
+	}
+	if fov.WidthDegrees <= 0 {
+		return 0, 0, fmt.Errorf("field width is unknown")
+	}
+
+	var width float64
+	switch units {
+	case "degwidth":
+		width = fov.WidthDegrees
+	case "arcminwidth":
+		width = fov.WidthDegrees * 60
+	case "arcsecperpix":
+		if fov.WidthPixels <= 0 {
+			return 0, 0, fmt.Errorf("arcsecperpix bounds need the image width in pixels")
+		}
+		width = fov.WidthDegrees * 3600 / float64(fov.WidthPixels)
+	default:
+		return 0, 0, fmt.Errorf("unknown scale units %q (want degwidth, arcminwidth or arcsecperpix)", units)
+	}
+	low, high = marginRange(width, margin)
+	return low, high, nil
+}
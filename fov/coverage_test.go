@@ -0,0 +1,83 @@
+package fov
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolveScaleBounds(t *testing.T) {
+	f := FieldOfView{WidthDegrees: 2, WidthArcmin: 120, WidthPixels: 4000}
+	tests := []struct {
+		margin    float64
+		units     string
+		low, high float64
+	}{
+		{1, "degwidth", 2, 2},
+		{1.2, "degwidth", 2 / 1.2, 2.4},
+		{1.2, "arcminwidth", 100, 144},
+		{1.5, "arcminwidth", 80, 180},
+		{1, "arcsecperpix", 1.8, 1.8},
+		{2, "arcsecperpix", 0.9, 3.6},
+	}
+	for _, tt := range tests {
+		low, high, err := f.SolveScaleBounds(tt.margin, tt.units)
+		if err != nil {
+			t.Errorf("SolveScaleBounds(%v, %q) failed: %v", tt.margin, tt.units, err)
+			continue
+		}
+		if math.Abs(low-tt.low) > 1e-9 || math.Abs(high-tt.high) > 1e-9 {
+			t.Errorf("SolveScaleBounds(%v, %q) = %v, %v, want %v, %v", tt.margin, tt.units, low, high, tt.low, tt.high)
+		}
+	}
+}
+
+func TestSolveScaleBounds_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		fov    FieldOfView
+		margin float64
+		units  string
+	}{
+		{"margin below 1", FieldOfView{WidthDegrees: 2}, 0.8, "degwidth"},
+		{"unknown units", FieldOfView{WidthDegrees: 2}, 1.2, "pixels"},
+		{"arcsecperpix without width", FieldOfView{WidthDegrees: 2}, 1.2, "arcsecperpix"},
+		{"no field width", FieldOfView{}, 1.2, "degwidth"},
+	}
+	for _, tt := range tests {
+		if _, _, err := tt.fov.SolveScaleBounds(tt.margin, tt.units); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestIndexCoverage(t *testing.T) {
+	idx4110 := IndexFile{Name: "index-4110", MinFOV: 3.0, MaxFOV: 4.2}
+	idx4112 := IndexFile{Name: "index-4112", MinFOV: 1.6, MaxFOV: 2.2}
+	tests := []struct {
+		width     float64
+		within    bool // by index-4110
+		coveredBy bool // by index-4110 or index-4112
+	}{
+		{3.5, true, true},
+		{3.0, true, true}, // bounds are inclusive
+		{4.2, true, true},
+		{2.0, false, true},
+		{2.6, false, false}, // the gap between the two
+		{5.0, false, false},
+	}
+	for _, tt := range tests {
+		f := FieldOfView{WidthDegrees: tt.width}
+		if got := idx4110.Covers(tt.width); got != tt.within {
+			t.Errorf("Covers(%v) = %v, want %v", tt.width, got, tt.within)
+		}
+		if got := f.WithinIndex(idx4110); got != tt.within {
+			t.Errorf("WithinIndex at %v° = %v, want %v", tt.width, got, tt.within)
+		}
+		if got := f.CoveredBy([]IndexFile{idx4110, idx4112}); got != tt.coveredBy {
+			t.Errorf("CoveredBy at %v° = %v, want %v", tt.width, got, tt.coveredBy)
+		}
+	}
+	if (FieldOfView{WidthDegrees: 3.5}).CoveredBy(nil) {
+		t.Error("CoveredBy(nil) = true, want false")
+	}
+}
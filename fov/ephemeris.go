@@ -0,0 +1,87 @@
+package fov
+
+//go:generate go run gen_ephemeris.go
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ephemeris is a table of geocentric J2000 positions sampled at a fixed
+// interval. positions holds RA, Dec pairs in millidegrees.
+type ephemeris struct {
+	start     float64 // Julian date (UTC) of the first sample
+	step      float64 // Days between samples
+	positions []int32
+}
+
+// SolarSystemBodies returns the names accepted by SolarSystemCoords.
+func SolarSystemBodies() []string {
+	names := make([]string, 0, len(ephemerides))
+	for name := range ephemerides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SolarSystemCoords returns the approximate geocentric J2000 RA/Dec in degrees
+// of the Sun, Moon or a planet at time t, for use as a solve hint.
+//
+// Positions are interpolated from a pre-built table covering 2020-2035 and
+// are accurate to about 0.1°. The Moon's position is geocentric; seen from
+// the Earth's surface it can be displaced by up to 1°, so use a hint radius
+// of a few degrees.
+//
+// Example:
+//
+//	ra, dec, err := fov.SolarSystemCoords("jupiter", time.Now())
+//	opts.RA, opts.Dec, opts.Radius = ra, dec, 5
+func SolarSystemCoords(body string, t time.Time) (ra, dec float64, err error) {
+	eph, ok := ephemerides[strings.ToLower(body)]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown solar system body %q (known: %s)",
+			body, strings.Join(SolarSystemBodies(), ", "))
+	}
+
+	jd := julianDate(t)
+	pos := (jd - eph.start) / eph.step
+	i := int(math.Floor(pos)) - 1 // first of the four samples around pos
+	samples := len(eph.positions) / 2
+	if i < 0 || i+3 >= samples {
+		return 0, 0, fmt.Errorf("time %s outside ephemeris range 2020-2035", t.UTC().Format(time.DateOnly))
+	}
+
+	// Cubic Lagrange interpolation over four samples, with RA unwrapped
+	// across 0°/360° relative to the first sample.
+	var ras, decs [4]float64
+	for k := range 4 {
+		ras[k] = float64(eph.positions[2*(i+k)]) / 1000
+		decs[k] = float64(eph.positions[2*(i+k)+1]) / 1000
+		if k > 0 {
+			ras[k] -= 360 * math.Round((ras[k]-ras[0])/360)
+		}
+	}
+	u := pos - float64(i)
+	ra = math.Mod(lagrange4(ras, u), 360)
+	if ra < 0 {
+		ra += 360
+	}
+	return ra, lagrange4(decs, u), nil
+}
+
+// lagrange4 interpolates four equally spaced samples at nodes 0..3.
+func lagrange4(y [4]float64, u float64) float64 {
+	return y[0]*(u-1)*(u-2)*(u-3)/-6 +
+		y[1]*u*(u-2)*(u-3)/2 +
+		y[2]*u*(u-1)*(u-3)/-2 +
+		y[3]*u*(u-1)*(u-2)/6
+}
+
+// julianDate converts t to a Julian date.
+func julianDate(t time.Time) float64 {
+	return float64(t.UTC().UnixNano())/86400e9 + 2440587.5
+}
@@ -0,0 +1,1669 @@
+// Code generated by gen_ephemeris.go; DO NOT EDIT.
+
+package fov
+
+var ephemerides = map[string]ephemeris{
+	"sun": {start: 2458833.5, step: 8, positions: []int32{
+		262860, -23274, 271731, -23427, 280596, -23079, 289386, -22241, 298040, -20938, 306512, -19209, 314776, -17104, 322823, -14679,
+		330663, -11991, 338316, -9100, 345817, -6063, 353202, -2938, 513, 223, 7796, 3365, 15091, 6439, 22439, 9395,
+		29876, 12185, 37432, 14761, 45129, 17078, 52976, 19091, 60972, 20759, 69102, 22047, 77337, 22926, 85637, 23376,
+		93955, 23387, 102241, 22960, 110448, 22106, 118537, 20848, 126481, 19216, 134266, 17246, 141892, 14978, 149369, 12455,
+		156720, 9722, 163974, 6824, 171168, 3808, 178341, 719, 185536, -2395, 192797, -5484, 200166, -8500, 207683, -11387,
+		215383, -14091, 223293, -16555, 231426, -18722, 239781, -20535, 248340, -21944, 257064, -22904, 265898, -23383, 274775, -23364,
+		283623, -22846, 292372, -21844, 300968, -20390, 309371, -18527, 317560, -16306, 325534, -13784, 333307, -11019, 340904, -8072,
+		348360, -4998, 355715, -1855, 3012, 1305, 10294, 4429, 17603, 7469, 24978, 10373, 32453, 13095, 40055, 15587,
+		47803, 17804, 55702, 19703, 63747, 21245, 71916, 22396, 80179, 23129, 88491, 23429, 96803, 23289, 105067, 22714,
+		113237, 21719, 121279, 20329, 129170, 18576, 136899, 16499, 144472, 14139, 151903, 11539, 159217, 8745, 166446, 5801,
+		173628, 2754, 180804, -348, 188016, -3460, 195310, -6530, 202725, -9507, 210302, -12338, 218071, -14966, 226057, -17335,
+		234267, -19386, 242695, -21066, 251316, -22325, 260085, -23123, 268942, -23433, 277816, -23242, 286637, -22555, 295340, -21395,
+		303873, -19795, 312204, -17803, 320318, -15472, 328221, -12860, 335930, -10026, 343475, -7029, 350892, -3926, 358222, -771,
+		5509, 2383, 12795, 5484, 20123, 8482, 27529, 11330, 35045, 13978, 42696, 16381, 50495, 18494, 58445, 20274,
+		66536, 21685, 74742, 22695, 83026, 23281, 91344, 23431, 99645, 23140, 107882, 22419, 116011, 21285, 124003, 19767,
+		131839, 17898, 139514, 15719, 147035, 13272, 154423, 10600, 161704, 7749, 168913, 4765, 176088, 1694, 183271, -1417,
+		190507, -4520, 197837, -7564, 205304, -10497, 212943, -13265, 220785, -15810, 228847, -18077, 237134, -20007, 245632, -21548,
+		254309, -22653, 263116, -23285, 271987, -23424, 280851, -23061, 289638, -22209, 298287, -20893, 306754, -19153, 315011, -17039,
+		323052, -14605, 330886, -11910, 338535, -9014, 346031, -5974, 353413, -2847, 724, 314, 8006, 3455, 15302, 6526,
+		22652, 9478, 30092, 12263, 37653, 14832, 45353, 17140, 53205, 19143, 61205, 20801, 69339, 22078, 77576, 22945,
+		85878, 23382, 94195, 23380, 102480, 22940, 110684, 22075, 118769, 20806, 126709, 19163, 134489, 17184, 142110, 14908,
+		149583, 12378, 156931, 9640, 164183, 6739, 171375, 3719, 178548, 629, 185745, -2484, 193008, -5573, 200381, -8585,
+		207903, -11467, 215609, -14165, 223524, -16622, 231664, -18779, 240025, -20582, 248590, -21978, 257318, -22924, 266154, -23389,
+		275031, -23356, 283876, -22823, 292622, -21808, 301213, -20342, 309610, -18467, 317793, -16237, 325761, -13707, 333528, -10937,
+		341121, -7985, 348573, -4908, 355926, -1764, 3222, 1396, 10504, 4519, 17814, 7554, 25192, 10454, 32671, 13170,
+		40277, 15655, 48029, 17864, 55932, 19753, 63981, 21284, 72154, 22422, 80418, 23144, 88731, 23431, 97043, 23278,
+		105305, 22690, 113472, 21684, 121509, 20283, 129395, 18520, 137120, 16435, 144689, 14067, 152116, 11461, 159427, 8661,
+		166654, 5714, 173835, 2665, 181011, -438, 188226, -3549, 195522, -6617, 202942, -9591, 210523, -12416, 218299, -15038,
+		226290, -17398, 234507, -19440, 242941, -21108, 251567, -22355, 260339, -23139, 269197, -23434, 278072, -23229, 286890, -22528,
+		295588, -21354, 304116, -19742, 312440, -17740, 320549, -15400, 328446, -12781, 336150, -9942, 343690, -6941, 351104, -3835,
+		358433, -679, 5719, 2473, 13006, 5572, 20335, 8567, 27744, 11409, 35264, 14051, 42919, 16446, 50723, 18549,
+		58677, 20320, 66771, 21720, 74980, 22718, 83266, 23292, 91584, 23428, 99884, 23125, 108118, 22391, 116244, 21246,
+		124232, 19717, 132063, 17839, 139733, 15652, 147251, 13197, 154635, 10520, 161913, 7665, 169120, 4678, 176295, 1605,
+		183479, -1507, 190717, -4609, 198051, -7650, 205522, -10579, 213167, -13341, 221014, -15880, 229083, -18137, 237376, -20057,
+		245880, -21585, 254562, -22677, 263371, -23296, 272243, -23420, 281106, -23043, 289889, -22177, 298534, -20848, 306994, -19097,
+		315246, -16972, 323280, -14530, 331108, -11829, 338753, -8928, 346245, -5885, 353625, -2756, 934, 405, 8216, 3545,
+		15513, 6613, 22865, 9561, 30309, 12340, 37873, 14902, 45578, 17202, 53434, 19196, 61439, 20843, 69575, 22108,
+		77815, 22963, 86118, 23388, 94436, 23373, 102718, 22921, 110919, 22043, 119001, 20763, 126936, 19110, 134711, 17122,
+		142328, 14838, 149797, 12302, 157141, 9558, 164391, 6653, 171582, 3631, 178755, 539, 185953, -2574, 193219, -5661,
+		200596, -8670, 208123, -11548, 215834, -14240, 223756, -16688, 231902, -18836, 240270, -20627, 248839, -22011, 257571, -22944,
+		266410, -23395, 275287, -23347, 284130, -22800, 292872, -21772, 301458, -20293, 309848, -18408, 318025, -16168, 325987, -13630,
+		333749, -10854, 341337, -7897, 348787, -4818, 356137, -1673, 3432, 1486, 10714, 4607, 18026, 7640, 25406, 10535,
+		32888, 13245, 40499, 15723, 48255, 17923, 56163, 19802, 64215, 21322, 72392, 22449, 80658, 23158, 88971, 23432,
+		97283, 23267, 105542, 22667, 113706, 21648, 121739, 20236, 129621, 18464, 137341, 16370, 144905, 13994, 152329, 11382,
+		159637, 8578, 166862, 5627, 174042, 2576, 181219, -528, 188435, -3638, 195734, -6704, 203158, -9674, 210745, -12495,
+		218526, -15110, 226524, -17462, 234747, -19493, 243188, -21150, 251818, -22384, 260594, -23154, 269453, -23435, 278327, -23215,
+		287143, -22500, 295836, -21313, 304358, -19690, 312677, -17676, 320779, -15328, 328671, -12702, 336369, -9857, 343906, -6852,
+		351316, -3744, 358643, -588, 5929, 2564, 13217, 5660, 20548, 8651, 27959, 11488, 35483, 14123, 43142, 16511,
+		50950, 18605, 58909, 20365, 67007, 21754, 75219, 22740, 83506, 23301, 91825, 23425, 100123, 23109, 108355, 22363,
+		116477, 21207, 124460, 19667, 132287, 17780, 139952, 15584, 147466, 13123, 154846, 10439, 162122, 7580, 169328, 4590,
+		176502, 1515, 183687, -1597, 190927, -4697, 198264, -7736, 205740, -10661, 213390, -13418, 221244, -15949, 229319, -18197,
+		237618, -20106, 246128, -21623, 254814, -22701, 263626, -23306, 272499, -23416, 281360, -23025, 290141, -22145, 298780, -20803,
+		307235, -19040, 315480, -16906, 323509, -14455, 331331, -11748, 338970, -8842, 346459, -5795, 353836, -2665, 1144, 496,
+		8426, 3634, 15724, 6700, 23079, 9644, 30525, 12417, 38093, 14972, 45803, 17264, 53663, 19248, 61672, 20885,
+		69812, 22139, 78055, 22981, 86358, 23393, 94676, 23366, 102956, 22901, 111155, 22012, 119232, 20720, 127163, 19057,
+		134934, 17059, 142546, 14768, 150011, 12225, 157352, 9476, 164599, 6567, 171790, 3542, 178963, 450, 186162, -2664,
+		193430, -5749, 200811, -8755, 208343, -11628, 216060, -14314, 223988, -16754, 232140, -18893, 240514, -20673, 249089, -22044,
+		257825, -22963, 266666, -23400, 275542, -23338, 284384, -22777, 293122, -21735, 301702, -20244, 310087, -18347, 318258, -16098,
+		326214, -13553, 333970, -10770, 341554, -7810, 349000, -4728, 356348, -1582, 3642, 1577, 10925, 4696, 18238, 7726,
+		25621, 10616, 33106, 13320, 40721, 15790, 48481, 17981, 56394, 19851, 64450, 21360, 72629, 22475, 80898, 23172,
+		89212, 23433, 97522, 23255, 105780, 22643, 113940, 21612, 121969, 20190, 129846, 18408, 137562, 16304, 145121, 13922,
+		152541, 11303, 159846, 8494, 167070, 5540, 174250, 2487, 181427, -618, 188645, -3728, 195947, -6792, 203375, -9758,
+		210967, -12573, 218754, -15181, 226759, -17525, 234988, -19546, 243434, -21192, 252069, -22412, 260848, -23169, 269709, -23435,
+		278582, -23201, 287395, -22472, 296085, -21272, 304601, -19636, 312913, -17613, 321010, -15255, 328895, -12622, 336589, -9772,
+		344121, -6764, 351529, -3654, 358853, -497, 6139, 2654, 13427, 5748, 20760, 8735, 28175, 11567, 35702, 14196,
+		43365, 16575, 51178, 18661, 59141, 20410, 67243, 21788, 75457, 22762, 83747, 23311, 92065, 23422, 100363, 23093,
+		108591, 22335, 116710, 21167, 124689, 19617, 132510, 17720, 140172, 15516, 147681, 13048, 155058, 10359, 162331, 7495,
+		169535, 4502, 176709, 1425, 183895, -1687, 191137, -4786, 198478, -7823, 205958, -10743, 213614, -13494, 221474, -16018,
+		229556, -18257, 237861, -20155, 246376, -21660, 255067, -22725, 263881, -23316, 272755, -23411, 281615, -23006, 290392, -22112,
+		299027, -20758, 307476, -18983, 315714, -16839, 323737, -14380, 331554, -11666, 339188, -8755, 346673, -5706, 354048, -2574,
+		1354, 587, 8636, 3724, 15935, 6787, 23292, 9726, 30742, 12494, 38314, 15042, 46028, 17326, 53892, 19300,
+		61905, 20926, 70049, 22169, 78294, 22999, 86599, 23398, 94916, 23358, 103195, 22881, 111390, 21979, 119463, 20677,
+		127389, 19004, 135156, 16997, 142763, 14697, 150225, 12148, 157563, 9394, 164808, 6481, 171997, 3454, 179170, 360,
+		186371, -2754, 193642, -5837, 201026, -8840, 208563, -11709, 216286, -14388, 224220, -16820, 232379, -18949, 240759, -20718,
+		249339, -22077, 258079, -22983, 266921, -23405, 275798, -23328, 284637, -22753, 293372, -21698, 301947, -20194, 310325, -18287,
+		318490, -16028, 326440, -13475, 334191, -10687, 341770, -7722, 349213, -4638, 356559, -1490, 3852, 1668, 11135, 4785,
+		18450, 7811, 25835, 10697, 33324, 13395, 40943, 15857, 48708, 18040, 56624, 19899, 64685, 21397, 72867, 22501,
+		81138, 23185, 89452, 23434, 97762, 23243, 106017, 22618, 114174, 21576, 122199, 20143, 130071, 18351, 137782, 16239,
+		145338, 13849, 152754, 11224, 160056, 8410, 167278, 5453, 174457, 2398, 181635, -708, 188854, -3817, 196159, -6879,
+		203592, -9842, 211189, -12652, 218982, -15253, 226993, -17587, 235229, -19599, 243681, -21233, 252321, -22440, 261103, -23183,
+		269965, -23435, 278837, -23186, 287647, -22444, 296332, -21230, 304843, -19583, 313150, -17549, 321240, -15183, 329119, -12543,
+		336808, -9687, 344336, -6675, 351741, -3563, 359064, -406, 6349, 2744, 13638, 5836, 20973, 8819, 28390, 11646,
+		35921, 14268, 43589, 16639, 51406, 18716, 59373, 20455, 67479, 21821, 75696, 22783, 83987, 23320, 92305, 23418,
+		100602, 23077, 108828, 22307, 116943, 21127, 124917, 19567, 132734, 17660, 140391, 15448, 147896, 12973, 155269, 10278,
+		162540, 7410, 169743, 4414, 176916, 1336, 184104, -1777, 191348, -4875, 198692, -7909, 206177, -10825, 213838, -13570,
+		221704, -16086, 229792, -18316, 238104, -20204, 246625, -21697, 255320, -22749, 264137, -23325, 273010, -23406, 281869, -22986,
+		290643, -22079, 299273, -20712, 307716, -18926, 315948, -16772, 323965, -14305, 331776, -11585, 339406, -8669, 346887, -5616,
+		354259, -2483, 1564, 678, 8846, 3813, 16146, 6873, 23506, 9808, 30959, 12570, 38535, 15112, 46253, 17387,
+		54122, 19352, 62139, 20967, 70286, 22198, 78533, 23016, 86839, 23403, 95156, 23350, 103433, 22860, 111625, 21947,
+		119695, 20633, 127616, 18950, 135378, 16934, 142981, 14627, 150438, 12071, 157773, 9312, 165016, 6394, 172204, 3365,
+		179378, 270, 186580, -2843, 193853, -5925, 201241, -8925, 208783, -11789, 216512, -14462, 224453, -16886, 232618, -19005,
+		241004, -20763, 249589, -22109, 258333, -23001, 267177, -23409, 276054, -23318, 284891, -22729, 293621, -21660}},
+	"moon": {start: 2458847.5, step: 1, positions: []int32{
+		325393, -17705, 337431, -14154, 348911, -10086, 2, -5669, 10908, -1050, 21846, 3634, 33045, 8243, 44727, 12615,
+		57096, 16551, 70300, 19810, 84377, 22117, 99204, 23200, 114477, 22862, 129791, 21041, 144771, 17852, 159194, 13548,
+		173026, 8465, 186378, 2957, 199445, -2643, 212446, -8032, 225584, -12934, 239003, -17102, 252751, -20312, 266761, -22392,
+		280848, -23237, 294762, -22836, 308258, -21276, 321172, -18718, 333451, -15364, 345143, -11419, 356374, -7075, 7315, -2497,
+		18166, 2167, 29139, 6777, 40452, 11187, 52317, 15229, 64922, 18702, 78387, 21369, 92717, 22963, 107745, 23237,
+		123142, 22029, 138512, 19333, 153522, 15320, 168010, 10309, 181983, 4690, 195574, -1135, 208969, -6795, 222357, -11968,
+		235882, -16386, 249614, -19833, 263521, -22151, 277472, -23252, 291270, -23124, 304710, -21837, 317639, -19524, 329989, -16363,
+		341781, -12549, 353109, -8273, 4113, -3713, 14962, 969, 25844, 5621, 36952, 10093, 48478, 14226, 60600, 17842,
+		73453, 20740, 87098, 22693, 101478, 23473, 116397, 22887, 131555, 20839, 146634, 17376, 161411, 12706, 175809, 7170,
+		189886, 1182, 203780, -4828, 217650, -10452, 231619, -15345, 245740, -19232, 259966, -21928, 274159, -23343, 288126, -23476,
+		301675, -22412, 314672, -20292, 327068, -17293, 338897, -13601, 350256, -9400, 1282, -4863, 12137, -153, 22994, 4570,
+		34029, 9149, 45414, 13416, 57305, 17195, 69819, 20292, 83011, 22504, 96846, 23632, 111186, 23504, 125808, 22013,
+		140471, 19149, 154983, 15024, 169262, 9870, 183340, 4020, 197336, -2122, 211404, -8123, 225673, -13561, 240197, -18067,
+		254911, -21364, 269636, -23295, 284120, -23833, 298119, -23066, 311466, -21160, 324108, -18314, 336089, -14734, 347526, -10609,
+		358578, -6108, 9426, -1391, 20253, 3390, 31243, 8077, 42569, 12502, 54380, 16478, 66785, 19807, 79828, 22279,
+		93457, 23697, 107525, 23901, 121810, 22797, 136087, 20379, 150187, 16736, 164051, 12045, 177727, 6560, 191352, 600,
+		205107, -5463, 219165, -11222, 233637, -16265, 248512, -20225, 263621, -22836, 278662, -23974, 293295, -23674, 307251, -22095,
+		320400, -19462, 332758, -16015, 344440, -11971, 355624, -7518, 6510, -2815, 17308, 1990, 28227, 6752, 39462, 11308,
+		51187, 15480, 63529, 19059, 76545, 21825, 90185, 23561, 104286, 24087, 118594, 23299, 132840, 21193, 146820, 17870,
+		160455, 13509, 173794, 8348, 186981, 2662, 200222, -3240, 213735, -9016, 227702, -14300, 242210, -18718, 257189, -21940,
+		272392, -23738, 287446, -24038, 301974, -22930, 315722, -20622, 328609, -17375, 340701, -13443, 352157, -9047, 3184, -4368,
+		14007, 441, 24854, 5240, 35947, 9882, 47491, 14204, 59659, 18011, 72552, 21079, 86169, 23172, 100370, 24075,
+		114888, 23644, 129400, 21842, 143628, 18761, 157426, 14595, 170794, 9601, 183858, 4068, 196820, -1705, 209912, -7411,
+		223353, -12733, 237299, -17346, 251786, -20934, 266684, -23235, 281696, -24096, 296441, -23511, 310581, -21621, 323923, -18661,
+		336441, -14899, 348238, -10586, 359491, -5936, 10416, -1124, 21240, 3702, 32195, 8401, 43503, 12826, 55367, 16805,
+		67946, 20135, 81310, 22584, 95400, 23914, 110004, 23930, 124794, 22530, 139430, 19753, 153668, 15769, 167423, 10852,
+		180760, 5324, 193848, -483, 206906, -6240, 220155, -11636, 233777, -16370, 247859, -20160, 262357, -22763, 277070, -24014,
+		291688, -23860, 305883, -22375, 319418, -19742, 332195, -16205, 344251, -12019, 355717, -7417, 6776, -2600, 17634, 2263,
+		28509, 7023, 39617, 11536, 51165, 15648, 63334, 19180, 76247, 21926, 89929, 23662, 104268, 24170, 119017, 23291,
+		133849, 20977, 148467, 17323, 162695, 12561, 176511, 7017, 190022, 1064, 203407, -4917, 216867, -10567, 230573, -15556,
+		244624, -19597, 259003, -22461, 273558, -23995, 288036, -24145, 302153, -22970, 315686, -20622, 328525, -17315, 340680, -13285,
+		352251, -8763, 3394, -3957, 14291, 947, 25136, 5785, 36127, 10403, 47453, 14647, 59286, 18352, 71758, 21335,
+		84930, 23398, 98764, 24341, 113102, 23992, 127700, 22250, 142290, 19121, 156674, 14745, 170774, 9381, 184638, 3380,
+		198403, -2851, 212243, -8891, 226313, -14338, 240694, -18839, 255356, -22121, 270137, -24014, 284781, -24470, 299012, -23556,
+		312619, -21434, 325508, -18317, 337697, -14435, 349290, -10008, 444, -5240, 11336, -314, 22154, 4598, 33081, 9335,
+		44291, 13732, 55937, 17621, 68138, 20823, 80950, 23156, 94347, 24439, 108211, 24517, 122352, 23288, 136556, 20726,
+		150656, 16900, 164579, 11977, 178361, 6223, 192128, -13, 206056, -6322, 220314, -12256, 235005, -17378, 250099, -21312,
+		265407, -23796, 280605, -24730, 295344, -24169, 309360, -22296, 322538, -19354, 334907, -15596, 346592, -11255, 357771, -6536,
+		8644, -1617, 19413, 3340, 30273, 8175, 41405, 12723, 52961, 16808, 65052, 20244, 77725, 22840, 90940, 24416,
+		104569, 24825, 118414, 23976, 132269, 21851, 145977, 18510, 159479, 14086, 172824, 8775, 186151, 2838, 199660, -3396,
+		213568, -9536, 228049, -15140, 243162, -19763, 258776, -23021, 274551, -24675, 290031, -24689, 304804, -23213, 318639, -20513,
+		331505, -16891, 343524, -12622, 354892, -7938, 5838, -3028, 16593, 1950, 27380, 6847, 38407, 11509, 49853, 15768,
+		61855, 19435, 74478, 22309, 87688, 24189, 101344, 24909, 115216, 24368, 129054, 22549, 142659, 19525, 155944, 15441,
+		168946, 10490, 181802, 4903, 194725, -1056, 207963, -7079, 221755, -12804, 236270, -17823, 251525, -21719, 267306, -24144,
+		283183, -24908, 298637, -24036, 313257, -21744, 326860, -18348, 339475, -14176, 351271, -9512, 2480, -4583, 13352, 433,
+		24132, 5385, 35055, 10134, 46334, 14528, 58150, 18394, 70617, 21536, 83757, 23743, 97463, 24817, 111507, 24618,
+		125595, 23095, 139459, 20307, 152939, 16409, 166011, 11620, 178780, 6194, 191438, 400, 204228, -5481, 217405, -11144,
+		231193, -16252, 245716, -20447, 260922, -23382, 276533, -24794, 292093, -24580, 307125, -22835, 321299, -19812, 334506, -15838,
+		346818, -11237, 358421, -6282, 9547, -1196, 20441, 3849, 31342, 8704, 42478, 13228, 54055, 17267, 66232, 20647,
+		79098, 23170, 92625, 24632, 106656, 24855, 120920, 23732, 135116, 21264, 149006, 17571, 162483, 12871, 175581, 7442,
+		188443, 1594, 201280, -4356, 214329, -10090, 227810, -15291, 241873, -19640, 256543, -22835, 271661, -24631, 286894, -24894,
+		301831, -23642, 316124, -21044, 329587, -17371, 342210, -12931, 354111, -8017, 5478, -2879, 16527, 2274, 27481, 7268,
+		38557, 11952, 49954, 16175, 61844, 19778, 74347, 22588, 87498, 24418, 101221, 25087, 115328, 24456, 129561, 22464,
+		143670, 19156, 157492, 14690, 170994, 9320, 184259, 3368, 197453, -2809, 210784, -8839, 224448, -14355, 238583, -19010,
+		253213, -22501, 268203, -24596, 283270, -25178, 298060, -24263, 312265, -21995, 325708, -18612, 338365, -14386, 350331, -9592,
+		1770, -4481, 12877, 729, 23853, 5845, 34896, 10694, 46188, 15117, 57888, 18951, 70111, 22030, 82907, 24185,
+		96239, 25251, 109974, 25092, 123918, 23626, 137861, 20846, 151652, 16836, 165234, 11776, 178661, 5930, 192072, -365,
+		205659, -6714, 219614, -12695, 234074, -17885, 249053, -21904, 264393, -24470, 279778, -25440, 294820, -24836, 309196, -22823,
+		322734, -19651, 335427, -15600, 347386, -10936, 358790, -5900, 9844, -701, 20755, 4472, 31718, 9442, 42913, 14039,
+		54486, 18092, 66544, 21427, 79127, 23875, 92198, 25277, 105633, 25506, 119252, 24486, 132870, 22203, 146354, 18713,
+		159659, 14137, 172842, 8666, 186047, 2559, 199476, -3848, 213353, -10150, 227860, -15888, 243064, -20596, 258833, -23874,
+		274814, -25472, 290520, -25348, 305512, -23661, 319539, -20695, 332567, -16771, 344720, -12190, 356204, -7206, 7251, -2030,
+		18095, 3159, 28955, 8196, 40027, 12915, 51476, 17145, 63414, 20708, 75886, 23422, 88848, 25120, 102167, 25670,
+		115648, 24995, 129084, 23086, 142325, 20004, 155318, 15864, 168116, 10827, 180867, 5096, 193787, -1074, 207134, -7360,
+		221157, -13362, 236028, -18615, 251745, -22642, 268036, -25051, 284383, -25649, 300197, -24494, 315047, -21854, 328770, -18092,
+		341441, -13570, 353266, -8590, 4502, -3391, 15412, 1836, 26242, 6932, 37219, 11745, 48537, 16114, 60342, 19869,
+		72709, 22823, 85617, 24798, 98937, 25642, 112456, 25260, 125933, 23637, 139175, 20834, 152086, 16980, 164690, 12243,
+		177114, 6822, 189565, 941, 202302, -5139, 215605, -11102, 229725, -16562, 244799, -21075, 260740, -24198, 277167, -25599,
+		293477, -25168, 309080, -23055, 323615, -19596, 337012, -15191, 349410, -10208, 1052, -4942, 12211, 380, 23155, 5580,
+		34131, 10509, 45360, 15018, 57025, 18950, 69244, 22131, 82046, 24378, 95345, 25524, 108943, 25447, 122582, 24100,
+		136020, 21529, 149105, 17861, 161807, 13278, 174214, 7999, 186503, 2259, 198911, -3686, 211708, -9557, 225158, -15031,
+		239464, -19737, 254678, -23278, 270605, -25294, 286787, -25564, 302641, -24092, 317685, -21104, 331686, -16963, 344662, -12061,
+		356786, -6748, 8305, -1304, 19479, 4053, 30561, 9151, 41783, 13841, 53344, 17972, 65396, 21388, 78016, 23917,
+		91180, 25389, 104745, 25663, 118480, 24656, 132129, 22373, 145495, 18912, 158486, 14447, 171137, 9206, 183581, 3452,
+		196024, -2538, 208707, -8467, 221880, -14019, 235748, -18856, 250408, -22631, 265769, -25017, 281512, -25782, 297160, -24860,
+		312243, -22378, 326464, -18618, 339749, -13935, 352194, -8683, 3998, -3175, 15391, 2331, 26610, 7627, 37877, 12540,
+		49390, 16912, 61306, 20591, 73728, 23417, 86671, 25234, 100052, 25896, 113694, 25303, 127374, 23421, 140890, 20297,
+		154120, 16064, 167052, 10925, 179779, 5140, 192470, -994, 205339, -7146, 218612, -12964, 232480, -18085, 247034, -22154,
+		262202, -24857, 277714, -25977, 293156, -25445, 308107, -23362, 322283, -19964, 335588, -15561, 348095, -10480, 359973, -5024,
+		11434, 541, 22698, 5985, 33975, 11109, 45448, 15735, 57267, 19697, 69532, 22835, 82268, 24998, 95417, 26050,
+		108839, 25890, 122348, 24470, 135764, 21805, 148973, 17981, 161953, 13148, 174777, 7516, 187599, 1355, 200627, -5008,
+		214082, -11191, 228157, -16780, 242943, -21353, 258359, -24541, 274110, -26092, 289753, -25929, 304844, -24161, 319095, -21036,
+		332421, -16866, 344906, -11969, 356731, -6631, 8120, -1106, 19300, 4384, 30483, 9638, 41857, 14465, 53569, 18685,
+		65713, 22122, 78311, 24616, 91299, 26028, 104538, 26261, 117847, 25268, 131052, 23062, 144043, 19711, 156799, 15333,
+		169397, 10083, 181997, 4168, 194817, -2148, 208108, -8526, 222110, -14550, 236978, -19749, 252686, -23650, 268944, -25882,
+		285229, -26274, 300967, -24901, 315754, -22032, 329452, -18028, 342148, -13244, 354052, -7989, 5419, -2516, 16506, 2965,
+		27552, 8265, 38767, 13205, 50322, 17607, 62328, 21288, 74818, 24071, 87730, 25802, 100911, 26370, 114154, 25723,
+		127258, 23879, 140087, 20913, 152601, 16943, 164867, 12115, 177041, 6597, 189345, 588, 202054, -5656, 215458, -11803,
+		229813, -17425, 245241, -22025, 261602, -25112, 278424, -26337, 295016, -25621, 310755, -23166, 325308, -19363, 338662, -14640,
+		351009, -9375, 2630, -3866, 13817, 1657, 24843, 7011, 35953, 12032, 47351, 16557, 59189, 20417, 71539, 23434,
+		84372, 25442, 97551, 26305, 110853, 25950, 124039, 24380, 136921, 21673, 149414, 17960, 161547, 13402, 173446, 8177,
+		185316, 2471, 197419, -3506, 210052, -9500, 223519, -15184, 238063, -20142, 253750, -23897, 270337, -25996, 287241, -26160,
+		303731, -24403, 319234, -21020, 333514, -16455, 346638, -11164, 358842, -5530, 10421, 154, 21670, 5671, 32859, 10846,
+		44222, 15528, 55945, 19564, 68146, 22795, 80850, 25059, 93971, 26209, 107318, 26147, 120644, 24845, 133723, 22357,
+		146414, 18810, 158691, 14376, 170637, 9254, 182420, 3654, 194267, -2204, 206447, -8083, 219249, -13707, 232938, -18745,
+		247685, -22805, 263444, -25471, 279866, -26397, 296346, -25435, 312250, -22702, 327165, -18539, 340980, -13393, 353818, -7699,
+		5917, -1824, 17555, 3946, 28999, 9393, 40491, 14341, 52231, 18638, 64363, 22135, 76952, 24685, 89963, 26149,
+		103260, 26420, 116633, 25445, 129860, 23244, 142775, 19911, 155307, 15604, 167495, 10522, 179467, 4891, 191416, -1043,
+		203578, -7016, 216207, -12740, 229545, -17898, 243762, -22143, 258874, -25115, 274662, -26504, 290678, -26129, 306383, -24013,
+		321348, -20380, 335380, -15589, 348512, -10046, 918, -4132, 12833, 1822, 24503, 7547, 36154, 12822, 47981, 17464,
+		60129, 21311, 72674, 24215, 85603, 26046, 98817, 26699, 112140, 26116, 125379, 24295, 138374, 21302, 151047, 17257,
+		163414, 12330, 175581, 6731, 187719, 705, 200046, -5466, 212799, -11466, 226201, -16941, 240407, -21517, 255422, -24831,
+		271040, -26582, 286851, -26603, 302364, -24913, 317183, -21704, 331124, -17289, 344209, -12023, 356594, -6256, 8503, -304,
+		20171, 5552, 31821, 11066, 43641, 16024, 55772, 20234, 68285, 23526, 81172, 25759, 94333, 26822, 107603, 26656,
+		120792, 25258, 133745, 22679, 146385, 19021, 158730, 14421, 170882, 9049, 183013, 3113, 195345, -3134, 208126, -9381,
+		221597, -15255, 235933, -20333, 251157, -24186, 267056, -26448, 283177, -26911, 298966, -25584, 313978, -22678, 328012, -18521,
+		341097, -13477, 353410, -7883, 5194, -2036, 16706, 3803, 28186, 9402, 39840, 14542, 51825, 19020, 64226, 22643,
+		77038, 25246, 90159, 26699, 103409, 26930, 116580, 25928, 129493, 23746, 142053, 20484, 154264, 16272, 166226, 11258,
+		178113, 5611, 190158, -471, 202632, -6733, 215817, -12844, 229960, -18387, 245176, -22882, 261329, -25859, 277969, -26984,
+		294435, -26171, 310125, -23605, 324711, -19648, 338172, -14719, 350687, -9197, 2529, -3400, 13983, 2416, 25321, 8032,
+		36781, 13251, 48553, 17880, 60756, 21728, 73420, 24616, 86461, 26394, 99698, 26964, 112898, 26299, 125844, 24444,
+		138398, 21502, 150533, 17611, 162323, 12924, 173928, 7599, 185571, 1807, 197526, -4255, 210100, -10331, 223601, -16078,
+		238264, -21055, 254130, -24756, 270903, -26715, 287942, -26667, 304485, -24654, 319974, -21004, 334217, -16179, 347323, -10642,
+		359555, -4774, 11225, 1128, 22633, 6831, 34048, 12146, 45692, 16895, 57724, 20907, 70219, 24010, 83138, 26046,
+		96333, 26900, 109569, 26519, 122605, 24928, 135256, 22225, 147446, 18552, 159212, 14075, 170682, 8965, 182057, 3396,
+		193584, -2451, 205557, -8367, 218291, -14083, 232081, -19249, 247117, -23423, 263331, -26119, 280291, -26936, 297266, -25717,
+		313533, -22625, 328659, -18074, 342579, -12567, 355473, -6575, 7629, -474, 19359, 5449, 30950, 10975, 42650, 15927,
+		54646, 20141, 67051, 23462, 79872, 25742, 93008, 26861, 106259, 26750, 119386, 25410, 132183, 22918, 144531, 19408,
+		156423, 15053, 167951, 10037, 179282, 4551, 190637, -1214, 202276, -7049, 214488, -12716, 227560, -17924, 241727, -22311,
+		257060, -25460, 273347, -26963, 290056, -26547, 306499, -24198, 322117, -20178, 336664, -14927, 350183, -8937, 2888, -2655,
+		15058, 3555, 26974, 9411, 38889, 14694, 51002, 19226, 63447, 22852, 76261, 25433, 89380, 26857, 102643, 27050,
+		115837, 26000, 128760, 23761, 141279, 20448, 153359, 16220, 165060, 11263, 176520, 5776, 187932, -31, 199530, -5933,
+		211572, -11683, 224317, -16998, 237986, -21550, 252680, -24974, 268287, -26910, 284430, -27084, 300548, -25404, 316110, -22011,
+		330803, -17231, 344578, -11491, 357575, -5231, 10029, 1150, 22196, 7314, 34318, 12983, 46592, 17930, 59150, 21972,
+		72043, 24956, 85218, 26768, 98535, 27336, 111796, 26643, 124809, 24736, 137443, 21711, 149658, 17709, 161504, 12896,
+		173107, 7460, 184648, 1608, 196346, -4428, 208443, -10382, 221182, -15949, 234765, -20785, 249283, -24523, 264636, -26816,
+		280483, -27405, 296323, -26201, 311667, -23310, 326214, -19003, 339901, -13644, 352847, -7618, 5270, -1297, 17419, 4982,
+		29534, 10915, 41816, 16236, 54405, 20715, 67350, 24162, 80603, 26433, 94013, 27442, 107375, 27167, 120483, 25652,
+		133193, 22993, 145460, 19320, 157333, 14786, 168942, 9554, 180475, 3803, 192158, -2259, 204244, -8379, 216991, -14247,
+		230619, -19493, 245237, -23704, 260749, -26479, 276794, -27519, 292821, -26716, 308293, -24184, 322883, -20206, 336525, -15148,
+		349353, -9379, 1602, -3239, 13542, 2966, 25433, 8955, 37501, 14467, 49913, 19255, 62747, 23098, 75971, 25812,
+		89435, 27271, 102907, 27429, 116142, 26317, 128955, 24032, 141267, 20714, 153110, 16516, 164610, 11594, 175956, 6105,
+		187386, 219, 199173, -5860, 211611, -11871, 224977, -17466, 239469, -22216, 255083, -25649, 271506, -27353, 288128, -27107,
+		304262, -24968, 319426, -21237, 333470, -16331, 346509, -10670, 358800, -4621, 10646, 1514, 22342, 7476, 34147, 13033,
+		46271, 17960, 58840, 22042, 71871, 25078, 85253, 26912, 98758, 27455, 112108, 26707, 125064, 24752, 137488, 21732,
+		149366, 17815, 160794, 13169, 171944, 7957, 183044, 2333, 194365, -3537, 206208, -9453, 218896, -15144, 232718, -20248,
+		247839, -24302, 264140, -26813, 281126, -27392, 298030, -25917, 314143, -22587, 329086, -17835, 342846, -12159, 355638, -6015,
+		7776, 232, 19581, 6296, 31348, 11947, 43322, 16985, 55675, 21219, 68480, 24467, 81683, 26568, 95108, 27415,
+		108491, 26973, 121565, 25296, 134142, 22511, 146152, 18787, 157641, 14310, 168748, 9259, 179671, 3804, 190653, -1891,
+		201973, -7651, 213938, -13264, 226865, -18452, 241015, -22845, 256478, -25991, 273022, -27434, 290045, -26867, 306775, -24274,
+		322603, -19951, 337283, -14391, 350891, -8123, 3677, -1616, 15953, 4757, 28024, 10709, 40153, 16017, 52540, 20497,
+		65294, 23983, 78410, 26334, 91761, 27448, 105129, 27280, 118264, 25861, 130966, 23294, 143133, 19735, 154771, 15367,
+		165982, 10380, 176932, 4962, 187833, -705, 198930, -6436, 210494, -12026, 222809, -17236, 236136, -21764, 250639, -25247,
+		266268, -27280, 282665, -27514, 299213, -25784, 315280, -22194, 330464, -17094, 344676, -10971, 358059, -4331, 10868, 2369,
+		23385, 8752, 35866, 14521, 48516, 19441, 61455, 23331, 74695, 26048, 88136, 27497, 101584, 27642, 114811, 26512,
+		127627, 24200, 139928, 20847, 151712, 16624, 163063, 11714, 174131, 6305, 185108, 588, 196218, -5234, 207706, -10944,
+		219822, -16292, 232798, -20989, 246786, -24708, 261771, -27097, 277505, -27848, 293515, -26775, 309262, -23895, 324346, -19432,
+		338617, -13759, 352148, -7319, 5145, -558, 17864, 6107, 30553, 12315, 43415, 17758, 56572, 22191, 70040, 25429,
+		83714, 27351, 97393, 27915, 110838, 27151, 123845, 25158, 136306, 22079, 148219, 18081, 159673, 13335, 170822, 8018,
+		181863, 2310, 193017, -3592, 204527, -9464, 216638, -15046, 229572, -20029, 243470, -24069, 258315, -26812, 273859, -27953,
+		289647, -27320, 305166, -24927, 320035, -20972, 334107, -15772, 347451, -9706, 268, -3161, 12819, 3486, 25363, 9876,
+		38124, 15679, 51250, 20595, 64781, 24380, 78619, 26853, 92546, 27928, 106280, 27613, 119569, 26004, 132265, 23252,
+		144342, 19535, 155884, 15030, 167054, 9906, 178059, 4328, 189137, -1531, 200548, -7471, 212557, -13244, 225413, -18542,
+		239294, -22996, 254213, -26209, 269932, -27821, 285961, -27618, 301716, -25597, 316747, -21968, 330869, -17068, 344145, -11278,
+		356791, -4968, 9087, 1522, 21319, 7874, 33748, 13786, 46568, 18965, 59873, 23142, 73619, 26091, 87608, 27665,
+		101536, 27821, 115094, 26623, 128059, 24218, 140349, 20794, 152015, 16545, 163203, 11649, 174122, 6271, 185017, 566,
+		196159, -5297, 207838, -11111, 220346, -16609, 233929, -21441, 248704, -25187, 264526, -27414, 280934, -27800, 297257, -26248,
+		312891, -22931, 327519, -18212, 341136, -12525, 353944, -6285, 6238, 151, 18328, 6472, 30504, 12405, 43001, 17689,
+		55971, 22075, 69437, 25334, 83267, 27291, 97191, 27853, 110880, 27037, 124054, 24961, 136558, 21808, 148384, 17782,
+		159642, 13081, 170516, 7883, 181240, 2345, 192076, -3378, 203313, -9113, 215258, -14645, 228212, -19688, 242398, -23863,
+		257845, -26727, 274256, -27856, 291003, -26999, 307354, -24189, 322786, -19737, 337126, -14109, 350493, -7795, 3160, -1228,
+		15445, 5237, 27660, 11306, 40066, 16731, 52853, 21285, 66094, 24761, 79723, 26989, 93529, 27862, 107214, 27365,
+		120485, 25579, 133145, 22666, 145131, 18826, 156504, 14268, 167415, 9183, 178068, 3747, 188704, -1879, 199589, -7534,
+		211013, -13031, 223274, -18139, 236644, -22553, 251279, -25887, 267091, -27715, 283657, -27681, 300296, -25637, 316353, -21733,
+		331456, -16361, 345561, -10033, 358856, -3260, 11628, 3511, 24183, 9916, 36795, 15664, 49672, 20514, 62919, 24270,
+		76508, 26774, 90275, 27928, 103959, 27710, 117283, 26187, 130046, 23500, 142162, 19834, 153663, 15394, 164669, 10380,
+		175356, 4979, 185941, -634, 196661, -6287, 207778, -11798, 219561, -16956, 232270, -21502, 246094, -25117, 261062, -27435,
+		276940, -28099, 293227, -26874, 309318, -23747, 324745, -18953, 339326, -12913, 353134, -6129, 6392, 900, 19376, 7722,
+		32350, 13960, 45523, 19307, 59008, 23525, 72792, 26443, 86727, 27961, 100564, 28066, 114037, 26827, 126944, 24382,
+		139197, 20913, 150824, 16616, 161940, 11687, 172712, 6312, 183342, 674, 194053, -5045, 205080, -10652, 216668, -15932,
+		229049, -20634, 242398, -24468, 256763, -27112, 271988, -28252, 287695, -27656, 303380, -25251, 318610, -21163, 333160, -15690,
+		347039, -9239, 422, -2264, 13560, 4774, 26713, 11442, 40096, 17353, 53833, 22186, 67915, 25700, 82186, 27751,
+		96373, 28305, 110179, 27434, 123375, 25289, 135858, 22063, 147656, 17960, 158889, 13173, 169739, 7884, 180417, 2265,
+		191150, -3506, 202175, -9233, 213728, -14693, 226033, -19626, 239251, -23732, 253424, -26687, 268399, -28189, 283816, -28022,
+		299201, -26118, 314143, -22584, 328424, -17668, 342049, -11703, 355184, -5066, 8080, 1847, 21012, 8635, 34227, 14901,
+		47896, 20271, 62053, 24422, 76562, 27123, 91127, 28268, 105384, 27886, 119024, 26126, 131884, 23199, 143957, 19333,
+		155362, 14739, 166289, 9601, 176968, 4087, 187646, -1644, 198581, -7415, 210032, -13018, 222244, -18196, 235410, -22638,
+		249597, -25990, 264664, -27909, 280231, -28143, 295771, -26609, 310807, -23422, 325080, -18848, 338578, -13226, 351472, -6913,
+		4029, -258, 16547, 6397, 29312, 12707, 42551, 18325, 56382, 22913, 70752, 26178, 85413, 27927, 99977, 28104,
+		114048, 26804, 127353, 24228, 139804, 20623, 151472, 16232, 162534, 11262, 173220, 5891, 183787, 273, 194508, -5437,
+		205667, -11066, 217545, -16392, 230396, -21130, 244375, -24924, 259440, -27384, 275264, -28170, 291276, -27105, 306871, -24250,
+		321647, -19879, 335499, -14378, 348562, -8157, 1100, -1592, 13430, 4982, 25862, 11254, 38665, 16926, 52022, 21700,
+		65975, 25297, 80378, 27489, 94907, 28154, 109153, 27312, 122769, 25111, 135566, 21784, 147535, 17586, 158801, 12757,
+		169565, 7496, 180073, 1976, 190588, -3652, 201392, -9234, 212775, -14589, 225026, -19487, 238386, -23624, 252955, -26624,
+		268583, -28094, 284805, -27733, 300969, -25452, 316502, -21429, 331113, -16043, 344814, -9762, 357816, -3044, 10420, 3707,
+		22942, 10143, 35668, 15967, 48812, 20907, 62471, 24718, 76580, 27193, 90898, 28204, 105076, 27730, 118765, 25871,
+		131735, 22822, 143915, 18823, 155372, 14118, 166264, 8929, 176805, 3447, 187232, -2158, 197803, -7727, 208787, -13095,
+		220461, -18067, 233081, -22398, 246831, -25778, 261723, -27847, 277499, -28259, 293645, -26793, 309558, -23452, 324795, -18488,
+		339200, -12327, 352870, -5465, 6044, 1607, 19016, 8451, 32064, 14693, 45408, 20020, 59160, 24178, 73284, 26974,
+		87583, 28294, 101748, 28124, 115462, 26556, 128500, 23765, 140780, 19974, 152349, 15412, 163343, 10301, 173950, 4841,
+		184385, -786, 194880, -6406, 205676, -11846, 217019, -16915, 229142, -21394, 242230, -25019, 256348, -27488, 271370, -28491,
+		286946, -27782, 302589, -25261, 317855, -21028, 332500, -15371, 346519, -8712, 79, -1533, 13435, 5675, 26855, 12450,
+		40558, 18383, 54660, 23139, 69125, 26471, 83748, 28245, 98208, 28449, 112173, 27188, 125413, 24652, 137845, 21070,
+		149526, 16673, 160599, 11676, 171259, 6274, 181720, 647, 192207, -5030, 202949, -10573, 214172, -15786, 226090, -20445,
+		238866, -24295, 252563, -27058, 267078, -28458, 282125, -28272, 297294, -26393, 312196, -22865, 326595, -17879, 340458, -11747,
+		353921, -4855, 7223, 2356, 20634, 9425, 34399, 15889, 48669, 21323, 63439, 25375, 78502, 27815, 93487, 28573,
+		107986, 27737, 121698, 25512, 134499, 22160, 146437, 17938, 157668, 13075, 168408, 7767, 178892, 2188, 189362, -3497,
+		200058, -9113, 211215, -14465, 223047, -19324, 235722, -23428, 249300, -26488, 263682, -28222, 278576, -28411, 293568, -26954,
+		308260, -23899, 322409, -19425, 335976, -13804, 349099, -7357, 2018, -442, 15025, 6551, 28402, 13203, 42371, 19082,
+		57014, 23772, 72197, 26937, 87563, 28389, 102626, 28128, 116957, 26330, 130323, 23269, 142706, 19241, 154244, 14514,
+		165159, 9306, 175707, 3797, 186150, -1854, 196752, -7487, 207773, -12927, 219456, -17958, 231999, -22322, 245504, -25716,
+		259903, -27828, 274912, -28400, 290082, -27299, 304948, -24564, 319200, -20386, 332756, -15056, 345731, -8903, 358365, -2262,
+		10959, 4529, 23824, 11115, 37232, 17123, 51358, 22161, 66195, 25863, 81491, 27953, 96787, 28317, 111575, 27038,
+		125485, 24353, 138380, 20570, 150326, 15991, 161517, 10874, 172202, 5424, 182653, -190, 193146, -5814, 203959, -11293,
+		215360, -16439, 227595, -21019, 240832, -24747, 255091, -27294, 270163, -28349, 285610, -27703, 300899, -25327, 315602, -21392,
+		329536, -16203, 342751, -10133, 355462, -3553, 7962, 3183, 20567, 9739, 33572, 15780, 47200, 20968, 61536, 24968,
+		76450, 27494, 91589, 28373, 106473, 27599, 120671, 25333, 133940, 21850, 146251, 17458, 157735, 12440, 168607, 7036,
+		179122, 1438, 189549, -4191, 200160, -9699, 211232, -14920, 223028, -19656, 235771, -23659, 249576, -26624, 264367, -28224,
+		279817, -28180, 295410, -26356, 310631, -22822, 325154, -17842, 338913, -11803, 352053, -5129, 4835, 1766, 17562, 8499,
+		30533, 14720, 43987, 20101, 58046, 24337, 72656, 27166, 87552, 28415, 102325, 28044, 116559, 26161, 129977, 22995,
+		142492, 18829, 154179, 13947, 165212, 8603, 175815, 3011, 186233, -2645, 196718, -8202, 207529, -13500, 218922, -18359,
+		231128, -22567, 244312, -25865, 258502, -27955, 273518, -28544, 288967, -27422, 304357, -24538, 319283, -20037, 333560, -14236,
+		347226, -7554, 480, -449, 13593, 6624, 26850, 13244, 40487, 19029, 54638, 23649, 69272, 26840, 84161, 28439,
+		98934, 28408, 113202, 26850, 126690, 23973, 139298, 20042, 151085, 15329, 162207, 10083, 172871, 4524, 183303, -1154,
+		193736, -6774, 204404, -12166, 215539, -17153, 227355, -21535, 240020, -25084, 253597, -27543, 267993, -28649, 282932, -28184,
+		298019, -26034, 312872, -22241, 327260, -17001, 341150, -10640, 354678, -3574, 8081, 3734, 21630, 10802, 35566, 17163,
+		50031, 22396, 64997, 26168, 80230, 28268, 95334, 28651, 109895, 27425, 123620, 24819, 136404, 21109, 148311, 16571,
+		159507, 11451, 170210, 5966, 180649, 304, 191057, -5357, 201661, -10840, 212676, -15960, 224300, -20517, 236683, -24286,
+		249886, -27027, 263835, -28503, 278300, -28517, 292942, -26959, 307423, -23834, 321523, -19271, 335197, -13500, 348555, -6836,
+		1822, 335, 15273, 7571, 29179, 14386, 43736, 20287, 58968, 24823, 74659, 27663, 90365, 28666, 105565, 27906,
+		119864, 25622, 133098, 22134, 145320, 17755, 156710, 12755, 167510, 7356, 177976, 1743, 188361, -3915, 198908, -9452,
+		209844, -14686, 221373, -19414, 233646, -23404, 246725, -26409, 260530, -28186, 274828, -28542, 289273, -27374, 303520, -24699,
+		317338, -20642, 330675, -15410, 343637, -9261, 356447, -2498, 9395, 4530, 22789, 11416, 36896, 17695, 51861, 22882,
+		67590, 26531, 83695, 28347, 99580, 28263, 114676, 26459, 128656, 23266, 141474, 19051, 153289, 14141, 164351, 8796,
+		174942, 3218, 185343, -2429, 195823, -7989, 206638, -13299, 218022, -18171, 230161, -22380, 243154, -25669, 256954, -27772,
+		271333, -28464, 285917, -27614, 300302, -25230, 314194, -21452, 327490, -16509, 340271, -10675, 352746, -4238, 5205, 2498,
+		17969, 9206, 31351, 15508, 45589, 20983, 60751, 25189, 76624, 27742, 92701, 28426, 108345, 27268, 123045, 24523,
+		136577, 20560, 148989, 15759, 160489, 10437, 171353, 4842, 181873, -837, 192335, -6443, 203014, -11824, 214170, -16817,
+		226032, -21222, 238762, -24800, 252394, -27280, 266775, -28399, 281559, -27966, 296298, -25926, 310606, -22385, 324275, -17581,
+		337313, -11824, 349887, -5446, 2261, 1225, 14748, 7865, 27661, 14140, 41272, 19695, 55737, 24154, 70995, 27157,
+		86711, 28437, 102339, 27910, 117325, 25708, 131316, 22129, 144222, 17540, 156161, 12291, 167359, 6675, 178084, 926,
+		188614, -4774, 199220, -10265, 210165, -15392, 221693, -19980, 234006, -23824, 247212, -26679, 261271, -28283, 275946, -28404,
+		290840, -26903, 305525, -23798, 319697, -19260, 333254, -13589, 346281, -7145, 358995, -313, 11676, 6527, 24621, 13006,
+		38095, 18765, 52269, 23455, 67142, 26756, 82481, 28424, 97848, 28356, 112750, 26625, 126821, 23460, 139912, 19178,
+		152074, 14112, 163484, 8562, 174373, 2786, 184990, -3004, 195586, -8625, 206405, -13910, 217680, -18689, 229612, -22776,
+		242338, -25957, 255884, -28001, 270118, -28681, 284750, -27825, 299411, -25368, 313775, -21382, 327669, -16078, 341100, -9769,
+		354221, -2842, 7275, 4282, 20537, 11166, 34263, 17382, 48617, 22527, 63602, 26254, 78999, 28320, 94393, 28629,
+		109318, 27260, 123421, 24433, 136557, 20448, 148771, 15614, 160230, 10219, 171157, 4512, 181789, -1289, 192363, -6990,
+		203105, -12410, 214228, -17369, 225918, -21678, 238306, -25136, 251431, -27532, 265205, -28662, 279403, -28363, 293720, -26549,
+		307868, -23237, 321671, -18553, 335105, -12716, 348291, -6034, 1447, 1115, 14842, 8292, 28743, 15019, 43343, 20805,
+		58666, 25205, 74490, 27886, 90359, 28701, 105734, 27721, 120204, 25190, 133600, 21436, 145973, 16793, 157508, 11550,
+		168450, 5954, 179055, 210, 189570, -5495, 200227, -10984, 211237, -16072, 222781, -20565, 234984, -24257, 247883, -26937,
+		261396, -28405, 275308, -28509, 289330, -27171, 303183, -24404, 316692, -20309, 329831, -15056, 342713, -8875, 355554, -2054,
+		8635, 5050, 22255, 12004, 36673, 18308, 52009, 23440, 68125, 26934, 84567, 28498, 100691, 28097, 115921, 25954,
+		129960, 22433, 142808, 17925, 154653, 12770, 165765, 7236, 176430, 1534, 186924, -4157, 197504, -9672, 208401, -14841,
+		219816, -19475, 231891, -23365, 244677, -26292, 258100, -28045, 271947, -28459, 285908, -27452, 299678, -25040, 313048, -21333,
+		325966, -16505, 338526, -10769, 350935, -4365, 3479, 2424, 16477, 9259, 30247, 15723, 45022, 21324, 60833, 25548,
+		77379, 27961, 94032, 28349, 110067, 26798, 124969, 23638, 138574, 19301, 151001, 14196, 162513, 8654, 173416, 2921,
+		184012, -2811, 194585, -8380, 205394, -13631, 216667, -18390, 228585, -22464, 241242, -25634, 254603, -27676, 268478, -28399,
+		282551, -27691, 296472, -25549, 309976, -22083, 322953, -17481, 335457, -11977, 347667, -5821, 359847, 720, 12310, 7359,
+		25387, 13754, 39370, 19503, 54424, 24145, 70453, 27222, 87008, 28398, 103393, 27582, 118944, 24969, 133291, 20950,
+		146398, 15967, 158453, 10415, 169742, 4603, 180573, -1238, 191243, -6928, 202026, -12310, 213174, -17224, 224898, -21496,
+		237341, -24923, 250535, -27285, 264353, -28374, 278519, -28036, 292668, -26222, 306475, -23006, 319757, -18569, 332500, -13160,
+		344838, -7059, 357003, -558, 9284, 6042, 21993, 12424, 35429, 18234, 49803, 23079, 65142, 26552, 81181, 28303,
+		97371, 28151, 113069, 26158, 127800, 22607, 141384, 17900, 153901, 12447, 165575, 6602, 176683, 647, 187512, -5200,
+		198335, -10762, 209404, -15875, 220943, -20375, 233125, -24078, 246033, -26783, 259619, -28285, 273682, -28409, 287905, -27055,
+		301950, -24238, 315575, -20085, 328694, -14824, 341375, -8740, 353805, -2156, 6242, 4591, 18975, 11150, 32285, 17157,
+		46385, 22233, 61338, 26006, 76966, 28155, 92834, 28491, 108382, 27021, 123139, 23953, 136875, 19623, 149599, 14408,
+		161482, 8661, 172767, 2685, 183716, -3272, 194583, -9003, 205609, -14325, 217007, -19067, 228953, -23053, 241552, -26096,
+		254803, -28008, 268575, -28617, 282618, -27803, 296635, -25532, 310377, -21868, 323719, -16974, 336679, -11090, 349400, -4519,
+		2106, 2388, 15067, 9242, 28549, 15627, 42761, 21119, 57766, 25312, 73401, 27875, 89255, 28622, 104796, 27559,
+		119568, 24881, 133336, 20902, 146103, 15971, 158026, 10422, 169338, 4545, 180292, -1409, 191137, -7223, 202100, -12698,
+		213385, -17649, 225156, -21891, 237514, -25238, 250472, -27509, 263928, -28545, 277674, -28230, 291450, -26515, 305027, -23433,
+		318277, -19093, 331204, -13668, 343931, -7394, 356668, -570, 9682, 6441, 23252, 13206, 37613, 19243, 52861, 24063,
+		68845, 27243, 85128, 28525, 101105, 27888, 116248, 25540, 130283, 21823, 143211, 17113, 155206, 11747, 166522, 6008,
+		177431, 136, 188195, -5665, 199052, -11200, 210210, -16282, 221837, -20718, 234034, -24312, 246814, -26878, 260075, -28251,
+		273614, -28320, 287173, -27041, 300521, -24447, 313524, -20634, 326178, -15748, 338599, -9970, 350999, -3524, 3651, 3308,
+		16866, 10165, 30939, 16592, 46066, 22064, 62212, 26047, 78996, 28125, 95733, 28136, 111711, 26225, 126484, 22760,
+		139966, 18184, 152322, 12896, 163836, 7216, 174816, 1389, 185556, -4388, 196322, -9938, 207348, -15087, 218824, -19651,
+		230878, -23436, 243543, -26242, 256728, -27892, 270226, -28257, 283754, -27285, 297049, -25013, 309940, -21548, 322392, -17048,
+		334501, -11689, 346465, -5668, 358553, 796, 11084, 7431, 24396, 13885, 38786, 19707, 54398, 24366, 71066, 27338,
+		88219, 28256, 105041, 27069, 120829, 24048, 135258, 19661, 148371, 14394, 160423, 8652, 171742, 2740, 182656, -3120,
+		193463, -8749, 204429, -13989, 215777, -18676, 227673, -22631, 240197, -25661, 253305, -27579, 266817, -28230, 280449, -27535,
+		293895, -25508, 306928, -22256, 319457, -17949, 331531, -12791, 343314, -6994, 355048, -779, 7031, 5619, 19593, 11919,
+		33062, 17771, 47692, 22741, 63529, 26340, 80268, 28120, 97240, 27833, 113655, 25543, 128946, 21602, 142928, 16500,
+		155726, 10718, 167618, 4649, 178927, -1415, 189968, -7255, 201023, -12698, 212341, -17588, 224118, -21764, 236476, -25050,
+		249434, -27270, 262874, -28260, 276556, -27910, 290179, -26195, 303474, -23186, 316288, -19040, 328611, -13968, 340555, -8210,
+		352324, -2015, 4181, 4358, 16422, 10632, 29353, 16497, 43238, 21590, 58209, 25503, 74144, 27832, 90599, 28276,
+		106909, 26760, 122462, 23473, 136921, 18801, 150258, 13202, 162653, 7107, 174378, 874, 185725, -5217, 196970, -10948,
+		208362, -16137, 220106, -20620, 232346, -24231, 245136, -26806, 258416, -28192, 272009, -28270, 285656, -26986, 299099, -24368,
+		312158, -20529, 324776, -15648, 337018, -9955, 349048, -3714, 1096, 2789, 13429, 9242, 26322, 15310, 40015, 20627,
+		54638, 24812, 70117, 27499, 86110, 28417, 102071, 27467, 117455, 24768, 131913, 20615, 145362, 15395, 157922, 9506,
+		169820, 3306, 181317, -2896, 192673, -8845, 204120, -14322, 215856, -19135, 228025, -23105, 240691, -26067, 253817, -27871,
+		267261, -28403, 280803, -27603, 294208, -25476, 307305, -22101, 320024, -17612, 332411, -12195, 344608, -6078, 356826, 467,
+		9321, 7119, 22360, 13509, 36179, 19228, 50907, 23848, 66465, 26973, 82512, 28317, 98502, 27784, 113893, 25494,
+		128340, 21736, 141763, 16873, 154284, 11271, 166131, 5262, 177567, -866, 188851, -6856, 200216, -12482, 211861, -17530,
+		223927, -21803, 236481, -25115, 249491, -27305, 262820, -28252, 276255, -27893, 289563, -26233, 302568, -23339, 315195, -19328,
+		327486, -14351, 339578, -8586, 351686, -2252, 4071, 4380, 17021, 10967, 30808, 17091, 45610, 22272, 61403, 26026,
+		77851, 27969, 94348, 27932, 110240, 26015, 125090, 22530, 138776, 17883, 151426, 12465, 163291, 6612, 174662, 601,
+		185822, -5333, 197026, -10977, 208491, -16129, 220380, -20587, 232780, -24155, 245671, -26653, 258923, -27942, 272312, -27942,
+		285585, -26652, 298536, -24141, 311063, -20528, 323187, -15963, 335035, -10608, 346815, -4639, 358796, 1733, 11287, 8242,
+		24605, 14533, 39021, 20154, 54643, 24582, 71269, 27313, 88320, 28012, 105013, 26647, 120698, 23495, 135095, 19004,
+		148261, 13640, 160450, 7800, 171982, 1792, 183171, -4146, 194304, -9813, 205628, -15025, 217337, -19597, 229553, -23342,
+		242297, -26075, 255466, -27640, 268845, -27935, 282162, -26936, 295169, -24703, 307720, -21362, 319795, -17072, 331490, -12007,
+		342991, -6344, 354549, -266, 6465, 6014, 19071, 12223, 32694, 17999, 47575, 22880, 63723, 26341, 80768, 27915,
+		97971, 27365, 114511, 24798, 129849, 20608, 143854, 15314, 156696, 9401, 168682, 3258, 180151, -2828, 191415, -8636,
+		202751, -13986, 214382, -18709, 226466, -22632, 239068, -25584, 252134, -27405, 265489, -27978, 278870, -27254, 292009, -25268,
+		304710, -22134, 316903, -18017, 328640, -13106, 340074, -7595, 351425, -1678, 2967, 4441, 15006, 10527, 27868, 16289,
+		41841, 21356, 57080, 25276, 73453, 27575, 90448, 27895, 107295, 26139, 123296, 22534, 138091, 17532, 151681, 11654,
+		164288, 5369, 176223, -951, 187804, -7026, 199322, -12643, 211020, -17625, 223081, -21808, 235605, -25032, 248583, -27147,
+		261890, -28034, 275301, -27626, 288559, -25933, 301449, -23042, 313858, -19102, 325793, -14303, 337366, -8852, 348765, -2964,
+		230, 3140, 12040, 9223, 24486, 15011, 37847, 20181, 52312, 24353, 67873, 27113, 84219, 28099, 100756, 27120,
+		116821, 24246, 131953, 19792, 146005, 14211, 159087, 7979, 171446, 1521, 183369, -4816, 195134, -10760, 206987, -16093,
+		219118, -20632, 231645, -24216, 244587, -26697, 257854, -27960, 271261, -27934, 284577, -26614, 297594, -24060, 310187, -20397,
+		322339, -15793, 334128, -10444, 345715, -4567, 357314, 1600, 9175, 7799, 21566, 13741, 34738, 19098, 48870, 23509,
+		63977, 26597, 79827, 28030, 95940, 27616, 111744, 25367, 126791, 21506, 140892, 16398, 154097, 10461, 166604, 4101,
+		178669, -2317, 190555, -8483, 202497, -14135, 214684, -19051, 227233, -23038, 240175, -25938, 253432, -27626, 266836, -28029,
+		280170, -27136, 293234, -24998, 305902, -21721, 318151, -17447, 330054, -12347, 341760, -6612, 353476, -462, 5442, 5845,
+		17919, 12007, 31153, 17669, 45317, 22437, 60424, 25910, 76245, 27746, 92307, 27751, 108047, 25938, 123025, 22520,
+		137055, 17833, 150184, 12254, 162607, 6151, 174580, -142, 186368, -6325, 198212, -12129, 210308, -17307, 222786, -21639,
+		235683, -24933, 248926, -27040, 262343, -27869, 275705, -27399, 288796, -25680, 301474, -22815, 313704, -18940, 325555, -14208,
+		337180, -8782, 348790, -2841, 638, 3398, 13001, 9665, 26155, 15614, 40320, 20827, 55560, 24841, 71670, 27233,
+		88144, 27730, 104326, 26308, 119674, 23192, 133946, 18753, 147180, 13396, 159590, 7494, 171460, 1362, 183079, -4727,
+		194713, -10525, 206585, -15803, 218852, -20336, 231585, -23918, 244736, -26370, 258139, -27571, 271547, -27473, 284705, -26114,
+		297428, -23597, 309646, -20067, 321405, -15684, 332844, -10605, 344171, -4987, 355644, 998, 7556, 7141, 20221, 13163,
+		33932, 18695, 48876, 23272, 64997, 26390, 81873, 27631, 98782, 26817, 114984, 24089, 130034, 19838, 143860, 14542,
+		156650, 8647, 168707, 2514, 180357, -3572, 191900, -9381, 203594, -14705, 215635, -19345, 228137, -23107, 241104, -25808,
+		254410, -27305, 267824, -27520, 281069, -26460, 293909, -24214, 306219, -20930, 317996, -16782, 329344, -11943, 340449, -6581,
+		351549, -855, 2924, 5062, 14888, 10958, 27767, 16549, 41849, 21455, 57268, 25204, 73848, 27303, 91021, 27388,
+		107975, 25388, 124016, 21565, 138822, 16401, 152433, 10429, 165102, 4113, 177157, -2183, 188923, -8187, 200689, -13686,
+		212683, -18496, 225059, -22444, 237869, -25366, 251046, -27122, 264405, -27621, 277690, -26843, 290654, -24850, 303126, -21771,
+		315053, -17782, 326492, -13070, 337588, -7822, 348548, -2215, 359618, 3573, 11082, 9351, 23242, 14888, 36397, 19889,
+		50774, 23975, 66403, 26707, 82987, 27668, 99900, 26612, 116405, 23581, 131971, 18908, 146419, 13093, 159860, 6665,
+		172556, 85, 184814, -6279, 196925, -12149, 209132, -17309, 221610, -21581, 234439, -24812, 247593, -26877, 260931, -27690,
+		274238, -27224, 287286, -25524, 299903, -22696, 312010, -18897, 323633, -14308, 334882, -9123, 345930, -3535, 356994, 2259,
+		8321, 8055, 20176, 13624, 32824, 18699, 46481, 22960, 61238, 26043, 76956, 27581, 93218, 27298, 109433, 25113,
+		125063, 21192, 139820, 15899, 153684, 9701, 166826, 3074, 179499, -3552, 191971, -9819, 204476, -15437, 217191, -20177,
+		230203, -23859, 243496, -26347, 256947, -27558, 270358, -27469, 283515, -26121, 296256, -23615, 308504, -20092, 320281, -15717,
+		331687, -10669, 342881, -5136, 354063, 682, 5464, 6568, 17325, 12276, 29884, 17527, 43334, 22002, 57750, 25358,
+		73017, 27262, 88789, 27467, 104571, 25882, 119893, 22611, 134475, 17927, 148272, 12203, 161413, 5851, 174124, -725,
+		186655, -7154, 199239, -13101, 212053, -18280, 225194, -22455, 238647, -25443, 252284, -27132, 265894, -27484, 279245, -26538,
+		292155, -24398, 304539, -21206, 316417, -17126, 327896, -12321, 339141, -6961, 350360, -1226, 1785, 4683, 13664, 10523,
+		26240, 16005, 39710, 20788, 54155, 24499, 69455, 26781, 85252, 27372, 101031, 26182, 116305, 23320, 130790, 19055,
+		144442, 13736, 157401, 7729, 169902, 1390, 182210, -4946, 194575, -10965, 207203, -16374, 220219, -20905, 233639, -24333,
+		247348, -26492, 261123, -27302, 274691, -26775, 287820, -25007, 300381, -22149, 312369, -18372, 323878, -13847, 335080, -8735,
+		346190, -3196, 357456, 2599, 9144, 8446, 21527, 14082, 34848, 19173, 49250, 23323, 64672, 26114, 80772, 27205,
+		96977, 26439, 112689, 23900, 127524, 19876, 141386, 14752, 154405, 8924, 166834, 2744, 178961, -3472, 191067, -9445,
+		203390, -14909, 216103, -19612, 229275, -23321, 242843, -25843, 256612, -27051, 270296, -26915, 283615, -25500, 296375, -22946,
+		308514, -19434, 320090, -15150, 331253, -10266, 342212, -4942, 353214, 669, 4532, 6399, 16459, 12036, 29286, 17301,
+		43246, 21830, 58412, 25189, 74574, 26950, 91198, 26821, 107584, 24765, 123160, 21022, 137674, 16009, 151176, 10186,
+		163900, 3971, 176150, -2289, 188229, -8310, 200407, -13843, 212891, -18661, 225801, -22552, 239134, -25327, 252753, -26845,
+		266410, -27042, 279815, -25945, 292733, -23670, 305040, -20387, 316740, -16292, 327945, -11573, 338834, -6402, 349635, -940,
+		606, 4659, 12030, 10216, 24206, 15509, 37414, 20243, 51847, 24039, 67488, 26466, 83996, 27132, 100732, 25831,
+		117003, 22642, 132341, 17911, 146622, 12123, 159982, 5776, 172696, -699, 185071, -6953, 197392, -12712, 209894, -17747,
+		222727, -21860, 235933, -24879, 249427, -26670, 263010, -27162, 276424, -26361, 289432, -24356, 301880, -21299, 313732, -17375,
+		325054, -12782, 335992, -7704, 346743, -2317, 357537, 3216, 8632, 8722, 20300, 14006, 32815, 18825, 46408, 22869,
+		61178, 25768, 76990, 27137, 93407, 26677, 109806, 24297, 125622, 20176, 140552, 14708, 154585, 8391, 167902, 1723,
+		180772, -4859, 193471, -11001, 206237, -16421, 219234, -20899, 232528, -24260, 246064, -26379, 259677, -27190, 273138, -26697,
+		286226, -24979, 298790, -22171, 310783, -18447, 322249, -13995, 333310, -9005, 344137, -3657, 354933, 1869, 5929, 7392,
+		17368, 12716, 29494, 17613, 42520, 21814, 56572, 25002, 71604, 26843, 87339, 27044, 103305, 25449, 118996, 22101,
+		134058, 17253, 148380, 11305, 162054, 4722, 175289, -2029, 188333, -8525, 201411, -14406, 214689, -19379, 228232, -23224,
+		241993, -25790, 255810, -27006, 269458, -26877, 282717, -25484, 295440, -22965, 307576, -19487, 319177, -15232, 330360, -10378,
+		341294, -5104, 352174, 412, 3214, 5983, 14638, 11400, 26669, 16430, 39498, 20803, 53233, 24220, 67839, 26376,
+		83086, 27008, 98579, 25965, 113884, 23255, 128681, 19053, 142846, 13662, 156441, 7461, 169648, 862, 182702, -5725,
+		195830, -11907, 209209, -17333, 222919, -21710, 236916, -24821, 251026, -26544, 264994, -26862, 278561, -25851, 291549, -23658,
+		303893, -20462, 315640, -16449, 326919, -11795, 337909, -6668, 348818, -1232, 359870, 4336, 11297, 9837, 23323, 15032,
+		36144, 19638, 49867, 23338, 64452, 25809, 79659, 26781, 95077, 26104, 110264, 23796, 124892, 20029, 138844, 15086,
+		152187, 9299, 165113, 3021, 177869, -3395, 190705, -9597, 203834, -15240, 217383, -20002, 231354, -23608, 245597, -25863,
+		259836, -26685, 273755, -26112, 287099, -24280, 299745, -21379, 311704, -17615, 323095, -13179, 334103, -8243, 344949, -2960,
+		355874, 2515, 7132, 8009, 18978, 13311, 31643, 18151, 45290, 22201, 59931, 25099, 75349, 26514, 91104, 26235,
+		106661, 24247, 121596, 20732, 135725, 16005, 149094, 10427, 161900, 4353, 174410, -1889, 186903, -7990, 199629, -13649,
+		212773, -18573, 226408, -22487, 240462, -25160, 254703, -26447, 268803, -26321, 282444, -24871, 295413, -22274, 307649, -18747,
+		319221, -14503, 330291, -9735, 341072, -4606, 351807, 737, 2756, 6143, 14193, 11441, 26388, 16407, 39576, 20755,
+		53883, 24126, 69225, 26140, 85239, 26479, 101351, 25005, 116989, 21825, 131797, 17251, 145707, 11699, 158867, 5591,
+		171536, -696, 184008, -6836, 196559, -12548, 209411, -17571, 222696, -21661, 236416, -24605, 250425, -26241, 264450, -26501,
+		278174, -25421, 291341, -23138, 303815, -19852, 315601, -15783, 326809, -11142, 337618, -6115, 348249, -864, 358945, 4463,
+		9967, 9711, 21584, 14702, 34060, 19204, 47603, 22922, 62285, 25502, 77940, 26581, 94128, 25892, 110257, 23376,
+		125811, 19222, 140533, 13814, 154433, 7624, 167699, 1117, 180599, -5300, 193407, -11289, 206359, -16568, 219610, -20905,
+		233205, -24106, 247055, -26030, 260951, -26607, 274626, -25855, 287835, -23881, 300426, -20855, 312366, -16983, 323722, -12474,
+		334634, -7527, 345290, -2321, 355903, 2981, 6704, 8220, 17936, 13227, 29840, 17805, 42631, 21714, 56445, 24663,
+		71263, 26330, 86843, 26421, 102744, 24762, 118469, 21375, 133649, 16498, 148148, 10531, 162039, 3951, 175521, -2763,
+		188835, -9178, 202205, -14926, 215782, -19717, 229617, -23330, 243632, -25624, 257640, -26540, 271397, -26106, 284683, -24429,
+		297361, -21671, 309399, -18025, 320860, -13690, 331874, -8856, 342608, -3706, 353256, 1587, 4024, 6854, 15129, 11918,
+		26780, 16583, 39166, 20629, 52412, 23802, 66531, 25830, 81375, 26455, 96636, 25493, 111927, 22892, 126916, 18766,
+		141432, 13383, 155478, 7123, 169195, 429, 182790, -6240, 196472, -12445, 210397, -17801, 224613, -22001, 239032, -24838,
+		253442, -26221, 267568, -26175, 281166, -24818, 294088, -22331, 306307, -18917, 317899, -14776, 329005, -10094, 339806, -5046,
+		350499, 202, 1290, 5483, 12386, 10614, 23988, 15395, 36266, 19597, 49329, 22969, 63178, 25251, 77667, 26208,
+		92514, 25677, 107375, 23611, 121961, 20090, 136123, 15317, 149871, 9582, 163339, 3236, 176733, -3326, 190277, -9689,
+		204156, -15439, 218459, -20196, 233126, -23657, 247937, -25639, 262562, -26105, 276676, -25151, 290060, -22970, 302644, -19789,
+		314494, -15833, 325759, -11305, 336638, -6378, 347349, -1210, 358117, 4041, 9166, 9210, 20711, 14100, 32938, 18482,
+		45968, 22093, 59809, 24653, 74312, 25910, 89170, 25691, 104008, 23952, 118505, 20784, 132492, 16393, 145976, 11051,
+		159101, 5069, 172089, -1222, 185189, -7473, 198632, -13316, 212576, -18381, 227046, -22327, 241889, -24887, 256785, -25926,
+		271343, -25466, 285238, -23663, 298304, -20752, 310540, -16985, 322071, -12592, 333094, -7770, 343838, -2684, 354543, 2518,
+		5457, 7686, 16818, 12647, 28847, 17191, 41716, 21064, 55487, 23971, 70057, 25621, 85127, 25786, 100273, 24375,
+		115090, 21464, 129325, 17272, 142931, 12102, 156027, 6282, 168834, 143, 181614, -5994, 194626, -11810, 208079, -16981,
+		222082, -21189, 236581, -24156, 251348, -25689, 266017, -25727, 280212, -24355, 293670, -21772, 306296, -18231, 318146, -13983,
+		329380, -9253, 340212, -4228, 350881, 935, 1636, 6089, 12729, 11084, 24404, 15740, 36878, 19835, 50292, 23095,
+		64647, 25214, 79738, 25910, 95164, 25002, 110455, 22481, 125235, 18527, 139341, 13454, 152816, 7637, 165845, 1452,
+		178680, -4749, 191586, -10641, 204792, -15924, 218448, -20312, 232577, -23549, 247037, -25440, 261545, -25891, 275753, -24931,
+		289371, -22707, 302237, -19440, 314343, -15379, 325790, -10761, 336754, -5794, 347449, -657, 358107, 4495, 8969, 9514,
+		20278, 14242, 32261, 18489, 45106, 22023, 58905, 24569, 73587, 25838, 88878, 25583, 104355, 23687, 119581, 20215,
+		134268, 15414, 148337, 9654, 161888, 3359, 175128, -3055, 188301, -9205, 201636, -14754, 215294, -19409, 229324, -22927,
+		243638, -25126, 258009, -25916, 272143, -25309, 285769, -23423, 298719, -20449, 310954, -16617, 322542, -12156, 333625, -7281,
+		344386, -2182, 355031, 2971, 5776, 8020, 16842, 12807, 28441, 17161, 40762, 20881, 53928, 23734, 67952, 25463,
+		82688, 25823, 97836, 24639, 113022, 21871, 127925, 17640, 142379, 12227, 156391, 6018, 170098, -546, 183704, -7018,
+		197416, -12982, 211386, -18076, 225663, -22015, 240160, -24601, 254664, -25745, 268899, -25468, 282612, -23888, 295646, -21193,
+		307965, -17600, 319637, -13328, 330797, -8586, 341619, -3562, 352293, 1568, 3016, 6639, 13987, 11485, 25396, 15933,
+		37410, 19792, 50145, 22852, 63628, 24890, 77767, 25689, 92349, 25082, 107085, 22991, 121706, 19457, 136048, 14652,
+		150081, 8860, 163904, 2456, 177692, -4132, 191642, -10448, 205909, -16055, 220548, -20570, 235461, -23712, 250404, -25339,
+		265054, -25457, 279123, -24196, 292436, -21762, 304959, -18388, 316770, -14302, 328019, -9707, 338893, -4789, 349592, 286,
+		319, 5353, 11268, 10247, 22622, 14789, 34535, 18783, 47107, 22019, 60355, 24279, 74182, 25367, 88389, 25133,
+		102714, 23509, 116920, 20525, 130865, 16307, 144534, 11069, 158028, 5093, 171528, -1276, 185247, -7639, 199380, -13561,
+		214037, -18608, 229171, -22398, 244544, -24671, 259773, -25342, 274462, -24501, 288339, -22365, 301312, -19201, 313445, -15269,
+		324900, -10797, 335883, -5974, 346616, -965, 357325, 4077, 8226, 8998, 19519, 13626, 31374, 17767, 43905, 21201,
+		57132, 23699, 70955, 25047, 85151, 25087, 99426, 23756, 113513, 21095, 127249, 17242, 140612, 12403, 153705, 6827,
+		166721, 799, 179897, -5360, 193473, -11288, 207634, -16591, 222444, -20869, 237771, -23780, 253273, -25113, 268493, -24843,
+		283022, -23128, 296630, -20236, 309293, -16465, 321134, -12083, 332359, -7315, 343203, -2340, 353911, 2689, 4720, 7629,
+		15860, 12327, 27537, 16609, 39909, 20265, 53050, 23059, 66902, 24748, 81256, 25134, 95787, 24109, 110158, 21697,
+		124124, 18042, 137601, 13377, 150655, 7975, 163465, 2128, 176268, -3868, 189323, -9701, 202860, -15043, 217029, -19546,
+		231829, -22878, 247053, -24773, 262315, -25105, 277170, -23923, 291272, -21427, 304465, -17904, 316776, -13650, 328358, -8928,
+		339424, -3955, 350212, 1097, 967, 6078, 11928, 10847, 23322, 15250, 35346, 19109, 48141, 22208, 61738, 24306,
+		76020, 25170, 90711, 24627, 105448, 22621, 119901, 19244, 133876, 14719, 147350, 9348, 160442, 3469, 173358, -2581,
+		186341, -8477, 199624, -13902, 213385, -18549, 227691, -22126, 242445, -24389, 257378, -25186, 272121, -24501, 286323, -22459,
+		299764, -19288, 312387, -15265, 324272, -10663, 335579, -5723, 346515, -649, 357301, 4388, 8162, 9239, 19317, 13755,
+		30971, 17779, 43289, 21129, 56368, 23595, 70192, 24954, 84604, 25004, 99322, 23614, 114018, 20778, 128432, 16631,
+		142438, 11434, 156066, 5534, 169457, -685, 182812, -6834, 196339, -12544, 210205, -17478, 224479, -21344, 239095, -23915,
+		253844, -25056, 268423, -24750, 282534, -23096, 295970, -20284, 308663, -16557, 320660, -12167, 332092, -7352, 343132, -2323,
+		353974, 2733, 4816, 7647, 15854, 12263, 27273, 16424, 39227, 19963, 51824, 22699, 65089, 24439, 78943, 24998,
+		93207, 24232, 107636, 22073, 121999, 18560, 136149, 13854, 150055, 8224, 163802, 2025, 177547, -4333, 191473, -10419,
+		205730, -15817, 220374, -20160, 235321, -23172, 250343, -24703, 265126, -24740, 279376, -23397, 292904, -20874, 305654, -17409,
+		317691, -13244, 329151, -8603, 340211, -3689, 351060, 1315, 1887, 6239, 12874, 10917, 24188, 15185, 35966, 18872,
+		48298, 21803, 61205, 23802, 74623, 24707, 88405, 24391, 102360, 22784, 116307, 19893, 130130, 15810, 143811, 10714,
+		157425, 4867, 171123, -1388, 185088, -7646, 199489, -13456, 214409, -18372, 229779, -22009, 245344, -24115, 260719, -24619,
+		275522, -23623, 289502, -21355, 302582, -18086, 314832, -14081, 326412, -9575, 337522, -4769, 348370, 163, 359165, 5059,
+		10099, 9761, 21344, 14105, 33039, 17917, 45270, 21015, 58050, 23219, 71304, 24368, 84874, 24342, 98562, 23084,
+		112184, 20610, 125630, 17004, 138891, 12411, 152056, 7033, 165291, 1129, 178807, -4980, 192818, -10906, 207480, -16212,
+		222818, -20453, 238646, -23259, 254566, -24419, 270088, -23940, 284811, -22019, 298544, -18957, 311301, -15067, 323234, -10626,
+		334559, -5859, 345510, -951, 356319, 3941, 7206, 8671, 18367, 13085, 29967, 17018, 42117, 20290, 54849, 22710,
+		68096, 24100, 81690, 24326, 95404, 23321, 109016, 21105, 122376, 17773, 135447, 13480, 148300, 8425, 161097, 2836,
+		174057, -3021, 187426, -8834, 201424, -14240, 216184, -18832, 231666, -22211, 247591, -24062, 263486, -24248, 278840, -22851,
+		293295, -20128, 306727, -16413, 319210, -12032, 330937, -7259, 342144, -2312, 353078, 2636, 3977, 7436, 15062, 11946,
+		26527, 16016, 38524, 19478, 51132, 22147, 64330, 23834, 77980, 24375, 91850, 23669, 105678, 21708, 119255, 18580,
+		132480, 14451, 145378, 9538, 158079, 4087, 170781, -1640, 183722, -7360, 197141, -12758, 211226, -17493, 226048, -21205,
+		241479, -23571, 257180, -24376, 272676, -23584, 287532, -21349, 301491, -17955, 314507, -13737, 326692, -9005, 338247, -4018,
+		349403, 1014, 398, 5923, 11460, 10563, 22797, 14790, 34588, 18454, 46955, 21386, 59940, 23405, 73475, 24336,
+		87378, 24041, 101396, 22460, 115279, 19633, 128858, 15702, 142088, 10888, 155039, 5460, 167872, -290, 180794, -6059,
+		194030, -11535, 207770, -16396, 222123, -20325, 237048, -23026, 252322, -24283, 267575, -24010, 282411, -22283, 296535, -19312,
+		309825, -15389, 322319, -10819, 334158, -5881, 345536, -813, 356667, 4190, 7761, 8961, 19022, 13349, 30629, 17210,
+		42722, 20389, 55382, 22725, 68603, 24052, 82278, 24224, 96218, 23141, 110197, 20784, 124023, 17231, 137595, 12656,
+		150922, 7314, 164108, 1513, 177321, -4408, 190754, -10095, 204578, -15201, 218893, -19394, 233674, -22391, 248742, -23990,
+		263794, -24106, 278489, -22792, 292559, -20220, 305877, -16639, 318454, -12326, 330402, -7547, 341888, -2543, 353101, 2475,
+		4230, 7325, 15458, 11842, 26948, 15871, 38833, 19261, 51198, 21860, 64061, 23521, 77361, 24109, 90970, 23520,
+		104716, 21705, 118441, 18686, 132046, 14567, 145515, 9538, 158919, 3861, 172397, -2131, 186117, -8059, 200237, -13521,
+		214842, -18124, 229891, -21531, 245186, -23511, 260409, -23976, 275218, -22987, 289356, -20722, 302711, -17426, 315308, -13363,
+		327264, -8784, 338750, -3916, 349954, 1036, 1062, 5888, 12248, 10470, 23665, 14617, 35431, 18171, 47618, 20977,
+		60239, 22892, 73238, 23792, 86503, 23585, 99891, 22226, 113273, 19721, 126574, 16136, 139794, 11598, 153010, 6297,
+		166363, 495, 180033, -5476, 194198, -11217, 208976, -16289, 224353, -20264, 240123, -22808, 255907, -23753, 271270, -23128,
+		285875, -21130, 299572, -18036, 312387, -14139, 324460, -9702, 335986, -4952, 347174, -81, 358230, 4735, 9343, 9333,
+		20677, 13552, 32357, 17231, 44457, 20206, 56981, 22327, 69863, 23464, 82974, 23527, 96157, 22478, 109275, 20335,
+		122247, 17163, 135076, 13074, 147848, 8218, 160716, 2792, 173885, -2954, 187576, -8696, 201979, -14041, 217184, -18543,
+		233086, -21773, 249341, -23417, 265430, -23373, 280854, -21772, 295307, -18904, 308724, -15116, 321223, -10727, 333013, -5999,
+		344334, -1138, 355419, 3682, 6488, 8309, 17731, 12594, 29303, 16386, 41307, 19524, 53774, 21849, 66645, 23217,
+		79785, 23521, 93007, 22711, 106133, 20805, 119043, 17882, 131705, 14065, 144181, 9509, 156615, 4396, 169207, -1064,
+		182198, -6620, 195828, -11961, 210285, -16715, 225622, -20468, 241666, -22832, 257992, -23553, 274037, -22599, 289314, -20167,
+		303569, -16597, 316792, -12263, 329139, -7493, 340842, -2548, 352152, 2370, 3309, 7102, 14531, 11504, 26004, 15438,
+		37870, 18758, 50206, 21314, 63006, 22955, 76167, 23554, 89511, 23031, 102831, 21376, 115953, 18656, 128787, 15000,
+		141342, 10583, 153718, 5603, 166086, 279, 178663, -5151, 191685, -10422, 205373, -15229, 219867, -19228, 235156, -22067,
+		251002, -23448, 266967, -23218, 282549, -21426, 297360, -18311, 311232, -14216, 324198, -9504, 336421, -4491, 348121, 567,
+		359527, 5470, 10863, 10057, 22328, 14189, 34084, 17730, 46246, 20543, 58859, 22492, 71884, 23445, 85198, 23303,
+		98621, 22017, 111969, 19612, 125105, 16185, 137981, 11902, 150640, 6974, 163202, 1646, 175844, -3821, 188766, -9149,
+		202165, -14043, 216188, -18195, 230873, -21301, 246098, -23094, 261569, -23404, 276889, -22210, 291694, -19649, 305758, -15982,
+		319031, -11531, 331595, -6617, 343614, -1525, 355282, 3506, 6796, 8278, 18340, 12628, 30073, 16411, 42114, 19494,
+		54531, 21749, 67325, 23061, 80427, 23330, 93715, 22492, 107042, 20534, 120284, 17506, 133372, 13523, 146312, 8767,
+		159185, 3473, 172128, -2080, 185308, -7581, 198888, -12701, 212987, -17105, 227626, -20485, 242690, -22585, 257926, -23254,
+		273002, -22470, 287612, -20345, 301560, -17096, 314795, -12995, 327384, -8328, 339465, -3366, 351209, 1647, 2793, 6498,
+		14382, 11001, 26117, 14988, 38102, 18313, 50399, 20844, 63009, 22469, 75883, 23098, 88924, 22672, 102024, 21170,
+		115087, 18616, 128068, 15084, 140978, 10700, 153894, 5644, 166945, 160, 180290, -5451, 194089, -10837, 208458, -15615,
+		223407, -19412, 238795, -21921, 254328, -22958, 269636, -22498, 284391, -20669, 298405, -17698, 311642, -13859, 324189, -9422,
+		336204, -4641, 347870, 260, 359375, 5076, 10888, 9624, 22551, 13728, 34466, 17230, 46683, 19982, 59195, 21862,
+		71940, 22776, 84815, 22667, 97706, 21523, 110524, 19368, 123230, 16266, 135848, 12315, 148468, 7647, 161237, 2446,
+		174335, -3052, 187956, -8537, 202262, -13633, 217321, -17923, 233031, -21008, 249082, -22595, 265021, -22582, 280402, -21067,
+		294938, -18304, 308550, -14606, 321325, -10277, 333442, -5586, 345114, -755, 356554, 4021, 7958, 8572, 19493, 12734,
+		31284, 16352, 43397, 19275, 55835, 21365, 68529, 22516, 81361, 22659, 94192, 21778, 106904, 19903, 119435, 17105,
+		131793, 13485, 144062, 9165, 156386, 4295, 168961, -939, 182011, -6302, 195757, -11486, 210363, -16114, 225855, -19764,
+		242035, -22043, 258467, -22701, 274598, -21711, 289966, -19275, 304338, -15729, 317718, -11437, 330265, -6724, 342204, -1849,
+		353772, 2981, 5196, 7594, 16670, 11838, 28351, 15570, 40343, 18647, 52682, 20935, 65328, 22314, 78168, 22700,
+		91048, 22057, 103819, 20408, 116376, 17823, 128691, 14412, 140813, 10310, 152862, 5668, 165016, 656, 177491, -4527,
+		190528, -9635, 204349, -14367, 219096, -18359, 234742, -21218, 251019, -22605, 267433, -22341, 283429, -20478, 298593, -17281,
+		312760, -13125, 325983, -8392, 338446, -3410, 350381, 1566, 2027, 6337, 13599, 10744, 25278, 14646, 37200, 17912,
+		49442, 20416, 62006, 22044, 74820, 22700, 87751, 22331, 100640, 20933, 113354, 18564, 125818, 15327, 138038, 11365,
+		150095, 6841, 162132, 1937, 174338, -3149, 186928, -8194, 200123, -12938, 214105, -17079, 228950, -20279, 244556, -22211,
+		260599, -22631, 276607, -21463, 292114, -18835, 306823, -15045, 320649, -10464, 333678, -5460, 346090, -348, 358098, 4621,
+		9914, 9253, 21724, 13391, 33680, 16900, 45881, 19663, 58365, 21569, 71105, 22529, 84008, 22481, 96948, 21401,
+		109793, 19319, 122450, 16316, 134888, 12519, 147147, 8094, 159332, 3233, 171595, -1849, 184119, -6919, 197095, -11721,
+		210689, -15973, 224997, -19378, 239984, -21646, 255449, -22545, 271044, -21956, 286377, -19921, 301139, -16640, 315181, -12415,
+		328512, -7588, 341249, -2490, 353561, 2592, 5630, 7421, 17626, 11805, 29690, 15586, 41924, 18635, 54377, 20844,
+		67043, 22126, 79863, 22421, 92744, 21702, 105586, 19980, 118309, 17308, 130880, 13782, 143321, 9544, 155711, 4771,
+		168177, -318, 180874, -5474, 193965, -10419, 207589, -14854, 221819, -18477, 236616, -21007, 251805, -22228, 267094, -22031,
+		282154, -20441, 296719, -17612, 310655, -13791, 323957, -9272, 336722, -4356, 349097, 677, 1248, 5575, 13328, 10121,
+		25463, 14131, 37742, 17450, 50204, 19955, 62838, 21550, 75590, 22177, 88377, 21810, 101114, 20457, 113743, 18162,
+		126245, 14998, 138655, 11073, 151055, 6527, 163572, 1546, 176363, -3638, 189589, -8742, 203388, -13442, 217825, -17392,
+		232843, -20270, 248234, -21828, 263673, -21948, 278812, -20662, 293383, -18133, 307267, -14605, 320480, -10354, 333136, -5656,
+		345396, -767, 357437, 4078, 9423, 8666, 21487, 12805, 33720, 16324, 46157, 19078, 58778, 20953, 71511, 21875,
+		84255, 21815, 96912, 20782, 109415, 18824, 121746, 16012, 133944, 12441, 146104, 8223, 158369, 3502, 170913, -1539,
+		183932, -6664, 197606, -11570, 212054, -15899, 227268, -19268, 243050, -21340, 259023, -21912, 274734, -20968, 289811, -18676,
+		304064, -15313, 317495, -11192, 330233, -6605, 342468, -1807, 354406, 2981, 6241, 7561, 18138, 11756, 30215, 15399,
+		42533, 18339, 55086, 20447, 67802, 21630, 80562, 21837, 93236, 21069, 105719, 19375, 117964, 16833, 129989, 13545,
+		141877, 9623, 153765, 5193, 165835, 401, 178304, -4569, 191396, -9482, 205313, -14035, 220163, -17860, 235881, -20564,
+		252168, -21821, 268528, -21473, 284436, -19592, 299522, -16442, 313658, -12386, 326915, -7780, 339481, -2933, 351583, 1912,
+		3447, 6556, 15270, 10832, 27211, 14589, 39373, 17686, 51792, 19998, 64430, 21420, 77185, 21883, 89917, 21368,
+		102491, 19907, 114812, 17576, 126856, 14484, 138666, 10755, 150351, 6524, 162069, 1935, 174021, -2853, 186433, -7650,
+		199537, -12220, 213530, -16268, 228499, -19446, 244338, -21395, 260699, -21834, 277062, -20663, 292916, -18014, 307935, -14203,
+		322029, -9627, 335295, -4668, 347926, 354, 145, 5194, 12167, 9662, 24175, 13609, 36304, 16904, 48633, 19434,
+		61172, 21101, 73865, 21833, 86604, 21594, 99259, 20395, 111719, 18292, 123921, 15385, 135865, 11800, 147618, 7684,
+		159299, 3194, 171070, -1504, 183126, -6226, 195674, -10765, 208914, -14877, 222993, -18279, 237939, -20666, 253596, -21749,
+		269613, -21339, 285537, -19417, 300965, -16159, 315663, -11890, 329593, -6997, 342853, -1856, 355613, 3214, 8061, 7962,
+		20372, 12199, 32685, 15779, 45096, 18589, 57642, 20538, 70303, 21564, 83014, 21629, 95680, 20735, 108210, 18920,
+		120541, 16261, 132658, 12867, 144598, 8879, 156448, 4455, 168337, -223, 180421, -4961, 192870, -9541, 205849, -13729,
+		219483, -17266, 233820, -19889, 248781, -21349, 264144, -21464, 279585, -20166, 294773, -17533, 309467, -13783, 323569, -9228,
+		337111, -4216, 350202, 915, 2990, 5865, 15617, 10390, 28201, 14297, 40823, 17442, 53514, 19723, 66260, 21073,
+		79007, 21462, 91682, 20892, 104218, 19399, 116574, 17047, 128748, 13926, 140781, 10151, 152755, 5864, 164790, 1236,
+		177025, -3536, 189614, -8221, 202693, -12564, 216362, -16295, 230636, -19141, 245424, -20865, 260516, -21301, 275630, -20391,
+		290489, -18198, 304900, -14898, 318791, -10743, 332195, -6026, 345219, -1048, 358000, 3902, 10674, 8565, 23350, 12714,
+		36095, 16167, 48925, 18783, 61805, 20473, 74664, 21192, 87414, 20943, 99984, 19765, 112332, 17723, 124466, 14903,
+		136442, 11407, 148357, 7351, 160341, 2876, 172550, -1845, 185150, -6597, 198295, -11123, 212093, -15127, 226560, -18301,
+		241584, -20365, 256915, -21122, 272223, -20505, 287191, -18585, 301614, -15549, 315425, -11651, 328680, -7168, 341511, -2375,
+		354084, 2469, 6558, 7125, 19070, 11372, 31707, 15019, 44498, 17901, 57406, 19896, 70344, 20932, 83194, 20991,
+		95849, 20104, 108239, 18340, 120352, 15790, 132235, 12560, 143985, 8759, 155744, 4507, 167682, -55, 179994, -4754,
+		192873, -9366, 206484, -13617, 220908, -17180, 236088, -19722, 251784, -20964, 267607, -20761, 283137, -19144, 298060, -16305,
+		312241, -12530, 325712, -8130, 338612, -3399, 351130, 1405, 3459, 6054, 15769, 10347, 28190, 14104, 40791, 17165,
+		53572, 19399, 66463, 20712, 79343, 21057, 92077, 20444, 104554, 18932, 116720, 16613, 128587, 13599, 140230, 10009,
+		151775, 5962, 163388, 1585, 175264, -2975, 187620, -7543, 200669, -11894, 214579, -15744, 229414, -18761, 245052, -20608,
+		261166, -21029, 277282, -19935, 292946, -17442, 307869, -13831, 321972, -9457, 335344, -4672, 348163, 219, 636, 4968,
+		12960, 9371, 25296, 13257, 37754, 16480, 50380, 18914, 63147, 20464, 75969, 21072, 88718, 20724, 101269, 19460,
+		113532, 17360, 125478, 14535, 137141, 11109, 148617, 7213, 160045, 2979, 171599, -1454, 183482, -5932, 195908, -10268,
+		209084, -14235, 223160, -17554, 238165, -19909, 253935, -20996, 270104, -20611, 286196, -18725, 301792, -15516, 316654, -11314,
+		330743, -6510, 344166, -1476, 357100, 3474, 9737, 8094, 22251, 12197, 34774, 15636, 47384, 18293, 60094, 20081,
+		72859, 20942, 85586, 20858, 98169, 19851, 110514, 17984, 122572, 15357, 134347, 12089, 145897, 8316, 157330, 4179,
+		168790, -177, 180447, -4596, 192492, -8905, 205114, -12906, 218479, -16366, 232683, -19021, 247693, -20594, 263306, -20852,
+		279171, -19670, 294888, -17091, 310139, -13332, 324768, -8735, 338776, -3689, 352267, 1434, 5390, 6319, 18296, 10723,
+		31110, 14463, 43913, 17411, 56736, 19480, 69558, 20617, 82318, 20806, 94934, 20067, 107336, 18456, 119481, 16056,
+		131370, 12977, 143047, 9343, 154596, 5297, 166136, 989, 177810, -3416, 189775, -7740, 202191, -11787, 215200, -15340,
+		228893, -18165, 243273, -20025, 258223, -20709, 273507, -20078, 288826, -18111, 303903, -14925, 318562, -10764, 332753, -5956,
+		346523, -860, 359978, 4178, 13234, 8857, 26388, 12936, 39499, 16239, 52584, 18652, 65614, 20113, 78528, 20605,
+		91257, 20152, 103741, 18812, 115955, 16665, 127911, 13811, 139665, 10363, 151303, 6448, 162942, 2208, 174714, -2193,
+		186765, -6571, 199232, -10718, 212232, -14407, 225830, -17401, 240011, -19472, 254657, -20427, 269564, -20142, 284481, -18594,
+		299184, -15870, 313536, -12156, 327500, -7713, 341130, -2843, 354527, 2139, 7806, 6928, 21060, 11253, 34341, 14889,
+		47646, 17672, 60921, 19501, 74077, 20338, 87017, 20198, 99664, 19142, 111985, 17257, 123995, 14645, 135760, 11416,
+		147382, 7685, 158993, 3576, 170743, -768, 182790, -5176, 195288, -9444, 208362, -13334, 222077, -16585, 236403, -18938,
+		251193, -20176, 266203, -20167, 281147, -18892, 295785, -16447, 309982, -13021, 323722, -8857, 337082, -4225, 350192, 599,
+		3199, 5345, 16230, 9756, 29368, 13601, 42634, 16686, 55977, 18868, 69291, 20066, 82441, 20266, 95304, 19512,
+		107802, 17892, 119921, 15516, 131711, 12504, 143272, 8973, 154745, 5039, 166294, 825, 178104, -3527, 190361, -7843,
+		203237, -11908, 216856, -15461, 231239, -18213, 246269, -19888, 261675, -20286, 277095, -19338, 292189, -17127, 306736, -13863,
+		320675, -9818, 334075, -5284, 347083, -539, 359878, 4165, 12627, 8595, 25465, 12542, 38463, 15818, 51623, 18271,
+		64868, 19789, 78067, 20324, 91068, 19885, 103745, 18542, 116029, 16400, 127923, 13588, 139496, 10235, 150868, 6465,
+		162197, 2399, 173665, -1837, 185475, -6102, 197832, -10220, 210925, -13974, 224875, -17099, 239685, -19297, 255178, -20294,
+		271009, -19910, 286751, -18131, 302039, -15120, 316673, -11167, 330631, -6618, 344019, -1802, 357009, 2990, 9786, 7519,
+		22517, 11582, 35320, 15013, 48254, 17669, 61300, 19443, 74374, 20270, 87350, 20134, 100094, 19077, 112504, 17185,
+		124538, 14577, 136217, 11387, 147620, 7752, 158874, 3803, 170135, -331, 181585, -4516, 193420, -8604, 205843, -12418,
+		219029, -15745, 233089, -18332, 248003, -19904, 263578, -20215, 279463, -19127, 295247, -16666, 310594, -13041, 325334, -8584,
+		339456, -3680, 353064, 1307, 6309, 6072, 19346, 10375, 32306, 14036, 45271, 16920, 58265, 18934, 71254, 20018,
+		84156, 20157, 96873, 19376, 109316, 17744, 121433, 15363, 133223, 12354, 144735, 8854, 156058, 5002, 167316, 936,
+		178657, -3202, 190246, -7266, 202253, -11093, 214844, -14502, 228149, -17284, 242229, -19211, 257025, -20058, 272345, -19643,
+		287888, -17892, 303333, -14877, 318437, -10824, 333089, -6070, 347296, -1000, 1139, 4012, 14728, 8647, 28157, 12660,
+		41491, 15880, 54750, 18202, 67909, 19571, 80912, 19981, 93686, 19462, 106171, 18082, 118336, 15934, 130191, 13129,
+		141785, 9791, 153200, 6051, 164544, 2050, 175944, -2068, 187536, -6145, 199461, -10015, 211851, -13498, 224807, -16402,
+		238382, -18527, 252550, -19679, 267191, -19699, 282109, -18499, 297078, -16089, 311904, -12596, 326475, -8257, 340768, -3388,
+		354828, 1655, 8732, 6517, 22545, 10886, 36300, 14517, 49982, 17246, 63534, 18986, 76873, 19721, 89915, 19485,
+		102598, 18355, 114899, 16432, 126842, 13830, 138494, 10667, 149952, 7066, 161338, 3153, 172783, -932, 184426, -5036,
+		196401, -8986, 208823, -12593, 221776, -15653, 235285, -17963, 249297, -19334, 263683, -19622, 278256, -18751, 292819, -16731,
+		307222, -13666, 321391, -9741, 335335, -5206, 349125, -354, 2854, 4501, 16609, 9047, 30435, 13002, 44317, 16139,
+		58174, 18308, 71881, 19442, 85303, 19551, 98330, 18708, 110909, 17020, 123046, 14615, 134808, 11623, 146304, 8166,
+		157671, 4365, 169064, 344, 180643, -3758, 192565, -7782, 204968, -11540, 217951, -14819, 231547, -17392, 245695, -19044,
+		260234, -19609, 274934, -18999, 289553, -17227, 303910, -14407, 317922, -10727, 331608, -6425, 345063, -1764, 358420, 2981,
+		11810, 7536, 25332, 11639, 39020, 15052, 52830, 17587, 66644, 19125, 80300, 19623, 93633, 19119, 106527, 17708,
+		118936, 15521, 130890, 12701, 142480, 9385, 153843, 5701, 165138, 1768, 176544, -2290, 188243, -6335, 200409, -10203,
+		213192, -13696, 226678, -16583, 240858, -18619, 255594, -19588, 270631, -19346, 285661, -17869, 300414, -15257, 314732, -11716,
+		328588, -7506, 342059, -2910, 355288, 1799, 8433, 6360, 21634, 10534, 34986, 14105, 48506, 16888, 62136, 18746,
+		75744, 19601, 89164, 19449, 102241, 18351, 114874, 16420, 127037, 13797, 138776, 10632, 150196, 7064, 161442, 3224,
+		172684, -766, 184106, -4783, 195899, -8686, 208246, -12308, 221297, -15447, 235131, -17868, 249707, -19324, 264837, -19607,
+		280213, -18606, 295493, -16352, 310404, -13018, 324815, -8881, 338731, -4262, 352253, 523, 5533, 5186, 18722, 9483,
+		31942, 13205, 45259, 16181, 58670, 18282, 72100, 19423, 85423, 19581, 98500, 18789, 111216, 17134, 123511, 14740,
+		135387, 11751, 146908, 8313, 158185, 4567, 169359, 645, 180596, -3327, 192073, -7220, 203971, -10888, 216461, -14163,
+		229676, -16846, 243673, -18713, 258390, -19537, 273623, -19142, 289067, -17455, 304403, -14551, 319394, -10651, 333935, -6077,
+		348043, -1190, 1813, 3662, 15367, 8184, 28818, 12137, 42242, 15345, 55662, 17682, 69042, 19073, 82300, 19495,
+		95332, 18974, 108043, 17581, 120378, 15423, 132329, 12630, 143937, 9343, 155284, 5703, 166485, 1849, 177675, -2084,
+		189004, -5962, 200630, -9647, 212706, -12987, 225367, -15811, 238705, -17932, 252734, -19149, 267366, -19283, 282409, -18209,
+		297610, -15907, 312730, -12489, 327606, -8197, 342177, -3366, 356468, 1627, 10546, 6420, 24484, 10704, 38324, 14247,
+		52073, 16898, 65691, 18575, 79109, 19261, 92243, 18988, 105023, 17829, 117410, 15890, 129412, 13291, 141075, 10164,
+		152482, 6644, 163739, 2866, 174965, -1034, 186291, -4915, 197848, -8631, 209763, -12030, 222147, -14952, 235077, -17226,
+		248576, -18682, 262598, -19162, 277023, -18547, 291685, -16787, 306409, -13924, 321065, -10107, 335590, -5583, 349989, -675,
+		4305, 4255, 18587, 8849, 32853, 12798, 47068, 15874, 61153, 17946, 74996, 18971, 88488, 18982, 101546, 18061,
+		114135, 16324, 126273, 13900, 138022, 10924, 149482, 7524, 160773, 3829, 172029, -32, 183382, -3924, 194962, -7698,
+		206884, -11195, 219240, -14244, 232085, -16673, 245418, -18315, 259178, -19023, 273245, -18696, 287472, -17292, 301721, -14843,
+		315901, -11460, 329987, -7328, 344014, -2696, 358057, 2138, 12193, 6844, 26468, 11095, 40864, 14602, 55287, 17152,
+		69581, 18631, 83570, 19028, 97104, 18412, 110099, 16908, 122546, 14662, 134503, 11824, 146077, 8533, 157408, 4920,
+		168650, 1106, 179962, -2784, 191496, -6614, 203390, -10231, 215754, -13459, 228646, -16110, 242060, -17996, 255912, -18956,
+		270045, -18875, 284272, -17716, 298423, -15518, 312390, -12401, 326150, -8542, 339760, -4161, 353327, 489, 6979, 5132,
+		20822, 9482, 34908, 13258, 49204, 16212, 63584, 18165, 77853, 19031, 91799, 18823, 105253, 17638, 118130, 15623,
+		130434, 12946, 142245, 9767, 153695, 6232, 164944, 2470, 176167, -1399, 187544, -5254, 199245, -8960, 211421, -12359,
+		224184, -15267, 237574, -17481, 251536, -18804, 265913, -19079, 280474, -18227, 294974, -16270, 309228, -13329, 323151, -9602,
+		336764, -5330, 350167, -769, 3504, 3820, 16921, 8184, 30533, 12074, 44386, 15266, 58441, 17568, 72563, 18855,
+		86561, 19081, 100234, 18287, 113427, 16587, 126070, 14138, 138179, 11112, 149840, 7674, 161187, 3971, 172383, 134,
+		183607, -3717, 195039, -7462, 206858, -10965, 219221, -14069, 232239, -16591, 245945, -18327, 260264, -19083, 275003, -18712,
+		289897, -17164, 304680, -14510, 319165, -10933, 333277, -6699, 347049, -2104, 587, 2557, 14026, 7011, 27494, 11019,
+		41081, 14373, 54808, 16903, 68621, 18486, 82395, 19059, 95971, 18630, 109199, 17271, 121978, 15109, 134275, 12299,
+		146126, 9007, 157619, 5392, 168882, 1601, 180064, -2234, 191328, -5989, 202840, -9539, 214764, -12751, 227238, -15470,
+		240362, -17522, 254158, -18721, 268545, -18895, 283345, -17924, 298313, -15784, 313216, -12576, 327890, -8520, 342273, -3921,
+		356391, 879, 10320, 5547, 24151, 9793, 37955, 13385, 51761, 16151, 65541, 17978, 79218, 18815, 92684, 18668,
+		105828, 17597, 118574, 15707, 130892, 13135, 142806, 10029, 154382, 6543, 165719, 2827, 176938, -979, 188170, -4739,
+		199554, -8324, 211228, -11601, 223316, -14433, 235920, -16673, 249095, -18162, 262835, -18747, 277059, -18300, 291621, -16746,
+		306347, -14098, 321081, -10476, 335725, -6110, 350247, -1316, 4672, 3543, 19038, 8110, 33373, 12075, 47660, 15207,
+		61842, 17363, 75822, 18486, 89492, 18593, 102761, 17755, 115573, 16081, 127923, 13707, 139853, 10774, 151443, 7425,
+		162799, 3802, 174038, 41, 185282, -3721, 196653, -7347, 208264, -10697, 220217, -13631, 232587, -16007, 245416, -17683,
+		258697, -18524, 272376, -18420, 286358, -17300, 300535, -15151, 314812, -12035, 329131, -8096, 343484, -3563, 357897, 1259,
+		12411, 6017, 27046, 10352, 41770, 13949, 56482, 16579, 71028, 18124, 85238, 18571, 98970, 17996, 112143, 16524,
+		124746, 14308, 136834, 11503, 148505, 8259, 159889, 4713, 171124, 995, 182352, -2767, 193705, -6440, 205302, -9883,
+		217239, -12949, 229581, -15484, 242349, -17343, 255515, -18391, 269003, -18522, 282706, -17677, 296512, -15850, 310340, -13096,
+		324163, -9533, 338008, -5337, 351947, -740, 6069, 3971, 20446, 8472, 35097, 12430, 49956, 15550, 64862, 17622,
+		79591, 18551, 93914, 18362, 107661, 17169, 120759, 15141, 133226, 12458, 145155, 9291, 156684, 5793, 167970, 2098,
+		179180, -1669, 190475, -5388, 202001, -8928, 213879, -12145, 226196, -14881, 238984, -16974, 252211, -18274, 265784, -18658,
+		279560, -18055, 293387, -16459, 307146, -13931, 320782, -10595, 334311, -6624, 347819, -2227, 1426, 2351, 15259, 6840,
+		29412, 10949, 43903, 14391, 58652, 16917, 73471, 18358, 88108, 18655, 102318, 17864, 115931, 16127, 128882, 13630,
+		141208, 10569, 153018, 7121, 164465, 3441, 175723, -338, 186967, -4097, 198370, -7716, 210088, -11067, 222246, -14004,
+		234923, -16363, 248128, -17977, 261789, -18695, 275757, -18411, 289844, -17090, 303872, -14779, 317721, -11604, 331358, -7752,
+		344830, -3446, 358245, 1070, 11740, 5539, 25446, 9704, 39446, 13308, 53744, 16118, 68244, 17949, 82758, 18694,
+		97052, 18344, 110914, 16985, 124207, 14774, 136892, 11900, 149018, 8556, 160698, 4919, 172082, 1142, 183340, -2645,
+		194644, -6321, 206164, -9769, 218054, -12860, 230436, -15450, 243381, -17376, 256883, -18475, 270846, -18605, 285102, -17679,
+		299444, -15695, 313692, -12748, 327736, -9018, 341556, -4747, 355208, -207, 8797, 4326, 22443, 8584, 36244, 12321,
+		50245, 15322, 64415, 17414, 78639, 18486, 92746, 18503, 106551, 17511, 119910, 15625, 132750, 13009, 145071, 9843,
+		156939, 6308, 168467, 2573, 179792, -1217, 191068, -4928, 202450, -8441, 214090, -11636, 226124, -14387, 238657, -16553,
+		251742, -17984, 265361, -18531, 279420, -18075, 293761, -16555, 308203, -13996, 322597, -10525, 336857, -6361, 350970, -1789,
+		4983, 2876, 18970, 7322, 33000, 11272, 47108, 14495, 61273, 16820, 75415, 18144, 89406, 18435, 103105, 17730,
+		116398, 16126, 129222, 13764, 141575, 10807, 153507, 7422, 165108, 3775, 176491, 18, 187782, -3707, 199112, -7267,
+		210610, -10541, 222397, -13404, 234575, -15733, 247213, -17398, 260335, -18267, 273906, -18224, 287844, -17183, 302030, -15119,
+		316346, -12083, 330705, -8221, 345068, -3767, 359441, 970, 13858, 5644, 28348, 9913, 42904, 13484, 57466, 16140,
+		71926, 17761, 86144, 18313, 99987, 17843, 113358, 16456, 126216, 14292, 138577, 11510, 150509, 8269, 162110, 4723,
+		173496, 1020, 184787, -2700, 196103, -6300, 207555, -9650, 219241, -12619, 231243, -15083, 243613, -16919, 256371, -18012,
+		269496, -18259, 282934, -17585, 296612, -15954, 310462, -13383, 324441, -9955, 338543, -5826, 352796, -1232, 7245, 3519,
+		21927, 8073, 36835, 12074, 51885, 15215, 66916, 17293, 81718, 18227, 96089, 18050, 109887, 16879, 123054, 14877,
+		135614, 12218, 147653, 9073, 159293, 5599, 170674, 1937, 181938, -1778, 193221, -5417, 204642, -8850, 216300, -11942,
+		228265, -14559, 240574, -16571, 253224, -17860, 266174, -18329, 279353, -17910, 292682, -16577, 306097, -14348, 319572, -11294,
+		333131, -7531, 346841, -3233, 797, 1369, 15093, 5983, 29788, 10273, 44859, 13893, 60176, 16547, 75499, 18045,
+		90540, 18339, 105041, 17509, 118846, 15726, 131919, 13195, 144326, 10117, 156194, 6674, 167687, 3019, 178974, -714,
+		190219, -4400, 201574, -7919, 213165, -11144, 225087, -13939, 237390, -16167, 250074, -17693, 263081, -18404, 276312, -18223,
+		289649, -17121, 302988, -15124, 316271, -12308, 329500, -8796, 342743, -4746, 356116, -354, 9755, 4149, 23790, 8489,
+		38295, 12364, 53251, 15470, 68508, 17547, 83800, 18441, 98810, 18134, 113265, 16736, 127003, 14445, 139998, 11489,
+		152326, 8084, 164133, 4418, 175593, 645, 186888, -3103, 198193, -6710, 209670, -10060, 221451, -13030, 233632, -15487,
+		246254, -17292, 259293, -18311, 272655, -18438, 286202, -17618, 299783, -15857, 313279, -13229, 326635, -9865, 339866, -5940,
+		353057, -1658, 6336, 2759, 19848, 7070, 33721, 11017, 48022, 14341, 62719, 16795, 77660, 18188, 92597, 18426,
+		107247, 17530, 121376, 15633, 134854, 12936, 147666, 9665, 159888, 6037, 171656, 2239, 183133, -1573, 194493, -5268,
+		205904, -8732, 217519, -11851, 229470, -14508, 241846, -16576, 254683, -17922, 267949, -18422, 281545, -17984, 295327, -16570,
+		309143, -14214, 322878, -11029, 336480, -7191, 349967, -2925, 3415, 1520, 16933, 5886, 30635, 9918, 44605, 13374,
+		58863, 16037, 73343, 17732, 87891, 18356, 102299, 17889, 116364, 16406, 129936, 14057, 142953, 11034, 155432, 7549,
+		167453, 3800, 179138, -33, 190626, -3798, 202066, -7362, 213600, -10608, 225358, -13427, 237447, -15704, 249940, -17323,
+		262860, -18169, 276175, -18137, 289802, -17156, 303628, -15210, 317539, -12354, 331452, -8719, 345336, -4515, 359208, -4,
+		13125, 4520, 27152, 8758, 41335, 12438, 55678, 15330, 70119, 17265, 84537, 18150, 98772, 17970, 112670, 16790,
+		126120, 14735, 139076, 11971, 151553, 8684, 163619, 5059, 175374, 1272, 186932, -2514, 198413, -6151, 209936, -9508,
+		221608, -12466, 233522, -14913, 245745, -16742, 258313, -17851, 271226, -18148, 284446, -17561, 297915, -16050, 311569, -13627,
+		325360, -10361, 339265, -6398, 353297, -1954, 7492, 2682, 21891, 7185, 36508, 11218, 51307, 14489, 66182, 16779,
+		80967, 17970, 95475, 18050, 109544, 17092, 123074, 15236, 136040, 12651, 148486, 9517, 160503, 6011, 172205, 2296,
+		183719, -1473, 195167, -5150, 206658, -8597, 218288, -11684, 230131, -14291, 242235, -16305, 254621, -17626, 267276, -18170,
+		280165, -17874, 293241, -16703, 306461, -14661, 319813, -11792, 333313, -8189, 347012, -4004, 983, 545, 15298, 5168,
+		30004, 9525, 45080, 13262, 60411, 16071, 75782, 17747, 90924, 18219, 105588, 17547, 119608, 15883, 132931, 13425,
+		145598, 10377, 157722, 6929, 169445, 3248, 180921, -515, 192301, -4224, 203717, -7748, 215279, -10957, 227067, -13725,
+		239128, -15930, 251469, -17461, 264061, -18228, 276846, -18168, 289753, -17249, 302721, -15480, 315724, -12908, 328782, -9617,
+		341963, -5728, 355376, -1409, 9147, 3122, 23387, 7584, 38162, 11647, 53435, 14964, 69038, 17239, 84683, 18289,
+		100026, 18085, 114777, 16744, 128771, 14474, 141984, 11514, 154501, 8089, 166472, 4396, 178071, 597, 189474, -3168,
+		200846, -6775, 212328, -10103, 224032, -13030, 236030, -15433, 248345, -17193, 260954, -18205, 273784, -18389, 286740, -17706,
+		299727, -16161, 312678, -13803, 325578, -10723, 338470, -7045, 351454, -2925, 4666, 1449, 18258, 5851, 32364, 10010,
+		47050, 13621, 62270, 16375, 77833, 18019, 93423, 18414, 108684, 17575, 123327, 15655, 137204, 12892, 150308, 9542,
+		162740, 5841, 174661, 1987, 186253, -1861, 197696, -5569, 209158, -9021, 220779, -12102, 232668, -14699, 244891, -16694,
+		257456, -17974, 270317, -18442, 283379, -18034, 296524, -16736, 309648, -14586, 322687, -11675, 335638, -8133, 348558, -4126,
+		1555, 155, 14770, 4497, 28350, 8660, 42410, 12384, 56992, 15398, 72024, 17454, 87304, 18369, 102535, 18073,
+		117409, 16631, 131696, 14217, 145286, 11072, 158194, 7450, 170522, 3581, 182419, -341, 194054, -4158, 205594, -7740,
+		217193, -10974, 228980, -13753, 241054, -15969, 253468, -17513, 266221, -18284, 279261, -18200, 292488, -17213, 305792, -15334,
+		319077, -12628, 332293, -9220, 345447, -5281, 358600, -1017, 11852, 3344, 25324, 7556, 39123, 11370, 53313, 14539,
+		67879, 16837, 82705, 18091, 97584, 18209, 112273, 17204, 126552, 15189, 140283, 12354, 153426, 8927, 166024, 5141,
+		178179, 1208, 190023, -2687, 201697, -6391, 213343, -9774, 225085, -12725, 237030, -15142, 249251, -16925, 261783, -17980,
+		274618, -18223, 287707, -17591, 300973, -16060, 314335, -13657, 327734, -10469, 341148, -6646, 354600, -2389, 8147, 2054,
+		21868, 6413, 35836, 10409, 50088, 13778, 64603, 16296, 79282, 17797, 93958, 18194, 108438, 17496, 122545, 15794,
+		136169, 13248, 149276, 10055, 161898, 6424, 174118, 2560, 186045, -1348, 197797, -5133, 209491, -8646, 221232, -11760,
+		233110, -14364, 245190, -16360, 257513, -17662, 270087, -18192, 282891, -17891, 295886, -16724, 309029, -14692, 322291, -11840,
+		335668, -8268, 349188, -4135, 2904, 336, 16879, 4868, 31167, 9146, 45777, 12856, 60652, 15720, 75650, 17539,
+		90573, 18216, 105207, 17762, 119380, 16279, 132998, 13931, 146052, 10911, 158601, 7419, 170747, 3645, 182610, -233,
+		194315, -4051, 205976, -7658, 217690, -10914, 229530, -13697, 241546, -15897, 253760, -17422, 266165, -18197, 278737, -18171,
+		291440, -17312, 304242, -15621, 317135, -13128, 330144, -9901, 343328, -6047, 356775, -1729, 10584, 2825, 24845, 7325,
+		39601, 11429, 54808, 14792, 70308, 17125, 85836, 18251, 101086, 18142, 115797, 16899, 129820, 14708, 143132, 11793,
+		155805, 8372, 167971, 4642, 179785, 778, 191401, -3063, 202960, -6738, 214580, -10113, 226346, -13060, 238312, -15459,
+		250490, -17206, 262857, -18216, 275363, -18432, 287947, -17826, 300554, -16402, 313160, -14196, 325782, -11274, 338486, -7727,
+		351380, -3681, 4606, 700, 18309, 5192, 32613, 9509, 47570, 13309, 63111, 16244, 79010, 18023, 94910, 18496,
+		110426, 17684, 125258, 15758, 139268, 12970, 152471, 9586, 164987, 5843, 176991, 1939, 188669, -1961, 200199, -5717,
+		211733, -9203, 223394, -12298, 235265, -14886, 247385, -16856, 259742, -18111, 272281, -18577, 284919, -18214, 297566, -17021,
+		310155, -15035, 322664, -12330, 335124, -9004, 347626, -5183, 302, -1012, 13319, 3330, 26848, 7624, 41029, 11596,
+		55922, 14931, 71449, 17311, 87361, 18481, 103277, 18325, 118796, 16903, 133619, 14421, 147613, 11164, 160805, 7422,
+		173323, 3446, 185348, -563, 197071, -4444, 208671, -8067, 220304, -11317, 232089, -14086, 244107, -16270, 256387, -17772,
+		268908, -18507, 281602, -18416, 294374, -17476, 307126, -15709, 319792, -13185, 332354, -10008, 344851, -6312, 357377, -2256,
+		10068, 1984, 23082, 6208, 36578, 10189, 50677, 13669, 65413, 16375, 80690, 18052, 96265, 18517, 111799, 17716,
+		126950, 15743, 141477, 12818, 155280, 9223, 168390, 5247, 180922, 1140, 193034, -2894, 204896, -6694, 216665, -10131,
+		228479, -13097, 240442, -15497, 252620, -17240, 265034, -18245, 277663, -18446, 290443, -17801, 303294, -16307, 316139, -14007,
+		328931, -10990, 341667, -7388, 354393, -3367, 7197, 877, 20195, 5133, 33515, 9168, 47263, 12742, 61496, 15612,
+		76180, 17558, 91179, 18407, 106261, 18076, 121164, 16594, 135661, 14098, 149614, 10810, 162992, 6989, 175845, 2897,
+		188279, -1230, 200423, -5196, 212409, -8838, 224358, -12028, 236370, -14661, 248515, -16649, 260834, -17917, 273332, -18405,
+		285984, -18069, 298747, -16889, 311573, -14883, 324430, -12108, 337311, -8668, 350245, -4712, 3292, -432, 16538, 3940,
+		30072, 8150, 43966, 11929, 58246, 15019, 72863, 17197, 87687, 18302, 102522, 18261, 117155, 17098, 131410, 14929,
+		145185, 11937, 158461, 8342, 171287, 4377, 183753, 269, 195970, -3778, 208049, -7579, 220088, -10980, 232168, -13852,
+		244342, -16095, 256636, -17627, 269052, -18389, 281572, -18343, 294167, -17471, 306811, -15781, 319496, -13311, 332243, -10133,
+		345101, -6358, 358147, -2143, 11473, 2301, 25171, 6716, 39308, 10803, 53894, 14258, 68851, 16806, 84005, 18248,
+		99112, 18494, 113924, 17572, 128252, 15612, 142005, 12804, 155192, 9367, 167891, 5523, 180221, 1478, 192313, -2574,
+		204291, -6456, 216263, -10009, 228308, -13088, 240469, -15571, 252758, -17363, 265156, -18393, 277625, -18617, 290118, -18022,
+		302600, -16618, 315061, -14442, 327529, -11556, 340070, -8046, 352788, -4032, 5812, 325, 19275, 4810, 33293, 9146,
+		47922, 13008, 63120, 16061, 78705, 18022, 94380, 18723, 109798, 18146, 124673, 16416, 138846, 13748, 152299, 10394,
+		165121, 6596, 177456, 2570, 189469, -1495, 201320, -5430, 213148, -9085, 225059, -12319, 237118, -15007, 249347, -17037,
+		261724, -18323, 274194, -18808, 286684, -18469, 299129, -17315, 311490, -15388, 323769, -12752, 336020, -9493, 348344, -5717,
+		881, -1556, 13799, 2826, 27267, 7209, 41422, 11314, 56323, 14812, 71888, 17363, 87865, 18694, 103867, 18673,
+		119485, 17347, 134415, 14917, 148528, 11664, 161851, 7877, 174519, 3812, 186712, -318, 198617, -4337, 210405, -8098,
+		222219, -11468, 234159, -14327, 246282, -16566, 258592, -18090, 271047, -18828, 283570, -18740, 296070, -17824, 308476, -16115,
+		320752, -13681, 332915, -10615, 345035, -7027, 357232, -3045, 9664, 1183, 22511, 5479, 35954, 9620, 50132, 13334,
+		65093, 16309, 80729, 18235, 96754, 18878, 112759, 18157, 128334, 16171, 143196, 13167, 157242, 9460, 170522, 5359,
+		183179, 1126, 195397, -3028, 207363, -6937, 219242, -10466, 231165, -13500, 243222, -15935, 255454, -17680, 267856, -18661,
+		280377, -18826, 292935, -18154, 305444, -16666, 317840, -14419, 330102, -11506, 342260, -8046, 354396, -4173, 6635, -41,
+		19134, 4179, 32064, 8290, 45581, 12061, 59793, 15233, 74707, 17531, 90183, 18706, 105935, 18598, 121593, 17192,
+		136819, 14630, 151396, 11177, 165260, 7149, 178468, 2853, 191154, -1449, 203477, -5549, 215600, -9285, 227662, -12530,
+		239771, -15182, 251992, -17154, 264351, -18376, 276832, -18795, 289387, -18381, 301949, -17137, 314456, -15102, 326870, -12351,
+		339193, -8999, 351473, -5184, 3798, -1072, 16288, 3157, 29082, 7299, 42316, 11133, 56095, 14422, 70454, 16923,
+		85329, 18414, 100538, 18736, 115813, 17825, 130873, 15745, 145494, 12678, 159564, 8886, 173074, 4663, 186098, 293,
+		198752, -3976, 211163, -7939, 223452, -11438, 235718, -14350, 248027, -16581, 260412, -18063, 272874, -18745, 285389, -18602,
+		297920, -17628, 310427, -15845, 322890, -13310, 335312, -10112, 347733, -6376, 223, -2263, 12879, 2038, 25814, 6309,
+		39132, 10308, 52911, 13786, 67168, 16496, 81834, 18226, 96751, 18827, 111698, 18241, 126446, 16515, 140820, 13792,
+		154728, 10284, 168168, 6244, 181200, 1933, 193922, -2399, 206444, -6530, 218865, -10268, 231264, -13459, 243690, -15984,
+		256160, -17757, 268664, -18727, 281173, -18866, 293654, -18173, 306082, -16670, 318452, -14401, 330788, -11436, 343149, -7873,
+		355621, -3843, 8311, 486, 21338, 4899, 34808, 9143, 48793, 12936, 63289, 15991, 78199, 18059, 93323, 18968,
+		108399, 18654, 123180, 17173, 137491, 14675, 151266, 11371, 164531, 7498, 177378, 3293, 189930, -1014, 202315, -5212,
+		214646, -9107, 227006, -12529, 239441, -15334, 251956, -17411, 264520, -18686, 277076, -19122, 289567, -18714, 301948, -17490,
+		314204, -15496, 326361, -12801, 338486, -9487, 350686, -5659, 3096, -1449, 15869, 2972, 29156, 7380, 43077, 11497,
+		57678, 15005, 72885, 17587, 88476, 18990, 104119, 19086, 119457, 17902, 134224, 15599, 148298, 12420, 161699, 8628,
+		174541, 4475, 186983, 187, 199195, -4037, 211327, -8017, 223498, -11590, 235783, -14609, 248210, -16950, 260750, -18516,
+		273338, -19249, 285886, -19127, 298309, -18171, 310557, -16431, 322622, -13981, 334552, -10911, 346443, -7319, 358433, -3321,
+		10694, 950, 23414, 5323, 36777, 9575, 50922, 13422, 65890, 16535, 81559, 18586, 97627, 19327, 113666, 18671,
+		129257, 16717, 144122, 13713, 158172, 9968, 171473, 5789, 184184, 1440, 196496, -2862, 208600, -6938, 220655, -10633,
+		232782, -13812, 245048, -16356, 257466, -18164, 269996, -19164, 282556, -19315, 295046, -18618, 307378, -17112, 319504, -14872,
+		331424, -11993}},
+	"mercury": {start: 2458845.5, step: 2, positions: []int32{
+		267644, -24316, 271071, -24524, 274526, -24645, 278006, -24675, 281509, -24612, 285031, -24454, 288568, -24199, 292116, -23845,
+		295671, -23390, 299228, -22833, 302782, -22173, 306327, -21409, 309854, -20543, 313356, -19574, 316820, -18506, 320231, -17343,
+		323570, -16094, 326812, -14768, 329921, -13382, 332855, -11959, 335557, -10528, 337962, -9128, 339993, -7805, 341567, -6614,
+		342608, -5611, 343054, -4852, 342875, -4383, 342086, -4234, 340761, -4408, 339032, -4877, 337076, -5585, 335089, -6449,
+		333253, -7384, 331713, -8309, 330562, -9163, 329843, -9904, 329561, -10509, 329695, -10966, 330209, -11275, 331061, -11438,
+		332210, -11463, 333616, -11355, 335245, -11122, 337066, -10772, 339052, -10309, 341182, -9740, 343439, -9070, 345807, -8304,
+		348276, -7446, 350837, -6499, 353485, -5469, 356214, -4358, 359024, -3169, 1915, -1906, 4887, -572, 7945, 828,
+		11093, 2292, 14337, 3814, 17684, 5388, 21142, 7007, 24717, 8663, 28416, 10344, 32245, 12036, 36202, 13722,
+		40284, 15379, 44477, 16983, 48760, 18507, 53102, 19924, 57467, 21210, 61810, 22343, 66091, 23310, 70267, 24104,
+		74304, 24726, 78171, 25181, 81844, 25478, 85304, 25629, 88533, 25648, 91518, 25549, 94248, 25346, 96710, 25054,
+		98893, 24685, 100787, 24253, 102380, 23771, 103660, 23252, 104620, 22708, 105250, 22152, 105550, 21596, 105520, 21050,
+		105175, 20529, 104538, 20042, 103647, 19603, 102554, 19221, 101327, 18908, 100043, 18672, 98786, 18519, 97639, 18455,
+		96681, 18479, 95976, 18589, 95578, 18778, 95525, 19035, 95845, 19349, 96552, 19702, 97654, 20077, 99153, 20453,
+		101045, 20808, 103322, 21119, 105971, 21359, 108972, 21504, 112297, 21529, 115908, 21410, 119756, 21130, 123785, 20676,
+		127932, 20045, 132133, 19242, 136332, 18280, 140481, 17178, 144545, 15956, 148499, 14636, 152331, 13238, 156033, 11783,
+		159608, 10285, 163059, 8760, 166392, 7219, 169614, 5671, 172735, 4125, 175762, 2588, 178702, 1066, 181562, -437,
+		184349, -1914, 187066, -3363, 189717, -4778, 192305, -6158, 194832, -7497, 197296, -8791, 199695, -10037, 202024, -11229,
+		204277, -12363, 206443, -13433, 208509, -14431, 210455, -15349, 212258, -16178, 213889, -16904, 215308, -17513, 216470, -17986,
+		217321, -18299, 217799, -18426, 217841, -18333, 217390, -17986, 216413, -17354, 214926, -16423, 213013, -15211, 210846, -13789,
+		208669, -12280, 206747, -10845, 205313, -9638, 204513, -8769, 204394, -8287, 204927, -8184, 206031, -8417, 207610, -8924,
+		209566, -9642, 211814, -10514, 214286, -11491, 216927, -12532, 219699, -13607, 222572, -14691, 225526, -15766, 228544, -16816,
+		231618, -17830, 234741, -18800, 237906, -19718, 241112, -20579, 244356, -21376, 247637, -22105, 250952, -22763, 254302, -23347,
+		257684, -23851, 261096, -24275, 264538, -24613, 268007, -24864, 271500, -25025, 275014, -25093, 278545, -25065, 282088, -24939,
+		285639, -24714, 289189, -24386, 292733, -23956, 296260, -23422, 299760, -22784, 303217, -22044, 306615, -21204, 309930, -20271,
+		313133, -19252, 316186, -18161, 319041, -17016, 321636, -15844, 323897, -14679, 325737, -13565, 327060, -12555, 327774, -11705,
+		327809, -11075, 327134, -10708, 325790, -10631, 323899, -10837, 321659, -11285, 319316, -11908, 317113, -12624, 315244, -13361,
+		313836, -14060, 312944, -14684, 312570, -15209, 312680, -15624, 313224, -15926, 314149, -16115, 315400, -16192, 316927, -16161,
+		318689, -16024, 320649, -15785, 322776, -15446, 325044, -15010, 327434, -14479, 329927, -13857, 332511, -13144, 335174, -12344,
+		337908, -11458, 340708, -10488, 343569, -9435, 346489, -8302, 349467, -7091, 352503, -5803, 355601, -4440, 358763, -3006,
+		1993, -1502, 5297, 68, 8680, 1699, 12149, 3386, 15709, 5121, 19364, 6893, 23117, 8690, 26964, 10496,
+		30899, 12289, 34905, 14045, 38960, 15738, 43032, 17340, 47084, 18824, 51076, 20170, 54965, 21360, 58714, 22386,
+		62289, 23245, 65660, 23938, 68802, 24472, 71693, 24857, 74314, 25100, 76649, 25215, 78680, 25210, 80395, 25096,
+		81780, 24883, 82824, 24581, 83523, 24199, 83877, 23745, 83893, 23231, 83591, 22668, 83003, 22069, 82176, 21451,
+		81168, 20832, 80051, 20234, 78901, 19680, 77796, 19193, 76811, 18791, 76010, 18492, 75444, 18304, 75153, 18233,
+		75164, 18276, 75492, 18429, 76147, 18680, 77133, 19017, 78448, 19424, 80092, 19884, 82063, 20378, 84358, 20886,
+		86973, 21386, 89904, 21853, 93143, 22264, 96674, 22591, 100476, 22808, 104516, 22891, 108752, 22818, 113130, 22574,
+		117590, 22152, 122073, 21553, 126523, 20786, 130895, 19866, 135154, 18814, 139276, 17648, 143250, 16390, 147070, 15059,
+		150738, 13671, 154258, 12241, 157637, 10782, 160884, 9306, 164006, 7820, 167012, 6334, 169908, 4855, 172702, 3388,
+		175398, 1941, 178000, 517, 180512, -878, 182933, -2239, 185264, -3561, 187501, -4838, 189640, -6063, 191671, -7232,
+		193586, -8335, 195368, -9363, 197000, -10307, 198456, -11152, 199709, -11884, 200721, -12483, 201453, -12926, 201859, -13186,
+		201893, -13231, 201512, -13029, 200694, -12548, 199444, -11771, 197818, -10702, 195934, -9388, 193969, -7919, 192142, -6428,
+		190666, -5063, 189712, -3955, 189378, -3191, 189685, -2809, 190595, -2800, 192030, -3127, 193895, -3735, 196099, -4565,
+		198559, -5563, 201208, -6679, 203994, -7875, 206879, -9116, 209837, -10378, 212847, -11641, 215898, -12889, 218982, -14111,
+		222093, -15297, 225231, -16439, 228393, -17532, 231580, -18569, 234792, -19548, 238030, -20464, 241296, -21313, 244589, -22091,
+		247910, -22797, 251259, -23426, 254636, -23976, 258038, -24444, 261465, -24826, 264913, -25119, 268379, -25322, 271857, -25430,
+		275340, -25442, 278821, -25355, 282290, -25168, 285733, -24880, 289135, -24490, 292475, -24000, 295727, -23414, 298860, -22736,
+		301829, -21977, 304583, -21150, 307053, -20276, 309156, -19384, 310795, -18510, 311861, -17698, 312249, -16998, 311879, -16460,
+		310729, -16121, 308870, -16000, 306484, -16084, 303844, -16338, 301257, -16709, 298996, -17145, 297243, -17600, 296087, -18044,
+		295537, -18453, 295551, -18814, 296068, -19114, 297016, -19348, 298329, -19509, 299947, -19593, 301819, -19598, 303902, -19520,
+		306160, -19358, 308564, -19112, 311090, -18780, 313718, -18362, 316433, -17858, 319221, -17268, 322073, -16592, 324980, -15832,
+		327937, -14986, 330938, -14056, 333982, -13042, 337066, -11945, 340190, -10766, 343354, -9505, 346562, -8165, 349815, -6746,
+		353117, -5250, 356472, -3680, 359883, -2040, 3356, -334, 6892, 1430, 10493, 3244, 14157, 5095, 17878, 6966,
+		21643, 8839, 25433, 10688, 29220, 12486, 32970, 14204, 36643, 15815, 40197, 17294, 43590, 18622, 46783, 19788,
+		49742, 20784, 52438, 21608, 54843, 22262, 56937, 22748, 58699, 23072, 60115, 23239, 61173, 23252, 61869, 23117,
+		62207, 22840, 62199, 22429, 61873, 21895, 61270, 21255, 60447, 20530, 59470, 19748, 58416, 18944, 57365, 18153,
+		56389, 17413, 55557, 16757, 54920, 16211, 54519, 15795, 54379, 15520, 54517, 15390, 54937, 15403, 55641, 15551,
+		56624, 15824, 57883, 16210, 59411, 16694, 61204, 17262, 63259, 17898, 65576, 18586, 68153, 19307, 70993, 20044,
+		74097, 20776, 77465, 21482, 81094, 22137, 84976, 22716, 89093, 23193, 93420, 23542, 97916, 23739, 102532, 23766,
+		107212, 23612, 111897, 23275, 116533, 22760, 121074, 22079, 125484, 21251, 129741, 20292, 133831, 19225, 137749, 18067,
+		141493, 16837, 145070, 15550, 148484, 14219, 151744, 12857, 154857, 11476, 157830, 10083, 160670, 8689, 163381, 7300,
+		165969, 5925, 168435, 4569, 170780, 3241, 173004, 1946, 175101, 692, 177068, -512, 178894, -1659, 180569, -2738,
+		182076, -3738, 183398, -4646, 184509, -5445, 185384, -6118, 185990, -6641, 186294, -6990, 186263, -7138, 185870, -7055,
+		185101, -6715, 183966, -6101, 182510, -5217, 180824, -4092, 179044, -2791, 177341, -1413, 175897, -77, 174875, 1100,
+		174392, 2022, 174509, 2625, 175227, 2882, 176503, 2797, 178263, 2398, 180419, 1726, 182881, 827, 185570, -248,
+		188418, -1456, 191374, -2757, 194399, -4118, 197467, -5513, 200558, -6921, 203664, -8325, 206776, -9713, 209892, -11075,
+		213012, -12403, 216137, -13689, 219268, -14930, 222408, -16120, 225560, -17257, 228726, -18335, 231909, -19353, 235111, -20307,
+		238333, -21194, 241576, -22011, 244842, -22756, 248129, -23425, 251436, -24014, 254761, -24522, 258099, -24945, 261444, -25280,
+		264790, -25525, 268125, -25676, 271438, -25732, 274710, -25691, 277920, -25553, 281039, -25319, 284034, -24990, 286857, -24574,
+		289450, -24078, 291740, -23516, 293636, -22906, 295032, -22274, 295810, -21649, 295855, -21066, 295087, -20557, 293500, -20146,
+		291208, -19848, 288457, -19661, 285587, -19579, 282948, -19591, 280809, -19688, 279317, -19858, 278512, -20083, 278357, -20346,
+		278779, -20624, 279693, -20900, 281017, -21156, 282681, -21378, 284624, -21554, 286796, -21674, 289155, -21732, 291670, -21721,
+		294313, -21636, 297063, -21474, 299901, -21233, 302814, -20910, 305789, -20504, 308816, -20013, 311889, -19436, 314999, -18773,
+		318144, -18023, 321319, -17187, 324522, -16262, 327752, -15251, 331007, -14152, 334289, -12966, 337598, -11694, 340936, -10336,
+		344305, -8895, 347707, -7371, 351144, -5768, 354616, -4091, 358125, -2346, 1666, -541, 5232, 1311, 8813, 3193,
+		12390, 5085, 15936, 6961, 19418, 8792, 22795, 10548, 26022, 12196, 29052, 13711, 31842, 15067, 34350, 16247,
+		36540, 17238, 38383, 18031, 39857, 18622, 40946, 19005, 41643, 19180, 41953, 19146, 41893, 18909, 41498, 18477,
+		40819, 17870, 39922, 17114, 38887, 16246, 37799, 15312, 36743, 14363, 35796, 13448, 35021, 12612, 34466, 11891,
+		34161, 11311, 34122, 10888, 34356, 10628, 34859, 10531, 35624, 10591, 36640, 10798, 37896, 11142, 39382, 11610,
+		41087, 12190, 43006, 12869, 45132, 13633, 47465, 14470, 50003, 15367, 52749, 16310, 55708, 17285, 58884, 18275,
+		62283, 19264, 65909, 20232, 69763, 21157, 73842, 22015, 78132, 22779, 82612, 23423, 87245, 23922, 91987, 24255,
+		96783, 24408, 101578, 24373, 106318, 24154, 110957, 23757, 115459, 23199, 119798, 22496, 123959, 21667, 127935, 20731,
+		131723, 19706, 135325, 18608, 138746, 17453, 141992, 16253, 145068, 15022, 147979, 13769, 150730, 12506, 153325, 11243,
+		155765, 9987, 158051, 8750, 160179, 7539, 162146, 6364, 163944, 5235, 165565, 4164, 166995, 3163, 168219, 2245,
+		169218, 1427, 169970, 725, 170451, 162, 170637, -242, 170507, -463, 170046, -476, 169250, -264, 168138, 186,
+		166757, 871, 165185, 1766, 163537, 2826, 161953, 3979, 160582, 5141, 159567, 6222, 159020, 7142, 159016, 7839,
+		159584, 8268, 160713, 8410, 162359, 8260, 164452, 7830, 166913, 7147, 169656, 6242, 172602, 5153, 175685, 3919,
+		178849, 2575, 182055, 1154, 185275, -317, 188490, -1817, 191689, -3327, 194866, -4833, 198021, -6324, 201155, -7791,
+		204268, -9228, 207366, -10628, 210452, -11988, 213530, -13302, 216605, -14568, 219679, -15782, 222758, -16942, 225843, -18044,
+		228938, -19087, 232043, -20067, 235161, -20981, 238290, -21826, 241429, -22601, 244576, -23300, 247725, -23922, 250870, -24462,
+		254002, -24919, 257107, -25289, 260169, -25569, 263165, -25756, 266065, -25850, 268834, -25850, 271422, -25756, 273768, -25571,
+		275795, -25299, 277407, -24947, 278494, -24525, 278935, -24045, 278618, -23518, 277477, -22958, 275534, -22375, 272953, -21790,
+		270035, -21233, 267161, -20747, 264684, -20380, 262838, -20164, 261724, -20106, 261332, -20192, 261590, -20393, 262404, -20677,
+		263681, -21011, 265336, -21367, 267297, -21721, 269508, -22055, 271922, -22353, 274502, -22604, 277220, -22799, 280051, -22929,
+		282977, -22990, 285982, -22976, 289053, -22884, 292180, -22711, 295354, -22454, 298568, -22112, 301815, -21682, 305091, -21163,
+		308391, -20554, 311712, -19855, 315052, -19064, 318408, -18181, 321780, -17205, 325167, -16137, 328569, -14976, 331985, -13723,
+		335416, -12378, 338861, -10945, 342320, -9425, 345789, -7822, 349264, -6144, 352737, -4400, 356196, -2601, 359621, -767,
+		2985, 1080, 6255, 2913, 9386, 4699, 12331, 6402, 15035, 7987, 17447, 9418, 19516, 10666, 21202, 11703,
+		22472, 12510, 23306, 13069, 23701, 13369, 23669, 13405, 23245, 13181, 22485, 12712, 21468, 12026, 20287, 11166,
+		19045, 10187, 17844, 9153, 16773, 8126, 15902, 7164, 15282, 6313, 14941, 5605, 14891, 5060, 15130, 4687,
+		15648, 4487, 16430, 4455, 17458, 4582, 18713, 4858, 20179, 5272, 21842, 5812, 23688, 6466, 25709, 7226,
+		27896, 8079, 30246, 9016, 32756, 10028, 35428, 11104, 38264, 12234, 41269, 13408, 44450, 14615, 47815, 15842,
+		51370, 17072, 55124, 18291, 59078, 19476, 63233, 20606, 67578, 21655, 72095, 22596, 76752, 23403, 81508, 24053,
+		86314, 24528, 91116, 24817, 95863, 24918, 100508, 24836, 105017, 24583, 109361, 24173, 113522, 23624, 117490, 22953,
+		121260, 22177, 124830, 21315, 128201, 20381, 131375, 19391, 134356, 18357, 137146, 17293, 139745, 16211, 142153, 15122,
+		144370, 14038, 146390, 12971, 148209, 11931, 149817, 10933, 151204, 9989, 152356, 9112, 153259, 8320, 153895, 7629,
+		154248, 7057, 154300, 6623, 154042, 6346, 153469, 6244, 152594, 6330, 151444, 6609, 150074, 7077, 148563, 7715,
+		147013, 8488, 145545, 9350, 144284, 10244, 143346, 11113, 142828, 11900, 142799, 12557, 143297, 13045, 144331, 13334,
+		145881, 13402, 147906, 13238, 150346, 12840, 153128, 12215, 156176, 11380, 159412, 10357, 162767, 9176, 166185, 7868,
+		169621, 6460, 173045, 4982, 176434, 3455, 179779, 1900, 183072, 332, 186313, -1235, 189503, -2791, 192647, -4329,
+		195750, -5841, 198816, -7322, 201851, -8768, 204861, -10176, 207850, -11540, 210823, -12860, 213784, -14131, 216737, -15352,
+		219685, -16519, 222628, -17631, 225567, -18683, 228503, -19673, 231432, -20599, 234352, -21457, 237255, -22243, 240133, -22954,
+		242974, -23586, 245759, -24135, 248468, -24598, 251070, -24969, 253528, -25245, 255792, -25421, 257800, -25492, 259475, -25454,
+		260721, -25301, 261431, -25025, 261493, -24617, 260814, -24070, 259355, -23379, 257183, -22553, 254503, -21631, 251649, -20689,
+		248998, -19829, 246866, -19146, 245437, -18702, 244763, -18508, 244798, -18543, 245452, -18764, 246623, -19123, 248213, -19576,
+		250140, -20085, 252339, -20619, 254756, -21153, 257349, -21667, 260088, -22148, 262947, -22582, 265906, -22960, 268950, -23276,
+		272065, -23522, 275241, -23694, 278470, -23789, 281744, -23802, 285056, -23730, 288402, -23572, 291776, -23324, 295174, -22985,
+		298592, -22554, 302027, -22028, 305475, -21407, 308935, -20690, 312404, -19875, 315880, -18963, 319360, -17952, 322843, -16844,
+		326326, -15639, 329805, -14338, 333276, -12944, 336732, -11463, 340163, -9901, 343553, -8268, 346883, -6579, 350125, -4854,
+		353240, -3118, 356185, -1403, 358902, 252, 1333, 1805, 3413, 3212, 5085, 4430, 6298, 5417, 7019, 6141,
+		7236, 6576, 6963, 6705, 6246, 6530, 5163, 6067, 3822, 5355, 2347, 4453, 870, 3431, 359510, 2368,
+		358364, 1334, 357499, 388, 356955, -428, 356745, -1087, 356864, -1576, 357297, -1891, 358021, -2037, 359009, -2020,
+		236, -1849, 1678, -1537, 3313, -1091, 5122, -523, 7091, 158, 9206, 944, 11459, 1827, 13842, 2799,
+		16351, 3853, 18985, 4982, 21744, 6180, 24630, 7439, 27649, 8753, 30807, 10112, 34110, 11508, 37569, 12931,
+		41191, 14368, 44983, 15803, 48951, 17219, 53093, 18594, 57403, 19904, 61863, 21122, 66447, 22220, 71116, 23175,
+		75825, 23966, 80525, 24578, 85168, 25005, 89711, 25250, 94116, 25319, 98358, 25224, 102414, 24981, 106272, 24606,
+		109922, 24116, 113358, 23526, 116578, 22854, 119578, 22114, 122358, 21321, 124913, 20488, 127241, 19631, 129336, 18761,
+		131192, 17892, 132802, 17039, 134153, 16214, 135236, 15433, 136037, 14711, 136545, 14062, 136749, 13503, 136642, 13050,
+		136226, 12716, 135511, 12516, 134524, 12456, 133309, 12540, 131931, 12763, 130473, 13113, 129032, 13569, 127714, 14105,
+		126622, 14688, 125845, 15286, 125460, 15867, 125518, 16399, 126054, 16854, 127079, 17204, 128591, 17424, 130568, 17492,
+		132976, 17388, 135765, 17098, 138876, 16614, 142240, 15934, 145785, 15069, 149445, 14034, 153156, 12851, 156870, 11546,
+		160550, 10144, 164171, 8668, 167718, 7139, 171183, 5577, 174566, 3997, 177868, 2410, 181094, 826, 184250, -745,
+		187342, -2298, 190377, -3827, 193361, -5327, 196300, -6794, 199201, -8226, 202067, -9618, 204903, -10968, 207713, -12273,
+		210498, -13531, 213259, -14738, 215997, -15891, 218709, -16988, 221392, -18024, 224039, -18996, 226642, -19900, 229188, -20732,
+		231661, -21485, 234037, -22154, 236288, -22732, 238375, -23210, 240249, -23580, 241850, -23828, 243104, -23940, 243924, -23898,
+		244214, -23680, 243884, -23260, 242872, -22617, 241183, -21740, 238927, -20651, 236338, -19420, 233743, -18169, 231478, -17041,
+		229802, -16162, 228845, -15600, 228620, -15364, 229064, -15422, 230078, -15720, 231559, -16199, 233413, -16806, 235562, -17494,
+		237946, -18228, 240516, -18978, 243236, -19723, 246078, -20445, 249022, -21129, 252051, -21767, 255154, -22349, 258320, -22867,
+		261543, -23318, 264814, -23695, 268130, -23995, 271486, -24214, 274876, -24349, 278297, -24396, 281746, -24355, 285217, -24221,
+		288709, -23993, 292217, -23670, 295738, -23248, 299269, -22728, 302804, -22107, 306342, -21384, 309877, -20560, 313405, -19633,
+		316919, -18605, 320413, -17478, 323877, -16254, 327298, -14939, 330659, -13541, 333937, -12071, 337101, -10547, 340112, -8992,
+		342918, -7436, 345459, -5916, 347666, -4480, 349463, -3177, 350780, -2061, 351558, -1184, 351762, -591, 351391, -312,
+		350488, -362, 349144, -726, 347494, -1364, 345701, -2209, 343933, -3180, 342338, -4192, 341032, -5168, 340085, -6052,
+		339531, -6805, 339370, -7405, 339585, -7844, 340146, -8122, 341017, -8244, 342164, -8217, 343552, -8050, 345152, -7751,
+		346936, -7329, 348884, -6792, 350975, -6146, 353195, -5397, 355531, -4553, 357976, -3618, 521, -2596, 3164, -1493,
+		5901, -314, 8734, 938, 11664, 2257, 14694, 3640, 17831, 5080, 21080, 6572, 24449, 8108, 27947, 9681,
+		31581, 11278, 35358, 12887, 39282, 14492, 43353, 16072, 47562, 17603, 51894, 19061, 56324, 20417, 60817, 21645,
+		65331, 22723, 69821, 23635, 74243, 24371, 78557, 24929, 82728, 25313, 86729, 25533, 90537, 25601, 94136, 25530,
+		97513, 25337, 100658, 25036, 103562, 24643, 106216, 24172, 108614, 23639, 110746, 23056, 112603, 22439, 114176, 21800,
+		115454, 21154, 116427, 20514, 117085, 19894, 117423, 19306, 117439, 18765, 117138, 18282, 116538, 17871, 115667, 17540,
+		114572, 17299, 113313, 17151, 111966, 17099, 110618, 17142, 109359, 17273, 108275, 17484, 107447, 17764, 106937, 18097,
+		106797, 18468, 107058, 18858, 107743, 19246, 108861, 19613, 110412, 19935, 112388, 20188, 114771, 20347, 117538, 20388,
+		120653, 20289, 124071, 20029, 127736, 19596, 131586, 18983, 135554, 18194, 139577, 17240, 143601, 16137, 147580, 14907,
+		151482, 13574, 155287, 12159, 158983, 10682, 162566, 9161, 166037, 7612, 169402, 6046, 172665, 4475, 175835, 2908,
+		178919, 1350, 181925, -191, 184860, -1710, 187729, -3204, 190539, -4668, 193295, -6098, 196000, -7491, 198656, -8844,
+		201265, -10154, 203826, -11417, 206339, -12628, 208798, -13786, 211197, -14884, 213528, -15917, 215776, -16881, 217926, -17767,
+		219953, -18568, 221829, -19273, 223517, -19871, 224969, -20345, 226130, -20678, 226932, -20845, 227302, -20819, 227165, -20567,
+		226464, -20057, 225179, -19265, 223358, -18192, 221147, -16883, 218785, -15439, 216566, -14013, 214766, -12764, 213581, -11821,
+		213096, -11246, 213299, -11044, 214119, -11175, 215456, -11579, 217211, -12194, 219294, -12960, 221633, -13829, 224169, -14759,
+		226859, -15720, 229670, -16686, 232580, -17640, 235570, -18567, 238629, -19454, 241747, -20293, 244917, -21077, 248135, -21800,
+		251397, -22455, 254699, -23040, 258040, -23549, 261416, -23980, 264825, -24329, 268264, -24593, 271732, -24769, 275224, -24855,
+		278739, -24847, 282271, -24744, 285818, -24543, 289374, -24242, 292935, -23840, 296495, -23336, 300047, -22727, 303583, -22014,
+		307093, -21199, 310566, -20281, 313984, -19266, 317329, -18159, 320574, -16970, 323685, -15713, 326618, -14407, 329317, -13080,
+		331714, -11768, 333729, -10515, 335277, -9374, 336271, -8403, 336641, -7661, 336352, -7196, 335419, -7040, 333923, -7198,
+		332014, -7640, 329891, -8305, 327775, -9113, 325861, -9977, 324298, -10824, 323173, -11597, 322520, -12263, 322331, -12800,
+		322576, -13204, 323210, -13471, 324187, -13607, 325458, -13615, 326984, -13503, 328727, -13274, 330655, -12934, 332741, -12489,
+		334965, -11942, 337307, -11298, 339753, -10560, 342291, -9731, 344914, -8816, 347614, -7816, 350387, -6734, 353231, -5573,
+		356145, -4335, 359131, -3024, 2191, -1642, 5328, -193, 8548, 1320, 11857, 2893, 15262, 4519, 18770, 6191,
+		22387, 7901, 26117, 9636, 29964, 11381, 33924, 13117, 37989, 14823, 42140, 16471, 46354, 18036, 50596, 19490,
+		54826, 20808, 59004, 21974, 63087, 22974, 67038, 23804, 70824, 24466, 74418, 24965, 77797, 25310, 80942, 25513,
+		83836, 25587, 86465, 25544, 88815, 25398, 90871, 25161, 92623, 24846, 94058, 24463, 95165, 24025, 95938, 23543,
+		96372, 23028, 96470, 22491, 96246, 21943, 95721, 21396, 94933, 20865, 93933, 20362, 92785, 19903, 91565, 19501,
+		90352, 19173, 89228, 18928, 88267, 18777, 87532, 18724, 87075, 18768, 86933, 18906, 87132, 19128, 87688, 19422,
+		88609, 19774, 89898, 20165, 91554, 20578, 93575, 20989, 95954, 21377, 98682, 21717, 101745, 21983, 105124, 22148,
+		108787, 22188, 112696, 22080, 116799, 21807, 121039, 21359, 125354, 20735, 129683, 19940, 133975, 18990, 138186, 17903,
+		142288, 16699, 146262, 15401, 150098, 14027, 153793, 12596, 157352, 11124, 160778, 9623, 164080, 8105, 167266, 6581,
+		170344, 5057, 173321, 3540, 176205, 2038, 179002, 555, 181718, -904, 184356, -2335, 186919, -3733, 189408, -5094,
+		191824, -6413, 194163, -7686, 196421, -8908, 198592, -10073, 200666, -11174, 202628, -12204, 204461, -13155, 206142, -14014,
+		207643, -14768, 208926, -15402, 209950, -15896, 210664, -16223, 211013, -16356, 210941, -16262, 210404, -15905, 209379, -15257,
+		207890, -14307, 206025, -13078, 203951, -11642, 201893, -10122, 200098, -8675, 198781, -7450, 198078, -6559, 198041, -6053,
+		198642, -5931, 199809, -6156, 201446, -6670, 203457, -7410, 205755, -8319, 208272, -9346, 210952, -10449, 213754, -11595,
+		216649, -12759, 219615, -13920, 222638, -15063, 225709, -16176, 228820, -17249, 231969, -18274, 235151, -19246, 238368, -20159,
+		241616, -21009, 244897, -21791, 248209, -22502, 251552, -23139, 254927, -23698, 258330, -24176, 261762, -24570, 265220, -24878,
+		268701, -25095, 272202, -25220, 275718, -25251, 279245, -25183, 282776, -25016, 286303, -24748, 289817, -24376, 293305, -23902,
+		296754, -23325, 300146, -22648, 303456, -21874, 306655, -21010, 309705, -20068, 312558, -19063, 315151, -18018, 317409, -16964,
+		319241, -15940, 320548, -14996, 321230, -14187, 321207, -13567, 320441, -13184, 318972, -13063, 316931, -13200, 314535, -13557,
+		312057, -14072, 309759, -14674, 307847, -15298, 306447, -15896, 305605, -16434, 305310, -16894, 305519, -17264, 306174, -17541,
+		307213, -17722, 308578, -17806, 310218, -17794, 312089, -17686, 314152, -17485, 316378, -17190, 318740, -16803, 321217, -16326,
+		323793, -15760, 326453, -15105, 329187, -14363, 331985, -13536, 334843, -12624, 337754, -11629, 340717, -10552, 343730, -9394,
+		346793, -8157, 349908, -6842, 353076, -5451, 356303, -3987, 359590, -2451, 2945, -848, 6372, 819, 9876, 2543,
+		13461, 4315, 17129, 6126, 20879, 7960, 24705, 9800, 28594, 11625, 32527, 13407, 36475, 15120, 40403, 16737,
+		44273, 18230, 48041, 19581, 51668, 20775, 55119, 21803, 58359, 22665, 61362, 23361, 64104, 23898, 66563, 24284,
+		68721, 24525, 70560, 24631, 72067, 24610, 73230, 24469, 74042, 24217, 74502, 23861, 74617, 23409, 74407, 22874,
+		73903, 22267, 73150, 21607, 72209, 20914, 71149, 20215, 70046, 19538, 68979, 18912, 68019, 18364, 67230, 17915,
+		66661, 17584, 66351, 17379, 66324, 17303, 66595, 17355, 67170, 17526, 68053, 17806, 69240, 18181, 70730, 18637,
+		72520, 19156, 74608, 19721, 76992, 20313, 79672, 20912, 82644, 21494, 85907, 22037, 89450, 22516, 93261, 22903,
+		97314, 23172, 101575, 23300, 105999, 23266, 110529, 23057, 115108, 22667, 119676, 22099, 124183, 21364, 128587, 20479,
+		132858, 19462, 136977, 18335, 140937, 17116, 144734, 15823, 148370, 14474, 151853, 13083, 155189, 11662, 158386, 10222,
+		161452, 8772, 164395, 7321, 167222, 5876, 169938, 4443, 172546, 3029, 175051, 1639, 177453, 280, 179752, -1043,
+		181945, -2324, 184026, -3556, 185989, -4731, 187822, -5842, 189511, -6878, 191038, -7827, 192380, -8677, 193508, -9411,
+		194390, -10008, 194988, -10444, 195262, -10694, 195172, -10724, 194685, -10505, 193788, -10010, 192499, -9224, 190879, -8159,
+		189049, -6863, 187177, -5427, 185465, -3976, 184110, -2649, 183266, -1565, 183027, -810, 183414, -421, 184394, -399,
+		185894, -713, 187823, -1313, 190089, -2148, 192610, -3162, 195317, -4308, 198155, -5544, 201084, -6836, 204076, -8158,
+		207111, -9489, 210178, -10812, 213267, -12114, 216375, -13385, 219500, -14618, 222641, -15806, 225800, -16943, 228978, -18026,
+		232176, -19049, 235396, -20011, 238640, -20906, 241908, -21733, 245202, -22488, 248522, -23168, 251867, -23770, 255236, -24291,
+		258627, -24728, 262037, -25078, 265461, -25337, 268894, -25505, 272327, -25577, 275751, -25551, 279153, -25427, 282517, -25202,
+		285822, -24879, 289044, -24457, 292149, -23943, 295095, -23342, 297828, -22666, 300281, -21932, 302370, -21164, 303995, -20391,
+		305043, -19653, 305404, -18992, 304989, -18453, 303768, -18070, 301813, -17862, 299312, -17823, 296558, -17929, 293884, -18143,
+		291575, -18428, 289820, -18755, 288703, -19098, 288220, -19437, 288323, -19756, 288938, -20042, 289990, -20282, 291407, -20468,
+		293127, -20592, 295098, -20648, 297275, -20631, 299624, -20537, 302116, -20364, 304725, -20110, 307433, -19773, 310224, -19352,
+		313085, -18847, 316005, -18256, 318977, -17580, 321993, -16819, 325050, -15972, 328144, -15039, 331272, -14022, 334434, -12920,
+		337630, -11735, 340860, -10466, 344128, -9114, 347434, -7682, 350783, -6171, 354178, -4584, 357621, -2925, 1115, -1198,
+		4662, 588, 8259, 2423, 11900, 4294, 15576, 6182, 19267, 8065, 22949, 9917, 26589, 11710, 30148, 13413,
+		33582, 14999, 36849, 16445, 39906, 17732, 42717, 18848, 45247, 19787, 47469, 20544, 49359, 21121, 50898, 21517,
+		52072, 21735, 52875, 21776, 53306, 21645, 53380, 21347, 53121, 20892, 52570, 20295, 51783, 19577, 50829, 18770,
+		49786, 17909, 48735, 17037, 47753, 16196, 46908, 15427, 46253, 14762, 45830, 14227, 45663, 13838, 45765, 13602,
+		46143, 13519, 46793, 13585, 47710, 13791, 48887, 14125, 50316, 14574, 51990, 15125, 53904, 15764, 56056, 16476,
+		58443, 17246, 61068, 18058, 63932, 18897, 67039, 19742, 70392, 20576, 73992, 21376, 77837, 22118, 81916, 22776,
+		86210, 23324, 90690, 23736, 95314, 23991, 100029, 24070, 104779, 23965, 109507, 23676, 114161, 23208, 118701, 22577,
+		123095, 21798, 127324, 20892, 131376, 19877, 135249, 18773, 138943, 17595, 142464, 16360, 145816, 15081, 149008, 13770,
+		152046, 12439, 154937, 11097, 157685, 9753, 160296, 8415, 162770, 7091, 165110, 5790, 167314, 4518, 169379, 3284,
+		171298, 2097, 173063, 966, 174663, -98, 176082, -1082, 177302, -1974, 178300, -2755, 179052, -3408, 179527, -3910,
+		179698, -4236, 179537, -4361, 179025, -4257, 178157, -3903, 176956, -3289, 175474, -2422, 173808, -1336, 172092, -98,
+		170487, 1201, 169161, 2455, 168260, 3559, 167890, 4429, 168105, 5006, 168908, 5262, 170260, 5194, 172093, 4821,
+		174322, 4175, 176860, 3297, 179625, 2232, 182549, 1023, 185576, -291, 188666, -1677, 191789, -3108, 194926, -4560,
+		198066, -6017, 201202, -7464, 204331, -8891, 207453, -10289, 210569, -11651, 213683, -12972, 216796, -14247, 219913, -15472,
+		223037, -16644, 226171, -17760, 229317, -18817, 232478, -19811, 235656, -20740, 238852, -21600, 242066, -22390, 245298, -23106,
+		248546, -23745, 251806, -24303, 255073, -24779, 258341, -25168, 261600, -25468, 264838, -25677, 268037, -25792, 271178, -25812,
+		274231, -25737, 277164, -25567, 279929, -25305, 282469, -24958, 284712, -24535, 286568, -24048, 287929, -23516, 288676, -22961,
+		288692, -22408, 287891, -21881, 286263, -21401, 283919, -20980, 281111, -20632, 278194, -20367, 275530, -20199, 273397, -20139,
+		271944, -20186, 271201, -20329, 271125, -20545, 271634, -20811, 272638, -21101, 274053, -21392, 275804, -21667, 277830, -21910,
+		280081, -22107, 282517, -22250, 285104, -22331, 287817, -22344, 290633, -22283, 293537, -22146, 296512, -21928, 299548, -21629,
+		302636, -21245, 305766, -20776, 308933, -20220, 312131, -19576, 315358, -18844, 318608, -18023, 321882, -17113, 325176, -16114,
+		328492, -15025, 331829, -13847, 335188, -12580, 338570, -11225, 341976, -9783, 345408, -8257, 348865, -6651, 352347, -4969,
+		355850, -3219, 359369, -1410, 2891, 441, 6400, 2318, 9870, 4197, 13269, 6048, 16556, 7842, 19685, 9545,
+		22608, 11124, 25276, 12551, 27644, 13802, 29673, 14859, 31331, 15705, 32595, 16332, 33451, 16731, 33898, 16897,
+		33949, 16831, 33633, 16539, 32998, 16035, 32113, 15343, 31058, 14502, 29924, 13558, 28803, 12567, 27779, 11583,
+		26922, 10660, 26285, 9841, 25901, 9158, 25789, 8632, 25955, 8272, 26394, 8081, 27096, 8054, 28048, 8185,
+		29236, 8462, 30647, 8873, 32269, 9408, 34091, 10053, 36106, 10796, 38309, 11627, 40698, 12533, 43273, 13503,
+		46035, 14525, 48989, 15586, 52142, 16673, 55499, 17770, 59068, 18860, 62852, 19923, 66852, 20938, 71063, 21880,
+		75469, 22722, 80044, 23437, 84750, 24002, 89540, 24396, 94359, 24607, 99153, 24629, 103871, 24467, 108471, 24130,
+		112922, 23633, 117200, 22993, 121293, 22228, 125195, 21358, 128904, 20399, 132422, 19367, 135753, 18278, 138902, 17144,
+		141872, 15979, 144669, 14794, 147295, 13599, 149750, 12405, 152036, 11222, 154149, 10060, 156085, 8931, 157837, 7843,
+		159395, 6811, 160747, 5846, 161877, 4963, 162768, 4178, 163398, 3510, 163748, 2978, 163797, 2604, 163529, 2409,
+		162938, 2413, 162030, 2632, 160836, 3070, 159410, 3721, 157839, 4557, 156240, 5531, 154746, 6580, 153496, 7629,
+		152615, 8604, 152203, 9436, 152323, 10073, 153000, 10475, 154224, 10620, 155956, 10497, 158133, 10113, 160678, 9481,
+		163511, 8627, 166551, 7583, 169729, 6382, 172987, 5059, 176283, 3645, 179583, 2169, 182869, 653, 186128, -882,
+		189354, -2422, 192544, -3955, 195701, -5470, 198826, -6961, 201925, -8420, 205000, -9843, 208058, -11226, 211103, -12564,
+		214138, -13856, 217169, -15097, 220199, -16286, 223231, -17419, 226267, -18494, 229309, -19507, 232356, -20457, 235409, -21340,
+		238465, -22153, 241520, -22894, 244567, -23557, 247599, -24142, 250603, -24643, 253562, -25059, 256456, -25385, 259256, -25619,
+		261926, -25758, 264419, -25802, 266675, -25749, 268618, -25600, 270156, -25356, 271181, -25020, 271574, -24594, 271225, -24082,
+		270066, -23487, 268121, -22817, 265547, -22095, 262646, -21366, 259799, -20697, 257361, -20158, 255569, -19802, 254521, -19644,
+		254203, -19672, 254539, -19852, 255433, -20147, 256786, -20517, 258512, -20929, 260540, -21355, 262812, -21774, 265283, -22166,
+		267916, -22519, 270682, -22821, 273560, -23064, 276530, -23241, 279578, -23346, 282692, -23376, 285861, -23326, 289078, -23193,
+		292334, -22976, 295624, -22671, 298943, -22277, 302286, -21793, 305649, -21218, 309030, -20549, 312426, -19787, 315835, -18930,
+		319256, -17979, 322687, -16932, 326128, -15791, 329578, -14555, 333036, -13226, 336499, -11806, 339965, -10298, 343428, -8708,
+		346880, -7043, 350309, -5314, 353696, -3538, 357015, -1734, 232, 72, 3304, 1847, 6180, 3555, 8806, 5158,
+		11123, 6616, 13079, 7892, 14626, 8954, 15730, 9774, 16371, 10331, 16548, 10609, 16283, 10602, 15624, 10317,
+		14642, 9776, 13435, 9014, 12112, 8087, 10786, 7060, 9562, 6003, 8526, 4980, 7740, 4047, 7242, 3245,
+		7050, 2600, 7164, 2125, 7573, 1825, 8260, 1697, 9204, 1733, 10384, 1924, 11780, 2259, 13374, 2727,
+		15149, 3317, 17092, 4020, 19195, 4825, 21449, 5723, 23849, 6705, 26394, 7764, 29083, 8890, 31920, 10074,
+		34909, 11309, 38056, 12584, 41369, 13888, 44857, 15209, 48526, 16531, 52384, 17837, 56433, 19107, 60669, 20317,
+		65080, 21442, 69643, 22453, 74325, 23326, 79081, 24039, 83863, 24574, 88617, 24923, 93297, 25085, 97860, 25068,
+		102274, 24881, 106514, 24541, 110565, 24065, 114417, 23469, 118065, 22770, 121507, 21986, 124743, 21132, 127774, 20221,
+		130600, 19270, 133221, 18289, 135637, 17292, 137844, 16292, 139839, 15301, 141614, 14331, 143160, 13395, 144468, 12509,
+		145523, 11685, 146312, 10940, 146819, 10291, 147031, 9755, 146936, 9349, 146531, 9091, 145820, 8994, 144826, 9069,
+		143590, 9315, 142176, 9726, 140669, 10281, 139176, 10950, 137811, 11691, 136688, 12458, 135909, 13205, 135556, 13889,
+		135683, 14468, 136323, 14910, 137480, 15186, 139137, 15275, 141257, 15160, 143788, 14831, 146663, 14288, 149809, 13539,
+		153152, 12599, 156620, 11492, 160153, 10244, 163703, 8884, 167234, 7437, 170724, 5929, 174157, 4381, 177526, 2809,
+		180830, 1228, 184071, -350, 187252, -1917, 190379, -3465, 193457, -4987, 196492, -6480, 199491, -7938, 202458, -9359,
+		205400, -10738, 208319, -12074, 211221, -13363, 214109, -14603, 216984, -15791, 219847, -16924, 222698, -18000, 225537, -19014,
+		228358, -19965, 231157, -20849, 233925, -21661, 236650, -22398, 239317, -23055, 241903, -23628, 244380, -24110, 246712, -24498,
+		248852, -24783, 250739, -24958, 252299, -25014, 253443, -24941, 254068, -24724, 254069, -24348, 253359, -23797, 251903, -23060,
+		249769, -22146, 247156, -21099, 244383, -20008, 241818, -18995, 239771, -18175, 238423, -17622, 237826, -17356, 237936, -17356,
+		238662, -17574, 239899, -17960, 241550, -18462, 243532, -19037, 245779, -19652, 248237, -20277, 250867, -20893, 253636, -21481,
+		256521, -22028, 259502, -22526, 262566, -22964, 265700, -23336, 268894, -23638, 272141, -23864, 275433, -24010, 278766, -24074,
+		282133, -24052, 285529, -23942, 288952, -23741, 292396, -23448, 295858, -23061, 299336, -22578, 302824, -21998, 306322, -21319,
+		309826, -20542, 313332, -19664, 316839, -18687, 320343, -17610, 323838, -16434, 327320, -15162, 330782, -13797, 334211, -12346,
+		337595, -10816, 340911, -9221, 344133, -7578, 347223, -5910, 350136, -4249, 352816, -2631, 355198, -1101, 357215, 296,
+		358804, 1510, 359907, 2495, 488, 3211, 534, 3628, 64, 3727, 359137, 3510, 357847, 3001, 356322, 2246,
+		354705, 1313, 353139, 282, 351747, -767, 350624, -1765, 349828, -2658, 349386, -3409, 349302, -3998, 349561, -4418,
+		350139, -4669, 351007, -4757, 352134, -4689, 353493, -4476, 355057, -4126, 356804, -3650, 358715, -3057, 773, -2354,
+		2967, -1549, 5285, -649, 7721, 340, 10271, 1411, 12933, 2559, 15705, 3777, 18592, 5061, 21596, 6404,
+		24723, 7799, 27980, 9239, 31376, 10715, 34919, 12217, 38617, 13731, 42476, 15243, 46499, 16733, 50684, 18179,
+		55019, 19556, 59483, 20838, 64047, 21996, 68671, 23008, 73309, 23853, 77913, 24521, 82439, 25007, 86846, 25313,
+		91103, 25448, 95185, 25424, 99072, 25256, 102753, 24959, 106217, 24550, 109459, 24045, 112472, 23459, 115253, 22807,
+		117797, 22105, 120098, 21365, 122151, 20602, 123946, 19831, 125475, 19064, 126727, 18315, 127693, 17600, 128359, 16932,
+		128718, 16326, 128763, 15798, 128494, 15361, 127921, 15028, 127067, 14808, 125970, 14709, 124687, 14732, 123291, 14873,
+		121872, 15120, 120526, 15460, 119350, 15872, 118435, 16333, 117856, 16818, 117672, 17304, 117924, 17764, 118635, 18175,
+		119815, 18513, 121457, 18751, 123545, 18867, 126048, 18839, 128927, 18647, 132128, 18276, 135590, 17720, 139244, 16979,
+		143022, 16062, 146862, 14986, 150708, 13773, 154519, 12447, 158267, 11031, 161931, 9549, 165503, 8018, 168978, 6456,
+		172360, 4878, 175651, 3294, 178858, 1713, 181988, 144, 185048, -1407, 188045, -2935, 190985, -4436, 193874, -5905,
+		196718, -7339, 199521, -8736, 202287, -10091, 205019, -11402, 207717, -12667, 210381, -13881, 213011, -15042, 215603, -16146,
+		218151, -17189, 220646, -18166, 223076, -19073, 225426, -19904, 227673, -20651, 229789, -21308, 231738, -21864, 233473, -22308,
+		234937, -22624, 236061, -22796, 236763, -22800, 236957, -22611, 236563, -22198, 235527, -21536, 233862, -20614, 231676, -19455,
+		229190, -18133, 226714, -16780, 224566, -15550, 222993, -14579, 222125, -13944, 221977, -13663, 222490, -13702, 223567, -14008,
+		225105, -14518, 227010, -15175, 229203, -15929, 231624, -16742, 234223, -17582, 236965, -18425, 239823, -19251, 242776, -20047,
+		245811, -20800, 248914, -21502, 252078, -22145, 255296, -22723, 258562, -23232, 261872, -23666, 265222, -24022, 268608, -24296,
+		272026, -24486, 275474, -24588, 278948, -24600, 282444, -24519, 285960, -24343, 289490, -24071, 293033, -23699, 296583, -23228,
+		300136, -22654, 303687, -21978, 307231, -21199, 310761, -20317, 314269, -19332, 317744, -18249, 321173, -17070, 324539, -15802,
+		327818, -14456, 330980, -13047, 333984, -11594, 336780, -10127, 339306, -8681, 341489, -7301, 343250, -6038, 344511, -4948,
+		345206, -4087, 345294, -3504, 344773, -3235, 343692, -3293, 342158, -3663, 340325, -4299, 338379, -5128, 336504, -6065,
+		334859, -7025, 333556, -7938, 332659, -8750, 332189, -9432, 332136, -9966, 332473, -10347, 333163, -10577, 334164, -10662,
+		335437, -10608, 336947, -10423, 338661, -10115, 340552, -9690, 342598, -9155, 344779, -8516, 347079, -7778, 349487, -6945,
+		351994, -6023, 354591, -5015, 357275, -3925, 44, -2757, 2896, -1515, 5834, -201, 8860, 1180, 11979, 2623,
+		15196, 4124, 18518, 5678, 21953, 7276, 25509, 8911, 29193, 10571, 33009, 12243, 36959, 13908, 41040, 15547,
+		45240, 17134, 49540, 18643, 53910, 20047, 58314, 21320, 62708, 22441, 67051, 23397, 71299, 24179, 75416, 24787,
+		79372, 25225, 83140, 25505, 86701, 25637, 90038, 25635, 93137, 25514, 95988, 25288, 98579, 24971, 100900, 24579,
+		102941, 24124, 104690, 23619, 106137, 23079, 107272, 22515, 108086, 21941, 108572, 21369, 108729, 20812, 108562, 20281,
+		108088, 19789, 107335, 19346, 106344, 18965, 105175, 18654, 103897, 18421, 102594, 18272, 101350, 18210, 100249, 18236,
+		99366, 18346, 98765, 18534, 98495, 18790, 98591, 19100, 99076, 19449, 99963, 19820, 101256, 20192, 102955, 20544,
+		105051, 20852, 107533, 21091, 110381, 21236, 113568, 21261, 117057, 21145, 120801, 20868, 124742, 20418, 128818, 19790,
+		132965, 18990, 137125, 18029, 141247, 16925, 145293, 15700, 149239, 14376, 153067, 12973, 156772, 11511, 160351, 10006,
+		163810, 8474, 167154, 6925, 170390, 5370, 173526, 3817, 176569, 2274, 179529, 746, 182410, -762, 185220, -2245,
+		187964, -3699, 190645, -5120, 193266, -6504, 195829, -7848, 198334, -9148, 200779, -10401, 203160, -11600, 205471, -12743,
+		207704, -13823, 209844, -14834, 211877, -15768, 213779, -16617, 215523, -17368, 217072, -18010, 218384, -18523, 219404, -18889,
+		220073, -19082, 220324, -19070, 220093, -18821, 219333, -18300, 218033, -17486, 216249, -16380, 214121, -15033, 211876, -13548,
+		209786, -12077, 208108, -10782, 207028, -9792, 206628, -9178, 206904, -8949, 207787, -9069, 209183, -9481, 210992, -10122,
+		213124, -10931, 215505, -11856, 218077, -12856, 220794, -13896, 223626, -14950, 226547, -15998, 229541, -17025, 232597, -18018,
+		235706, -18968, 238862, -19866, 242061, -20708, 245300, -21486, 248577, -22198, 251891, -22838, 255240, -23402, 258621, -23889,
+		262035, -24293, 265478, -24613, 268947, -24845, 272442, -24987, 275957, -25036, 279490, -24989, 283036, -24844, 286590, -24600,
+		290145, -24253, 293696, -23804, 297232, -23250, 300744, -22593, 304220, -21833, 307641, -20973, 310989, -20018, 314237, -18976,
+		317350, -17858, 320283, -16682, 322981, -15472, 325375, -14261, 327382, -13091, 328910, -12011, 329868, -11080, 330177, -10356,
+		329792, -9891, 328727, -9716, 327070, -9839, 324989, -10229, 322708, -10827, 320469, -11553, 318483, -12327, 316902, -13081,
+		315807, -13768, 315221, -14357, 315126, -14834, 315480, -15192, 316232, -15429, 317328, -15550, 318719, -15556, 320360, -15451,
+		322213, -15239, 324246, -14924, 326431, -14510, 328746, -13998, 331174, -13392, 333698, -12695, 336308, -11909, 338994, -11036,
+		341750, -10078, 344571, -9038, 347454, -7917, 350398, -6718, 353404, -5442, 356474, -4092, 359610, -2671, 2816, -1180,
+		6099, 375, 9464, 1992, 12917, 3663, 16464, 5382, 20110, 7139, 23859, 8921, 27708, 10712, 31652, 12492,
+		35677, 14237, 39762, 15921, 43876, 17516, 47985, 18996, 52046, 20337, 56019, 21525, 59865, 22548, 63548, 23403,
+		67038, 24092, 70309, 24622, 73341, 25000, 76113, 25239, 78608, 25348, 80811, 25340, 82708, 25225, 84284, 25014,
+		85528, 24717, 86432, 24345, 86991, 23906, 87208, 23411, 87095, 22871, 86674, 22298, 85982, 21705, 85069, 21110,
+		83997, 20531, 82841, 19987, 81679, 19501, 80589, 19090, 79642, 18772, 78902, 18559, 78415, 18455, 78220, 18463,
+		78340, 18579, 78789, 18792, 79575, 19092, 80701, 19464, 82167, 19891, 83970, 20354, 86108, 20834, 88578, 21307,
+		91373, 21752, 94486, 22142, 97903, 22451, 101601, 22654, 105549, 22725, 109706, 22642, 114019, 22391, 118428, 21962,
+		122873, 21356, 127298, 20581, 131654, 19653, 135906, 18591, 140027, 17415, 144005, 16146, 147833, 14803, 151512, 13403,
+		155045, 11961, 158439, 10491, 161703, 9002, 164844, 7506, 167871, 6009, 170791, 4519, 173611, 3042, 176336, 1583,
+		178971, 148, 181519, -1258, 183981, -2630, 186357, -3964, 188645, -5254, 190840, -6495, 192937, -7680, 194925, -8802,
+		196790, -9854, 198516, -10825, 200080, -11704, 201454, -12475, 202605, -13123, 203492, -13625, 204070, -13956, 204291, -14086,
+		204106, -13983, 203481, -13612, 202404, -12949, 200909, -11987, 199088, -10753, 197100, -9321, 195158, -7813, 193487, -6376,
+		192285, -5155, 191682, -4257, 191727, -3736, 192398, -3599, 193628, -3813, 195325, -4326, 197394, -5080, 199748, -6015,
+		202315, -7082, 205039, -8237, 207877, -9446, 210799, -10680, 213784, -11920, 216816, -13147, 219887, -14350, 222991, -15518,
+		226124, -16644, 229284, -17720, 232472, -18742, 235687, -19705, 238929, -20604, 242200, -21437, 245500, -22199, 248828, -22888,
+		252185, -23499, 255570, -24031, 258982, -24481, 262418, -24844, 265877, -25118, 269353, -25301, 272843, -25390, 276340, -25382,
+		279837, -25275, 283324, -25067, 286790, -24758, 290219, -24347, 293593, -23834, 296888, -23224, 300075, -22521, 303115, -21734,
+		305960, -20877, 308546, -19968, 310798, -19034, 312623, -18111, 313916, -17242, 314572, -16478, 314501, -15871, 313658, -15465,
+		312078, -15287, 309901, -15335, 307364, -15578, 304763, -15963, 302382, -16432, 300437, -16932, 299051, -17422, 298262, -17875,
+		298048, -18275, 298355, -18610, 299116, -18871, 300266, -19056, 301741, -19159, 303489, -19179, 305464, -19113, 307627, -18962,
+		309948, -18724, 312401, -18399, 314964, -17987, 317621, -17487, 320357, -16902, 323162, -16230, 326026, -15473, 328944, -14631,
+		331909, -13704, 334920, -12695, 337973, -11602, 341070, -10428, 344209, -9173, 347393, -7839, 350625, -6427, 353908, -4939,
+		357245, -3378, 643, -1747, 4104, -51, 7633, 1703, 11231, 3507, 14898, 5348, 18630, 7212, 22414, 9077,
+		26234, 10922, 30065, 12719, 33874, 14439, 37621, 16055, 41267, 17543, 44768, 18882, 48086, 20061, 51186, 21071,
+		54037, 21912, 56612, 22584, 58890, 23092, 60849, 23442, 62473, 23638, 63749, 23688, 64667, 23595, 65226, 23367,
+		65433, 23010, 65304, 22534, 64872, 21952, 64182, 21281, 63293, 20544, 62275, 19772, 61207, 18996, 60164, 18253,
+		59221, 17575, 58440, 16991, 57869, 16526, 57545, 16193, 57492, 16000, 57721, 15947, 58239, 16031, 59045, 16242,
+		60135, 16569, 61506, 16998, 63152, 17514, 65071, 18102, 67260, 18744, 69719, 19424, 72448, 20123, 75449, 20820,
+		78720, 21493, 82260, 22119, 86059, 22672, 90101, 23126, 94362, 23454, 98802, 23634, 103374, 23646, 108021, 23478,
+		112684, 23128, 117308, 22599, 121845, 21905, 126259, 21061, 130524, 20088, 134626, 19004, 138559, 17830, 142321, 16583,
+		145917, 15279, 149352, 13932, 152635, 12554, 155773, 11156, 158774, 9748, 161644, 8337, 164389, 6932, 167014, 5540,
+		169522, 4168, 171913, 2821, 174188, 1507, 176344, 233, 178375, -994, 180275, -2167, 182032, -3275, 183633, -4308,
+		185060, -5255, 186290, -6099, 187297, -6825, 188050, -7411, 188515, -7834, 188657, -8066, 188443, -8077, 187850, -7840,
+		186874, -7331, 185543, -6543, 183927, -5492, 182146, -4230, 180363, -2845, 178765, -1455, 177529, -183, 176798, 860,
+		176657, 1594, 177127, 1981, 178179, 2016, 179747, 1720, 181743, 1135, 184078, 308, 186667, -710, 189438, -1872,
+		192337, -3136, 195319, -4468, 198355, -5839, 201425, -7227, 204516, -8614, 207619, -9987, 210731, -11335, 213850, -12649,
+		216976, -13923, 220112, -15151, 223259, -16329, 226418, -17453, 229594, -18518, 232788, -19522, 236001, -20462, 239237, -21334,
+		242495, -22136, 245776, -22865, 249080, -23517, 252405, -24090, 255749, -24580, 259108, -24985, 262476, -25301, 265848, -25527,
+		269212, -25658, 272558, -25694, 275869, -25632, 279125, -25473, 282302, -25216, 285365, -24864, 288273, -24423, 290973, -23900,
+		293397, -23310, 295461, -22669, 297063, -22003, 298090, -21343, 298425, -20725, 297972, -20185, 296696, -19754}},
+	"venus": {start: 2458841.5, step: 4, positions: []int32{
+		307118, -20984, 312188, -19738, 317163, -18345, 322041, -16820, 326822, -15176, 331508, -13427, 336106, -11588, 340621, -9672,
+		345059, -7693, 349430, -5666, 353740, -3602, 357999, -1517, 2215, 579, 6395, 2672, 10547, 4749, 14678, 6798,
+		18793, 8809, 22895, 10768, 26988, 12664, 31071, 14486, 35142, 16224, 39196, 17867, 43225, 19406, 47216, 20832,
+		51154, 22137, 55017, 23314, 58778, 24359, 62405, 25267, 65859, 26037, 69094, 26667, 72058, 27157, 74689, 27509,
+		76921, 27722, 78679, 27795, 79887, 27725, 80471, 27504, 80375, 27119, 79572, 26554, 78093, 25794, 76042, 24840,
+		73605, 23716, 71033, 22481, 68592, 21223, 66520, 20040, 64982, 19018, 64064, 18210, 63787, 17639, 64126, 17300,
+		65030, 17167, 66442, 17210, 68302, 17391, 70555, 17673, 73155, 18024, 76059, 18411, 79230, 18805, 82638, 19183,
+		86255, 19522, 90056, 19800, 94019, 20002, 98121, 20113, 102344, 20119, 106668, 20011, 111076, 19779, 115549, 19420,
+		120073, 18928, 124631, 18302, 129212, 17542, 133803, 16651, 138395, 15632, 142980, 14492, 147552, 13236, 152109, 11872,
+		156647, 10410, 161169, 8859, 165675, 7229, 170169, 5532, 174655, 3779, 179141, 1981, 183632, 151, 188136, -1698,
+		192661, -3554, 197216, -5404, 201810, -7235, 206450, -9032, 211145, -10783, 215902, -12472, 220728, -14086, 225628, -15611,
+		230605, -17033, 235660, -18337, 240794, -19510, 246004, -20540, 251282, -21414, 256622, -22123, 262012, -22657, 267439, -23009,
+		272888, -23175, 278345, -23151, 283792, -22937, 289215, -22535, 294598, -21950, 299928, -21187, 305196, -20256, 310393, -19165,
+		315514, -17925, 320556, -16550, 325520, -15050, 330407, -13440, 335221, -11733, 339970, -9941, 344661, -8080, 349302, -6161,
+		353904, -4198, 358476, -2204, 3029, -192, 7576, 1825, 12126, 3834, 16691, 5822, 21282, 7777, 25909, 9685,
+		30582, 11533, 35309, 13309, 40099, 14997, 44956, 16587, 49886, 18064, 54889, 19415, 59967, 20628, 65115, 21691,
+		70328, 22593, 75598, 23324, 80913, 23877, 86260, 24245, 91624, 24424, 96989, 24411, 102339, 24207, 107656, 23814,
+		112927, 23236, 118138, 22480, 123278, 21555, 128338, 20469, 133314, 19234, 138201, 17861, 143000, 16363, 147711, 14752,
+		152339, 13041, 156890, 11243, 161370, 9370, 165787, 7435, 170149, 5451, 174467, 3429, 178750, 1383, 183007, -678,
+		187250, -2740, 191487, -4792, 195728, -6822, 199983, -8819, 204259, -10770, 208563, -12665, 212903, -14491, 217283, -16236,
+		221706, -17890, 226172, -19439, 230681, -20873, 235227, -22182, 239804, -23356, 244400, -24385, 249000, -25263, 253588, -25983,
+		258140, -26541, 262632, -26937, 267035, -27169, 271318, -27243, 275445, -27163, 279380, -26937, 283081, -26577, 286506, -26096,
+		289606, -25509, 292328, -24832, 294614, -24084, 296402, -23285, 297625, -22453, 298219, -21610, 298130, -20771, 297332, -19956,
+		295848, -19177, 293774, -18452, 291286, -17797, 288635, -17231, 286102, -16776, 283941, -16449, 282343, -16254, 281411, -16184,
+		281174, -16219, 281607, -16333, 282656, -16493, 284254, -16667, 286330, -16828, 288817, -16947, 291654, -17004, 294785, -16978,
+		298163, -16856, 301744, -16626, 305492, -16279, 309372, -15810, 313358, -15217, 317424, -14499, 321550, -13659, 325719, -12701,
+		329917, -11630, 334133, -10453, 338360, -9178, 342593, -7814, 346829, -6371, 351067, -4858, 355308, -3287, 359554, -1668,
+		3810, -13, 8081, 1668, 12372, 3364, 16691, 5061, 21042, 6750, 25434, 8417, 29874, 10052, 34368, 11641,
+		38923, 13173, 43543, 14636, 48234, 16016, 52998, 17302, 57836, 18481, 62749, 19542, 67734, 20474, 72787, 21265,
+		77900, 21907, 83066, 22390, 88273, 22709, 93509, 22857, 98762, 22831, 104017, 22630, 109262, 22254, 114482, 21705,
+		119667, 20989, 124807, 20110, 129892, 19077, 134919, 17898, 139883, 16583, 144784, 15144, 149622, 13592, 154401, 11938,
+		159125, 10196, 163801, 8377, 168437, 6495, 173041, 4562, 177623, 2591, 182193, 595, 186762, -1412, 191341, -3418,
+		195941, -5410, 200572, -7373, 205245, -9294, 209970, -11160, 214756, -12956, 219611, -14669, 224540, -16282, 229549, -17784,
+		234640, -19159, 239813, -20394, 245064, -21475, 250387, -22392, 255774, -23132, 261214, -23688, 266691, -24052, 272190, -24218,
+		277693, -24185, 283183, -23953, 288643, -23524, 294057, -22903, 299410, -22096, 304692, -21114, 309894, -19966, 315011, -18665,
+		320039, -17223, 324978, -15654, 329832, -13971, 334605, -12189, 339303, -10322, 343933, -8383, 348506, -6387, 353031, -4347,
+		357519, -2276, 1979, -188, 6423, 1904, 10862, 3987, 15306, 6048, 19766, 8075, 24250, 10054, 28769, 11972,
+		33328, 13817, 37935, 15576, 42594, 17236, 47307, 18785, 52075, 20212, 56896, 21504, 61765, 22651, 66673, 23645,
+		71611, 24476, 76564, 25139, 81517, 25629, 86451, 25944, 91348, 26083, 96185, 26048, 100944, 25843, 105601, 25476,
+		110139, 24954, 114536, 24287, 118774, 23488, 122835, 22570, 126701, 21546, 130353, 20432, 133773, 19246, 136938, 18003,
+		139824, 16722, 142401, 15424, 144636, 14128, 146488, 12859, 147913, 11643, 148860, 10507, 149279, 9484, 149125, 8607,
+		148371, 7913, 147027, 7432, 145154, 7189, 142881, 7189, 140401, 7417, 137950, 7834, 135761, 8382, 134028, 8998,
+		132880, 9622, 132372, 10204, 132506, 10707, 133245, 11104, 134530, 11377, 136297, 11513, 138480, 11506, 141015, 11352,
+		143849, 11050, 146931, 10601, 150220, 10009, 153680, 9278, 157281, 8415, 160999, 7428, 164813, 6325, 168708, 5116,
+		172673, 3813, 176700, 2426, 180781, 967, 184916, -551, 189102, -2115, 193341, -3713, 197635, -5330, 201987, -6953,
+		206400, -8568, 210881, -10160, 215431, -11715, 220057, -13219, 224762, -14658, 229547, -16015, 234414, -17279, 239363, -18434,
+		244391, -19466, 249494, -20365, 254664, -21118, 259894, -21715, 265172, -22147, 270484, -22408, 275818, -22493, 281158, -22400,
+		286489, -22128, 291797, -21680, 297068, -21058, 302291, -20271, 307457, -19324, 312559, -18227, 317592, -16992, 322553, -15627,
+		327444, -14147, 332266, -12562, 337024, -10886, 341724, -9132, 346372, -7311, 350978, -5438, 355550, -3524, 99, -1582,
+		4634, 376, 9167, 2336, 13709, 4287, 18269, 6215, 22858, 8109, 27486, 9955, 32163, 11742, 36895, 13454,
+		41692, 15081, 46557, 16608, 51496, 18023, 56508, 19314, 61595, 20467, 66752, 21471, 71975, 22317, 77253, 22993,
+		82578, 23493, 87935, 23810, 93310, 23940, 98688, 23881, 104053, 23633, 109389, 23198, 114683, 22581, 119922, 21789,
+		125096, 20829, 130198, 19710, 135222, 18444, 140167, 17043, 145032, 15518, 149820, 13881, 154535, 12147, 159184, 10327,
+		163774, 8435, 168314, 6484, 172813, 4486, 177283, 2454, 181733, 401, 186176, -1660, 190621, -3718, 195081, -5760,
+		199567, -7772, 204089, -9741, 208657, -11656, 213280, -13501, 217966, -15265, 222722, -16933, 227553, -18492, 232460, -19929,
+		237444, -21230, 242503, -22382, 247630, -23375, 252817, -24198, 258052, -24841, 263320, -25297, 268604, -25561, 273887, -25631,
+		279147, -25505, 284366, -25186, 289525, -24679, 294607, -23989, 299595, -23127, 304477, -22102, 309242, -20926, 313880, -19613,
+		318387, -18175, 322757, -16629, 326985, -14988, 331070, -13267, 335007, -11482, 338794, -9647, 342425, -7779, 345893, -5891,
+		349188, -4001, 352297, -2124, 355201, -278, 357877, 1520, 295, 3248, 2418, 4886, 4199, 6407, 5588, 7780,
+		6526, 8969, 6956, 9932, 6830, 10620, 6123, 10982, 4852, 10974, 3096, 10569, 1006, 9777, 358792, 8656,
+		356689, 7312, 354916, 5878, 353636, 4488, 352937, 3249, 352845, 2232, 353336, 1474, 354359, 985, 355850, 757,
+		357744, 773, 359981, 1011, 2507, 1446, 5278, 2054, 8256, 2813, 11412, 3700, 14722, 4694, 18167, 5776,
+		21735, 6927, 25415, 8128, 29201, 9364, 33088, 10618, 37073, 11874, 41154, 13116, 45331, 14330, 49602, 15502,
+		53968, 16617, 58426, 17661, 62976, 18621, 67613, 19485, 72334, 20239, 77132, 20873, 82000, 21377, 86929, 21741,
+		91907, 21959, 96925, 22023, 101969, 21930, 107026, 21678, 112084, 21264, 117132, 20692, 122157, 19964, 127151, 19085,
+		132107, 18061, 137018, 16899, 141880, 15609, 146694, 14200, 151458, 12682, 156176, 11068, 160851, 9367, 165489, 7593,
+		170097, 5757, 174682, 3872, 179254, 1951, 183822, 6, 188395, -1950, 192985, -3903, 197600, -5841, 202251, -7750,
+		206948, -9616, 211699, -11425, 216514, -13162, 221399, -14815, 226359, -16368, 231398, -17807, 236517, -19118, 241716, -20289,
+		246990, -21305, 252333, -22156, 257735, -22832, 263185, -23325, 268667, -23626, 274166, -23734, 279665, -23645, 285148, -23360,
+		290597, -22883, 295999, -22219, 301341, -21375, 306612, -20361, 311806, -19188, 316917, -17866, 321944, -16409, 326888, -14831,
+		331753, -13145, 336543, -11364, 341266, -9503, 345929, -7576, 350543, -5595, 355118, -3575, 359665, -1529, 4194, 530,
+		8717, 2589, 13246, 4635, 17791, 6655, 22364, 8635, 26973, 10563, 31629, 12425, 36340, 14208, 41113, 15898,
+		45952, 17483, 50861, 18950, 55840, 20285, 60888, 21477, 66000, 22515, 71169, 23387, 76384, 24086, 81634, 24604,
+		86903, 24937, 92175, 25080, 97433, 25034, 102660, 24799, 107838, 24380, 112952, 23781, 117989, 23010, 122937, 22077,
+		127788, 20992, 132535, 19766, 137174, 18411, 141703, 16940, 146122, 15365, 150433, 13699, 154638, 11955, 158740, 10146,
+		162744, 8282, 166652, 6377, 170469, 4443, 174195, 2490, 177834, 531, 181384, -1424, 184843, -3363, 188208, -5276,
+		191469, -7149, 194617, -8973, 197635, -10735, 200503, -12423, 203194, -14022, 205672, -15518, 207897, -16893, 209817, -18128,
+		211373, -19199, 212502, -20077, 213136, -20725, 213220, -21103, 212716, -21164, 211634, -20866, 210040, -20183, 208078, -19124,
+		205955, -17749, 203909, -16168, 202164, -14525, 200891, -12964, 200190, -11603, 200093, -10515, 200583, -9734, 201614, -9261,
+		203129, -9076, 205067, -9150, 207373, -9448, 209998, -9934, 212901, -10574, 216048, -11336, 219412, -12189, 222971, -13106,
+		226707, -14062, 230606, -15031, 234656, -15992, 238848, -16924, 243170, -17806, 247615, -18622, 252173, -19353, 256833, -19985,
+		261585, -20504, 266415, -20897, 271312, -21155, 276262, -21268, 281248, -21232, 286258, -21042, 291276, -20696, 296288, -20195,
+		301283, -19541, 306248, -18738, 311176, -17793, 316058, -16712, 320890, -15504, 325670, -14180, 330397, -12749, 335072, -11222,
+		339699, -9611, 344282, -7926, 348827, -6181, 353341, -4385, 357833, -2553, 2311, -694, 6784, 1178, 11262, 3052,
+		15755, 4917, 20271, 6759, 24821, 8567, 29413, 10328, 34056, 12030, 38757, 13661, 43523, 15208, 48358, 16657,
+		53266, 17997, 58248, 19216, 63302, 20301, 68427, 21241, 73615, 22026, 78859, 22648, 84148, 23097, 89470, 23368,
+		94811, 23458, 100156, 23364, 105491, 23085, 110802, 22625, 116074, 21987, 121297, 21178, 126460, 20205, 131558, 19077,
+		136585, 17805, 141540, 16400, 146423, 14873, 151236, 13238, 155984, 11507, 160674, 9693, 165312, 7808, 169908, 5866,
+		174471, 3880, 179012, 1862, 183542, -175, 188071, -2217, 192612, -4251, 197175, -6265, 201772, -8245, 206413, -10177,
+		211108, -12048, 215866, -13844, 220694, -15551, 225599, -17155, 230585, -18641, 235653, -19997, 240802, -21207, 246029, -22261,
+		251327, -23145, 256685, -23850, 262092, -24368, 267531, -24691, 272986, -24815, 278440, -24738, 283872, -24462, 289266, -23989,
+		294606, -23324, 299877, -22475, 305068, -21453, 310171, -20267, 315178, -18930, 320089, -17456, 324901, -15857, 329618, -14148,
+		334242, -12344, 338781, -10457, 343239, -8502, 347625, -6493, 351947, -4443, 356213, -2366, 431, -274, 4609, 1820,
+		8755, 3904, 12874, 5965, 16973, 7990, 21055, 9970, 25123, 11891, 29178, 13743, 33218, 15514, 37238, 17195,
+		41230, 18776, 45183, 20248, 49081, 21602, 52903, 22831, 56623, 23931, 60209, 24896, 63622, 25723, 66817, 26410,
+		69740, 26956, 72330, 27362, 74520, 27625, 76233, 27743, 77394, 27710, 77930, 27517, 77784, 27147, 76935, 26584,
+		75417, 25811, 73342, 24831, 70903, 23670, 68352, 22394, 65956, 21096, 63944, 19879, 62475, 18829, 61628, 18002,
+		61418, 17418, 61816, 17073, 62771, 16941, 64225, 16989, 66119, 17180, 68400, 17478, 71020, 17848, 73940, 18258,
+		77124, 18681, 80541, 19091, 84165, 19465, 87972, 19782, 91940, 20026, 96049, 20181, 100279, 20234, 104612, 20173,
+		109030, 19991, 113516, 19681, 118055, 19238, 122630, 18661, 127229, 17949, 131840, 17104, 136452, 16129, 141058, 15029,
+		145651, 13811, 150227, 12482, 154784, 11050, 159322, 9526, 163843, 7920, 168348, 6241, 172844, 4503, 177335, 2715,
+		181827, 891, 186330, -957, 190850, -2817, 195396, -4676, 199976, -6520, 204600, -8335, 209276, -10109, 214011, -11827,
+		218812, -13475, 223685, -15039, 228634, -16505, 233661, -17859, 238767, -19086, 243950, -20175, 249205, -21112, 254525, -21887,
+		259899, -22491, 265316, -22915, 270761, -23153, 276220, -23202, 281675, -23061, 287111, -22731, 292512, -22216, 297867, -21520,
+		303161, -20651, 308388, -19619, 313540, -18434, 318614, -17108, 323608, -15654, 328525, -14083, 333368, -12410, 338142, -10647,
+		342854, -8809, 347513, -6909, 352129, -4960, 356711, -2974, 1271, -966, 5818, 1052, 10365, 3067, 14923, 5067,
+		19503, 7037, 24115, 8966, 28769, 10840, 33474, 12646, 38239, 14371, 43069, 16001, 47970, 17524, 52945, 18926,
+		57994, 20194, 63115, 21317, 68303, 22282, 73552, 23081, 78850, 23704, 84186, 24144, 89544, 24396, 94909, 24457,
+		100265, 24326, 105595, 24005, 110883, 23498, 116116, 22810, 121281, 21949, 126370, 20923, 131375, 19745, 136292, 18424,
+		141120, 16973, 145860, 15404, 150515, 13731, 155089, 11965, 159589, 10121, 164022, 8209, 168397, 6243, 172723, 4236,
+		177009, 2198, 181266, 142, 185503, -1920, 189729, -3976, 193955, -6016, 198190, -8027, 202441, -9997, 206717, -11915,
+		211024, -13770, 215368, -15549, 219751, -17241, 224175, -18834, 228639, -20318, 233141, -21681, 237672, -22913, 242224, -24005,
+		246782, -24950, 251330, -25741, 255846, -26373, 260305, -26845, 264679, -27155, 268937, -27307, 273043, -27305, 276959, -27156,
+		280644, -26871, 284051, -26462, 287132, -25943, 289830, -25331, 292088, -24641, 293838, -23894, 295015, -23106, 295552, -22296,
+		295398, -21479, 294530, -20669, 292979, -19880, 290849, -19125, 288329, -18425, 285677, -17803, 283173, -17286, 281070, -16897,
+		279545, -16647, 278694, -16531, 278537, -16531, 279045, -16620, 280162, -16766, 281820, -16936, 283948, -17100, 286482, -17231,
+		289360, -17305, 292530, -17302, 295944, -17206, 299559, -17005, 303340, -16690, 307253, -16254, 311271, -15694, 315369, -15009,
+		319526, -14201, 323726, -13273, 327953, -12230, 332198, -11079, 336451, -9827, 340709, -8483, 344966, -7057, 349223, -5558,
+		353480, -3996, 357739, -2384, 2005, -730, 6282, 952, 10575, 2653, 14892, 4360, 19239, 6061, 23623, 7746,
+		28051, 9402, 32531, 11017, 37068, 12579, 41669, 14075, 46338, 15493, 51080, 16822, 55895, 18048, 60785, 19159,
+		65748, 20145, 70781, 20994, 75877, 21697, 81029, 22244, 86227, 22628, 91458, 22842, 96711, 22884, 101971, 22750,
+		107226, 22441, 112460, 21957, 117663, 21304, 122824, 20485, 127933, 19509, 132985, 18383, 137975, 17118, 142901, 15724,
+		147764, 14212, 152566, 12594, 157311, 10882, 162004, 9089, 166654, 7228, 171269, 5312, 175858, 3352, 180431, 1363,
+		184998, -642, 189572, -2652, 194161, -4652, 198778, -6629, 203433, -8569, 208136, -10459, 212896, -12285, 217722, -14032,
+		222621, -15686, 227599, -17233, 232657, -18659, 237797, -19950, 243018, -21093, 248314, -22075, 253677, -22885, 259097, -23513,
+		264561, -23952, 270053, -24195, 275556, -24239, 281053, -24083, 286526, -23730, 291958, -23181, 297335, -22445, 302645, -21529,
+		307878, -20444, 313026, -19200, 318087, -17810, 323058, -16288, 327943, -14647, 332744, -12901, 337467, -11064, 342120, -9150,
+		346712, -7174, 351251, -5148, 355748, -3087, 213, -1003, 4658, 1089, 9093, 3178, 13529, 5250, 17975, 7293,
+		22443, 9292, 26941, 11237, 31476, 13113, 36055, 14908, 40685, 16609, 45367, 18204, 50103, 19682, 54892, 21030,
+		59729, 22237, 64608, 23294, 69520, 24193, 74451, 24926, 79387, 25489, 84309, 25877, 89199, 26091, 94036, 26131,
+		98799, 26000, 103467, 25705, 108018, 25253, 112432, 24654, 116689, 23919, 120770, 23062, 124656, 22095, 128327, 21036,
+		131763, 19899, 134941, 18702, 137835, 17464, 140415, 16204, 142646, 14944, 144488, 13707, 145894, 12518, 146814, 11407,
+		147196, 10403, 146998, 9541, 146194, 8854, 144797, 8373, 142875, 8118, 140561, 8096, 138056, 8289, 135596, 8662,
+		133418, 9160, 131709, 9725, 130593, 10300, 130122, 10841, 130294, 11311, 131069, 11684, 132388, 11941, 134186, 12071,
+		136397, 12064, 138959, 11915, 141817, 11624, 144922, 11188, 148233, 10612, 151713, 9898, 155334, 9053, 159069, 8082,
+		162899, 6995, 166808, 5801, 170785, 4510, 174821, 3133, 178910, 1681, 183048, 166, 187236, -1399, 191472, -3000,
+		195761, -4625, 200104, -6260, 204506, -7892, 208971, -9505, 213505, -11086, 218111, -12621, 222794, -14095, 227556, -15493,
+		232399, -16801, 237324, -18006, 242329, -19092, 247411, -20049, 252564, -20863, 257779, -21525, 263046, -22025, 268353, -22355,
+		273687, -22510, 279032, -22487, 284374, -22284, 289698, -21904, 294990, -21348, 300238, -20623, 305432, -19735, 310563, -18694,
+		315627, -17509, 320619, -16191, 325540, -14752, 330391, -13204, 335175, -11559, 339898, -9831, 344567, -8032, 349190, -6175,
+		353775, -4273, 358333, -2338, 2874, -382, 7407, 1581, 11945, 3539, 16498, 5480, 21076, 7390, 25689, 9258,
+		30347, 11071, 35058, 12815, 39830, 14478, 44669, 16046, 49580, 17507, 54566, 18848, 59625, 20056, 64757, 21120,
+		69956, 22028, 75215, 22770, 80525, 23339, 85872, 23727, 91242, 23929, 96621, 23942, 101993, 23766, 107342, 23402,
+		112654, 22853, 117914, 22127, 123114, 21229, 128243, 20169, 133296, 18958, 138269, 17607, 143163, 16127, 147978, 14531,
+		152718, 12832, 157389, 11043, 161997, 9177, 166552, 7246, 171063, 5264, 175539, 3244, 179991, 1197, 184432, -864,
+		188870, -2925, 193319, -4975, 197788, -7000, 202290, -8988, 206833, -10926, 211428, -12801, 216083, -14599, 220805, -16306,
+		225599, -17911, 230469, -19398, 235416, -20754, 240439, -21968, 245532, -23027, 250688, -23919, 255896, -24636, 261143, -25169,
+		266413, -25512, 271688, -25661, 276947, -25616, 282173, -25377, 287344, -24948, 292444, -24334, 297455, -23544, 302363, -22588,
+		307156, -21476, 311825, -20223, 316361, -18841, 320758, -17344, 325013, -15748, 329121, -14068, 333078, -12318, 336880, -10513,
+		340522, -8671, 343996, -6805, 347291, -4932, 350394, -3069, 353287, -1234, 355945, 557, 358338, 2281, 428, 3915,
+		2171, 5434, 3513, 6806, 4397, 7994, 4768, 8955, 4578, 9639, 3807, 9997, 2477, 9983, 673, 9574,
+		358552, 8784, 356329, 7673, 354239, 6350, 352498, 4947, 351262, 3592, 350616, 2390, 350578, 1409, 351122, 681,
+		352195, 218, 353731, 10, 355667, 42, 357941, 292, 500, 737, 3299, 1354, 6301, 2121, 9477, 3016,
+		12803, 4020, 16261, 5112, 19837, 6276, 23522, 7492, 27310, 8746, 31194, 10020, 35174, 11299, 39247, 12568,
+		43413, 13813, 47672, 15018, 52023, 16170, 56466, 17255, 60999, 18260, 65620, 19171, 70326, 19977, 75110, 20665,
+		79967, 21226, 84887, 21649, 89860, 21927, 94877, 22053, 99923, 22023, 104987, 21833, 110056, 21482, 115118, 20970,
+		120161, 20300, 125176, 19477, 130153, 18506, 135087, 17394, 139974, 16150, 144811, 14783, 149597, 13303, 154336, 11722,
+		159029, 10050, 163683, 8300, 168303, 6484, 172898, 4614, 177475, 2703, 182044, 763, 186615, -1193, 191197, -3151,
+		195801, -5099, 200438, -7022, 205116, -8908, 209846, -10742, 214635, -12511, 219493, -14199, 224423, -15793, 229432, -17279,
+		234521, -18642, 239690, -19870, 244936, -20948, 250254, -21865, 255635, -22610, 261069, -23174, 266541, -23550, 272036, -23732,
+		277538, -23719, 283030, -23509, 288494, -23105, 293917, -22512, 299283, -21736, 304583, -20785, 309807, -19671, 314950, -18403,
+		320009, -16996, 324985, -15462, 329879, -13815, 334697, -12067, 339444, -10235, 344128, -8330, 348759, -6367, 353347, -4359,
+		357902, -2320, 2435, -263, 6958, 1799, 11481, 3853, 16017, 5885, 20576, 7883, 25168, 9834, 29803, 11724,
+		34490, 13540, 39235, 15269, 44046, 16898, 48924, 18413, 53873, 19801, 58892, 21051, 63976, 22150, 69120, 23089,
+		74315, 23857, 79548, 24447, 84807, 24853, 90074, 25072, 95334, 25100, 100568, 24940, 105759, 24593, 110892, 24065,
+		115952, 23363, 120926, 22494, 125806, 21470, 130582, 20301, 135251, 18998, 139809, 17575, 144256, 16043, 148592, 14416,
+		152819, 12707, 156941, 10927, 160959, 9089, 164878, 7206, 168700, 5289, 172428, 3350, 176063, 1401, 179604, -548,
+		183049, -2484, 186394, -4396, 189630, -6273, 192747, -8104, 195728, -9874, 198553, -11573, 201195, -13185, 203618, -14695,
+		205780, -16086, 207632, -17335, 209114, -18419, 210162, -19306, 210714, -19960, 210713, -20337, 210129, -20390, 208976, -20078,
+		207331, -19377, 205342, -18301, 203221, -16915, 201205, -15333, 199513, -13699, 198306, -12158, 197676, -10822, 197649, -9762,
+		198204, -9007, 199293, -8558, 200858, -8396, 202838, -8490, 205178, -8807, 207831, -9312, 210755, -9971, 213917, -10754,
+		217292, -11630, 220856, -12573, 224594, -13557, 228492, -14558, 232538, -15554, 236723, -16525, 241038, -17451, 245475, -18312,
+		250025, -19094, 254678, -19779, 259425, -20354, 264253, -20806, 269151, -21124, 274105, -21299, 279100, -21325, 284122, -21198,
+		289157, -20913, 294190, -20472, 299208, -19876, 304200, -19129, 309156, -18236, 314067, -17204, 318930, -16041, 323739, -14757,
+		328495, -13362, 333197, -11867, 337849, -10283, 342453, -8621, 347018, -6893, 351548, -5112, 356051, -3288, 537, -1434,
+		5015, 438, 9493, 2317, 13982, 4190, 18490, 6046, 23029, 7872, 27606, 9656, 32231, 11385, 36912, 13048,
+		41654, 14631, 46465, 16121, 51347, 17507, 56303, 18776, 61332, 19915, 66433, 20913, 71600, 21760, 76826, 22446,
+		82101, 22962, 87415, 23303, 92752, 23462, 98100, 23438, 103442, 23230, 108765, 22838, 114054, 22267, 119298, 21522,
+		124486, 20609, 129610, 19539, 134665, 18319, 139647, 16963, 144557, 15480, 149395, 13884, 154167, 12187, 158877, 10402,
+		163532, 8541, 168142, 6619, 172714, 4647, 177261, 2639, 181792, 607, 186318, -1435, 190851, -3475, 195402, -5499,
+		199982, -7494, 204602, -9447, 209273, -11344, 214003, -13172, 218801, -14915, 223673, -16561, 228625, -18096, 233658, -19504,
+		238774, -20773, 243969, -21889, 249237, -22841, 254571, -23618, 259958, -24210, 265383, -24610, 270832, -24812, 276285, -24814,
+		281724, -24616, 287132, -24219, 292491, -23629, 297787, -22852, 303006, -21896, 308139, -20773, 313180, -19495, 318123, -18073,
+		322967, -16522, 327714, -14856}},
+	"mars": {start: 2458833.5, step: 8, positions: []int32{
+		225089, -16536, 230464, -18033, 235950, -19386, 241544, -20578, 247243, -21595, 253037, -22422, 258913, -23048, 264855, -23463,
+		270846, -23659, 276863, -23634, 282885, -23387, 288891, -22920, 294859, -22241, 300771, -21360, 306612, -20290, 312369, -19047,
+		318030, -17649, 323590, -16117, 329041, -14471, 334381, -12736, 339605, -10936, 344708, -9096, 349683, -7242, 354520, -5400,
+		359203, -3597, 3712, -1860, 8017, -214, 12075, 1313, 15835, 2697, 19229, 3913, 22171, 4938, 24562, 5751,
+		26289, 6332, 27238, 6668, 27316, 6753, 26489, 6599, 24832, 6246, 22557, 5770, 20012, 5279, 17602, 4894,
+		15683, 4717, 14485, 4805, 14100, 5173, 14513, 5804, 15652, 6664, 17421, 7712, 19727, 8908, 22484, 10213,
+		25622, 11592, 29084, 13013, 32826, 14448, 36811, 15869, 41011, 17252, 45403, 18575, 49968, 19817, 54689, 20958,
+		59548, 21980, 64531, 22869, 69621, 23610, 74800, 24191, 80051, 24603, 85354, 24839, 90691, 24893, 96043, 24764,
+		101392, 24451, 106721, 23958, 112017, 23287, 117266, 22447, 122459, 21443, 127591, 20287, 132658, 18986, 137658, 17554,
+		142594, 15999, 147470, 14335, 152292, 12572, 157069, 10724, 161809, 8801, 166524, 6815, 171225, 4779, 175925, 2706,
+		180639, 607, 185378, -1504, 190159, -3614, 194995, -5709, 199900, -7773, 204889, -9791, 209975, -11748, 215170, -13624,
+		220486, -15402, 225931, -17063, 231511, -18588, 237228, -19955, 243083, -21147, 249067, -22142, 255171, -22925, 261379, -23478,
+		267670, -23790, 274022, -23851, 280408, -23656, 286801, -23204, 293175, -22498, 299508, -21547, 305779, -20362, 311973, -18960,
+		318080, -17360, 324093, -15582, 330011, -13650, 335838, -11589, 341579, -9423, 347240, -7178, 352833, -4881, 358367, -2557,
+		3851, -232, 9294, 2070, 14703, 4324, 20082, 6508, 25434, 8600, 30754, 10581, 36035, 12432, 41265, 14140,
+		46424, 15694, 51487, 17084, 56420, 18309, 61183, 19369, 65724, 20270, 69985, 21025, 73895, 21652, 77372, 22171,
+		80319, 22611, 82627, 22999, 84175, 23363, 84839, 23724, 84517, 24087, 83162, 24436, 80841, 24730, 77785, 24915,
+		74386, 24959, 71120, 24869, 68413, 24703, 66545, 24536, 65627, 24428, 65644, 24411, 66510, 24489, 68115, 24644,
+		70346, 24851, 73101, 25077, 76293, 25292, 79847, 25466, 83700, 25575, 87796, 25596, 92090, 25512, 96540, 25307,
+		101111, 24972, 105770, 24498, 110491, 23882, 115249, 23121, 120026, 22216, 124805, 21170, 129573, 19989, 134322, 18677,
+		139047, 17242, 143744, 15693, 148414, 14039, 153059, 12289, 157685, 10454, 162299, 8543, 166908, 6569, 171523, 4541,
+		176155, 2471, 180816, 373, 185519, -1742, 190278, -3859, 195106, -5966, 200020, -8046, 205032, -10082, 210157, -12059,
+		215407, -13956, 220795, -15754, 226328, -17433, 232015, -18969, 237857, -20342, 243851, -21529, 249992, -22508, 256264, -23260,
+		262650, -23766, 269126, -24013, 275664, -23991, 282234, -23693, 288805, -23120, 295347, -22278, 301837, -21177, 308252, -19831,
+		314577, -18260, 320803, -16485, 326927, -14532, 332949, -12426, 338877, -10195, 344719, -7866, 350488, -5468, 356196, -3027,
+		1860, -571, 7493, 1874, 13109, 4282, 18721, 6628, 24341, 8889, 29977, 11043, 35634, 13068, 41314, 14945,
+		47016, 16659, 52732, 18195, 58451, 19540, 64159, 20688, 69836, 21635, 75457, 22379, 80997, 22926, 86425, 23283,
+		91711, 23464, 96822, 23485, 101724, 23368, 106381, 23138, 110755, 22825, 114804, 22462, 118479, 22084, 121725, 21733,
+		124474, 21451, 126647, 21284, 128153, 21276, 128890, 21466, 128763, 21877, 127704, 22503, 125719, 23299, 122936, 24169,
+		119636, 24989, 116224, 25645, 113138, 26070, 110728, 26260, 109194, 26252, 108590, 26092, 108869, 25816, 109929, 25446,
+		111655, 24990, 113931, 24446, 116657, 23809, 119745, 23072, 123120, 22229, 126722, 21276, 130502, 20210, 134419, 19029,
+		138443, 17735, 142549, 16331, 146718, 14822, 150938, 13214, 155199, 11512, 159498, 9726, 163834, 7864, 168208, 5935,
+		172624, 3949, 177090, 1918, 181612, -147, 186202, -2233, 190870, -4327, 195629, -6415, 200492, -8480, 205471, -10506,
+		210580, -12475, 215831, -14367, 221236, -16160, 226803, -17833, 232537, -19362, 238441, -20723, 244510, -21893, 250737, -22847,
+		257105, -23564, 263592, -24026, 270173, -24217, 276815, -24128, 283486, -23752, 290151, -23091, 296780, -22153, 303345, -20948,
+		309825, -19495, 316205, -17816, 322477, -15933, 328639, -13876, 334694, -11671, 340650, -9347, 346518, -6935, 352313, -4462,
+		358051, -1958, 3747, 551, 9420, 3037, 15083, 5475, 20753, 7840, 26442, 10108, 32161, 12257, 37916, 14267,
+		43714, 16117, 49553, 17792, 55430, 19275, 61337, 20555, 67262, 21622, 73188, 22470, 79097, 23097, 84968, 23502,
+		90777, 23692, 96503, 23673, 102124, 23459, 107618, 23062, 112968, 22502, 118156, 21796, 123169, 20966, 127992, 20035,
+		132614, 19027, 137019, 17967, 141194, 16882, 145119, 15799, 148772, 14748, 152123, 13760, 155133, 12870, 157755, 12112,
+		159928, 11526, 161578, 11152, 162621, 11030, 162967, 11193, 162540, 11661, 161295, 12423, 159267, 13425, 156600, 14563,
+		153564, 15691, 150516, 16665, 147819, 17376, 145750, 17774, 144466, 17861, 144000, 17667, 144309, 17229, 145307, 16580,
+		146892, 15748, 148969, 14754, 151450, 13614, 154265, 12341, 157354, 10945, 160670, 9438, 164178, 7829, 167850, 6128,
+		171667, 4347, 175616, 2495, 179689, 585, 183883, -1370, 188200, -3358, 192641, -5365, 197213, -7374, 201924, -9370,
+		206783, -11334, 211800, -13246, 216983, -15086, 222342, -16831, 227883, -18458, 233609, -19941, 239521, -21254, 245612, -22373,
+		251870, -23272, 258277, -23930, 264808, -24325, 271434, -24444, 278120, -24275, 284829, -23816, 291527, -23067, 298181, -22037,
+		304763, -20741, 311252, -19198, 317633, -17431, 323900, -15467, 330051, -13333, 336092, -11060, 342032, -8678, 347884, -6215,
+		353663, -3703, 359385, -1168, 5069, 1361, 10731, 3858, 16389, 6297, 22057, 8655, 27749, 10908, 33476, 13035,
+		39245, 15015, 45063, 16830, 50930, 18464, 56842, 19900, 62793, 21128, 68771, 22136, 74761, 22920, 80747, 23475,
+		86708, 23802, 92625, 23903, 98476, 23786, 104244, 23459, 109912, 22935, 115465, 22228, 120892, 21353, 126186, 20329,
+		131340, 19173, 136352, 17904, 141221, 16540, 145945, 15101, 150526, 13604, 154964, 12070, 159256, 10517, 163401, 8964,
+		167393, 7429, 171222, 5932, 174875, 4492, 178332, 3129, 181566, 1864, 184543, 719, 187216, -282, 189528, -1116,
+		191411, -1754, 192782, -2170, 193551, -2335, 193631, -2225, 192955, -1828, 191503, -1154, 189345, -249, 186662, 798,
+		183742, 1854, 180931, 2781, 178545, 3463, 176809, 3833, 175832, 3871, 175626, 3591, 176143, 3025, 177302, 2211,
+		179021, 1183, 181218, -26, 183827, -1387, 186792, -2873, 190070, -4461, 193627, -6128, 197440, -7852, 201494, -9613,
+		205778, -11387, 210286, -13153, 215017, -14887, 219969, -16564, 225143, -18158, 230538, -19643, 236150, -20991, 241974, -22173,
+		247998, -23164, 254203, -23936, 260568, -24467, 267063, -24735, 273652, -24727, 280299, -24432, 286965, -23849, 293613, -22980,
+		300210, -21836, 306729, -20433, 313151, -18792, 319461, -16937, 325654, -14895, 331732, -12697, 337701, -10371, 343570, -7948,
+		349354, -5457, 355069, -2927, 731, -386, 6358, 2139, 11968, 4623, 17577, 7042, 23199, 9372, 28849, 11592,
+		34538, 13681, 40272, 15619, 46057, 17390, 51893, 18977, 57779, 20365, 63706, 21544, 69664, 22504, 75640, 23239,
+		81616, 23745, 87574, 24021, 93495, 24071, 99360, 23899, 105152, 23515, 110856, 22929, 116460, 22154, 121955, 21203,
+		127334, 20093, 132595, 18839, 137738, 17457, 142765, 15964, 147681, 14376, 152492, 12710, 157203, 10980, 161822, 9203,
+		166356, 7393, 170812, 5565, 175195, 3733, 179509, 1911, 183757, 113, 187940, -1648, 192057, -3359, 196102, -5008,
+		200066, -6584, 203936, -8073, 207693, -9467, 211312, -10756, 214761, -11931, 217997, -12985, 220968, -13911, 223611, -14705,
+		225849, -15361, 227591, -15877, 228739, -16247, 229190, -16464, 228854, -16521, 227687, -16410, 225724, -16129, 223118, -15698,
+		220153, -15165, 217197, -14612, 214619, -14141, 212701, -13842, 211601, -13774, 211360, -13960, 211947, -14390, 213289, -15037,
+		215305, -15864, 217917, -16830, 221055, -17891, 224663, -19007, 228694, -20139, 233111, -21246, 237881, -22290, 242974, -23237,
+		248360, -24049, 254008, -24695, 259886, -25145, 265955, -25372, 272175, -25356, 278504, -25082, 284898, -24540, 291315, -23730,
+		297718, -22657, 304072, -21332, 310353, -19773, 316543, -17999, 322631, -16036, 328612, -13911, 334490, -11652, 340271, -9287,
+		345965, -6843, 351587, -4350, 357151, -1834, 2675, 680, 8174, 3167, 13665, 5603, 19164, 7966, 24683, 10235,
+		30236, 12390, 35832, 14411, 41478, 16281, 47179, 17984, 52934, 19505, 58740, 20830, 64591, 21949, 70476, 22852,
+		76381, 23535, 82291, 23993, 88187, 24225, 94053, 24235, 99870, 24026, 105622, 23606, 111294, 22986, 116877, 22176,
+		122361, 21189, 127742, 20039, 133018, 18741, 138191, 17310, 143263, 15760, 148241, 14107, 153133, 12364, 157946, 10548,
+		162691, 8671, 167379, 6747, 172020, 4791, 176624, 2814, 181203, 832, 185766, -1145, 190323, -3102, 194882, -5028,
+		199452, -6909, 204038, -8733, 208644, -10489, 213273, -12165, 217926, -13750, 222600, -15232, 227290, -16604, 231987, -17857,
+		236679, -18985, 241349, -19984, 245979, -20853, 250543, -21592, 255012, -22206, 259351, -22703, 263521, -23096, 267476, -23403,
+		271160, -23643, 274512, -23844, 277457, -24037, 279910, -24256, 281773, -24535, 282941, -24906, 283314, -25389, 282817, -25982,
+		281447, -26650, 279317, -27322, 276695, -27909, 273978, -28335, 271609, -28570, 269958, -28632, 269247, -28567, 269549, -28418,
+		270827, -28206, 272985, -27934, 275899, -27586, 279447, -27139, 283512, -26567, 287986, -25846, 292777, -24958, 297802, -23891,
+		302992, -22638, 308289, -21200, 313645, -19584, 319024, -17800, 324400, -15866, 329755, -13798, 335078, -11617, 340365, -9345,
+		345617, -7005, 350839, -4617, 356038, -2205, 1223, 210, 6407, 2607, 11599, 4966, 16813, 7266, 22059, 9489,
+		27348, 11616, 32688, 13631, 38085, 15515, 43545, 17253, 49069, 18831, 54657, 20234, 60302, 21451, 65999, 22471,
+		71736, 23286, 77499, 23890, 83273, 24280, 89041, 24454, 94787, 24414, 100492, 24164, 106143, 23710, 111724, 23061,
+		117227, 22226, 122643, 21218, 127968, 20048, 133200, 18730, 138341, 17277, 143395, 15704, 148367, 14023, 153265, 12248,
+		158100, 10393, 162881, 8471, 167621, 6495, 172331, 4478, 177024, 2434, 181713, 374, 186411, -1687, 191131, -3737,
+		195885, -5763, 200685, -7749, 205544, -9683, 210470, -11550, 215474, -13334, 220562, -15022, 225740, -16598, 231011, -18047,
+		236376, -19355, 241831, -20508, 247370, -21493, 252983, -22300, 258659, -22919, 264381, -23343, 270130, -23568, 275888, -23594,
+		281633, -23421, 287344, -23057, 293002, -22508, 298587, -21788, 304082, -20911, 309470, -19895, 314738, -18759, 319871, -17527,
+		324854, -16222, 329671, -14873, 334302, -13506, 338724, -12155, 342905, -10851, 346804, -9629, 350370, -8528, 353536, -7584,
+		356222, -6838, 358333, -6326, 359766, -6077, 424, -6108, 246, -6407, 359246, -6922, 357553, -7555, 355437, -8167,
+		353261, -8614, 351400, -8784, 350144, -8624, 349646, -8132, 349941, -7337, 350977, -6284, 352665, -5015, 354905, -3570,
+		357601, -1985, 674, -289, 4057, 1491, 7698, 3328, 11558, 5201, 15607, 7087, 19823, 8966}},
+	"jupiter": {start: 2458817.5, step: 16, positions: []int32{
+		269069, -23294, 272990, -23295, 276990, -23193, 280963, -22990, 284802, -22700, 288400, -22346, 291650, -21959, 294441, -21580,
+		296662, -21253, 298204, -21021, 298970, -20921, 298901, -20977, 298005, -21183, 296402, -21504, 294340, -21877, 292180, -22231,
+		290312, -22512, 289060, -22689, 288622, -22757, 289063, -22715, 290346, -22563, 292372, -22297, 295017, -21908, 298147, -21392,
+		301635, -20746, 305358, -19976, 309204, -19095, 313073, -18124, 316871, -17093, 320508, -16035, 323901, -14993, 326963, -14013,
+		329605, -13144, 331734, -12439, 333253, -11950, 334072, -11721, 334125, -11780, 333406, -12125, 332005, -12708, 330139, -13430,
+		328138, -14161, 326374, -14767, 325172, -15152, 324734, -15264, 325130, -15094, 326323, -14653, 328216, -13966, 330683, -13062,
+		333594, -11972, 336827, -10730, 340275, -9371, 343840, -7933, 347438, -6453, 350991, -4972, 354427, -3530, 357670, -2168,
+		643, -928, 3259, 147, 5423, 1014, 7032, 1629, 7982, 1953, 8193, 1958, 7636, 1639, 6377, 1035,
+		4615, 237, 2664, -610, 900, -1343, 359649, -1827, 359125, -1978, 359405, -1776, 461, -1240, 2203, -414,
+		4515, 648, 7280, 1890, 10389, 3257, 13743, 4699, 17257, 6169, 20853, 7627, 24457, 9034, 27994, 10357,
+		31388, 11566, 34551, 12635, 37386, 13540, 39781, 14258, 41614, 14770, 42760, 15058, 43113, 15108, 42624, 14916,
+		41347, 14500, 39482, 13915, 37369, 13261, 35417, 12666, 33991, 12255, 33326, 12110, 33511, 12261, 34520, 12688,
+		36265, 13346, 38628, 14175, 41492, 15117, 44747, 16114, 48292, 17119, 52037, 18090, 55895, 18994, 59786, 19806,
+		63623, 20510, 67317, 21096, 70770, 21561, 73870, 21910, 76497, 22154, 78515, 22309, 79789, 22390, 80203, 22410,
+		79697, 22376, 78324, 22287, 76292, 22142, 73971, 21955, 71809, 21765, 70210, 21627, 69434, 21585, 69577, 21661,
+		70610, 21845, 72430, 22108, 74909, 22409, 77914, 22708, 81320, 22968, 85014, 23159, 88891, 23263, 92857, 23267,
+		96820, 23170, 100690, 22979, 104378, 22710, 107786, 22386, 110810, 22042, 113337, 21716, 115245, 21454, 116410, 21298,
+		116730, 21282, 116158, 21419, 114754, 21688, 112730, 22034, 110447, 22384, 108339, 22675, 106796, 22872, 106063, 22967,
+		106226, 22964, 107249, 22867, 109022, 22673, 111411, 22377, 114280, 21973, 117502, 21458, 120962, 20834, 124562, 20109,
+		128212, 19295, 131829, 18415, 135333, 17493, 138645, 16566, 141678, 15672, 144338, 14859, 146522, 14180, 148121, 13690,
+		149024, 13440, 149151, 13466, 148476, 13772, 147083, 14316, 145189, 15003, 143133, 15705, 141295, 16294, 139998, 16684,
+		139436, 16832, 139667, 16732, 140649, 16401, 142283, 15860, 144450, 15135, 147033, 14248, 149923, 13227, 153025, 12095,
+		156252, 10880, 159530, 9613, 162783, 8326, 165939, 7057, 168920, 5845, 171640, 4737, 174001, 3781, 175899, 3030,
+		177221, 2533, 177867, 2333, 177771, 2455, 176941, 2888, 175494, 3569, 173667, 4386, 171787, 5192, 170189, 5845,
+		169131, 6245, 168756, 6344, 169094, 6138, 170102, 5652, 171691, 4922, 173765, 3987, 176225, 2890, 178980, 1670,
+		181950, 364, 185059, -990, 188235, -2355, 191403, -3694, 194487, -4967, 197397, -6137, 200037, -7164, 202295, -8008,
+		204054, -8633, 205195, -9005, 205621, -9099, 205289, -8907, 204239, -8451, 202634, -7796, 200745, -7047, 198907, -6336,
+		197431, -5787, 196538, -5490, 196336, -5483, 196837, -5766, 197993, -6310, 199723, -7073, 201938, -8008, 204550, -9068,
+		207476, -10209, 210636, -11388, 213954, -12565, 217349, -13705, 220738, -14773, 224027, -15742, 227111, -16586, 229873, -17287,
+		232188, -17828, 233923, -18200, 234958, -18396, 235202, -18413, 234630, -18254, 233322, -17935, 231484, -17496, 229431, -17003,
+		227521, -16545, 226069, -16209, 225285, -16059, 225260, -16122, 225993, -16395, 227429, -16849, 229481, -17442, 232056, -18129,
+		235060, -18864, 238401, -19605, 241986, -20314, 245725, -20961, 249519, -21521, 253267, -21981, 256860, -22334, 260180, -22585,
+		263103, -22745, 265501, -22834, 267247, -22875, 268225, -22891, 268356, -22897, 267629, -22896, 266143, -22883, 264128, -22846,
+		261928, -22783, 259931, -22709, 258473, -22655, 257772, -22649, 257919, -22706, 258903, -22822, 260651, -22975, 263061, -23135,
+		266017, -23270, 269403, -23349, 273105, -23346, 277011, -23247, 281012, -23042, 285001, -22738, 288874, -22347, 292526, -21894,
+		295852, -21414, 298746, -20946, 301100, -20537, 302807, -20233, 303768, -20075, 303912, -20092, 303227, -20287, 301798, -20629,
+		299840, -21055, 297687, -21484, 295729, -21842, 294315, -22084, 293676, -22191, 293909, -22162, 294995, -21996, 296849, -21695,
+		299346, -21255, 302354, -20674, 305742, -19957, 309387, -19110, 313176, -18150, 317008, -17099, 320789, -15987, 324434, -14849,
+		327858, -13728, 330981, -12667, 333714, -11716, 335966, -10927, 337642, -10352, 338648, -10037, 338909, -10017, 338397, -10298,
+		337174, -10845, 335419, -11571, 333436, -12345, 331595, -13024, 330236, -13493, 329598, -13683, 329780, -13573, 330769, -13173,
+		332479, -12507, 334790, -11608, 337573, -10512, 340707, -9256, 344080, -7877, 347598, -6416, 351172, -4911, 354727, -3403,
+		358190, -1932, 1486, -537, 4539, 740, 7265, 1860, 9567, 2781, 11343, 3461, 12485, 3863, 12902, 3955,
+		12545, 3725, 11451, 3198, 9785, 2449, 7837, 1608, 5980, 837, 4564, 282, 3835, 43, 3905, 157,
+		4767, 613, 6343, 1368, 8522, 2370, 11187, 3559, 14227, 4880, 17541, 6279, 21042, 7710, 24650, 9130,
+		28289, 10501, 31886, 11791, 35362, 12971, 38632, 14016, 41598, 14906, 44151, 15621, 46167, 16145, 47518, 16465,
+		48088, 16568, 47807, 16450, 46698, 16119, 44926, 15615, 42805, 15018, 40742, 14441, 39131, 14007, 38247, 13806,
+		38214, 13880, 39030, 14220}},
+	"saturn": {start: 2458817.5, step: 16, positions: []int32{
+		289104, -22184, 290911, -21974, 292863, -21723, 294874, -21437, 296861, -21130, 298739, -20819, 300431, -20522, 301862, -20261,
+		302967, -20057, 303691, -19929, 303997, -19888, 303872, -19941, 303336, -20081, 302452, -20291, 301326, -20544, 300097, -20807,
+		298923, -21048, 297956, -21241, 297320, -21369, 297096, -21422, 297317, -21397, 297976, -21294, 299036, -21115, 300435, -20865,
+		302100, -20550, 303949, -20181, 305898, -19770, 307865, -19334, 309768, -18894, 311529, -18472, 313077, -18093, 314343, -17781,
+		315269, -17559, 315809, -17445, 315936, -17448, 315648, -17569, 314981, -17795, 314011, -18096, 312855, -18437, 311661, -18772,
+		310584, -19063, 309764, -19276, 309308, -19391, 309277, -19399, 309685, -19296, 310510, -19088, 311703, -18783, 313196, -18391,
+		314912, -17929, 316769, -17414, 318687, -16867, 320585, -16311, 322388, -15771, 324023, -15276, 325424, -14851, 326528, -14522,
+		327285, -14312, 327655, -14237, 327619, -14302, 327189, -14501, 326414, -14811, 325383, -15195, 324225, -15605, 323090, -15988,
+		322128, -16298, 321467, -16499, 321192, -16571, 321345, -16507, 321923, -16307, 322892, -15984, 324192, -15551, 325752, -15028,
+		327495, -14436, 329341, -13801, 331212, -13149, 333035, -12508, 334736, -11906, 336250, -11373, 337513, -10936, 338469, -10620,
+		339070, -10446, 339284, -10426, 339102, -10562, 338546, -10838, 337680, -11224, 336607, -11673, 335465, -12127, 334405, -12528,
+		333569, -12827, 333068, -12988, 332969, -12993, 333295, -12839, 334027, -12535, 335119, -12098, 336508, -11549, 338121, -10914,
+		339880, -10221, 341710, -9500, 343536, -8779, 345290, -8090, 346902, -7462, 348308, -6925, 349449, -6505, 350271, -6224,
+		350730, -6102, 350800, -6146, 350482, -6351, 349812, -6696, 348866, -7141, 347762, -7631, 346647, -8101, 345670, -8491,
+		344964, -8751, 344623, -8848, 344694, -8771, 345181, -8522, 346054, -8117, 347260, -7580, 348730, -6937, 350391, -6221,
+		352169, -5461, 353990, -4691, 355786, -3940, 357486, -3241, 359027, -2620, 344, -2107, 1380, -1726, 2081, -1497,
+		2409, -1434, 2343, -1541, 1893, -1808, 1112, -2205, 91, -2685, 358964, -3188, 357882, -3646, 356994, -3997,
+		356419, -4196, 356235, -4216, 356470, -4051, 357113, -3713, 358122, -3224, 359438, -2611, 991, -1907, 2709, -1145,
+		4519, -358, 6350, 421, 8133, 1163, 9802, 1839, 11292, 2422, 12539, 2886, 13483, 3211, 14074, 3380,
+		14275, 3383, 14073, 3221, 13491, 2910, 12598, 2485, 11503, 1996, 10355, 1510, 9312, 1093, 8517, 803,
+		8076, 680, 8049, 741, 8447, 985, 9244, 1395, 10392, 1942, 11825, 2596, 13473, 3323, 15262, 4088,
+		17123, 4859, 18985, 5606, 20779, 6302, 22439, 6920, 23897, 7438, 25087, 7834, 25950, 8092, 26434, 8199,
+		26506, 8149, 26164, 7948, 25445, 7617, 24435, 7192, 23268, 6728, 22103, 6288, 21104, 5936, 20407, 5723,
+		20105, 5679, 20238, 5814, 20802, 6118, 21760, 6570, 23054, 7139, 24616, 7792, 26374, 8495, 28255, 9217,
+		30187, 9927, 32101, 10600, 33927, 11211, 35594, 11740, 37033, 12169, 38176, 12482, 38959, 12667, 39333, 12716,
+		39270, 12628, 38779, 12413, 37916, 12091, 36788, 11701, 35550, 11295, 34375, 10930, 33430, 10661, 32842, 10530,
+		32687, 10558, 32987, 10747, 33724, 11081, 34850, 11536, 36300, 12080, 38004, 12683, 39887, 13313, 41874, 13941,
+		43895, 14544, 45875, 15100, 47743, 15590, 49426, 16001, 50849, 16321, 51942, 16540, 52641, 16652, 52896, 16656,
+		52688, 16552, 52041, 16353, 51029, 16077, 49787, 15759, 48489, 15441, 47320, 15173, 46444, 14997, 45978, 14944,
+		45979, 15026, 46454, 15237, 47370, 15561, 48670, 15972, 50283, 16441, 52135, 16940, 54148, 17444, 56247, 17929,
+		58357, 18379, 60403, 18777, 62309, 19114, 64000, 19383, 65397, 19579, 66427, 19701, 67027, 19750, 67151, 19727,
+		66790, 19636, 65984, 19487, 64833, 19295, 63493, 19082, 62157, 18879, 61018, 18721, 60233, 18637, 59904, 18647,
+		60071, 18753, 60725, 18948, 61819, 19215, 63288, 19531, 65056, 19872, 67044, 20216, 69174, 20544, 71367, 20842,
+		73547, 21099, 75636, 21308, 77556, 21469, 79228, 21581, 80573, 21650, 81517, 21682, 81999, 21684, 81981, 21660,
+		81466, 21615, 80514, 21552, 79247, 21475, 77843, 21393, 76506, 21319, 75430, 21272, 74761, 21267, 74583, 21312,
+		74918, 21407, 75742, 21544, 76997, 21708, 78612, 21881, 80505, 22049, 82596, 22196, 84804, 22312, 87050, 22391,
+		89255, 22432, 91342, 22437, 93231, 22414, 94843, 22372, 96100, 22325, 96930, 22285, 97276, 22264, 97112, 22268,
+		96455, 22299, 95384, 22349, 94042, 22408, 92621, 22467, 91330, 22521, 90355, 22570, 89824, 22618, 89803, 22667,
+		90297, 22714, 91267, 22753, 92648, 22777, 94364, 22774, 96333, 22739, 98471, 22667, 100698, 22555, 102937, 22408,
+		105109, 22233, 107138, 22041, 108945, 21848, 110452, 21673, 111585, 21534, 112276, 21451, 112476, 21438, 112170, 21498,
+		111393, 21626, 110242, 21802, 108875, 22000, 107490, 22191, 106292, 22352, 105449, 22470, 105070, 22539, 105199, 22558,
+		105827, 22526, 106906, 22442, 108366, 22304, 110129, 22113, 112112, 21871, 114237, 21582, 116424, 21254, 118597, 20898,
+		120683, 20532, 122605, 20173, 124288, 19845, 125656, 19572, 126639, 19379, 127176, 19288, 127227, 19310, 126793, 19447,
+		125923, 19681, 124731, 19979, 123384, 20298, 122081, 20593, 121009, 20828, 120316, 20979, 120089, 21037, 120352, 20999,
+		121085, 20868, 122232, 20650, 123726, 20352, 125487, 19983, 127438, 19552, 129502, 19074, 131606, 18563, 133677, 18039,
+		135642, 17524, 137429, 17045, 138965, 16628, 140177, 16303, 140999, 16096, 141377, 16029, 141283, 16110, 140733, 16332,
+		139794, 16667, 138592, 17068}},
+	"uranus": {start: 2458817.5, step: 16, positions: []int32{
+		31081, 12073, 30665, 11933, 30428, 11857, 30398, 11855, 30581, 11930, 30967, 12076, 31533, 12283, 32243, 12538,
+		33058, 12825, 33932, 13128, 34822, 13431, 35682, 13718, 36469, 13976, 37144, 14194, 37669, 14360, 38017, 14467,
+		38166, 14510, 38109, 14487, 37852, 14401, 37422, 14261, 36862, 14078, 36231, 13873, 35597, 13667, 35032, 13483,
+		34597, 13344, 34342, 13266, 34294, 13258, 34463, 13324, 34839, 13458, 35398, 13653, 36107, 13894, 36926, 14166,
+		37809, 14455, 38711, 14744, 39588, 15019, 40395, 15267, 41091, 15478, 41640, 15640, 42010, 15747, 42180, 15794,
+		42141, 15779, 41898, 15703, 41475, 15575, 40916, 15405, 40278, 15211, 39632, 15015, 39050, 14838, 38597, 14701,
+		38322, 14621, 38256, 14608, 38410, 14665, 38775, 14788, 39328, 14969, 40036, 15194, 40858, 15451, 41750, 15723,
+		42665, 15997, 43559, 16258, 44386, 16495, 45105, 16697, 45678, 16854, 46072, 16960, 46265, 17010, 46244, 17001,
+		46016, 16936, 45601, 16820, 45042, 16664, 44399, 16484, 43741, 16298, 43142, 16129, 42668, 15996, 42373, 15916,
+		42289, 15898, 42427, 15947, 42780, 16058, 43327, 16224, 44034, 16433, 44860, 16671, 45760, 16926, 46689, 17182,
+		47600, 17428, 48448, 17652, 49191, 17843, 49788, 17993, 50207, 18097, 50423, 18149, 50423, 18146, 50209, 18091,
+		49803, 17988, 49247, 17847, 48597, 17681, 47927, 17508, 47311, 17349, 46817, 17221, 46501, 17142, 46397, 17120,
+		46519, 17160, 46860, 17259, 47400, 17409, 48105, 17601, 48934, 17821, 49843, 18056, 50785, 18293, 51714, 18521,
+		52584, 18730, 53350, 18908, 53972, 19051, 54417, 19150, 54657, 19202, 54678, 19206, 54481, 19161, 54085, 19071,
+		53531, 18945, 52877, 18794, 52195, 18636, 51561, 18488, 51047, 18367, 50709, 18290, 50585, 18265, 50689, 18296,
+		51017, 18383, 51549, 18518, 52251, 18691, 53083, 18891, 54001, 19105, 54956, 19322, 55903, 19530, 56794, 19721,
+		57584, 19886, 58232, 20017, 58703, 20111, 58968, 20163, 59011, 20171, 58832, 20135, 58447, 20059, 57897, 19949,
+		57239, 19816, 56546, 19673, 55895, 19537, 55360, 19425, 55000, 19351, 54854, 19324, 54940, 19348, 55255, 19422,
+		55777, 19541, 56476, 19695, 57310, 19873, 58235, 20065, 59203, 20259, 60167, 20447, 61079, 20618, 61893, 20766,
+		62568, 20886, 63065, 20972, 63356, 21022, 63423, 21033, 63262, 21007, 62890, 20944, 62345, 20851, 61684, 20735,
+		60981, 20610, 60313, 20489, 59757, 20387, 59375, 20317, 59207, 20289, 59274, 20306, 59573, 20369, 60085, 20471,
+		60779, 20604, 61615, 20760, 62546, 20928, 63526, 21098, 64506, 21262, 65438, 21412, 66276, 21543, 66977, 21648,
+		67501, 21725, 67819, 21771, 67910, 21785, 67769, 21767, 67412, 21717, 66874, 21641, 66212, 21544, 65499, 21438,
+		64816, 21333, 64239, 21243, 63834, 21179, 63643, 21151, 63690, 21163, 63972, 21213, 64473, 21298, 65161, 21411,
+		65995, 21544, 66932, 21687, 67922, 21831, 68918, 21970, 69869, 22097, 70731, 22207, 71457, 22296, 72009, 22362,
+		72354, 22403, 72470, 22418, 72351, 22407, 72010, 22371, 71481, 22311, 70819, 22235, 70098, 22148, 69400, 22061,
+		68803, 21984, 68375, 21929, 68161, 21902, 68186, 21908, 68451, 21947, 68938, 22016, 69618, 22108, 70451, 22216,
+		71390, 22333, 72390, 22450, 73399, 22562, 74369, 22664, 75252, 22752, 76004, 22823, 76583, 22877, 76955, 22911,
+		77097, 22925, 77002, 22920, 76678, 22896, 76160, 22854, 75501, 22797, 74774, 22731, 74063, 22663, 73447, 22602,
+		72995, 22556, 72758, 22532, 72760, 22535, 73005, 22563, 73478, 22615, 74148, 22686, 74976, 22770, 75917, 22859,
+		76923, 22948, 77944, 23032, 78931, 23107, 79835, 23171, 80611, 23223, 81216, 23261, 81616, 23286, 81786, 23299,
+		81714, 23299, 81411, 23286, 80905, 23261, 80251, 23225, 79521, 23181, 78798, 23133, 78163, 23089, 77689, 23054,
+		77428, 23035, 77406, 23034, 77631, 23053, 78086, 23089, 78744, 23139, 79566, 23197, 80506, 23258, 81517, 23318,
+		82547, 23373, 83549, 23420, 84472, 23458, 85271, 23488, 85903, 23510, 86330, 23525, 86527, 23533, 86481, 23537,
+		86199, 23535, 85709, 23526, 85062, 23511, 84331, 23489, 83597, 23463, 82946, 23437, 82450, 23415, 82164, 23402,
+		82119, 23400, 82321, 23410, 82757, 23431, 83401, 23460, 84214, 23492, 85150, 23525, 86163, 23555, 87201, 23579,
+		88215, 23597, 89155, 23608, 89976, 23615, 90632, 23618, 91086, 23621, 91311, 23624, 91292, 23629, 91033, 23637,
+		90560, 23644, 89924, 23649, 89194, 23650, 88453, 23646, 87787, 23640, 87270, 23632, 86960, 23626, 86889, 23624,
+		87067, 23627, 87483, 23634, 88110, 23642, 88911, 23650, 89841, 23655, 90852, 23654, 91895, 23647, 92919, 23634,
+		93875, 23617, 94714, 23599, 95394, 23582, 95875, 23571, 96127, 23567, 96136, 23573, 95901, 23588, 95449, 23609,
+		94825, 23634, 94099, 23658, 93354, 23678, 92674, 23692, 92138, 23700, 91804, 23704, 91707, 23703, 91860, 23700,
+		92253, 23693, 92862, 23682, 93648, 23666, 94569, 23642, 95576, 23611, 96620, 23572, 97651, 23528, 98619, 23482,
+		99477, 23438, 100178, 23400, 100685, 23372, 100965, 23360, 101002, 23364, 100794, 23385, 100363, 23420, 99755, 23464,
+		99036, 23511, 98288, 23554, 97598, 23590, 97043, 23615, 96686, 23629, 96563, 23632, 96690, 23624, 97059, 23606,
+		97645, 23577, 98414, 23536, 99323, 23485, 100323, 23423, 101366, 23353, 102401, 23278, 103379, 23202, 104252, 23130,
+		104974, 23070, 105505, 23025, 105813, 23002, 105878, 23003, 105697, 23029, 105291, 23075, 104700, 23137, 103991, 23206,
+		103244, 23272, 102545, 23330}},
+	"neptune": {start: 2458817.5, step: 16, positions: []int32{
+		347213, -6609, 347300, -6565, 347521, -6466, 347862, -6317, 348302, -6127, 348814, -5910, 349366, -5677, 349926, -5443,
+		350461, -5222, 350942, -5026, 351342, -4865, 351639, -4750, 351818, -4687, 351870, -4677, 351795, -4722, 351603, -4816,
+		351311, -4950, 350947, -5111, 350545, -5286, 350146, -5456, 349788, -5606, 349508, -5719, 349336, -5785, 349291, -5796,
+		349382, -5749, 349606, -5646, 349950, -5494, 350391, -5302, 350904, -5082, 351455, -4848, 352013, -4613, 352546, -4391,
+		353024, -4194, 353420, -4035, 353714, -3921, 353889, -3859, 353937, -3851, 353859, -3898, 353664, -3994, 353370, -4129,
+		353005, -4293, 352604, -4468, 352205, -4639, 351849, -4788, 351573, -4901, 351404, -4965, 351364, -4974, 351459, -4924,
+		351686, -4818, 352033, -4664, 352476, -4469, 352989, -4247, 353540, -4011, 354096, -3775, 354627, -3553, 355102, -3357,
+		355495, -3198, 355785, -3086, 355956, -3025, 356001, -3020, 355920, -3068, 355722, -3166, 355426, -3303, 355060, -3468,
+		354658, -3645, 354261, -3816, 353907, -3965, 353634, -4076, 353469, -4138, 353433, -4145, 353532, -4092, 353763, -3984,
+		354112, -3827, 354557, -3630, 355071, -3406, 355621, -3169, 356176, -2933, 356705, -2710, 357177, -2515, 357567, -2358,
+		357854, -2246, 358022, -2188, 358063, -2184, 357979, -2234, 357778, -2334, 357480, -2473, 357112, -2639, 356710, -2816,
+		356314, -2987, 355962, -3135, 355691, -3245, 355531, -3306, 355498, -3310, 355602, -3255, 355836, -3144, 356188, -2984,
+		356636, -2786, 357150, -2560, 357700, -2322, 358254, -2086, 358781, -1864, 359250, -1670, 359638, -1513, 359921, -1404,
+		86, -1347, 124, -1345, 36, -1397, 359832, -1498, 359532, -1639, 359162, -1805, 358760, -1983, 358364, -2154,
+		358014, -2301, 357747, -2410, 357590, -2469, 357562, -2470, 357669, -2413, 357908, -2300, 358263, -2138, 358712, -1937,
+		359227, -1711, 359777, -1472, 331, -1236, 856, -1015, 1323, -822, 1707, -667, 1988, -559, 2149, -504,
+		2184, -504, 2093, -558, 1886, -660, 1583, -802, 1212, -970, 809, -1147, 414, -1318, 65, -1464,
+		359801, -1571, 359647, -1628, 359623, -1627, 359735, -1567, 359977, -1452, 336, -1288, 787, -1086, 1304, -859,
+		1854, -620, 2407, -384, 2930, -164, 3396, 28, 3777, 181, 4054, 287, 4212, 340, 4244, 338,
+		4149, 282, 3939, 179, 3634, 36, 3261, -132, 2857, -310, 2462, -480, 2116, -625, 1854, -730,
+		1704, -784, 1685, -781, 1800, -719, 2047, -601, 2408, -436, 2862, -233, 3380, -5, 3931, 233,
+		4483, 469, 5005, 687, 5469, 877, 5848, 1028, 6122, 1132, 6277, 1183, 6305, 1180, 6207, 1123,
+		5994, 1018, 5686, 874, 5311, 706, 4906, 529, 4512, 360, 4167, 216, 3908, 113, 3762, 61,
+		3746, 66, 3866, 131, 4116, 250, 4481, 417, 4938, 621, 5457, 849, 6009, 1087, 6561, 1321,
+		7082, 1538, 7544, 1726, 7921, 1875, 8192, 1977, 8344, 2026, 8368, 2020, 8267, 1962, 8050, 1856,
+		7739, 1712, 7362, 1544, 6957, 1367, 6562, 1199, 6219, 1057, 5962, 956, 5820, 906, 5808, 914,
+		5933, 981, 6187, 1102, 6556, 1270, 7015, 1475, 7536, 1702, 8089, 1939, 8641, 2172, 9161, 2387,
+		9621, 2573, 9996, 2720, 10265, 2819, 10414, 2866, 10435, 2859, 10330, 2799, 10110, 2692, 9795, 2548,
+		9416, 2379, 9009, 2204, 8615, 2037, 8273, 1897, 8019, 1798, 7880, 1751, 7873, 1761, 8002, 1830,
+		8260, 1953, 8632, 2122, 9094, 2326, 9617, 2553, 10171, 2789, 10723, 3020, 11243, 3233, 11702, 3416,
+		12075, 3561, 12341, 3658, 12487, 3703, 12504, 3693, 12396, 3632, 12172, 3525, 11855, 3380, 11473, 3213,
+		11065, 3038, 10670, 2873, 10329, 2735, 10078, 2638, 9943, 2594, 9940, 2606, 10073, 2677, 10336, 2801,
+		10712, 2971, 11177, 3175, 11702, 3401, 12257, 3635, 12810, 3864, 13330, 4075, 13787, 4256, 14158, 4397,
+		14422, 4492, 14565, 4534, 14579, 4524, 14466, 4461, 14239, 4353, 13918, 4209, 13534, 4042, 13124, 3868,
+		12729, 3705, 12390, 3570, 12141, 3476, 12009, 3433, 12011, 3448, 12149, 3521, 12416, 3646, 12796, 3816,
+		13264, 4020, 13792, 4245, 14348, 4477, 14902, 4704, 15421, 4911, 15878, 5090, 16247, 5228, 16508, 5320,
+		16648, 5361, 16658, 5348, 16542, 5285, 16311, 5176, 15987, 5032, 15600, 4866, 15188, 4694, 14793, 4533,
+		14454, 4400, 14208, 4309, 14080, 4269, 14086, 4286, 14229, 4360, 14500, 4486, 14884, 4657, 15356, 4860,
+		15886, 5083, 16445, 5313, 16999, 5537, 17518, 5742, 17974, 5917, 18342, 6052, 18601, 6142, 18737, 6180,
+		18744, 6166, 18624, 6101, 18389, 5993, 18061, 5849, 17671, 5684, 17257, 5514, 16862, 5356, 16525, 5225,
+		16281, 5137, 16156, 5100, 16167, 5119, 16314, 5194, 16590, 5321, 16978, 5492, 17453, 5694, 17987, 5915,
+		18547, 6143, 19102, 6363, 19622, 6565, 20077, 6736, 20443, 6869, 20700, 6955, 20833, 6991, 20837, 6976,
+		20713, 6910, 20473, 6801, 20141, 6659, 19749, 6496, 19333, 6328, 18937, 6172, 18601, 6044, 18359, 5958,
+		18239, 5924, 18253, 5945, 18405, 6022, 18687, 6149, 19079, 6319, 19558, 6520, 20094, 6739, 20656, 6964,
+		21213, 7181, 21733, 7379, 22188, 7547, 22552, 7676, 22807, 7760, 22937, 7794, 22937, 7776, 22809, 7710,
+		22565, 7602, 22229, 7460, 21833, 7298, 21416, 7133, 21019, 6980, 20684, 6855, 20445, 6772, 20328, 6740,
+		20347, 6764, 20504, 6842}},
+}
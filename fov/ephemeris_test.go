@@ -0,0 +1,119 @@
+package fov
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// separation returns the angular distance in degrees between two positions.
+func separation(ra1, dec1, ra2, dec2 float64) float64 {
+	r := math.Pi / 180
+	cos := math.Sin(dec1*r)*math.Sin(dec2*r) + math.Cos(dec1*r)*math.Cos(dec2*r)*math.Cos((ra1-ra2)*r)
+	return math.Acos(math.Min(1, cos)) / r
+}
+
+func TestSolarSystemCoords_Jupiter(t *testing.T) {
+	tests := []struct {
+		name string
+		time string
+		ra   float64 // degrees
+		dec  float64 // degrees
+	}{
+		// Great conjunction with Saturn, near 20h10m -20°34'
+		{"2020 great conjunction", "2020-12-21T18:00:00Z", 302.49, -20.57},
+		// Opposition in Aquarius, 21h58m -13°40'
+		{"2021 opposition", "2021-08-20T00:00:00Z", 329.51, -13.66},
+		// Opposition in Pisces, 0h15m -0°08'
+		{"2022 opposition", "2022-09-26T00:00:00Z", 3.76, -0.14},
+		// Opposition in Aries, 2h33m +13°30'
+		{"2023 opposition", "2023-11-03T00:00:00Z", 38.16, 13.51},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			when, err := time.Parse(time.RFC3339, tt.time)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ra, dec, err := SolarSystemCoords("Jupiter", when)
+			if err != nil {
+				t.Fatalf("SolarSystemCoords: %v", err)
+			}
+			if sep := separation(ra, dec, tt.ra, tt.dec); sep > 0.1 {
+				t.Errorf("Jupiter at (%.3f, %.3f), %.3f° from expected (%.2f, %.2f)", ra, dec, sep, tt.ra, tt.dec)
+			}
+		})
+	}
+}
+
+func TestSolarSystemCoords_GreatConjunction(t *testing.T) {
+	// Jupiter and Saturn passed 6.1' apart on 2020-12-21
+	when := time.Date(2020, 12, 21, 18, 20, 0, 0, time.UTC)
+	jra, jdec, err := SolarSystemCoords("jupiter", when)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sra, sdec, err := SolarSystemCoords("saturn", when)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sep := separation(jra, jdec, sra, sdec) * 60; math.Abs(sep-6.1) > 1 {
+		t.Errorf("Jupiter-Saturn separation = %.1f', want ~6.1'", sep)
+	}
+}
+
+func TestSolarSystemCoords_Oppositions(t *testing.T) {
+	// Jupiter is ~180° from the Sun on its published opposition dates
+	for _, when := range []time.Time{
+		time.Date(2021, 8, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 9, 26, 20, 0, 0, 0, time.UTC),
+		time.Date(2023, 11, 3, 5, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 7, 21, 0, 0, 0, time.UTC),
+	} {
+		jra, jdec, err := SolarSystemCoords("jupiter", when)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sra, sdec, err := SolarSystemCoords("sun", when)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if elong := separation(jra, jdec, sra, sdec); elong < 177 {
+			t.Errorf("%s: elongation = %.2f°, want ~180°", when.Format(time.DateOnly), elong)
+		}
+	}
+}
+
+func TestSolarSystemCoords_RAWrap(t *testing.T) {
+	// The Sun crosses RA 0h at the March equinox; interpolation must not
+	// average 359° and 1° to 180°.
+	for h := 0; h < 48; h++ {
+		when := time.Date(2024, 3, 19, h, 0, 0, 0, time.UTC)
+		ra, dec, err := SolarSystemCoords("sun", when)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ra > 2 && ra < 358 {
+			t.Fatalf("%s: Sun RA = %.3f, want near 0°", when, ra)
+		}
+		if math.Abs(dec) > 1 {
+			t.Fatalf("%s: Sun Dec = %.3f, want near 0°", when, dec)
+		}
+	}
+}
+
+func TestSolarSystemCoords_Errors(t *testing.T) {
+	if _, _, err := SolarSystemCoords("pluto", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error for unknown body")
+	}
+	if _, _, err := SolarSystemCoords("mars", time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error for time outside table range")
+	}
+	if _, _, err := SolarSystemCoords("moon", time.Date(2035, 12, 31, 23, 0, 0, 0, time.UTC)); err != nil {
+		t.Errorf("end of 2035 should be in range: %v", err)
+	}
+	if _, _, err := SolarSystemCoords("neptune", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Errorf("start of 2020 should be in range: %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package fov_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+)
+
+func ExampleAnalyzeImage() {
+	// A JPEG holding only the EXIF of a Nikon D7500 frame shot at 300 mm
+	info, err := fov.AnalyzeImage("testdata/nikon-d7500-300mm.jpg")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s at %.0f mm on %s\n", info.Model, info.FocalLength, info.Sensor.Name)
+	fmt.Printf("FOV: %s\n", info.FOV.String())
+	fmt.Printf("Use scale: %.0f-%.0f arcminwidth\n", info.ScaleLow, info.ScaleHigh)
+	// Output:
+	// NIKON D7500 at 300 mm on APS-C Nikon/Sony
+	// FOV: 4.50° x 3.00° (270.3' x 179.9')
+	// Use scale: 225-324 arcminwidth
+}
+
+func ExampleRecommendIndexesForLens() {
+	// 50-300mm zoom on APS-C
+	rec := fov.RecommendIndexesForLens(50, 300, fov.APSCNikon, 1.3)
+	for _, idx := range rec.Indexes {
+		fmt.Printf("%s: %.1f° - %.1f°\n", idx.Name, idx.MinFOV, idx.MaxFOV)
+	}
+	fmt.Printf("%.1f MB\n", rec.TotalSizeMB)
+	// Output:
+	// index-4110: 3.0° - 4.2°
+	// index-4109: 4.2° - 5.6°
+	// index-4108: 5.6° - 8.0°
+	// index-4107: 8.0° - 11.0°
+	// 335.0 MB
+}
@@ -0,0 +1,84 @@
+package fov
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// J2000 orientation of the galactic frame: the north galactic pole and the
+// galactic longitude of the north celestial pole, in degrees.
+const (
+	galacticPoleRA  = 192.85948
+	galacticPoleDec = 27.12825
+	galacticNCPLon  = 122.93192
+)
+
+// Cosecant law parameters: E(B-V) at the galactic pole, and the galactic
+// latitude in degrees below which the law is held constant.
+const (
+	poleReddening        = 0.06
+	minCosecantLatitude  = 10.0
+	dustWarningMagnitude = 1.0
+)
+
+// extinctionRatios is A/E(B-V) per Johnson-Cousins band for R_V = 3.1,
+// from Cardelli, Clayton & Mathis (1989).
+var extinctionRatios = map[string]float64{
+	"B": 4.10,
+	"V": 3.10,
+	"R": 2.32,
+	"I": 1.49,
+}
+
+// galacticCoords converts J2000 ra, dec to galactic longitude and latitude,
+// all in degrees.
+func galacticCoords(ra, dec float64) (l, b float64) {
+	const d2r = math.Pi / 180
+	dRA := (ra - galacticPoleRA) * d2r
+	sinDec, cosDec := math.Sincos(dec * d2r)
+	sinPole, cosPole := math.Sincos(galacticPoleDec * d2r)
+
+	sinB := sinDec*sinPole + cosDec*cosPole*math.Cos(dRA)
+	b = math.Asin(math.Max(-1, math.Min(1, sinB))) / d2r
+	l = galacticNCPLon - math.Atan2(cosDec*math.Sin(dRA), sinDec*cosPole-cosDec*sinPole*math.Cos(dRA))/d2r
+	return math.Mod(l+360, 360), b
+}
+
+// GalacticExtinction estimates the reddening E(B-V) towards ra, dec
+// (degrees, J2000) from the cosecant law E(B-V) ≈ 0.06 / |sin b|, where b is
+// the galactic latitude: about 0.06 at the galactic poles, rising towards
+// the plane. Within 10° of the plane, where the law diverges and real dust
+// is patchy, the value at 10° (about 0.35) is returned as a lower bound.
+// It is a rough guide for exposure estimation, not a dust map.
+func GalacticExtinction(ra, dec float64) (EBminusV float64) {
+	_, b := galacticCoords(ra, dec)
+	b = math.Max(math.Abs(b), minCosecantLatitude)
+	return poleReddening / math.Sin(b*math.Pi/180)
+}
+
+// ExtinctionMagnitudes converts a reddening E(B-V) to the extinction in
+// magnitudes in band "B", "V", "R" or "I" (case-insensitive), for the
+// standard interstellar R_V = 3.1. It returns NaN for other bands.
+func ExtinctionMagnitudes(ebv float64, band string) float64 {
+	ratio, ok := extinctionRatios[strings.ToUpper(band)]
+	if !ok {
+		return math.NaN()
+	}
+	return ratio * ebv
+}
+
+// DustWarning returns a warning when galactic dust dims ra, dec (degrees,
+// J2000) by more than 1 magnitude in V, so fewer stars are detected and
+// solving may need longer exposures or a deeper index. It returns "" when
+// extinction is lower.
+func DustWarning(ra, dec float64) string {
+	ebv := GalacticExtinction(ra, dec)
+	av := ExtinctionMagnitudes(ebv, "V")
+	if av <= dustWarningMagnitude {
+		return ""
+	}
+	_, b := galacticCoords(ra, dec)
+	return fmt.Sprintf("high galactic extinction: A_V ≈ %.1f mag (E(B-V) %.2f) at galactic latitude %.1f°; expect fewer stars",
+		av, ebv, b)
+}
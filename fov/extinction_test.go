@@ -0,0 +1,70 @@
+package fov
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestGalacticCoords(t *testing.T) {
+	tests := []struct {
+		name         string
+		ra, dec      float64
+		wantL, wantB float64
+	}{
+		{"galactic center", 266.40499, -28.93617, 0, 0},
+		{"north galactic pole", galacticPoleRA, galacticPoleDec, 0, 90},
+		{"north celestial pole", 0, 90, galacticNCPLon, galacticPoleDec},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, b := galacticCoords(tt.ra, tt.dec)
+			if math.Abs(b-tt.wantB) > 1e-3 {
+				t.Errorf("b = %.5f, want %.5f", b, tt.wantB)
+			}
+			// Longitude is undefined at the pole
+			if tt.wantB != 90 && math.Abs(math.Mod(l-tt.wantL+540, 360)-180) > 1e-3 {
+				t.Errorf("l = %.5f, want %.5f", l, tt.wantL)
+			}
+		})
+	}
+}
+
+func TestGalacticExtinction(t *testing.T) {
+	// Galactic plane near the center: warned
+	plane := GalacticExtinction(266.40499, -28.93617)
+	if want := poleReddening / math.Sin(minCosecantLatitude*math.Pi/180); math.Abs(plane-want) > 1e-9 {
+		t.Errorf("plane E(B-V) = %.4f, want %.4f", plane, want)
+	}
+	if w := DustWarning(266.40499, -28.93617); !strings.Contains(w, "high galactic extinction") {
+		t.Errorf("DustWarning at plane = %q, want a warning", w)
+	}
+
+	// Galactic pole: negligible
+	pole := GalacticExtinction(galacticPoleRA, galacticPoleDec)
+	if math.Abs(pole-poleReddening) > 1e-6 {
+		t.Errorf("pole E(B-V) = %.4f, want %.2f", pole, poleReddening)
+	}
+	if av := ExtinctionMagnitudes(pole, "V"); av > 0.2 {
+		t.Errorf("pole A_V = %.3f, want under 0.2", av)
+	}
+	if w := DustWarning(galacticPoleRA, galacticPoleDec); w != "" {
+		t.Errorf("DustWarning at pole = %q, want none", w)
+	}
+
+	// 60° south of the pole along its meridian, b = 30°: twice the polar value
+	if got := GalacticExtinction(galacticPoleRA, galacticPoleDec-60); math.Abs(got-2*poleReddening) > 1e-6 {
+		t.Errorf("E(B-V) at b=30° = %.4f, want %.2f", got, 2*poleReddening)
+	}
+}
+
+func TestExtinctionMagnitudes(t *testing.T) {
+	for band, want := range map[string]float64{"B": 0.41, "v": 0.31, "R": 0.232, "i": 0.149} {
+		if got := ExtinctionMagnitudes(0.1, band); math.Abs(got-want) > 1e-9 {
+			t.Errorf("ExtinctionMagnitudes(0.1, %q) = %v, want %v", band, got, want)
+		}
+	}
+	if got := ExtinctionMagnitudes(0.1, "K"); !math.IsNaN(got) {
+		t.Errorf("ExtinctionMagnitudes for unknown band = %v, want NaN", got)
+	}
+}
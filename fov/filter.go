@@ -0,0 +1,93 @@
+package fov
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
+
+// Filter names reported in ImageInfo.FilterInUse.
+const (
+	FilterHAlpha    = "Hα"
+	FilterOIII      = "OIII"
+	FilterSII       = "SII"
+	FilterLuminance = "L"
+	FilterRed       = "R"
+	FilterGreen     = "G"
+	FilterBlue      = "B"
+)
+
+// narrowbandOdds is the --odds-to-solve threshold used for narrowband
+// frames, down from solve-field's default of 1e9.
+const narrowbandOdds = "1e6"
+
+// narrowbandFilters maps the spellings capture software and users write to
+// narrowband filter names. They are unambiguous, so any word matches.
+var narrowbandFilters = map[string]string{
+	"hα": FilterHAlpha, "ha": FilterHAlpha, "h-alpha": FilterHAlpha, "halpha": FilterHAlpha, "h-α": FilterHAlpha,
+	"oiii": FilterOIII, "o-iii": FilterOIII, "o3": FilterOIII,
+	"sii": FilterSII, "s-ii": FilterSII, "s2": FilterSII,
+}
+
+// broadbandFilters maps broadband filter spellings. Single letters are too
+// common in free text, so they only match next to the word "filter".
+var broadbandFilters = map[string]string{
+	"l": FilterLuminance, "lum": FilterLuminance, "luminance": FilterLuminance,
+	"r": FilterRed, "red": FilterRed,
+	"g": FilterGreen, "green": FilterGreen,
+	"b": FilterBlue, "blue": FilterBlue,
+}
+
+// detectFilter finds a filter name in free text such as an EXIF
+// UserComment or ImageDescription, returning "" when none is found.
+func detectFilter(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+	})
+
+	for _, w := range words {
+		if name, ok := narrowbandFilters[w]; ok {
+			return name
+		}
+	}
+	for i, w := range words {
+		name, ok := broadbandFilters[w]
+		if !ok {
+			continue
+		}
+		if (i > 0 && words[i-1] == "filter") || (i+1 < len(words) && words[i+1] == "filter") {
+			return name
+		}
+	}
+	return ""
+}
+
+// isNarrowband reports whether filter is a narrowband filter name.
+func isNarrowband(filter string) bool {
+	return filter == FilterHAlpha || filter == FilterOIII || filter == FilterSII
+}
+
+// FilterAdjustedSolveOptions returns a copy of baseOpts (or the defaults
+// when nil) adjusted for the filter in info.FilterInUse.
+//
+// Narrowband filters pass only a few nm of light, so far fewer stars are
+// detected and fewer can be matched against the index. For Hα, OIII and
+// SII the solver's acceptance threshold is lowered from odds of 1e9 to 1e6
+// (solve-field --odds-to-solve via ExtraArgs), letting a match with fewer
+// stars count as a solution. Broadband filters and unknown filters leave
+// the options unchanged.
+//
+// The options are a client.SolveOptions.
+func FilterAdjustedSolveOptions(info *ImageInfo, baseOpts *solver.SolveOptions) *solver.SolveOptions {
+	if baseOpts == nil {
+		baseOpts = solver.DefaultSolveOptions()
+	}
+	opts := *baseOpts
+	opts.ExtraArgs = append([]string(nil), baseOpts.ExtraArgs...)
+
+	if info != nil && isNarrowband(info.FilterInUse) {
+		opts.ExtraArgs = append(opts.ExtraArgs, "--odds-to-solve", narrowbandOdds)
+	}
+	return &opts
+}
@@ -0,0 +1,116 @@
+package fov
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestDetectFilter(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Shot through Hα filter", FilterHAlpha},
+		{"Ha 7nm, 300s", FilterHAlpha},
+		{"H-alpha", FilterHAlpha},
+		{"Filter: OIII", FilterOIII},
+		{"SII 3nm Baader", FilterSII},
+		{"L filter, gain 100", FilterLuminance},
+		{"Filter=R", FilterRed},
+		{"green filter", FilterGreen},
+		{"filter: B", FilterBlue},
+		{"Captured with NINA", ""},
+		{"Gain 100 offset 50 B mode", ""}, // lone letter without "filter"
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := detectFilter(tt.text); got != tt.want {
+			t.Errorf("detectFilter(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+// writeEXIFJPEG writes a minimal JPEG whose EXIF UserComment is comment.
+func writeEXIFJPEG(t *testing.T, comment string) string {
+	t.Helper()
+	le := binary.LittleEndian
+
+	// TIFF header, IFD0 pointing at the Exif IFD, Exif IFD with UserComment
+	var tiff bytes.Buffer
+	tiff.WriteString("II")
+	_ = binary.Write(&tiff, le, uint16(42))
+	_ = binary.Write(&tiff, le, uint32(8))
+	// IFD0 at 8: one entry, ExifIFDPointer -> 26
+	_ = binary.Write(&tiff, le, uint16(1))
+	_ = binary.Write(&tiff, le, []uint16{0x8769, 4})
+	_ = binary.Write(&tiff, le, []uint32{1, 26, 0})
+	// Exif IFD at 26: one entry, UserComment (UNDEFINED) data at 44
+	value := append([]byte("ASCII\x00\x00\x00"), comment...)
+	_ = binary.Write(&tiff, le, uint16(1))
+	_ = binary.Write(&tiff, le, []uint16{0x9286, 7})
+	_ = binary.Write(&tiff, le, []uint32{uint32(len(value)), 44, 0})
+	tiff.Write(value)
+
+	var jpeg bytes.Buffer
+	jpeg.Write([]byte{0xFF, 0xD8, 0xFF, 0xE1})
+	_ = binary.Write(&jpeg, binary.BigEndian, uint16(2+6+tiff.Len()))
+	jpeg.WriteString("Exif\x00\x00")
+	jpeg.Write(tiff.Bytes())
+	jpeg.Write([]byte{0xFF, 0xD9})
+
+	path := filepath.Join(t.TempDir(), "frame.jpg")
+	if err := os.WriteFile(path, jpeg.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAnalyzeImage_FilterInUse(t *testing.T) {
+	info, err := AnalyzeImage(writeEXIFJPEG(t, "Shot through Hα filter"))
+	if err != nil {
+		t.Fatalf("AnalyzeImage failed: %v", err)
+	}
+	if info.FilterInUse != FilterHAlpha {
+		t.Errorf("FilterInUse = %q, want %q", info.FilterInUse, FilterHAlpha)
+	}
+
+	info, err = AnalyzeImage(writeEXIFJPEG(t, "M42, 30x120s"))
+	if err != nil {
+		t.Fatalf("AnalyzeImage failed: %v", err)
+	}
+	if info.FilterInUse != "" {
+		t.Errorf("FilterInUse = %q, want none", info.FilterInUse)
+	}
+}
+
+func TestFilterAdjustedSolveOptions(t *testing.T) {
+	base := &ImageInfo{}
+	narrow := &ImageInfo{FilterInUse: FilterOIII}
+	broad := &ImageInfo{FilterInUse: FilterRed}
+
+	opts := FilterAdjustedSolveOptions(narrow, nil)
+	if !slices.Equal(opts.ExtraArgs, []string{"--odds-to-solve", "1e6"}) {
+		t.Errorf("narrowband ExtraArgs = %v", opts.ExtraArgs)
+	}
+	if opts.DownsampleFactor != 2 {
+		t.Errorf("nil base did not use defaults: %+v", opts)
+	}
+
+	for _, info := range []*ImageInfo{base, broad, nil} {
+		if opts := FilterAdjustedSolveOptions(info, nil); len(opts.ExtraArgs) != 0 {
+			t.Errorf("filter %+v: unexpected ExtraArgs %v", info, opts.ExtraArgs)
+		}
+	}
+
+	// The base options are copied, not modified
+	baseOpts := FilterAdjustedSolveOptions(nil, nil)
+	baseOpts.ExtraArgs = []string{"--cpulimit", "60"}
+	adjusted := FilterAdjustedSolveOptions(narrow, baseOpts)
+	if len(baseOpts.ExtraArgs) != 2 || len(adjusted.ExtraArgs) != 4 {
+		t.Errorf("base %v, adjusted %v", baseOpts.ExtraArgs, adjusted.ExtraArgs)
+	}
+}
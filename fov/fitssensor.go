@@ -0,0 +1,113 @@
+package fov
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// astroCameraBrands maps substrings of the (uppercased) INSTRUME or
+// TELESCOP value to the astronomy camera maker, in match order. Capture
+// software writes the driver name, so patterns cover both the brand and
+// its model prefixes.
+var astroCameraBrands = []struct {
+	Pattern string
+	Brand   string
+}{
+	{"STARLIGHT XPRESS", "Starlight Xpress"},
+	{"SXVR", "Starlight Xpress"},
+	{"SXV", "Starlight Xpress"},
+	{"TRIUS", "Starlight Xpress"},
+	{"SX-", "Starlight Xpress"},
+	{"MORAVIAN", "Moravian Instruments"},
+	{"FINGER LAKES", "FLI"},
+	{"MICROLINE", "FLI"},
+	{"PROLINE", "FLI"},
+	{"FLI", "FLI"},
+	{"ZWO", "ZWO"},
+	{"ASI", "ZWO"},
+	{"QHY", "QHYCCD"},
+	{"ATIK", "Atik"},
+	{"SBIG", "SBIG"},
+}
+
+// DetectSensorFromFITS reads the sensor of a dedicated astronomy camera
+// from a FITS image header, where such cameras record the instrument
+// instead of EXIF Make/Model. The sensor size is the pixel pitch
+// (XPIXSZ/YPIXSZ, in microns) times the array size (NAXIS1/NAXIS2); both
+// are after binning when the capture software binned, so their product is
+// the physical size either way. YPIXSZ defaults to XPIXSZ for square
+// pixels. The sensor is named after INSTRUME, and the brand (e.g.
+// "Starlight Xpress", "Moravian Instruments", "FLI") is detected from
+// INSTRUME or TELESCOP, or "" if unknown.
+//
+// Example:
+//
+//	sensor, brand, err := fov.DetectSensorFromFITS("m42_L_300s.fits")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	field := fov.CalculateFOV(530, sensor)
+func DetectSensorFromFITS(fitsPath string) (SensorSize, string, error) {
+	file, err := os.Open(fitsPath)
+	if err != nil {
+		return SensorSize{}, "", fmt.Errorf("failed to open FITS file: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	header, err := fits.ReadHeader(file)
+	if err != nil {
+		return SensorSize{}, "", fmt.Errorf("failed to read FITS header: %w", err)
+	}
+	return sensorFromFITSHeader(header)
+}
+
+// sensorFromFITSHeader returns the sensor and camera brand described by a
+// FITS header, as DetectSensorFromFITS.
+func sensorFromFITSHeader(header *fits.Header) (SensorSize, string, error) {
+	width, okW := header.Int("NAXIS1")
+	height, okH := header.Int("NAXIS2")
+	if !okW || !okH || width <= 0 || height <= 0 {
+		return SensorSize{}, "", fmt.Errorf("FITS header has no image dimensions (NAXIS1/NAXIS2)")
+	}
+	xPixel, ok := header.Float("XPIXSZ")
+	if !ok || xPixel <= 0 {
+		return SensorSize{}, "", fmt.Errorf("FITS header has no pixel size (XPIXSZ)")
+	}
+	yPixel, ok := header.Float("YPIXSZ")
+	if !ok || yPixel <= 0 {
+		yPixel = xPixel
+	}
+
+	instrument, _ := header.Get("INSTRUME")
+	telescope, _ := header.Get("TELESCOP")
+	name := strings.TrimSpace(instrument)
+	if name == "" {
+		name = "FITS camera"
+	}
+
+	sensor := SensorSize{
+		Width:  float64(width) * xPixel / 1000,
+		Height: float64(height) * yPixel / 1000,
+		Name:   name,
+	}
+	return sensor, detectAstroCameraBrand(instrument, telescope), nil
+}
+
+// detectAstroCameraBrand returns the maker of the camera named in any of
+// values, or "" when none matches.
+func detectAstroCameraBrand(values ...string) string {
+	for _, v := range values {
+		upper := strings.ToUpper(v)
+		for _, b := range astroCameraBrands {
+			if strings.Contains(upper, b.Pattern) {
+				return b.Brand
+			}
+		}
+	}
+	return ""
+}
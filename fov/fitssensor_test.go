@@ -0,0 +1,115 @@
+package fov
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// writeFITSHeader writes a FITS header with the given cards after the
+// mandatory ones, without a data unit, and returns its path.
+func writeFITSHeader(t *testing.T, cards []fits.Card) string {
+	t.Helper()
+	header := append([]fits.Card{
+		{Key: "SIMPLE", Value: "T"}, {Key: "BITPIX", Value: "16"}, {Key: "NAXIS", Value: "2"},
+	}, cards...)
+	path := filepath.Join(t.TempDir(), "frame.fits")
+	if err := os.WriteFile(path, fits.EncodeHeader(header), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDetectSensorFromFITS_StarlightXpress(t *testing.T) {
+	// SX-46: KAF-16200, 4540 x 3640 pixels of 6 µm
+	path := writeFITSHeader(t, []fits.Card{
+		{Key: "NAXIS1", Value: "4540"}, {Key: "NAXIS2", Value: "3640"},
+		{Key: "INSTRUME", Value: fits.Quote("SX-46")},
+		{Key: "TELESCOP", Value: fits.Quote("Takahashi FSQ-106")},
+		{Key: "XPIXSZ", Value: "6.0"}, {Key: "YPIXSZ", Value: "6.0"},
+	})
+
+	sensor, brand, err := DetectSensorFromFITS(path)
+	if err != nil {
+		t.Fatalf("DetectSensorFromFITS failed: %v", err)
+	}
+	if math.Abs(sensor.Width-27.24) > 1e-9 || math.Abs(sensor.Height-21.84) > 1e-9 {
+		t.Errorf("sensor = %v x %v mm, want 27.24 x 21.84", sensor.Width, sensor.Height)
+	}
+	if sensor.Name != "SX-46" || brand != "Starlight Xpress" {
+		t.Errorf("name, brand = %q, %q, want SX-46, Starlight Xpress", sensor.Name, brand)
+	}
+
+	// The computed sensor drives the FOV like a preset one
+	field := CalculateFOV(530, sensor)
+	if want := 2 * math.Atan(27.24/(2*530)) * 180 / math.Pi; math.Abs(field.WidthDegrees-want) > 1e-9 {
+		t.Errorf("FOV width = %v, want %v", field.WidthDegrees, want)
+	}
+}
+
+func TestDetectSensorFromFITS_Binned(t *testing.T) {
+	// 2x2 binning halves NAXIS and doubles XPIXSZ; YPIXSZ omitted
+	path := writeFITSHeader(t, []fits.Card{
+		{Key: "NAXIS1", Value: "2270"}, {Key: "NAXIS2", Value: "1820"},
+		{Key: "INSTRUME", Value: fits.Quote("Moravian Instruments G3-16200")},
+		{Key: "XPIXSZ", Value: "12.0"},
+	})
+
+	sensor, brand, err := DetectSensorFromFITS(path)
+	if err != nil {
+		t.Fatalf("DetectSensorFromFITS failed: %v", err)
+	}
+	if math.Abs(sensor.Width-27.24) > 1e-9 || math.Abs(sensor.Height-21.84) > 1e-9 {
+		t.Errorf("sensor = %v x %v mm, want 27.24 x 21.84", sensor.Width, sensor.Height)
+	}
+	if brand != "Moravian Instruments" {
+		t.Errorf("brand = %q", brand)
+	}
+}
+
+func TestDetectSensorFromFITS_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cards   []fits.Card
+		wantErr string
+	}{
+		{"no pixel size", []fits.Card{{Key: "NAXIS1", Value: "100"}, {Key: "NAXIS2", Value: "100"}}, "XPIXSZ"},
+		{"no dimensions", []fits.Card{{Key: "XPIXSZ", Value: "3.76"}}, "NAXIS1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := DetectSensorFromFITS(writeFITSHeader(t, tt.cards))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want mention of %s", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, _, err := DetectSensorFromFITS(filepath.Join(t.TempDir(), "missing.fits")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestDetectAstroCameraBrand(t *testing.T) {
+	tests := map[string]string{
+		"SXVR-H694":              "Starlight Xpress",
+		"Trius SX-814":           "Starlight Xpress",
+		"FLI ProLine PL16803":    "FLI",
+		"Finger Lakes Microline": "FLI",
+		"ZWO ASI2600MM Pro":      "ZWO",
+		"QHY268M":                "QHYCCD",
+		"Atik 460EX":             "Atik",
+		"SBIG STF-8300M":         "SBIG",
+		"Canon EOS 6D":           "",
+		"":                       "",
+	}
+	for instrument, want := range tests {
+		if got := detectAstroCameraBrand(instrument); got != want {
+			t.Errorf("detectAstroCameraBrand(%q) = %q, want %q", instrument, got, want)
+		}
+	}
+}
@@ -35,12 +35,34 @@ import (
 )
 
 // SensorSize represents the physical dimensions of a camera sensor in millimeters.
+//
+// Width is the long side in landscape orientation, so Width >= Height;
+// square sensors have the two equal. Nothing assumes a 3:2 frame: the
+// presets include 4:3 (Micro Four Thirds, medium format, small sensors)
+// and 1:1 sensors, and FOV is computed per axis.
 type SensorSize struct {
 	Width  float64 // Sensor width in mm
 	Height float64 // Sensor height in mm
 	Name   string  // Descriptive name
+
+	// ProjectionType is the lens projection used by AzimuthalFOV:
+	// ProjectionRectilinear (the default when empty), ProjectionEquidistant
+	// or ProjectionStereographic.
+	ProjectionType string
 }
 
+// Lens projection types for SensorSize.ProjectionType.
+const (
+	// ProjectionRectilinear is a normal lens: r = f·tan(θ).
+	ProjectionRectilinear = "rectilinear"
+
+	// ProjectionEquidistant is a typical fisheye lens: r = f·θ.
+	ProjectionEquidistant = "equidistant"
+
+	// ProjectionStereographic is a stereographic fisheye: r = 2f·tan(θ/2).
+	ProjectionStereographic = "stereographic"
+)
+
 // Common sensor sizes
 var (
 	// Full Frame (35mm)
@@ -54,8 +76,26 @@ var (
 	// Micro Four Thirds
 	MicroFourThirds = SensorSize{Width: 17.3, Height: 13.0, Name: "Micro Four Thirds"}
 
+	// Medium format (4:3)
+	MediumFormatGFX = SensorSize{Width: 43.8, Height: 32.9, Name: "Medium Format (Fujifilm GFX)"}
+
+	// APS-H (Canon EOS-1D to 1D Mark IV)
+	APSHCanon = SensorSize{Width: 28.7, Height: 19.0, Name: "APS-H Canon"}
+
+	// Sigma Foveon X3 (SD1, Merrill and Quattro; sd Quattro H is APS-H)
+	FoveonAPSC = SensorSize{Width: 23.5, Height: 15.5, Name: "APS-C Sigma Foveon"}
+	FoveonAPSH = SensorSize{Width: 26.7, Height: 17.9, Name: "APS-H Sigma Foveon"}
+
 	// Smaller sensors
 	OneInch = SensorSize{Width: 13.2, Height: 8.8, Name: "1\" sensor"}
+
+	// Compact and planetary camera sensors (4:3 nominal optical formats)
+	OneOverOnePointSeven = SensorSize{Width: 7.6, Height: 5.7, Name: "1/1.7\" sensor"}
+	OneOverOnePointEight = SensorSize{Width: 7.18, Height: 5.32, Name: "1/1.8\" sensor"}
+	OneOverTwoPointNine  = SensorSize{Width: 4.96, Height: 3.72, Name: "1/2.9\" sensor"}
+
+	// Square 1" astronomy sensor (Sony IMX533, 3008x3008 3.76µm pixels)
+	SquareOneInch = SensorSize{Width: 11.31, Height: 11.31, Name: "1\" square (IMX533)"}
 )
 
 // FieldOfView represents the calculated field of view for an imaging setup.
@@ -65,6 +105,7 @@ type FieldOfView struct {
 	WidthArcmin   float64 // FOV width in arcminutes
 	HeightArcmin  float64 // FOV height in arcminutes
 	DiagonalDeg   float64 // Diagonal FOV in degrees
+	WidthPixels   int     // Image width in pixels, if known; needed for arcsecperpix scale bounds
 }
 
 // CalculateFOV calculates the field of view for a given focal length and sensor size.
@@ -98,6 +139,45 @@ func CalculateFOV(focalLengthMM float64, sensor SensorSize) FieldOfView {
 	}
 }
 
+// AzimuthalFOV calculates the field of view using the lens projection in
+// sensor.ProjectionType, where θ is the angle from the optical axis and r
+// the distance from the sensor center. For rectilinear lenses it matches
+// CalculateFOV. Fisheye projections cover much more sky than the tangent
+// formula suggests at short focal lengths, e.g. about 147° rather than 104°
+// across a full-frame sensor at 14mm for an equidistant fisheye.
+//
+// Example:
+//
+//	sensor := fov.FullFrame
+//	sensor.ProjectionType = fov.ProjectionEquidistant
+//	fisheye := fov.AzimuthalFOV(14, sensor)
+func AzimuthalFOV(focalLengthMM float64, sensor SensorSize) FieldOfView {
+	// angle returns the full angle subtended by a sensor dimension
+	angle := func(sizeMM float64) float64 {
+		r := sizeMM / 2
+		var theta float64
+		switch sensor.ProjectionType {
+		case ProjectionEquidistant:
+			theta = r / focalLengthMM
+		case ProjectionStereographic:
+			theta = 2 * math.Atan(r/(2*focalLengthMM))
+		default:
+			theta = math.Atan(r / focalLengthMM)
+		}
+		return 2 * theta * 180 / math.Pi
+	}
+
+	widthDeg := angle(sensor.Width)
+	heightDeg := angle(sensor.Height)
+	return FieldOfView{
+		WidthDegrees:  widthDeg,
+		HeightDegrees: heightDeg,
+		WidthArcmin:   widthDeg * 60,
+		HeightArcmin:  heightDeg * 60,
+		DiagonalDeg:   angle(math.Hypot(sensor.Width, sensor.Height)),
+	}
+}
+
 // CalculateFOVRange calculates the FOV range for a zoom lens.
 func CalculateFOVRange(minFocalLength, maxFocalLength float64, sensor SensorSize) (minFOV, maxFOV FieldOfView) {
 	// At max focal length, FOV is smallest
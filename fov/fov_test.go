@@ -120,6 +120,14 @@ func TestSensorPresets(t *testing.T) {
 		APSCFuji,
 		MicroFourThirds,
 		OneInch,
+		MediumFormatGFX,
+		APSHCanon,
+		FoveonAPSC,
+		FoveonAPSH,
+		OneOverOnePointSeven,
+		OneOverOnePointEight,
+		OneOverTwoPointNine,
+		SquareOneInch,
 	}
 
 	for _, sensor := range sensors {
@@ -135,9 +143,10 @@ func TestSensorPresets(t *testing.T) {
 				t.Error("Sensor name is empty")
 			}
 
-			// Verify width > height (landscape orientation)
+			// Verify width >= height (landscape orientation; square
+			// sensors have them equal)
 			if sensor.Width < sensor.Height {
-				t.Errorf("Sensor width (%.1f) should be greater than height (%.1f)",
+				t.Errorf("Sensor width (%.1f) should not be less than height (%.1f)",
 					sensor.Width, sensor.Height)
 			}
 
@@ -156,3 +165,52 @@ func BenchmarkCalculateFOV(b *testing.B) {
 		CalculateFOV(50, APSCNikon)
 	}
 }
+
+func TestAzimuthalFOV(t *testing.T) {
+	sensor := func(projection string) SensorSize {
+		s := FullFrame
+		s.ProjectionType = projection
+		return s
+	}
+
+	rectilinear := AzimuthalFOV(14, sensor(""))
+	equidistant := AzimuthalFOV(14, sensor(ProjectionEquidistant))
+	stereographic := AzimuthalFOV(14, sensor(ProjectionStereographic))
+
+	// Default projection matches the tangent formula
+	if planar := CalculateFOV(14, FullFrame); math.Abs(rectilinear.WidthDegrees-planar.WidthDegrees) > 1e-9 ||
+		math.Abs(rectilinear.DiagonalDeg-planar.DiagonalDeg) > 1e-9 {
+		t.Errorf("rectilinear %v differs from CalculateFOV %v", rectilinear, planar)
+	}
+	if explicit := AzimuthalFOV(14, sensor(ProjectionRectilinear)); explicit != rectilinear {
+		t.Errorf("explicit rectilinear %v differs from default %v", explicit, rectilinear)
+	}
+
+	// 14mm full frame: rectilinear 104.3°, stereographic 130.9°, equidistant 147.3°
+	tests := []struct {
+		name string
+		fov  FieldOfView
+		want float64
+	}{
+		{"rectilinear", rectilinear, 104.25},
+		{"stereographic", stereographic, 130.94},
+		{"equidistant", equidistant, 147.33},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.fov.WidthDegrees-tt.want) > 0.05 {
+			t.Errorf("%s width = %.2f°, want %.2f°", tt.name, tt.fov.WidthDegrees, tt.want)
+		}
+	}
+
+	for _, fisheye := range []FieldOfView{equidistant, stereographic} {
+		if d := fisheye.WidthDegrees - rectilinear.WidthDegrees; d < 3 {
+			t.Errorf("fisheye width %.2f° only %.2f° wider than rectilinear", fisheye.WidthDegrees, d)
+		}
+	}
+
+	// At long focal lengths the projections converge
+	long := AzimuthalFOV(300, sensor(ProjectionEquidistant)).WidthDegrees
+	if d := long - CalculateFOV(300, FullFrame).WidthDegrees; d < 0 || d > 0.01 {
+		t.Errorf("300mm equidistant differs from rectilinear by %.4f°", d)
+	}
+}
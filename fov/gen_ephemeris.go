@@ -0,0 +1,247 @@
+//go:build ignore
+
+// gen_ephemeris generates ephemeris_table.go, the tabulated geocentric
+// positions used by SolarSystemCoords.
+//
+// Planet positions come from the JPL approximate Keplerian elements
+// (Standish, valid 1800-2050) with a light-time correction. The Moon uses
+// the principal terms of the ELP-2000/82 series from Meeus, Astronomical
+// Algorithms ch. 47. Both are accurate to well under 0.1° over 2020-2035.
+//
+// Usage:
+//
+//	go run gen_ephemeris.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+)
+
+const (
+	deg = math.Pi / 180
+
+	// startJD and endJD bound the tabulated range: 2020-01-01 to 2036-01-01 UTC.
+	startJD = 2458849.5
+	endJD   = 2464693.5
+
+	// deltaT is TT-UTC in days, held constant over the table range.
+	deltaT = 69.2 / 86400
+
+	// obliquityJ2000 is the mean obliquity of the ecliptic at J2000.
+	obliquityJ2000 = 23.43928 * deg
+
+	// lightDayAU is the light travel time for one AU in days.
+	lightDayAU = 0.0057755183
+)
+
+// elements are the Standish mean orbital elements and their rates per
+// Julian century: a (AU), e, I, L, long. perihelion, long. node (degrees).
+type elements struct {
+	a, e, i, l, peri, node                   float64
+	aDot, eDot, iDot, lDot, periDot, nodeDot float64
+}
+
+var planets = map[string]elements{
+	"mercury": {0.38709927, 0.20563593, 7.00497902, 252.25032350, 77.45779628, 48.33076593,
+		0.00000037, 0.00001906, -0.00594749, 149472.67411175, 0.16047689, -0.12534081},
+	"venus": {0.72333566, 0.00677672, 3.39467605, 181.97909950, 131.60246718, 76.67984255,
+		0.00000390, -0.00004107, -0.00078890, 58517.81538729, 0.00268329, -0.27769418},
+	"earth": {1.00000261, 0.01671123, -0.00001531, 100.46457166, 102.93768193, 0.0,
+		0.00000562, -0.00004392, -0.01294668, 35999.37244981, 0.32327364, 0.0},
+	"mars": {1.52371034, 0.09339410, 1.84969142, -4.55343205, -23.94362959, 49.55953891,
+		0.00001847, 0.00007882, -0.00813131, 19140.30268499, 0.44441088, -0.29257343},
+	"jupiter": {5.20288700, 0.04838624, 1.30439695, 34.39644051, 14.72847983, 100.47390909,
+		-0.00011607, -0.00013253, -0.00183714, 3034.74612775, 0.21252668, 0.20469106},
+	"saturn": {9.53667594, 0.05386179, 2.48599187, 49.95424423, 92.59887831, 113.66242448,
+		-0.00125060, -0.00050991, 0.00193609, 1222.49362201, -0.41897216, -0.28867794},
+	"uranus": {19.18916464, 0.04725744, 0.77263783, 313.23810451, 170.95427630, 74.01692503,
+		-0.00196176, -0.00004397, -0.00242939, 428.48202785, 0.40805281, 0.04240589},
+	"neptune": {30.06992276, 0.00859048, 1.77004347, -55.12002969, 44.96476227, 131.78422574,
+		0.00026291, 0.00005105, 0.00035372, 218.45945325, -0.32241464, -0.00508664},
+}
+
+// bodies lists the tabulated bodies with their table step in days. Steps are
+// chosen so cubic interpolation error stays below 0.01°.
+var bodies = []struct {
+	name string
+	step float64
+}{
+	{"sun", 8},
+	{"moon", 1},
+	{"mercury", 2},
+	{"venus", 4},
+	{"mars", 8},
+	{"jupiter", 16},
+	{"saturn", 16},
+	{"uranus", 16},
+	{"neptune", 16},
+}
+
+// heliocentric returns the J2000 ecliptic heliocentric position of a planet in AU.
+func heliocentric(el elements, T float64) (x, y, z float64) {
+	a := el.a + el.aDot*T
+	e := el.e + el.eDot*T
+	inc := (el.i + el.iDot*T) * deg
+	l := el.l + el.lDot*T
+	peri := el.peri + el.periDot*T
+	node := (el.node + el.nodeDot*T) * deg
+
+	argPeri := peri*deg - node
+	m := math.Mod(l-peri, 360) * deg
+
+	// Solve Kepler's equation
+	E := m + e*math.Sin(m)
+	for range 10 {
+		E -= (E - e*math.Sin(E) - m) / (1 - e*math.Cos(E))
+	}
+
+	xp := a * (math.Cos(E) - e)
+	yp := a * math.Sqrt(1-e*e) * math.Sin(E)
+
+	cw, sw := math.Cos(argPeri), math.Sin(argPeri)
+	cn, sn := math.Cos(node), math.Sin(node)
+	ci, si := math.Cos(inc), math.Sin(inc)
+
+	x = (cw*cn-sw*sn*ci)*xp + (-sw*cn-cw*sn*ci)*yp
+	y = (cw*sn+sw*cn*ci)*xp + (-sw*sn+cw*cn*ci)*yp
+	z = (sw*si)*xp + (cw*si)*yp
+	return x, y, z
+}
+
+// century returns Julian centuries of TT since J2000 for a UTC Julian date.
+func century(jd float64) float64 {
+	return (jd + deltaT - 2451545.0) / 36525
+}
+
+// planetEcliptic returns the geocentric J2000 ecliptic position of a planet
+// or the Sun, corrected for light time.
+func planetEcliptic(name string, jd float64) (x, y, z float64) {
+	ex, ey, ez := heliocentric(planets["earth"], century(jd))
+	if name == "sun" {
+		return -ex, -ey, -ez
+	}
+
+	tau := 0.0
+	for range 3 {
+		px, py, pz := heliocentric(planets[name], century(jd-tau))
+		x, y, z = px-ex, py-ey, pz-ez
+		tau = math.Sqrt(x*x+y*y+z*z) * lightDayAU
+	}
+	return x, y, z
+}
+
+// moonTerm is one periodic term: coefficients of D, M, M', F and the amplitude.
+type moonTerm struct {
+	d, m, mp, f float64
+	amp         float64
+}
+
+var moonLongitude = []moonTerm{
+	{0, 0, 1, 0, 6.288774}, {2, 0, -1, 0, 1.274027}, {2, 0, 0, 0, 0.658314},
+	{0, 0, 2, 0, 0.213618}, {0, 1, 0, 0, -0.185116}, {0, 0, 0, 2, -0.114332},
+	{2, 0, -2, 0, 0.058793}, {2, -1, -1, 0, 0.057066}, {2, 0, 1, 0, 0.053322},
+	{2, -1, 0, 0, 0.045758}, {0, 1, -1, 0, -0.040923}, {1, 0, 0, 0, -0.034720},
+	{0, 1, 1, 0, -0.030383}, {2, 0, 0, -2, 0.015327}, {0, 0, 1, 2, -0.012528},
+	{0, 0, 1, -2, 0.010980}, {4, 0, -1, 0, 0.010675}, {0, 0, 3, 0, 0.010034},
+	{4, 0, -2, 0, 0.008548}, {2, 1, -1, 0, -0.007888}, {2, 1, 0, 0, -0.006766},
+	{1, 0, -1, 0, -0.005163}, {1, 1, 0, 0, 0.004987}, {2, -1, 1, 0, 0.004036},
+	{2, 0, 2, 0, 0.003994}, {4, 0, 0, 0, 0.003861}, {2, 0, -3, 0, 0.003665},
+	{0, 1, -2, 0, -0.002689}, {2, 0, -1, 2, -0.002602}, {2, -1, -2, 0, 0.002390},
+	{1, 0, 1, 0, -0.002348}, {2, -2, 0, 0, 0.002236}, {0, 1, 2, 0, -0.002120},
+	{0, 2, 0, 0, -0.002069},
+}
+
+var moonLatitude = []moonTerm{
+	{0, 0, 0, 1, 5.128122}, {0, 0, 1, 1, 0.280602}, {0, 0, 1, -1, 0.277693},
+	{2, 0, 0, -1, 0.173237}, {2, 0, -1, 1, 0.055413}, {2, 0, -1, -1, 0.046271},
+	{2, 0, 0, 1, 0.032573}, {0, 0, 2, 1, 0.017198}, {2, 0, 1, -1, 0.009266},
+	{0, 0, 2, -1, 0.008822}, {2, -1, 0, -1, 0.008216}, {2, 0, -2, -1, 0.004324},
+	{2, 0, 1, 1, 0.004200}, {2, 1, 0, -1, -0.003359}, {2, -1, -1, 1, 0.002463},
+	{2, -1, 0, 1, 0.002211}, {2, -1, -1, -1, 0.002065},
+}
+
+// moonEcliptic returns the geocentric J2000 ecliptic unit vector of the Moon.
+func moonEcliptic(jd float64) (x, y, z float64) {
+	T := century(jd)
+	lp := 218.3164477 + 481267.88123421*T
+	d := (297.8501921 + 445267.1114034*T) * deg
+	m := (357.5291092 + 35999.0502909*T) * deg
+	mp := (134.9633964 + 477198.8675055*T) * deg
+	f := (93.2720950 + 483202.0175233*T) * deg
+	ecc := 1 - 0.002516*T - 0.0000074*T*T
+
+	series := func(terms []moonTerm) float64 {
+		sum := 0.0
+		for _, t := range terms {
+			amp := t.amp * math.Pow(ecc, math.Abs(t.m))
+			sum += amp * math.Sin(t.d*d+t.m*m+t.mp*mp+t.f*f)
+		}
+		return sum
+	}
+
+	// Series give ecliptic of date; remove general precession to reach J2000
+	lon := (lp + series(moonLongitude) - 1.396971*T) * deg
+	lat := series(moonLatitude) * deg
+	return math.Cos(lat) * math.Cos(lon), math.Cos(lat) * math.Sin(lon), math.Sin(lat)
+}
+
+// equatorial converts a J2000 ecliptic vector to RA/Dec in degrees.
+func equatorial(x, y, z float64) (ra, dec float64) {
+	ce, se := math.Cos(obliquityJ2000), math.Sin(obliquityJ2000)
+	xe := x
+	ye := y*ce - z*se
+	ze := y*se + z*ce
+
+	ra = math.Atan2(ye, xe) / deg
+	if ra < 0 {
+		ra += 360
+	}
+	dec = math.Atan2(ze, math.Hypot(xe, ye)) / deg
+	return ra, dec
+}
+
+func main() {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_ephemeris.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package fov")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var ephemerides = map[string]ephemeris{")
+
+	for _, body := range bodies {
+		// Pad two steps either side for cubic interpolation at the range ends
+		first := startJD - 2*body.step
+		last := endJD + 2*body.step
+
+		fmt.Fprintf(&buf, "%q: {start: %.1f, step: %g, positions: []int32{\n", body.name, first, body.step)
+		n := 0
+		for jd := first; jd <= last; jd += body.step {
+			var x, y, z float64
+			if body.name == "moon" {
+				x, y, z = moonEcliptic(jd)
+			} else {
+				x, y, z = planetEcliptic(body.name, jd)
+			}
+			ra, dec := equatorial(x, y, z)
+			fmt.Fprintf(&buf, "%d,%d,", int32(math.Round(ra*1000)), int32(math.Round(dec*1000)))
+			if n++; n%8 == 0 {
+				fmt.Fprintln(&buf)
+			}
+		}
+		fmt.Fprintln(&buf, "}},")
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format generated source: %v", err)
+	}
+	if err := os.WriteFile("ephemeris_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,206 @@
+package fov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// horizonsURL is the NASA JPL Horizons API endpoint. Tests point it at a
+// local server.
+var horizonsURL = "https://ssd.jpl.nasa.gov/api/horizons.api"
+
+// defaultHorizonsObserver is the geocentric observer (the Earth's center).
+const defaultHorizonsObserver = "500@399"
+
+// horizonsBodies maps the names SolarSystemCoords accepts (plus Pluto) to
+// Horizons major-body IDs.
+var horizonsBodies = map[string]string{
+	"sun": "10", "moon": "301", "mercury": "199", "venus": "299", "mars": "499",
+	"jupiter": "599", "saturn": "699", "uranus": "799", "neptune": "899", "pluto": "999",
+}
+
+// horizonsKey identifies a cached FetchHorizonsCoords call.
+type horizonsKey struct {
+	command  string
+	jd       string
+	observer string
+}
+
+// horizonsCache keeps Horizons positions for the life of the process; a
+// body's position at a given instant never changes.
+var horizonsCache = struct {
+	sync.Mutex
+	positions map[horizonsKey][2]float64
+}{positions: make(map[horizonsKey][2]float64)}
+
+// FetchHorizonsCoords returns the astrometric J2000 RA/Dec in degrees of
+// target at time t, fetched from the NASA JPL Horizons API, for hints
+// outside the 2020-2035 range of SolarSystemCoords or for comets and
+// asteroids it does not cover.
+//
+// target is a body name known to SolarSystemCoords (plus "pluto"), or any
+// Horizons COMMAND such as "433;" (the asteroid Eros) or "DES=1P;"
+// (Halley's comet). observerLocation is a Horizons CENTER such as
+// "500@399" (geocentric) or "675@399" (Palomar); empty means geocentric.
+//
+// Results are cached in memory. If Horizons cannot be reached, target is
+// covered by SolarSystemCoords and the observer is geocentric, the built-in
+// geocentric table is used instead. A topocentric observer gets the network
+// error rather than a geocentric position, which for the Moon can be off by
+// a degree. API errors (e.g. an unknown target) are returned as errors.
+func FetchHorizonsCoords(ctx context.Context, target string, t time.Time, observerLocation string) (ra, dec float64, err error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return 0, 0, fmt.Errorf("horizons target is empty")
+	}
+	command, ok := horizonsBodies[strings.ToLower(target)]
+	if !ok {
+		command = target
+	}
+	if observerLocation == "" {
+		observerLocation = defaultHorizonsObserver
+	}
+	key := horizonsKey{
+		command:  command,
+		jd:       strconv.FormatFloat(julianDate(t), 'f', 6, 64),
+		observer: observerLocation,
+	}
+
+	horizonsCache.Lock()
+	pos, ok := horizonsCache.positions[key]
+	horizonsCache.Unlock()
+	if ok {
+		return pos[0], pos[1], nil
+	}
+
+	ra, dec, err = queryHorizons(ctx, key)
+	var netErr *horizonsNetworkError
+	if errors.As(err, &netErr) && ctx.Err() == nil && geocentricObserver(observerLocation) {
+		if fra, fdec, ferr := SolarSystemCoords(target, t); ferr == nil {
+			return fra, fdec, nil
+		}
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	horizonsCache.Lock()
+	horizonsCache.positions[key] = [2]float64{ra, dec}
+	horizonsCache.Unlock()
+	return ra, dec, nil
+}
+
+// geocentricObserver reports whether a Horizons CENTER is the Earth's
+// center, the observer SolarSystemCoords computes positions for.
+func geocentricObserver(center string) bool {
+	switch strings.ToLower(strings.TrimSpace(center)) {
+	case defaultHorizonsObserver, "500", "@399", "geo":
+		return true
+	}
+	return false
+}
+
+// horizonsNetworkError marks a failure to reach Horizons at all, as opposed
+// to an error response, so FetchHorizonsCoords knows to fall back.
+type horizonsNetworkError struct {
+	err error
+}
+
+func (e *horizonsNetworkError) Error() string { return "Horizons query failed: " + e.err.Error() }
+func (e *horizonsNetworkError) Unwrap() error { return e.err }
+
+// queryHorizons requests a single-epoch observer ephemeris in text format.
+func queryHorizons(ctx context.Context, key horizonsKey) (ra, dec float64, err error) {
+	quote := func(s string) string { return "'" + s + "'" }
+	params := url.Values{
+		"format":      {"text"},
+		"COMMAND":     {quote(key.command)},
+		"OBJ_DATA":    {"'NO'"},
+		"MAKE_EPHEM":  {"'YES'"},
+		"EPHEM_TYPE":  {"'OBSERVER'"},
+		"CENTER":      {quote(key.observer)},
+		"TLIST":       {quote(key.jd)},
+		"TLIST_TYPE":  {"'JD'"},
+		"QUANTITIES":  {"'1'"},
+		"ANG_FORMAT":  {"'DEG'"},
+		"CSV_FORMAT":  {"'YES'"},
+		"EXTRA_PREC":  {"'YES'"},
+		"TIME_DIGITS": {"'MINUTES'"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, horizonsURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create Horizons request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, 0, &horizonsNetworkError{err: err}
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // Response fully read, close error not critical
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return 0, 0, &horizonsNetworkError{err: err}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("Horizons query failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return parseHorizonsResponse(string(body))
+}
+
+// parseHorizonsResponse reads the first row between the $$SOE and $$EOE
+// markers of a CSV observer table with QUANTITIES=1 in degrees:
+//
+//	2024-Jan-01 00:00, , ,  37.6474013, 13.7934422,
+//
+// The blank columns are the solar and lunar presence flags.
+func parseHorizonsResponse(body string) (ra, dec float64, err error) {
+	start := strings.Index(body, "$$SOE")
+	end := strings.Index(body, "$$EOE")
+	if start == -1 || end < start {
+		return 0, 0, fmt.Errorf("Horizons returned no ephemeris: %s", horizonsMessage(body))
+	}
+
+	for _, line := range strings.Split(body[start+len("$$SOE"):end], "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) < 3 {
+			continue
+		}
+		var values []float64
+		for _, f := range fields[1:] {
+			if v, err := strconv.ParseFloat(strings.TrimSpace(f), 64); err == nil {
+				values = append(values, v)
+			}
+		}
+		if len(values) < 2 {
+			return 0, 0, fmt.Errorf("malformed Horizons ephemeris row %q", strings.TrimSpace(line))
+		}
+		return values[0], values[1], nil
+	}
+	return 0, 0, fmt.Errorf("Horizons ephemeris is empty")
+}
+
+// horizonsMessage returns the last non-blank lines of a response without
+// an ephemeris, where Horizons explains what went wrong.
+func horizonsMessage(body string) string {
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "***") {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > 3 {
+		lines = lines[len(lines)-3:]
+	}
+	return strings.Join(lines, " ")
+}
@@ -0,0 +1,144 @@
+package fov
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// jupiterHorizons is a Horizons text response for Jupiter on 2024-01-01.
+const jupiterHorizons = `API VERSION: 1.2
+API SOURCE: NASA/JPL Horizons API
+
+*******************************************************************************
+Ephemeris / API_USER Mon Jan  1 00:00:00 2024 Pasadena, USA      / Horizons
+*******************************************************************************
+Target body name: Jupiter (599)                   {source: jup365_merged}
+Center body name: Earth (399)                     {source: DE441}
+Center-site name: GEOCENTRIC
+*******************************************************************************
+ Date__(UT)__HR:MN, , , R.A._____(ICRF), DEC______(ICRF),
+*******************************************************************************
+$$SOE
+ 2024-Jan-01 00:00, , ,  37.6474013, 13.7934422,
+$$EOE
+*******************************************************************************
+`
+
+// serveHorizons points horizonsURL at a test server for the duration of t
+// and clears the session cache around it.
+func serveHorizons(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	orig := horizonsURL
+	horizonsURL = server.URL
+	clearHorizonsCache()
+	t.Cleanup(func() {
+		horizonsURL = orig
+		clearHorizonsCache()
+	})
+	return server
+}
+
+func clearHorizonsCache() {
+	horizonsCache.Lock()
+	clear(horizonsCache.positions)
+	horizonsCache.Unlock()
+}
+
+func TestFetchHorizonsCoords(t *testing.T) {
+	requests := 0
+	serveHorizons(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if q.Get("COMMAND") != "'599'" || q.Get("CENTER") != "'500@399'" || q.Get("format") != "text" {
+			t.Errorf("unexpected Horizons parameters: %v", q)
+		}
+		if q.Get("TLIST") != "'2460310.500000'" {
+			t.Errorf("TLIST = %s, want the Julian date of 2024-01-01", q.Get("TLIST"))
+		}
+		_, _ = w.Write([]byte(jupiterHorizons))
+	})
+
+	when := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for range 2 {
+		ra, dec, err := FetchHorizonsCoords(context.Background(), "Jupiter", when, "")
+		if err != nil {
+			t.Fatalf("FetchHorizonsCoords failed: %v", err)
+		}
+		if math.Abs(ra-37.6474013) > 1e-9 || math.Abs(dec-13.7934422) > 1e-9 {
+			t.Errorf("got (%v, %v), want (37.6474013, 13.7934422)", ra, dec)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1 (second call cached)", requests)
+	}
+}
+
+func TestFetchHorizonsCoords_Errors(t *testing.T) {
+	serveHorizons(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("API VERSION: 1.2\n\nNo matches found.\n"))
+	})
+
+	when := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, err := FetchHorizonsCoords(context.Background(), "DES=Nonexistent;", when, "")
+	if err == nil || !strings.Contains(err.Error(), "No matches found") {
+		t.Errorf("expected Horizons message in error, got %v", err)
+	}
+
+	// An API error for a known body is not a network failure, so no fallback
+	if _, _, err := FetchHorizonsCoords(context.Background(), "jupiter", when, ""); err == nil {
+		t.Error("expected error for response without an ephemeris")
+	}
+}
+
+func TestFetchHorizonsCoords_OfflineFallback(t *testing.T) {
+	server := serveHorizons(t, func(w http.ResponseWriter, r *http.Request) {})
+	server.Close()
+
+	when := time.Date(2023, 11, 3, 0, 0, 0, 0, time.UTC)
+	ra, dec, err := FetchHorizonsCoords(context.Background(), "jupiter", when, "")
+	if err != nil {
+		t.Fatalf("expected fallback to built-in table, got %v", err)
+	}
+	wantRA, wantDec, _ := SolarSystemCoords("jupiter", when)
+	if ra != wantRA || dec != wantDec {
+		t.Errorf("got (%v, %v), want built-in (%v, %v)", ra, dec, wantRA, wantDec)
+	}
+
+	if ra, _, err := FetchHorizonsCoords(context.Background(), "jupiter", when, "500@399"); err != nil || ra != wantRA {
+		t.Errorf("explicit geocentric observer = %v, %v; want the built-in table", ra, err)
+	}
+
+	// The table is geocentric, so a topocentric request does not fall back
+	if _, _, err := FetchHorizonsCoords(context.Background(), "moon", when, "675@399"); err == nil {
+		t.Error("expected error when offline with a topocentric observer")
+	}
+
+	// Outside the table there is nothing to fall back to
+	if _, _, err := FetchHorizonsCoords(context.Background(), "jupiter", when.AddDate(50, 0, 0), ""); err == nil {
+		t.Error("expected error when offline outside the built-in range")
+	}
+}
+
+func TestParseHorizonsResponse(t *testing.T) {
+	ra, dec, err := parseHorizonsResponse(jupiterHorizons)
+	if err != nil || ra != 37.6474013 || dec != 13.7934422 {
+		t.Errorf("got (%v, %v, %v)", ra, dec, err)
+	}
+
+	// Daylight and moonlight flags occupy the presence columns
+	flagged := "$$SOE\n 2024-Jan-01 00:00,*,m, 280.1, -23.0,\n$$EOE\n"
+	if ra, dec, err := parseHorizonsResponse(flagged); err != nil || ra != 280.1 || dec != -23.0 {
+		t.Errorf("flagged row: got (%v, %v, %v)", ra, dec, err)
+	}
+
+	if _, _, err := parseHorizonsResponse("$$SOE\n 2024-Jan-01 00:00, , , n.a., n.a.,\n$$EOE\n"); err == nil {
+		t.Error("expected error for row without coordinates")
+	}
+}
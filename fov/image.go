@@ -1,10 +1,17 @@
 package fov
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
 	"github.com/rwcarlsen/goexif/exif"
+	"github.com/rwcarlsen/goexif/tiff"
 )
 
 const (
@@ -12,6 +19,21 @@ const (
 	detectionSourceEXIF = "exif"
 	// detectionSourceDefault indicates default sensor was used
 	detectionSourceDefault = "default"
+	// detectionSourceFITS indicates sensor was computed from the FITS
+	// header's pixel size and image dimensions
+	detectionSourceFITS = "fits"
+	// detectionSourceLensModel indicates the focal length was parsed from
+	// the EXIF LensModel or LensSpecification, in the absence of FocalLength
+	detectionSourceLensModel = "lensmodel"
+
+	// Image formats reported in ImageInfo.SourceFormat
+	sourceFormatJPEG = "jpeg"
+	sourceFormatTIFF = "tiff"
+	sourceFormatFITS = "fits"
+
+	// analyzeScaleMargin is the margin AnalyzeImage applies to the FOV
+	// for its recommended scale bounds.
+	analyzeScaleMargin = 1.2
 )
 
 // ImageInfo contains camera and lens information extracted from an image.
@@ -24,7 +46,11 @@ type ImageInfo struct {
 	ScaleLow     float64 // Recommended lower scale bound (arcminwidth)
 	ScaleHigh    float64 // Recommended upper scale bound (arcminwidth)
 	HasEXIF      bool    // Whether EXIF data was found
-	DetectedFrom string  // How sensor was detected ("exif" or "default")
+	DetectedFrom string  // How sensor was detected ("exif", "fits" or "default"), or "lensmodel" when the focal length came from the lens model
+	FilterInUse  string  // Filter named in the EXIF UserComment/ImageDescription or FITS FILTER (e.g. "Hα"), or ""
+	SourceFormat string  // Format the metadata was read from: "jpeg", "tiff", "fits", or "" for others
+	LensModel    string  // Lens named in the EXIF LensModel tag, or ""
+	Fisheye      bool    // Lens or camera model marks a fisheye or all-sky camera; see TooWide
 }
 
 // AnalyzeImage extracts camera information from an image file and calculates FOV.
@@ -33,6 +59,15 @@ type ImageInfo struct {
 // then attempts to match the camera to a known sensor size. If successful,
 // it calculates the field of view and recommends scale parameters for solving.
 //
+// The format is detected from the file's contents. JPEG and TIFF are read
+// through their EXIF data (a TIFF's own IFDs). Manual and adapted lenses
+// that report no FocalLength are recovered from a prime lens's
+// LensSpecification or LensModel (e.g. "Samyang 135mm F2"), with
+// DetectedFrom "lensmodel". FITS images from astronomy
+// cameras have no EXIF; their header gives the focal length (FOCALLEN), the
+// sensor from the pixel size and dimensions (see DetectSensorFromFITS), the
+// camera (INSTRUME) and the filter (FILTER), with DetectedFrom "fits".
+//
 // Example:
 //
 //	info, err := fov.AnalyzeImage("photo.jpg")
@@ -47,22 +82,55 @@ func AnalyzeImage(imagePath string) (*ImageInfo, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to open image: %w", err)
 	}
-	defer func() {
-		if closeErr := file.Close(); closeErr != nil && err == nil {
-			err = fmt.Errorf("failed to close file: %w", closeErr)
+	defer file.Close() //nolint:errcheck // Read-only file, close error not critical
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	return AnalyzeImageReader(file, stat.Size())
+}
+
+// AnalyzeImageBytes is AnalyzeImage for image data already in memory, such
+// as the data passed to Client.SolveBytes, without writing it to disk.
+func AnalyzeImageBytes(data []byte) (*ImageInfo, error) {
+	return AnalyzeImageReader(bytes.NewReader(data), int64(len(data)))
+}
+
+// AnalyzeImageReader is AnalyzeImage for the size bytes of image data read
+// from r. Only the metadata is read: for a JPEG, the marker segments up to
+// and including the EXIF APP1 segment, and for FITS the header. A TIFF's
+// IFDs may lie anywhere in the file, so a TIFF is read whole.
+func AnalyzeImageReader(r io.ReaderAt, size int64) (*ImageInfo, error) {
+	format := detectImageFormat(io.NewSectionReader(r, 0, size))
+	var exifData io.Reader = io.NewSectionReader(r, 0, size)
+	switch format {
+	case sourceFormatFITS:
+		return analyzeFITS(io.NewSectionReader(r, 0, size))
+	case sourceFormatJPEG:
+		segment, err := jpegEXIFSegment(r, size)
+		if err != nil {
+			return &ImageInfo{SourceFormat: format}, fmt.Errorf("failed to decode EXIF data: %w", err)
 		}
-	}()
+		exifData = segment
+	}
 
 	// Try to decode EXIF data
-	x, err := exif.Decode(file)
+	x, err := exif.Decode(exifData)
 	if err != nil {
 		return &ImageInfo{
-			HasEXIF: false,
+			HasEXIF:      false,
+			SourceFormat: format,
 		}, fmt.Errorf("failed to decode EXIF data: %w", err)
 	}
+	return exifImageInfo(x, format), nil
+}
 
+// exifImageInfo builds the ImageInfo of an image in format from its EXIF data.
+func exifImageInfo(x *exif.Exif, format string) *ImageInfo {
 	info := &ImageInfo{
-		HasEXIF: true,
+		HasEXIF:      true,
+		SourceFormat: format,
 	}
 
 	// Extract camera make
@@ -79,29 +147,186 @@ func AnalyzeImage(imagePath string) (*ImageInfo, error) {
 		}
 	}
 
+	if lens, lensErr := x.Get(exif.LensModel); lensErr == nil {
+		if lensStr, strErr := lens.StringVal(); strErr == nil {
+			info.LensModel = strings.TrimSpace(lensStr)
+		}
+	}
+
+	// Detect a filter noted by the capture software or photographer
+	for _, field := range []exif.FieldName{exif.UserComment, exif.ImageDescription} {
+		if tag, tagErr := x.Get(field); tagErr == nil {
+			if info.FilterInUse = detectFilter(exifText(tag)); info.FilterInUse != "" {
+				break
+			}
+		}
+	}
+
 	// Extract focal length
 	if focalTag, focalErr := x.Get(exif.FocalLength); focalErr == nil {
 		if num, denom, ratErr := focalTag.Rat2(0); ratErr == nil && denom != 0 {
 			info.FocalLength = float64(num) / float64(denom)
 		}
 	}
+	focalFromLens := false
+	if info.FocalLength <= 0 {
+		info.FocalLength, focalFromLens = lensFocalLength(x, info.LensModel)
+	}
 
 	// Detect sensor size from camera model
 	info.Sensor, info.DetectedFrom = detectSensor(info.Make, info.Model)
+	if focalFromLens {
+		info.DetectedFrom = detectionSourceLensModel
+	}
+
+	// Fisheye lenses map angles linearly, covering far more sky than the
+	// rectilinear formula gives
+	info.Fisheye = isFisheye(info.LensModel, info.Model)
+	if info.Fisheye && info.Sensor.ProjectionType == "" {
+		info.Sensor.ProjectionType = ProjectionEquidistant
+	}
 
 	// Calculate FOV if we have focal length and sensor size
 	if info.FocalLength > 0 && info.Sensor.Width > 0 {
-		info.FOV = CalculateFOV(info.FocalLength, info.Sensor)
+		info.FOV = AzimuthalFOV(info.FocalLength, info.Sensor)
+
+		info.FOV.WidthPixels = exifWidth(x)
 
 		// Calculate recommended scale bounds with 20% margin
-		margin := 1.2
-		info.ScaleLow = info.FOV.WidthArcmin / margin
-		info.ScaleHigh = info.FOV.WidthArcmin * margin
+		info.ScaleLow, info.ScaleHigh, _ = info.FOV.SolveScaleBounds(analyzeScaleMargin, "arcminwidth")
 	}
 
+	return info
+}
+
+// errNoEXIFSegment is returned by jpegEXIFSegment for a JPEG without EXIF.
+var errNoEXIFSegment = errors.New("no EXIF APP1 segment before the image data")
+
+// jpegEXIFSegment returns the EXIF data of the JPEG read from r: the
+// contents of its APP1 segment, from the "Exif\x00\x00" header on. It reads
+// only the marker segments up to that one, and stops with
+// errNoEXIFSegment at the start of the image data.
+func jpegEXIFSegment(r io.ReaderAt, size int64) (*io.SectionReader, error) {
+	var marker [4]byte
+	off := int64(2) // After SOI
+	for off+int64(len(marker)) <= size {
+		if _, err := r.ReadAt(marker[:], off); err != nil {
+			return nil, err
+		}
+		if marker[0] != 0xFF {
+			return nil, fmt.Errorf("invalid JPEG marker at offset %d", off)
+		}
+		switch m := marker[1]; {
+		case m == 0xFF: // Fill byte
+			off++
+			continue
+		case m == 0x01 || (m >= 0xD0 && m <= 0xD8): // TEM, RSTn and SOI have no length
+			off += 2
+			continue
+		case m == 0xD9 || m == 0xDA: // EOI, or SOS and the image data
+			return nil, errNoEXIFSegment
+		}
+
+		length := int64(binary.BigEndian.Uint16(marker[2:]))
+		if length < 2 || off+2+length > size {
+			return nil, fmt.Errorf("truncated JPEG segment at offset %d", off)
+		}
+		// APP1 also holds XMP, which is skipped
+		if marker[1] == 0xE1 && length >= 8 {
+			var header [6]byte
+			if _, err := r.ReadAt(header[:], off+4); err != nil {
+				return nil, err
+			}
+			if string(header[:]) == "Exif\x00\x00" {
+				return io.NewSectionReader(r, off+4, length-2), nil
+			}
+		}
+		off += 2 + length
+	}
+	return nil, errNoEXIFSegment
+}
+
+// detectImageFormat returns the SourceFormat of the image read from r,
+// judged by its first bytes, or "" when unrecognised.
+func detectImageFormat(r io.Reader) string {
+	magic := make([]byte, 9)
+	n, _ := io.ReadFull(r, magic)
+	magic = magic[:n]
+	switch {
+	case bytes.HasPrefix(magic, []byte{0xff, 0xd8}):
+		return sourceFormatJPEG
+	case bytes.HasPrefix(magic, []byte("II*\x00")), bytes.HasPrefix(magic, []byte("MM\x00*")):
+		return sourceFormatTIFF
+	case bytes.Equal(magic, []byte("SIMPLE  =")):
+		return sourceFormatFITS
+	}
+	return ""
+}
+
+// analyzeFITS builds the ImageInfo of a FITS image from its header.
+func analyzeFITS(r io.Reader) (*ImageInfo, error) {
+	info := &ImageInfo{SourceFormat: sourceFormatFITS}
+	header, err := fits.ReadHeader(r)
+	if err != nil {
+		return info, fmt.Errorf("failed to read FITS header: %w", err)
+	}
+
+	instrument, _ := header.Get("INSTRUME")
+	info.Model = strings.TrimSpace(instrument)
+	info.Fisheye = isFisheye(info.Model)
+	if filter, ok := header.Get("FILTER"); ok {
+		info.FilterInUse = detectFilter(filter)
+	}
+	if focal, ok := header.Float("FOCALLEN"); ok && focal > 0 {
+		info.FocalLength = focal
+	}
+
+	// Without a pixel size the sensor is unknown, but the rest still helps
+	sensor, brand, sensorErr := sensorFromFITSHeader(header)
+	if sensorErr != nil {
+		return info, nil
+	}
+	info.Make, info.Sensor, info.DetectedFrom = brand, sensor, detectionSourceFITS
+	if info.Fisheye {
+		info.Sensor.ProjectionType = ProjectionEquidistant
+	}
+
+	if info.FocalLength > 0 {
+		info.FOV = AzimuthalFOV(info.FocalLength, info.Sensor)
+		info.FOV.WidthPixels, _ = header.Int("NAXIS1")
+		info.ScaleLow, info.ScaleHigh, _ = info.FOV.SolveScaleBounds(analyzeScaleMargin, "arcminwidth")
+	}
 	return info, nil
 }
 
+// exifWidth returns the image width from the EXIF PixelXDimension tag, or
+// a TIFF's ImageWidth, or 0 when both are absent.
+func exifWidth(x *exif.Exif) int {
+	for _, field := range []exif.FieldName{exif.PixelXDimension, exif.ImageWidth} {
+		tag, err := x.Get(field)
+		if err != nil {
+			continue
+		}
+		if width, err := tag.Int(0); err == nil {
+			return width
+		}
+	}
+	return 0
+}
+
+// exifText returns the text of an ASCII or UNDEFINED EXIF tag. UserComment
+// is UNDEFINED with an 8-byte character code prefix, which is dropped.
+func exifText(tag *tiff.Tag) string {
+	if s, err := tag.StringVal(); err == nil {
+		return s
+	}
+	val := tag.Val
+	if tag.Id == 0x9286 && len(val) >= 8 {
+		val = val[8:]
+	}
+	return strings.TrimRight(string(val), "\x00 ")
+}
+
 // detectSensor attempts to identify the sensor size based on camera make and model.
 // Camera mappings are defined in constants.go and should be reviewed for accuracy.
 func detectSensor(cameraMake, model string) (SensorSize, string) {
@@ -136,6 +361,24 @@ func detectSensor(cameraMake, model string) (SensorSize, string) {
 		}
 	}
 
+	// Fujifilm cameras
+	if contains(makeUpper, "FUJIFILM") {
+		for _, mapping := range fujifilmMappings {
+			if contains(modelUpper, mapping.Pattern) {
+				return mapping.Sensor, detectionSourceEXIF
+			}
+		}
+	}
+
+	// Sigma cameras
+	if contains(makeUpper, "SIGMA") {
+		for _, mapping := range sigmaMappings {
+			if contains(modelUpper, mapping.Pattern) {
+				return mapping.Sensor, detectionSourceEXIF
+			}
+		}
+	}
+
 	// Olympus/OM System
 	if contains(makeUpper, "OLYMPUS") || contains(makeUpper, "OM SYSTEM") {
 		for _, mapping := range olympusMappings {
@@ -164,7 +407,7 @@ func detectSensor(cameraMake, model string) (SensorSize, string) {
 
 // String returns a human-readable summary of the image info.
 func (i *ImageInfo) String() string {
-	if !i.HasEXIF {
+	if !i.HasEXIF && i.SourceFormat != sourceFormatFITS {
 		return "No EXIF data found"
 	}
 
@@ -172,7 +415,13 @@ func (i *ImageInfo) String() string {
 	if i.FocalLength > 0 {
 		result += fmt.Sprintf("Focal Length: %.0fmm\n", i.FocalLength)
 	}
+	if i.LensModel != "" {
+		result += fmt.Sprintf("Lens: %s\n", i.LensModel)
+	}
 	result += fmt.Sprintf("Sensor: %s (%s)\n", i.Sensor.Name, i.DetectedFrom)
+	if i.Fisheye {
+		result += "Fisheye/all-sky: solve a central crop\n"
+	}
 	if i.FOV.WidthDegrees > 0 {
 		result += fmt.Sprintf("FOV: %s\n", i.FOV.String())
 		result += fmt.Sprintf("Recommended scale: %.0f-%.0f arcminwidth", i.ScaleLow, i.ScaleHigh)
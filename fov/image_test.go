@@ -1,9 +1,16 @@
 package fov
 
 import (
+	"bytes"
+	"encoding/binary"
+	"math"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
 )
 
 func TestDetectSensor(t *testing.T) {
@@ -184,6 +191,56 @@ func TestDetectSensor(t *testing.T) {
 			expectedSensor: APSCNikon,
 			expectedFrom:   "default",
 		},
+		// APS-H, medium format and Foveon
+		{
+			name:           "Canon EOS-1D Mark IV",
+			make:           "Canon",
+			model:          "Canon EOS-1D Mark IV",
+			expectedSensor: APSHCanon,
+			expectedFrom:   "exif",
+		},
+		{
+			name:           "Canon EOS-1D X is full frame",
+			make:           "Canon",
+			model:          "Canon EOS-1D X Mark II",
+			expectedSensor: FullFrame,
+			expectedFrom:   "exif",
+		},
+		{
+			name:           "Fujifilm GFX100S",
+			make:           "FUJIFILM",
+			model:          "GFX100S",
+			expectedSensor: MediumFormatGFX,
+			expectedFrom:   "exif",
+		},
+		{
+			name:           "Fujifilm X-T5",
+			make:           "FUJIFILM",
+			model:          "X-T5",
+			expectedSensor: APSCFuji,
+			expectedFrom:   "exif",
+		},
+		{
+			name:           "Fujifilm X-S1 bridge camera",
+			make:           "FUJIFILM",
+			model:          "X-S1",
+			expectedSensor: APSCNikon,
+			expectedFrom:   "default",
+		},
+		{
+			name:           "Sigma sd Quattro H",
+			make:           "SIGMA",
+			model:          "sd Quattro H",
+			expectedSensor: FoveonAPSH,
+			expectedFrom:   "exif",
+		},
+		{
+			name:           "Sigma DP2 Merrill",
+			make:           "SIGMA",
+			model:          "SIGMA DP2 Merrill",
+			expectedSensor: FoveonAPSC,
+			expectedFrom:   "exif",
+		},
 		{
 			name:           "Empty strings",
 			make:           "",
@@ -347,3 +404,233 @@ func TestAnalyzeImage_InvalidEXIF(t *testing.T) {
 		t.Error("AnalyzeImage() HasEXIF should be false for file without EXIF")
 	}
 }
+
+// tiffWithEXIF returns a minimal TIFF whose IFD0 holds the width, make and
+// model and whose Exif IFD holds the focal length in mm.
+func tiffWithEXIF(cameraMake, model string, width int, focalLength uint32) []byte {
+	le := binary.LittleEndian
+	makeOff := uint32(8 + 2 + 4*12 + 4 + 2 + 12 + 4)
+	modelOff := makeOff + uint32(len(cameraMake)+1)
+	focalOff := modelOff + uint32(len(model)+1)
+
+	var tiff bytes.Buffer
+	tiff.WriteString("II")
+	_ = binary.Write(&tiff, le, uint16(42))
+	_ = binary.Write(&tiff, le, uint32(8))
+	// IFD0 at 8: ImageWidth, Make, Model, ExifIFDPointer -> 62
+	_ = binary.Write(&tiff, le, uint16(4))
+	_ = binary.Write(&tiff, le, []uint16{0x0100, 3})
+	_ = binary.Write(&tiff, le, []uint32{1, uint32(width)})
+	_ = binary.Write(&tiff, le, []uint16{0x010f, 2})
+	_ = binary.Write(&tiff, le, []uint32{uint32(len(cameraMake) + 1), makeOff})
+	_ = binary.Write(&tiff, le, []uint16{0x0110, 2})
+	_ = binary.Write(&tiff, le, []uint32{uint32(len(model) + 1), modelOff})
+	_ = binary.Write(&tiff, le, []uint16{0x8769, 4})
+	_ = binary.Write(&tiff, le, []uint32{1, 62, 0})
+	// Exif IFD at 62: FocalLength (RATIONAL)
+	_ = binary.Write(&tiff, le, uint16(1))
+	_ = binary.Write(&tiff, le, []uint16{0x920a, 5})
+	_ = binary.Write(&tiff, le, []uint32{1, focalOff, 0})
+	tiff.WriteString(cameraMake + "\x00" + model + "\x00")
+	_ = binary.Write(&tiff, le, []uint32{focalLength, 1})
+	return tiff.Bytes()
+}
+
+// jpegWithEXIF wraps a TIFF EXIF block in a minimal JPEG.
+func jpegWithEXIF(tiff []byte) []byte {
+	var jpeg bytes.Buffer
+	jpeg.Write([]byte{0xFF, 0xD8, 0xFF, 0xE1})
+	_ = binary.Write(&jpeg, binary.BigEndian, uint16(2+6+len(tiff)))
+	jpeg.WriteString("Exif\x00\x00")
+	jpeg.Write(tiff)
+	jpeg.Write([]byte{0xFF, 0xD9})
+	return jpeg.Bytes()
+}
+
+// writeFile writes data to name in a temporary directory.
+func writeFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAnalyzeImage_Formats(t *testing.T) {
+	// The same virtual setup three ways: an APS-C Nikon sensor (23.6 x 15.7
+	// mm, 5900 pixels of 4 µm across) behind a 300 mm lens
+	tiffData := tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, 300)
+	jpegPath := writeFile(t, "frame.jpg", jpegWithEXIF(tiffData))
+	tiffPath := writeFile(t, "frame.tif", tiffData)
+	// Keywords as written by N.I.N.A.
+	fitsPath := writeFITSHeader(t, []fits.Card{
+		{Key: "NAXIS1", Value: "5900"}, {Key: "NAXIS2", Value: "3925"},
+		{Key: "IMAGETYP", Value: fits.Quote("LIGHT")},
+		{Key: "EXPOSURE", Value: "120.0"}, {Key: "EXPTIME", Value: "120.0"},
+		{Key: "XBINNING", Value: "1"}, {Key: "YBINNING", Value: "1"},
+		{Key: "XPIXSZ", Value: "4"}, {Key: "YPIXSZ", Value: "4"},
+		{Key: "INSTRUME", Value: fits.Quote("ZWO ASI2600MM Pro")},
+		{Key: "TELESCOP", Value: fits.Quote("Sharpstar 61EDPH")},
+		{Key: "FOCALLEN", Value: "300"}, {Key: "FOCRATIO", Value: "4.9"},
+		{Key: "FILTER", Value: fits.Quote("Ha")},
+		{Key: "CCD-TEMP", Value: "-10.0"}, {Key: "GAIN", Value: "100"},
+	})
+
+	jpeg, err := AnalyzeImage(jpegPath)
+	if err != nil {
+		t.Fatalf("AnalyzeImage(jpeg) failed: %v", err)
+	}
+	if jpeg.SourceFormat != "jpeg" || jpeg.DetectedFrom != "exif" || jpeg.Sensor != APSCNikon || jpeg.FocalLength != 300 {
+		t.Fatalf("jpeg info = %+v", jpeg)
+	}
+
+	tests := []struct {
+		path, wantFormat, wantFrom, wantMake, wantModel string
+	}{
+		{tiffPath, "tiff", "exif", "NIKON CORPORATION", "NIKON D7500"},
+		{fitsPath, "fits", "fits", "ZWO", "ZWO ASI2600MM Pro"},
+	}
+	for _, tt := range tests {
+		info, err := AnalyzeImage(tt.path)
+		if err != nil {
+			t.Fatalf("AnalyzeImage(%s) failed: %v", tt.wantFormat, err)
+		}
+		if info.SourceFormat != tt.wantFormat || info.DetectedFrom != tt.wantFrom ||
+			info.Make != tt.wantMake || info.Model != tt.wantModel {
+			t.Errorf("%s: format %q, detected from %q, camera %q %q",
+				tt.wantFormat, info.SourceFormat, info.DetectedFrom, info.Make, info.Model)
+		}
+		if math.Abs(info.FOV.WidthDegrees-jpeg.FOV.WidthDegrees) > 1e-9 ||
+			math.Abs(info.FOV.HeightDegrees-jpeg.FOV.HeightDegrees) > 1e-9 ||
+			info.FOV.WidthPixels != jpeg.FOV.WidthPixels ||
+			math.Abs(info.ScaleLow-jpeg.ScaleLow) > 1e-6 || math.Abs(info.ScaleHigh-jpeg.ScaleHigh) > 1e-6 {
+			t.Errorf("%s: FOV %+v, scale %.3f-%.3f; jpeg FOV %+v, scale %.3f-%.3f", tt.wantFormat,
+				info.FOV, info.ScaleLow, info.ScaleHigh, jpeg.FOV, jpeg.ScaleLow, jpeg.ScaleHigh)
+		}
+	}
+
+	info, _ := AnalyzeImage(fitsPath)
+	if info.FilterInUse == "" || strings.Contains(info.String(), "No EXIF") {
+		t.Errorf("FITS filter %q, summary %q", info.FilterInUse, info.String())
+	}
+}
+
+// readTracker is an io.ReaderAt that records the furthest byte read.
+type readTracker struct {
+	r   *bytes.Reader
+	end int64
+}
+
+func (t *readTracker) ReadAt(p []byte, off int64) (int, error) {
+	n, err := t.r.ReadAt(p, off)
+	t.end = max(t.end, off+int64(n))
+	return n, err
+}
+
+// jpegWithScan appends a JFIF APP0 segment, the segments in head and a
+// scan of scanSize bytes to a JPEG SOI marker.
+func jpegWithScan(head []byte, scanSize int) []byte {
+	jpeg := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10}
+	jpeg = append(jpeg, "JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"...)
+	jpeg = append(jpeg, head...)
+	jpeg = append(jpeg, 0xFF, 0xDA, 0x00, 0x02)
+	jpeg = append(jpeg, make([]byte, scanSize)...)
+	return append(jpeg, 0xFF, 0xD9)
+}
+
+func TestAnalyzeImageReader_StopsAfterEXIF(t *testing.T) {
+	app1 := jpegWithEXIF(tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, 300))
+	app1 = app1[2 : len(app1)-2] // Without SOI and EOI
+	data := jpegWithScan(app1, 1<<20)
+	headerEnd := int64(len(data) - 1<<20 - 6)
+
+	tracker := &readTracker{r: bytes.NewReader(data)}
+	info, err := AnalyzeImageReader(tracker, int64(len(data)))
+	if err != nil {
+		t.Fatalf("AnalyzeImageReader failed: %v", err)
+	}
+	if info.Sensor != APSCNikon || info.FocalLength != 300 || info.FOV.WidthPixels != 5900 {
+		t.Errorf("info = %+v", info)
+	}
+	if tracker.end > headerEnd {
+		t.Errorf("read to byte %d, want no further than the EXIF segment's end at %d", tracker.end, headerEnd)
+	}
+
+	// Without EXIF, reading stops at the scan
+	data = jpegWithScan(nil, 1<<20)
+	tracker = &readTracker{r: bytes.NewReader(data)}
+	info, err = AnalyzeImageReader(tracker, int64(len(data)))
+	if err == nil || info == nil || info.HasEXIF || info.SourceFormat != sourceFormatJPEG {
+		t.Errorf("JPEG without EXIF = %+v, %v; want a partial info and an error", info, err)
+	}
+	if tracker.end > 24 {
+		t.Errorf("read to byte %d of a JPEG without EXIF, want to stop at the scan", tracker.end)
+	}
+}
+
+func TestAnalyzeImageBytes(t *testing.T) {
+	tiffData := tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, 300)
+	for _, data := range [][]byte{jpegWithEXIF(tiffData), tiffData} {
+		fromBytes, err := AnalyzeImageBytes(data)
+		if err != nil {
+			t.Fatalf("AnalyzeImageBytes failed: %v", err)
+		}
+		fromFile, err := AnalyzeImage(writeFile(t, "frame", data))
+		if err != nil {
+			t.Fatalf("AnalyzeImage failed: %v", err)
+		}
+		if fromBytes.String() != fromFile.String() || fromBytes.SourceFormat != fromFile.SourceFormat {
+			t.Errorf("AnalyzeImageBytes = %+v, AnalyzeImage = %+v", fromBytes, fromFile)
+		}
+	}
+
+	if info, err := AnalyzeImageBytes(nil); err == nil || info == nil {
+		t.Errorf("AnalyzeImageBytes(nil) = %+v, %v; want a partial info and an error", info, err)
+	}
+}
+
+// exifDecodeOverhead bounds what goexif allocates per decode beyond the
+// EXIF segment itself: the copy in Exif.Raw, its tag maps and the parsed
+// IFDs. About 9 KB for the test segment.
+const exifDecodeOverhead = 16 << 10
+
+func TestAnalyzeImageBytes_Allocations(t *testing.T) {
+	app1 := jpegWithEXIF(tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, 300))
+	const runs = 20
+	perCall := func(scanSize int) uint64 {
+		data := jpegWithScan(app1[2:len(app1)-2], scanSize)
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		for range runs {
+			if _, err := AnalyzeImageBytes(data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		runtime.ReadMemStats(&after)
+		return (after.TotalAlloc - before.TotalAlloc) / runs
+	}
+
+	small, large := perCall(1<<10), perCall(8<<20)
+	if limit := uint64(len(app1) + exifDecodeOverhead); large > limit {
+		t.Errorf("AnalyzeImageBytes allocated %d bytes per call for an 8 MB JPEG, want at most the %d byte EXIF segment plus %d",
+			large, len(app1), exifDecodeOverhead)
+	}
+	// Allocation must not grow with the image data after the header
+	if large > small+1<<10 {
+		t.Errorf("AnalyzeImageBytes allocated %d bytes for an 8 MB JPEG and %d for a 1 KB one", large, small)
+	}
+}
+
+func BenchmarkAnalyzeImageBytes(b *testing.B) {
+	app1 := jpegWithEXIF(tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, 300))
+	data := jpegWithScan(app1[2:len(app1)-2], 8<<20)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for b.Loop() {
+		if _, err := AnalyzeImageBytes(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -12,6 +12,16 @@ type IndexFile struct {
 	MaxFOV      float64 // Maximum field width in degrees
 	SizeMB      float64 // File size in megabytes
 	DownloadURL string  // URL to download the index
+	SizeBytes   int64   // Exact file size, if known; used to verify downloads
+	SHA256      string  // Hex SHA-256 of the file, if known; used to verify downloads
+
+	// SizeMBPerDegree is SizeMB / (MaxFOV - MinFOV), the disk cost of each
+	// degree of field width covered. It is filled in for AllIndexFiles.
+	SizeMBPerDegree float64
+
+	// Priority orders indexes for SortByPriority, lowest first. It is
+	// unset (0) in AllIndexFiles and is for callers' own preferences.
+	Priority int
 }
 
 // AllIndexFiles contains metadata for all available 4100-series index files.
@@ -32,12 +42,26 @@ var AllIndexFiles = []IndexFile{
 	{Name: "index-4119", MinFOV: 0.1, MaxFOV: 0.2, SizeMB: 0.144, DownloadURL: "http://data.astrometry.net/4100/index-4119.fits"},
 }
 
+func init() {
+	for i := range AllIndexFiles {
+		AllIndexFiles[i].SizeMBPerDegree = AllIndexFiles[i].sizePerDegree()
+	}
+}
+
 // IndexRecommendation contains recommended index files for a given FOV.
 type IndexRecommendation struct {
-	TargetFOV      FieldOfView
-	Indexes        []IndexFile
-	TotalSizeMB    float64
+	TargetFOV   FieldOfView
+	Indexes     []IndexFile
+	TotalSizeMB float64
+
+	// DownloadScript is a bash/wget script. Use Script for other formats
+	// and download verification.
 	DownloadScript string
+
+	// Warning is set when the field is wider than any index covers, such
+	// as an all-sky frame; the wide end of the range then has no indexes
+	// and the image should be solved from a central crop.
+	Warning string
 }
 
 // RecommendIndexes recommends index files for a given field of view.
@@ -52,22 +76,65 @@ type IndexRecommendation struct {
 //	fov := fov.CalculateFOV(50, fov.APSCNikon)
 //	rec := fov.RecommendIndexes(fov.WidthDegrees, 1.5)
 //	fmt.Println(rec.DownloadScript)
+//
+// Results are cached, so repeated calls with the same arguments are cheap.
+// Each call returns its own copy of the Indexes slice, which the caller may
+// modify. See SetRecommendationCacheSize and ClearRecommendationCache.
 func RecommendIndexes(fovDegrees, margin float64) IndexRecommendation {
-	minFOV := fovDegrees / margin
-	maxFOV := fovDegrees * margin
+	return RecommendIndexesWithBudget(fovDegrees, margin, 0)
+}
+
+// RecommendIndexesWithBudget is RecommendIndexes limited to maxTotalMB of
+// downloads. When the bracketing indexes exceed the budget, they are taken
+// in SortByEfficiency order (most field width per megabyte first) while
+// they fit, so a tight budget keeps the small narrow-field indexes and
+// drops the large wide-field ones. A maxTotalMB of 0 or less means no
+// budget. Results are cached like RecommendIndexes.
+//
+// Example:
+//
+//	rec := fov.RecommendIndexesWithBudget(3.5, 1.5, 50)
+//	fmt.Printf("%d indexes, %.1f MB\n", len(rec.Indexes), rec.TotalSizeMB)
+func RecommendIndexesWithBudget(fovDegrees, margin, maxTotalMB float64) IndexRecommendation {
+	maxTotalMB = max(maxTotalMB, 0)
+	key := recommendationKey{fovDegrees: fovDegrees, margin: margin, maxTotalMB: maxTotalMB, series: indexSeries}
+	if rec, ok := recommendations.get(key); ok {
+		return rec
+	}
+
+	rec := recommendIndexes(fovDegrees, margin, maxTotalMB)
+	recommendations.put(key, rec)
+	return rec
+}
+
+// recommendIndexes computes the recommendation for
+// RecommendIndexesWithBudget; maxTotalMB 0 means no budget.
+func recommendIndexes(fovDegrees, margin, maxTotalMB float64) IndexRecommendation {
+	minFOV, maxFOV := marginRange(fovDegrees, margin)
 
 	var recommended []IndexFile
-	for _, idx := range AllIndexFiles {
+	for _, idx := range IndexCatalog() {
 		// Include if there's overlap with our FOV range
-		if idx.MaxFOV >= minFOV && idx.MinFOV <= maxFOV {
+		if idx.overlaps(minFOV, maxFOV) {
 			recommended = append(recommended, idx)
 		}
 	}
 
+	// Keep the most efficient indexes that fit the budget
+	if maxTotalMB > 0 {
+		var withinBudget []IndexFile
+		var size float64
+		for _, idx := range SortIndexFiles(recommended, SortByEfficiency) {
+			if size+idx.SizeMB <= maxTotalMB {
+				withinBudget = append(withinBudget, idx)
+				size += idx.SizeMB
+			}
+		}
+		recommended = withinBudget
+	}
+
 	// Sort by MinFOV (narrowest to widest)
-	sort.Slice(recommended, func(i, j int) bool {
-		return recommended[i].MinFOV < recommended[j].MinFOV
-	})
+	recommended = SortIndexFiles(recommended, SortByMinFOV)
 
 	// Calculate total size
 	var totalSize float64
@@ -78,6 +145,9 @@ func RecommendIndexes(fovDegrees, margin float64) IndexRecommendation {
 	// Generate download script
 	script := "#!/bin/bash\n# Download recommended astrometry index files\n\n"
 	script += fmt.Sprintf("# Target FOV: %.2f degrees\n", fovDegrees)
+	if maxTotalMB > 0 {
+		script += fmt.Sprintf("# Download budget: %.1f MB\n", maxTotalMB)
+	}
 	script += fmt.Sprintf("# Total download size: %.1f MB\n\n", totalSize)
 	script += "mkdir -p astrometry-data && cd astrometry-data\n\n"
 	for _, idx := range recommended {
@@ -89,6 +159,7 @@ func RecommendIndexes(fovDegrees, margin float64) IndexRecommendation {
 		Indexes:        recommended,
 		TotalSizeMB:    totalSize,
 		DownloadScript: script,
+		Warning:        coverageWarning(fovDegrees),
 	}
 }
 
@@ -114,13 +185,13 @@ func RecommendIndexesForLens(minFocalLength, maxFocalLength float64, sensor Sens
 
 	// We need to cover from the narrowest FOV (maxFocalLength) to widest FOV (minFocalLength)
 	// Apply margin to both ends
-	fovMin := minFOV.WidthDegrees / margin
-	fovMax := maxFOV.WidthDegrees * margin
+	fovMin, _ := marginRange(minFOV.WidthDegrees, margin)
+	_, fovMax := marginRange(maxFOV.WidthDegrees, margin)
 
 	var recommended []IndexFile
-	for _, idx := range AllIndexFiles {
+	for _, idx := range IndexCatalog() {
 		// Include if there's overlap with our FOV range
-		if idx.MaxFOV >= fovMin && idx.MinFOV <= fovMax {
+		if idx.overlaps(fovMin, fovMax) {
 			recommended = append(recommended, idx)
 		}
 	}
@@ -152,12 +223,27 @@ func RecommendIndexesForLens(minFocalLength, maxFocalLength float64, sensor Sens
 		Indexes:        recommended,
 		TotalSizeMB:    totalSize,
 		DownloadScript: script,
+		Warning:        coverageWarning(maxFOV.WidthDegrees),
 	}
 }
 
+// coverageWarning returns the IndexRecommendation warning for a field
+// fovDegrees wide, or "" when an index covers it.
+func coverageWarning(fovDegrees float64) string {
+	widest := widestIndexFOV()
+	if fovDegrees <= widest {
+		return ""
+	}
+	return fmt.Sprintf("no index in the %s series covers a %.1f° field (widest %.1f°); "+
+		"solve a central crop of at most %.0f° instead", indexSeries, fovDegrees, widest, widest)
+}
+
 // String returns a human-readable summary of the recommendation.
 func (r IndexRecommendation) String() string {
 	result := fmt.Sprintf("Recommended indexes for FOV %s:\n", r.TargetFOV.String())
+	if r.Warning != "" {
+		result += fmt.Sprintf("Warning: %s\n", r.Warning)
+	}
 	result += fmt.Sprintf("Total download size: %.1f MB\n\n", r.TotalSizeMB)
 	for _, idx := range r.Indexes {
 		result += fmt.Sprintf("  %s: %.2f° - %.2f° (%.1f MB)\n",
@@ -0,0 +1,87 @@
+package fov
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"github.com/rwcarlsen/goexif/tiff"
+)
+
+// lensSpecificationTag is the Exif IFD's LensSpecification tag: the
+// minimum and maximum focal length and the apertures at each, as four
+// RATIONALs. goexif does not name it.
+const lensSpecificationTag = 0xA432
+
+// lensFocalPattern matches a focal length or zoom range in a lens name,
+// such as "135mm" or "18-55mm", but not a version number like "44-2".
+var lensFocalPattern = regexp.MustCompile(`(?i)(?:^|[^\d.])(\d+(?:\.\d+)?)(?:\s*[-–~]\s*(\d+(?:\.\d+)?))?\s*mm`)
+
+// lensFocalLength returns the focal length of a prime lens from the EXIF
+// LensSpecification, or failing that from lensModel, such as 135 for
+// "Samyang 135mm F2". Manual and adapted lenses often report no
+// FocalLength but still name the lens. Zoom lenses give no focal length.
+func lensFocalLength(x *exif.Exif, lensModel string) (float64, bool) {
+	if low, high, ok := exifLensSpecification(x); ok {
+		if low == high {
+			return low, true
+		}
+		return 0, false
+	}
+	return focalLengthFromLensModel(lensModel)
+}
+
+// focalLengthFromLensModel parses the focal length of a prime lens out of
+// its name. It fails for zooms and names without a focal length in mm.
+func focalLengthFromLensModel(lensModel string) (float64, bool) {
+	m := lensFocalPattern.FindStringSubmatch(lensModel)
+	if m == nil {
+		return 0, false
+	}
+	focal, err := strconv.ParseFloat(m[1], 64)
+	if err != nil || focal <= 0 {
+		return 0, false
+	}
+	if m[2] != "" {
+		if tele, err := strconv.ParseFloat(m[2], 64); err != nil || tele != focal {
+			return 0, false
+		}
+	}
+	return focal, true
+}
+
+// exifLensSpecification returns the focal length range from the Exif IFD's
+// LensSpecification tag, when present with a non-zero minimum.
+func exifLensSpecification(x *exif.Exif) (low, high float64, ok bool) {
+	ptr, err := x.Get(exif.ExifIFDPointer)
+	if err != nil || x.Tiff == nil {
+		return 0, 0, false
+	}
+	offset, err := ptr.Int64(0)
+	if err != nil {
+		return 0, 0, false
+	}
+	r := bytes.NewReader(x.Raw)
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return 0, 0, false
+	}
+	dir, _, err := tiff.DecodeDir(r, x.Tiff.Order)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	for _, tag := range dir.Tags {
+		if tag.Id != lensSpecificationTag || tag.Count < 2 {
+			continue
+		}
+		lowNum, lowDenom, lowErr := tag.Rat2(0)
+		highNum, highDenom, highErr := tag.Rat2(1)
+		if lowErr != nil || highErr != nil || lowDenom == 0 || highDenom == 0 || lowNum <= 0 {
+			return 0, 0, false
+		}
+		return float64(lowNum) / float64(lowDenom), float64(highNum) / float64(highDenom), true
+	}
+	return 0, 0, false
+}
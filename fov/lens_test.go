@@ -0,0 +1,115 @@
+package fov
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestFocalLengthFromLensModel(t *testing.T) {
+	tests := []struct {
+		lens   string
+		want   float64
+		wantOK bool
+	}{
+		{"Samyang 135mm F2", 135, true},
+		{"Rokinon 14mm F2.8 ED AS IF UMC", 14, true},
+		{"TTArtisan 7.5mm f/2 Fisheye", 7.5, true},
+		{"Helios 44-2 58mm f/2", 58, true},
+		{"EF100mm f/2.8L Macro IS USM", 100, true},
+		{"Sigma 105mm F1.4 DG HSM | Art 018", 105, true},
+		{"Meade 80 MM APO", 80, true},
+		{"AF-S NIKKOR 18-55mm f/3.5-5.6G", 0, false},
+		{"FE 70 - 200mm F2.8 GM OSS", 0, false},
+		{"Manual lens", 0, false},
+		{"0mm", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := focalLengthFromLensModel(tt.lens)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("focalLengthFromLensModel(%q) = %v, %v; want %v, %v", tt.lens, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+// tiffWithLens returns a minimal Nikon TIFF without FocalLength whose Exif
+// IFD holds lensModel and, when spec is set, LensSpecification as
+// numerator/denominator pairs.
+func tiffWithLens(lensModel string, spec []uint32) []byte {
+	const cameraMake, model = "NIKON CORPORATION", "NIKON D7500"
+	le := binary.LittleEndian
+	entries := uint32(1)
+	if spec != nil {
+		entries++
+	}
+	exifOff := uint32(8 + 2 + 3*12 + 4)
+	makeOff := exifOff + 2 + 12*entries + 4
+	modelOff := makeOff + uint32(len(cameraMake)+1)
+	lensOff := modelOff + uint32(len(model)+1)
+	specOff := lensOff + uint32(len(lensModel)+1)
+
+	var tiff bytes.Buffer
+	tiff.WriteString("II")
+	_ = binary.Write(&tiff, le, uint16(42))
+	_ = binary.Write(&tiff, le, uint32(8))
+	// IFD0: Make, Model, ExifIFDPointer
+	_ = binary.Write(&tiff, le, uint16(3))
+	_ = binary.Write(&tiff, le, []uint16{0x010f, 2})
+	_ = binary.Write(&tiff, le, []uint32{uint32(len(cameraMake) + 1), makeOff})
+	_ = binary.Write(&tiff, le, []uint16{0x0110, 2})
+	_ = binary.Write(&tiff, le, []uint32{uint32(len(model) + 1), modelOff})
+	_ = binary.Write(&tiff, le, []uint16{0x8769, 4})
+	_ = binary.Write(&tiff, le, []uint32{1, exifOff, 0})
+	// Exif IFD: LensSpecification (RATIONAL), LensModel
+	_ = binary.Write(&tiff, le, uint16(entries))
+	if spec != nil {
+		_ = binary.Write(&tiff, le, []uint16{0xa432, 5})
+		_ = binary.Write(&tiff, le, []uint32{uint32(len(spec) / 2), specOff})
+	}
+	_ = binary.Write(&tiff, le, []uint16{0xa434, 2})
+	_ = binary.Write(&tiff, le, []uint32{uint32(len(lensModel) + 1), lensOff, 0})
+	tiff.WriteString(cameraMake + "\x00" + model + "\x00" + lensModel + "\x00")
+	_ = binary.Write(&tiff, le, spec)
+	return tiff.Bytes()
+}
+
+func TestAnalyzeImage_FocalLengthFromLens(t *testing.T) {
+	tests := []struct {
+		name      string
+		lensModel string
+		spec      []uint32
+		wantFocal float64
+		wantFrom  string
+	}{
+		{"lens model", "Samyang 135mm F2 ED UMC", nil, 135, "lensmodel"},
+		{"lens specification", "Manual lens", []uint32{50, 1, 50, 1, 14, 10, 14, 10}, 50, "lensmodel"},
+		{"zoom specification", "Samyang 135mm F2", []uint32{18, 1, 55, 1, 35, 10, 56, 10}, 0, "exif"},
+		{"no focal length", "Manual lens", nil, 0, "exif"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := AnalyzeImageBytes(jpegWithEXIF(tiffWithLens(tt.lensModel, tt.spec)))
+			if err != nil {
+				t.Fatalf("AnalyzeImageBytes failed: %v", err)
+			}
+			if info.FocalLength != tt.wantFocal || info.DetectedFrom != tt.wantFrom {
+				t.Errorf("FocalLength %v from %q, want %v from %q", info.FocalLength, info.DetectedFrom, tt.wantFocal, tt.wantFrom)
+			}
+			if info.LensModel != tt.lensModel || info.Sensor != APSCNikon {
+				t.Errorf("lens %q, sensor %s", info.LensModel, info.Sensor.Name)
+			}
+			if wantFOV := CalculateFOV(tt.wantFocal, APSCNikon).WidthDegrees; tt.wantFocal > 0 &&
+				math.Abs(info.FOV.WidthDegrees-wantFOV) > 1e-9 {
+				t.Errorf("FOV %.3f°, want %.3f°", info.FOV.WidthDegrees, wantFOV)
+			}
+		})
+	}
+
+	// A numeric FocalLength takes precedence
+	info, err := AnalyzeImageBytes(jpegWithEXIF(tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, 300)))
+	if err != nil || info.FocalLength != 300 || info.DetectedFrom != "exif" {
+		t.Errorf("info = %+v, %v; want 300 mm from exif", info, err)
+	}
+}
@@ -0,0 +1,70 @@
+package fov
+
+import (
+	"math"
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
+
+// AltAzToRaDec converts a horizontal position, such as a mount's encoder
+// readout, to equatorial coordinates for an observer at latitude and
+// longitude (degrees, north and east positive) at time t. Azimuth is
+// measured from north through east. It returns RA and Dec in degrees in
+// the equinox of date, close enough to J2000 for a solve hint.
+//
+// Refraction is ignored, so positions near the horizon read about half a
+// degree low.
+func AltAzToRaDec(alt, az, latitude, longitude float64, t time.Time) (ra, dec float64) {
+	altR, azR, latR := alt*math.Pi/180, az*math.Pi/180, latitude*math.Pi/180
+
+	sinDec := math.Sin(altR)*math.Sin(latR) + math.Cos(altR)*math.Cos(latR)*math.Cos(azR)
+	dec = math.Asin(math.Max(-1, math.Min(1, sinDec))) * 180 / math.Pi
+
+	ha := math.Atan2(-math.Sin(azR)*math.Cos(altR),
+		math.Sin(altR)*math.Cos(latR)-math.Cos(altR)*math.Sin(latR)*math.Cos(azR)) * 180 / math.Pi
+	ra = math.Mod(localSiderealTime(longitude, t)-ha+720, 360)
+	return ra, dec
+}
+
+// HourAngleToDec converts an equatorial mount's hour angle and declination
+// (degrees, hour angle positive west of the meridian) to altitude and
+// azimuth (degrees, azimuth from north through east) for an observer at
+// latitude.
+func HourAngleToDec(ha, dec, latitude float64) (alt, az float64) {
+	haR, decR, latR := ha*math.Pi/180, dec*math.Pi/180, latitude*math.Pi/180
+
+	sinAlt := math.Sin(decR)*math.Sin(latR) + math.Cos(decR)*math.Cos(latR)*math.Cos(haR)
+	alt = math.Asin(math.Max(-1, math.Min(1, sinAlt))) * 180 / math.Pi
+
+	az = math.Atan2(-math.Sin(haR)*math.Cos(decR),
+		math.Sin(decR)*math.Cos(latR)-math.Cos(decR)*math.Sin(latR)*math.Cos(haR)) * 180 / math.Pi
+	return alt, math.Mod(az+360, 360)
+}
+
+// MountPositionHint returns solve options hinting the position a mount
+// reports in alt/az, searching within radius degrees of it. Mount pointing
+// is rarely better than a degree or two, so radius should allow for that
+// plus the field size. It seeds solving when the image has no EXIF or
+// header coordinates.
+// The options are a client.SolveOptions.
+//
+// Example:
+//
+//	opts := fov.MountPositionHint(alt, az, 52.2, -7.1, time.Now(), 5)
+//	result, err := c.Solve(ctx, "frame.fits", opts)
+func MountPositionHint(alt, az, latitude, longitude float64, t time.Time, radius float64) *solver.SolveOptions {
+	opts := solver.DefaultSolveOptions()
+	opts.RA, opts.Dec = AltAzToRaDec(alt, az, latitude, longitude, t)
+	opts.Radius = radius
+	return opts
+}
+
+// localSiderealTime returns the local mean sidereal time in degrees at
+// longitude (east positive), from the IAU 1982 GMST expression.
+func localSiderealTime(longitude float64, t time.Time) float64 {
+	d := julianDate(t) - 2451545.0
+	centuries := d / 36525
+	gmst := 280.46061837 + 360.98564736629*d + 0.000387933*centuries*centuries
+	return math.Mod(math.Mod(gmst+longitude, 360)+360, 360)
+}
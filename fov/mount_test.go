@@ -0,0 +1,95 @@
+package fov
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// j2000 is 2000-01-01 12:00 UTC, when GMST is 280.46061837°.
+var j2000 = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestAltAzToRaDec_Transit(t *testing.T) {
+	tests := []struct {
+		latitude, dec float64
+	}{
+		{52, 30},
+		{52, -20},
+		{-33, -60},
+		{10, 5},
+	}
+	for _, tt := range tests {
+		// On the meridian south of the zenith, the altitude falls short of
+		// 90° by the distance between the object and the zenith
+		alt := 90 - math.Abs(tt.latitude-tt.dec)
+		az := 180.0
+		if tt.dec > tt.latitude {
+			az = 0 // north of the zenith
+		}
+		ra, dec := AltAzToRaDec(alt, az, tt.latitude, 0, j2000)
+		if math.Abs(dec-tt.dec) > 1e-9 {
+			t.Errorf("lat %v: dec = %v, want %v", tt.latitude, dec, tt.dec)
+		}
+		// Hour angle 0, so RA is the local sidereal time
+		if math.Abs(ra-280.46061837) > 1e-6 {
+			t.Errorf("lat %v: ra = %v, want 280.46061837", tt.latitude, ra)
+		}
+	}
+}
+
+func TestAltAzToRaDec_Horizon(t *testing.T) {
+	const latitude = 52.0
+	tests := []struct {
+		az      float64
+		dec, ha float64
+	}{
+		{0, 90 - latitude, 180}, // due north: lower culmination of a circumpolar star
+		{90, 0, -90},            // due east: the celestial equator rising
+		{270, 0, 90},            // due west: setting
+		{180, latitude - 90, 0}, // due south
+	}
+	for _, tt := range tests {
+		ra, dec := AltAzToRaDec(0, tt.az, latitude, 0, j2000)
+		if math.Abs(dec-tt.dec) > 1e-9 {
+			t.Errorf("az %v: dec = %v, want %v", tt.az, dec, tt.dec)
+		}
+		wantRA := math.Mod(280.46061837-tt.ha+360, 360)
+		if math.Abs(ra-wantRA) > 1e-6 {
+			t.Errorf("az %v: ra = %v, want %v", tt.az, ra, wantRA)
+		}
+	}
+}
+
+func TestAltAzToRaDec_Longitude(t *testing.T) {
+	// 15° east is one sidereal hour later in RA
+	ra0, _ := AltAzToRaDec(40, 120, 52, 0, j2000)
+	ra15, _ := AltAzToRaDec(40, 120, 52, 15, j2000)
+	if diff := math.Mod(ra15-ra0+360, 360); math.Abs(diff-15) > 1e-9 {
+		t.Errorf("RA shift for 15° east = %v, want 15", diff)
+	}
+}
+
+func TestHourAngleToDec_RoundTrip(t *testing.T) {
+	const latitude = 52.0
+	lst := localSiderealTime(0, j2000)
+	for _, ha := range []float64{-120, -45, 0, 30, 150} {
+		for _, dec := range []float64{-30, 0, 45, 80} {
+			alt, az := HourAngleToDec(ha, dec, latitude)
+			gotRA, gotDec := AltAzToRaDec(alt, az, latitude, 0, j2000)
+			wantRA := math.Mod(lst-ha+360, 360)
+			if math.Abs(gotDec-dec) > 1e-9 || math.Abs(math.Remainder(gotRA-wantRA, 360)) > 1e-9 {
+				t.Errorf("ha %v dec %v: round trip gave ra %v dec %v, want ra %v", ha, dec, gotRA, gotDec, wantRA)
+			}
+		}
+	}
+}
+
+func TestMountPositionHint(t *testing.T) {
+	opts := MountPositionHint(68, 180, 52, 0, j2000, 5)
+	if math.Abs(opts.Dec-30) > 1e-9 || math.Abs(opts.RA-280.46061837) > 1e-6 || opts.Radius != 5 {
+		t.Errorf("hint = RA %v Dec %v radius %v, want 280.46 30 5", opts.RA, opts.Dec, opts.Radius)
+	}
+	if opts.ScaleUnits == "" {
+		t.Error("hint should start from the default solve options")
+	}
+}
@@ -0,0 +1,52 @@
+package fov
+
+import "math"
+
+const (
+	// PolarisDec is the J2000 declination of Polaris (α UMi) in degrees.
+	PolarisDec = 89.264
+
+	// SigmaOctantisDec is the J2000 declination of σ Octantis, the southern
+	// pole star, in degrees.
+	SigmaOctantisDec = -88.956
+)
+
+// IsCircumpolar reports whether an object at declination dec never sets when
+// observed from latitude, i.e. |dec| > 90 - |latitude| with the object in the
+// observer's hemisphere. Objects near the opposite pole never rise and are
+// not circumpolar.
+func IsCircumpolar(dec, latitude float64) bool {
+	if dec*latitude <= 0 {
+		return false
+	}
+	return math.Abs(dec) > 90-math.Abs(latitude)
+}
+
+// PolarisSuitability rates how well Polaris serves for polar alignment at
+// latitude (degrees, north positive). Polaris sits at an altitude close to
+// the observer's latitude, so the rating follows how far it clears horizon
+// haze and obstructions:
+//
+//	"excellent"  above 60°N
+//	"good"       30°N to 60°N
+//	"poor"       10°N to 30°N
+//	"unusable"   below 10°N or in the southern hemisphere
+func PolarisSuitability(latitude float64) string {
+	switch {
+	case latitude > 60:
+		return "excellent"
+	case latitude >= 30:
+		return "good"
+	case latitude >= 10:
+		return "poor"
+	default:
+		return "unusable"
+	}
+}
+
+// SigmaOctantisVisibility reports whether σ Octantis is circumpolar from
+// latitude and so can be used as the southern pole star. It is faint
+// (magnitude 5.4), so a clear dark sky is still needed to see it.
+func SigmaOctantisVisibility(latitude float64) bool {
+	return IsCircumpolar(SigmaOctantisDec, latitude)
+}
@@ -0,0 +1,55 @@
+package fov
+
+import "testing"
+
+func TestPolarHelpers(t *testing.T) {
+	tests := []struct {
+		name          string
+		latitude      float64
+		suitability   string
+		polarisCirc   bool
+		sigmaOctVisib bool
+	}{
+		{"equator", 0, "unusable", false, false},
+		{"30N", 30, "good", true, false},
+		{"51N", 51, "good", true, false},
+		{"70N", 70, "excellent", true, false},
+		{"35S", -35, "unusable", false, true},
+		{"20N", 20, "poor", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PolarisSuitability(tt.latitude); got != tt.suitability {
+				t.Errorf("PolarisSuitability(%v) = %q, want %q", tt.latitude, got, tt.suitability)
+			}
+			if got := IsCircumpolar(PolarisDec, tt.latitude); got != tt.polarisCirc {
+				t.Errorf("IsCircumpolar(Polaris, %v) = %v, want %v", tt.latitude, got, tt.polarisCirc)
+			}
+			if got := SigmaOctantisVisibility(tt.latitude); got != tt.sigmaOctVisib {
+				t.Errorf("SigmaOctantisVisibility(%v) = %v, want %v", tt.latitude, got, tt.sigmaOctVisib)
+			}
+		})
+	}
+}
+
+func TestIsCircumpolar(t *testing.T) {
+	tests := []struct {
+		dec, latitude float64
+		want          bool
+	}{
+		{45, 51, true},     // Deneb-ish from London
+		{38, 51, false},    // just below the circumpolar limit
+		{-60, -35, true},   // Alpha Centauri from Sydney
+		{-60, 51, false},   // never rises
+		{60, -35, false},   // never rises
+		{0, 90, false},     // celestial equator on the horizon at the pole
+		{89, 0, false},     // nothing is circumpolar from the equator
+		{-89, -0.5, false}, // limit is 89.5
+	}
+	for _, tt := range tests {
+		if got := IsCircumpolar(tt.dec, tt.latitude); got != tt.want {
+			t.Errorf("IsCircumpolar(%v, %v) = %v, want %v", tt.dec, tt.latitude, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package fov
+
+import (
+	"math"
+	"time"
+)
+
+// minRefractionAltitude is the lowest apparent altitude, in degrees, that
+// Bennett's formula is fitted to.
+const minRefractionAltitude = -1.0
+
+// AtmosphericRefraction returns how far the atmosphere raises a star seen at
+// apparent altitude altitudeDeg, in arcseconds, from Bennett's formula with
+// Meeus's correction for standard conditions (10°C, 1010 hPa). It is about
+// 58" at 45° and 34' at the horizon, and within a few arcseconds of the
+// Astronomical Almanac's tables. It returns 0 below -1°, where the formula
+// no longer applies.
+func AtmosphericRefraction(altitudeDeg float64) float64 {
+	if altitudeDeg < minRefractionAltitude {
+		return 0
+	}
+	h := altitudeDeg
+	r := 1 / math.Tan((h+7.31/(h+4.4))*math.Pi/180) // arcminutes
+	r -= 0.06 * math.Sin((14.7*r+13)*math.Pi/180)
+	return math.Max(0, r*60)
+}
+
+// ApparentAltitude returns the altitude at which a star at true (airless)
+// altitude trueAltDeg is seen, in degrees.
+func ApparentAltitude(trueAltDeg float64) float64 {
+	// Refraction depends on the apparent altitude being solved for, so
+	// iterate; it changes slowly enough to converge in a few steps
+	apparent := trueAltDeg
+	for i := 0; i < 20; i++ {
+		next := trueAltDeg + AtmosphericRefraction(apparent)/3600
+		if math.Abs(next-apparent) < 1e-12 {
+			return next
+		}
+		apparent = next
+	}
+	return apparent
+}
+
+// TrueAltitude returns the true (airless) altitude of a star seen at
+// apparentAltDeg, in degrees.
+func TrueAltitude(apparentAltDeg float64) float64 {
+	return apparentAltDeg - AtmosphericRefraction(apparentAltDeg)/3600
+}
+
+// RefractedCoords returns where a star at catalog position ra, dec
+// (degrees) appears for an observer at latitude and longitude (degrees,
+// north and east positive) at time t, after refraction raises it towards
+// the zenith. Compare a solved image of a low field against these rather
+// than the catalog position, since refraction differs across the field by
+// up to several arcminutes near the horizon.
+func RefractedCoords(ra, dec, latitude, longitude float64, t time.Time) (apparentRA, apparentDec float64) {
+	ha := localSiderealTime(longitude, t) - ra
+	alt, az := HourAngleToDec(ha, dec, latitude)
+	return AltAzToRaDec(ApparentAltitude(alt), az, latitude, longitude, t)
+}
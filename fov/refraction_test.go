@@ -0,0 +1,82 @@
+package fov
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAtmosphericRefraction(t *testing.T) {
+	// Astronomical Almanac mean refraction at standard conditions
+	tests := []struct {
+		altitude   float64
+		wantArcsec float64
+		tolerance  float64
+	}{
+		{0, 34*60 + 30, 30},
+		{5, 9.9 * 60, 6},
+		{10, 5*60 + 16, 5},
+		{20, 2*60 + 38, 3},
+		{30, 1*60 + 41, 2},
+		{45, 58, 1},
+		{60, 34, 1},
+		{90, 0, 0.5},
+	}
+	for _, tt := range tests {
+		if got := AtmosphericRefraction(tt.altitude); math.Abs(got-tt.wantArcsec) > tt.tolerance {
+			t.Errorf("AtmosphericRefraction(%v) = %.1f\", want %.1f\" ±%v", tt.altitude, got, tt.wantArcsec, tt.tolerance)
+		}
+	}
+	if got := AtmosphericRefraction(-5); got != 0 {
+		t.Errorf("AtmosphericRefraction(-5) = %v, want 0 below the horizon", got)
+	}
+}
+
+func TestApparentAltitude_RoundTrip(t *testing.T) {
+	for _, trueAlt := range []float64{0, 2, 5, 15, 45, 80} {
+		apparent := ApparentAltitude(trueAlt)
+		if apparent <= trueAlt && trueAlt < 90 {
+			t.Errorf("ApparentAltitude(%v) = %v, want higher than the true altitude", trueAlt, apparent)
+		}
+		if got := TrueAltitude(apparent); math.Abs(got-trueAlt) > 1e-8 {
+			t.Errorf("TrueAltitude(ApparentAltitude(%v)) = %v", trueAlt, got)
+		}
+	}
+}
+
+func TestRefractedCoords(t *testing.T) {
+	const latitude, longitude = 52.0, -7.0
+	tests := []struct {
+		name    string
+		ra, dec float64
+	}{
+		{"low in the south", 280, -25},
+		{"high", 200, 45},
+		{"rising", 0, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lst := localSiderealTime(longitude, j2000)
+			alt, az := HourAngleToDec(lst-tt.ra, tt.dec, latitude)
+			if alt < 0 {
+				t.Fatalf("test position is below the horizon (alt %v)", alt)
+			}
+
+			apparentRA, apparentDec := RefractedCoords(tt.ra, tt.dec, latitude, longitude, j2000)
+			gotAlt, gotAz := HourAngleToDec(lst-apparentRA, apparentDec, latitude)
+			if lift := (gotAlt - alt) * 3600; math.Abs(lift-AtmosphericRefraction(gotAlt)) > 1e-3 {
+				t.Errorf("altitude raised by %.3f\", want %.3f\"", lift, AtmosphericRefraction(gotAlt))
+			}
+			if math.Abs(gotAz-az) > 1e-6 {
+				t.Errorf("azimuth changed from %v to %v", az, gotAz)
+			}
+		})
+	}
+}
+
+func TestRefractedCoords_BelowHorizon(t *testing.T) {
+	// The celestial pole's opposite is never above a northern horizon
+	ra, dec := RefractedCoords(100, -80, 52, 0, j2000)
+	if math.Abs(ra-100) > 1e-6 || math.Abs(dec+80) > 1e-6 {
+		t.Errorf("RefractedCoords below the horizon = (%v, %v), want unchanged", ra, dec)
+	}
+}
@@ -0,0 +1,58 @@
+package fov
+
+import "fmt"
+
+// samplingRatio compares the pixel scale with the Nyquist scale for the
+// seeing, half the seeing FWHM: 1 means two pixels across a star, below 1
+// the star is spread over more pixels than the seeing can fill.
+func samplingRatio(pixelScaleArcsec, seeingArcsec float64) float64 {
+	return 2 * pixelScaleArcsec / seeingArcsec
+}
+
+// OverSamplingWarning checks a pixel scale (arcsec/px) against the seeing
+// FWHM (arcsec) and returns the sampling ratio with a warning when the
+// image is over-sampled. ratio is the pixel scale divided by the Nyquist
+// scale (seeing / 2).
+//
+// A ratio of 2 or more (at least a seeing disc per pixel) is well sampled
+// for solving and returns no warning. Between 1 and 2 the warning suggests
+// 2× binning; below 1 each star covers many pixels, adding noise and
+// solve time without detail, and the warning suggests the binning from
+// OptimalBinningForSeeing. Non-positive inputs return "", 0.
+func OverSamplingWarning(pixelScaleArcsec, seeingArcsec float64) (warning string, ratio float64) {
+	if pixelScaleArcsec <= 0 || seeingArcsec <= 0 {
+		return "", 0
+	}
+	ratio = samplingRatio(pixelScaleArcsec, seeingArcsec)
+	if ratio >= 2 {
+		return "", ratio
+	}
+
+	bin := OptimalBinningForSeeing(pixelScaleArcsec, seeingArcsec)
+	if ratio < 1 {
+		return fmt.Sprintf("over-sampled: %.2f\"/px is finer than the %.2f\" Nyquist scale for %.1f\" seeing; bin %d× (%.2f\"/px)",
+			pixelScaleArcsec, seeingArcsec/2, seeingArcsec, bin, pixelScaleArcsec*float64(bin)), ratio
+	}
+	return fmt.Sprintf("slightly over-sampled: %.2f\"/px at %.1f\" seeing; bin %d× (%.2f\"/px) to speed up solving",
+		pixelScaleArcsec, seeingArcsec, bin, pixelScaleArcsec*float64(bin)), ratio
+}
+
+// OptimalBinningForSeeing returns the binning factor (1, 2 or 4) that
+// brings the pixel scale up to the seeing FWHM, the coarsest scale that
+// still resolves stars for centroiding. Use it as SolveOptions
+// DownsampleFactor:
+//
+//	opts.DownsampleFactor = fov.OptimalBinningForSeeing(0.5, 2.0) // 4
+//
+// Binning is capped at 4, and non-positive inputs return 1.
+func OptimalBinningForSeeing(pixelScaleArcsec, seeingArcsec float64) int {
+	if pixelScaleArcsec <= 0 || seeingArcsec <= 0 {
+		return 1
+	}
+	for _, bin := range []int{1, 2} {
+		if samplingRatio(pixelScaleArcsec*float64(bin), seeingArcsec) >= 2 {
+			return bin
+		}
+	}
+	return 4
+}
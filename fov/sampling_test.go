@@ -0,0 +1,52 @@
+package fov
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOptimalBinningForSeeing(t *testing.T) {
+	tests := []struct {
+		scale, seeing float64
+		want          int
+	}{
+		{0.5, 2, 4},
+		{1.5, 2, 2},
+		{3, 2, 1},
+		{2, 2, 1},   // one seeing disc per pixel already
+		{0.1, 2, 4}, // capped
+		{0, 2, 1},
+		{1, 0, 1},
+	}
+	for _, tt := range tests {
+		if got := OptimalBinningForSeeing(tt.scale, tt.seeing); got != tt.want {
+			t.Errorf("OptimalBinningForSeeing(%v, %v) = %d, want %d", tt.scale, tt.seeing, got, tt.want)
+		}
+	}
+}
+
+func TestOverSamplingWarning(t *testing.T) {
+	tests := []struct {
+		scale, seeing float64
+		ratio         float64
+		warn          string
+	}{
+		{3, 2, 3, ""},
+		{2, 2, 2, ""},
+		{1.5, 2, 1.5, "bin 2×"},
+		{0.5, 2, 0.5, "bin 4×"},
+		{0, 2, 0, ""},
+	}
+	for _, tt := range tests {
+		warning, ratio := OverSamplingWarning(tt.scale, tt.seeing)
+		if ratio != tt.ratio {
+			t.Errorf("OverSamplingWarning(%v, %v) ratio = %v, want %v", tt.scale, tt.seeing, ratio, tt.ratio)
+		}
+		if tt.warn == "" && warning != "" {
+			t.Errorf("OverSamplingWarning(%v, %v) = %q, want no warning", tt.scale, tt.seeing, warning)
+		}
+		if tt.warn != "" && !strings.Contains(warning, tt.warn) {
+			t.Errorf("OverSamplingWarning(%v, %v) = %q, want it to mention %q", tt.scale, tt.seeing, warning, tt.warn)
+		}
+	}
+}
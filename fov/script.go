@@ -0,0 +1,115 @@
+package fov
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ScriptFormat selects the shell and download tool used by IndexRecommendation.Script.
+type ScriptFormat int
+
+const (
+	// ScriptBashWget is a bash script using wget. DownloadScript uses this format.
+	ScriptBashWget ScriptFormat = iota
+
+	// ScriptBashCurl is a bash script using curl, resuming partial downloads
+	// with -C -. Useful in minimal containers without wget.
+	ScriptBashCurl
+
+	// ScriptPowerShell is a Windows PowerShell script using Invoke-WebRequest.
+	ScriptPowerShell
+)
+
+// String returns the format name.
+func (f ScriptFormat) String() string {
+	switch f {
+	case ScriptBashWget:
+		return "bash-wget"
+	case ScriptBashCurl:
+		return "bash-curl"
+	case ScriptPowerShell:
+		return "powershell"
+	default:
+		return fmt.Sprintf("ScriptFormat(%d)", int(f))
+	}
+}
+
+// scriptDir is the directory the download scripts create and download into.
+const scriptDir = "astrometry-data"
+
+// Script returns a download script for the recommended indexes in the given
+// format. The script creates the destination directory if needed and, for
+// indexes whose metadata has SizeBytes or SHA256 set, verifies each
+// download and stops on the first mismatch.
+//
+// Example:
+//
+//	rec := fov.RecommendIndexes(3.5, 1.5)
+//	os.WriteFile("download.ps1", []byte(rec.Script(fov.ScriptPowerShell)), 0644)
+func (r IndexRecommendation) Script(format ScriptFormat) string {
+	var b strings.Builder
+	if format == ScriptPowerShell {
+		writePowerShellScript(&b, r)
+	} else {
+		writeBashScript(&b, r, format)
+	}
+	return b.String()
+}
+
+func writeBashScript(b *strings.Builder, r IndexRecommendation, format ScriptFormat) {
+	b.WriteString("#!/bin/bash\n# Download recommended astrometry index files\n")
+	b.WriteString("set -euo pipefail\n\n")
+	fmt.Fprintf(b, "# Total download size: %.1f MB\n\n", r.TotalSizeMB)
+	fmt.Fprintf(b, "mkdir -p %s && cd %s\n\n", scriptDir, scriptDir)
+
+	for _, idx := range r.Indexes {
+		file := indexFileName(idx)
+		fmt.Fprintf(b, "# %s: %.2f° - %.2f° (%.1f MB)\n", idx.Name, idx.MinFOV, idx.MaxFOV, idx.SizeMB)
+		if format == ScriptBashCurl {
+			fmt.Fprintf(b, "curl -fL -C - -o %s %s\n", file, idx.DownloadURL)
+		} else {
+			fmt.Fprintf(b, "wget -c -O %s %s\n", file, idx.DownloadURL)
+		}
+		if idx.SizeBytes > 0 {
+			fmt.Fprintf(b, "[ \"$(wc -c < %s | tr -d ' ')\" = \"%d\" ] || { echo \"size mismatch: %s\" >&2; exit 1; }\n",
+				file, idx.SizeBytes, file)
+		}
+		if idx.SHA256 != "" {
+			fmt.Fprintf(b, "echo \"%s  %s\" | sha256sum -c -\n", strings.ToLower(idx.SHA256), file)
+		}
+		b.WriteString("\n")
+	}
+}
+
+func writePowerShellScript(b *strings.Builder, r IndexRecommendation) {
+	b.WriteString("# Download recommended astrometry index files\n")
+	b.WriteString("$ErrorActionPreference = 'Stop'\n")
+	b.WriteString("$ProgressPreference = 'SilentlyContinue'\n\n")
+	fmt.Fprintf(b, "# Total download size: %.1f MB\n\n", r.TotalSizeMB)
+	fmt.Fprintf(b, "New-Item -ItemType Directory -Force -Path '%s' | Out-Null\n", scriptDir)
+	fmt.Fprintf(b, "Set-Location '%s'\n\n", scriptDir)
+
+	for _, idx := range r.Indexes {
+		file := indexFileName(idx)
+		fmt.Fprintf(b, "# %s: %.2f° - %.2f° (%.1f MB)\n", idx.Name, idx.MinFOV, idx.MaxFOV, idx.SizeMB)
+		fmt.Fprintf(b, "Invoke-WebRequest -Uri '%s' -OutFile '%s'\n", idx.DownloadURL, file)
+		if idx.SizeBytes > 0 {
+			fmt.Fprintf(b, "if ((Get-Item '%s').Length -ne %d) { throw 'size mismatch: %s' }\n",
+				file, idx.SizeBytes, file)
+		}
+		if idx.SHA256 != "" {
+			fmt.Fprintf(b, "if ((Get-FileHash '%s' -Algorithm SHA256).Hash -ne '%s') { throw 'checksum mismatch: %s' }\n",
+				file, strings.ToUpper(idx.SHA256), file)
+		}
+		b.WriteString("\n")
+	}
+}
+
+// indexFileName returns the local file name for an index download.
+func indexFileName(idx IndexFile) string {
+	if name := path.Base(idx.DownloadURL); strings.HasSuffix(name, ".fits") {
+		return name
+	}
+	return idx.Name + ".fits"
+}
@@ -0,0 +1,113 @@
+package fov
+
+import (
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// scriptRecommendation has one index with verification metadata and one without.
+func scriptRecommendation() IndexRecommendation {
+	verified := AllIndexFiles[3] // index-4110
+	verified.SizeBytes = 26214400
+	verified.SHA256 = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	plain := AllIndexFiles[4] // index-4111
+	return IndexRecommendation{
+		Indexes:     []IndexFile{plain, verified},
+		TotalSizeMB: plain.SizeMB + verified.SizeMB,
+	}
+}
+
+func TestScript_Golden(t *testing.T) {
+	rec := scriptRecommendation()
+	for _, tt := range []struct {
+		format ScriptFormat
+		golden string
+	}{
+		{ScriptBashWget, "script_bash_wget.golden"},
+		{ScriptBashCurl, "script_bash_curl.golden"},
+		{ScriptPowerShell, "script_powershell.golden"},
+	} {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			got := rec.Script(tt.format)
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read golden file (run with -update to create): %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("script differs from %s:\n%s", path, got)
+			}
+		})
+	}
+}
+
+func TestScript_Verification(t *testing.T) {
+	rec := scriptRecommendation()
+
+	bash := rec.Script(ScriptBashCurl)
+	if !strings.Contains(bash, "mkdir -p astrometry-data") {
+		t.Error("bash script does not create the destination directory idempotently")
+	}
+	if strings.Count(bash, "sha256sum -c") != 1 || strings.Count(bash, "size mismatch") != 1 {
+		t.Error("bash script should verify only the index with metadata")
+	}
+
+	ps := rec.Script(ScriptPowerShell)
+	if !strings.Contains(ps, "New-Item -ItemType Directory -Force") {
+		t.Error("PowerShell script does not create the destination directory idempotently")
+	}
+	if strings.Count(ps, "Get-FileHash") != 1 || strings.Count(ps, "Length -ne") != 1 {
+		t.Error("PowerShell script should verify only the index with metadata")
+	}
+}
+
+func TestScript_Syntax(t *testing.T) {
+	rec := RecommendIndexes(3.5, 1.5)
+
+	t.Run("bash", func(t *testing.T) {
+		bash, err := exec.LookPath("bash")
+		if err != nil {
+			t.Skip("bash not available")
+		}
+		for _, format := range []ScriptFormat{ScriptBashWget, ScriptBashCurl} {
+			cmd := exec.Command(bash, "-n")
+			cmd.Stdin = strings.NewReader(rec.Script(format))
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("%s script has syntax errors: %v\n%s", format, err, out)
+			}
+		}
+		// The compatibility script must stay valid too
+		cmd := exec.Command(bash, "-n")
+		cmd.Stdin = strings.NewReader(rec.DownloadScript)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("DownloadScript has syntax errors: %v\n%s", err, out)
+		}
+	})
+
+	t.Run("powershell", func(t *testing.T) {
+		pwsh, err := exec.LookPath("pwsh")
+		if err != nil {
+			t.Skip("pwsh not available")
+		}
+		path := filepath.Join(t.TempDir(), "download.ps1")
+		if err := os.WriteFile(path, []byte(rec.Script(ScriptPowerShell)), 0644); err != nil {
+			t.Fatal(err)
+		}
+		parse := "$errs = $null; [System.Management.Automation.Language.Parser]::ParseFile('" + path +
+			"', [ref]$null, [ref]$errs) | Out-Null; if ($errs) { $errs; exit 1 }"
+		if out, err := exec.Command(pwsh, "-NoProfile", "-Command", parse).CombinedOutput(); err != nil {
+			t.Errorf("PowerShell script has syntax errors: %v\n%s", err, out)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package fov
+
+import (
+	"slices"
+	"strings"
+)
+
+// sensorNames maps the names SensorByName accepts, normalized by
+// normalizeSensorName, to the sensor presets.
+var sensorNames = map[string]SensorSize{
+	"fullframe":       FullFrame,
+	"ff":              FullFrame,
+	"35mm":            FullFrame,
+	"apsc":            APSCNikon,
+	"apscnikon":       APSCNikon,
+	"apscsony":        APSCNikon,
+	"apsccanon":       APSCCanon,
+	"apscfuji":        APSCFuji,
+	"apscfujifilm":    APSCFuji,
+	"apsh":            APSHCanon,
+	"apshcanon":       APSHCanon,
+	"foveon":          FoveonAPSC,
+	"foveonapsc":      FoveonAPSC,
+	"foveonapsh":      FoveonAPSH,
+	"gfx":             MediumFormatGFX,
+	"mediumformat":    MediumFormatGFX,
+	"m43":             MicroFourThirds,
+	"mft":             MicroFourThirds,
+	"microfourthirds": MicroFourThirds,
+	"1":               OneInch,
+	"1inch":           OneInch,
+	"1/1.7":           OneOverOnePointSeven,
+	"1/1.8":           OneOverOnePointEight,
+	"1/2.9":           OneOverTwoPointNine,
+	"imx533":          SquareOneInch,
+	"1square":         SquareOneInch,
+	"1inchsquare":     SquareOneInch,
+}
+
+// SensorByName returns the sensor preset with the given name, such as
+// "full-frame", "apsc-canon", "aps-h", "foveon", "gfx", "m43", "1inch",
+// "1/1.8\"" or "imx533". Case, spaces, hyphens, underscores and inch
+// marks are ignored. See SensorNames for the full list.
+//
+// Example:
+//
+//	sensor, ok := fov.SensorByName("gfx")
+//	if !ok {
+//		log.Fatalf("unknown sensor; known: %s", strings.Join(fov.SensorNames(), ", "))
+//	}
+//	fmt.Println(fov.CalculateFOV(110, sensor))
+func SensorByName(name string) (SensorSize, bool) {
+	sensor, ok := sensorNames[normalizeSensorName(name)]
+	return sensor, ok
+}
+
+// SensorNames returns the names SensorByName accepts, sorted.
+func SensorNames() []string {
+	names := make([]string, 0, len(sensorNames))
+	for name := range sensorNames {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// normalizeSensorName lowercases name and drops spaces, hyphens,
+// underscores and inch marks, so "APS-C Canon", "aps_c_canon" and
+// "apsc-canon" match, as do "1/1.8\"" and "1/1.8in".
+func normalizeSensorName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimSuffix(name, "in")
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '"', '\'', '″':
+			return -1
+		}
+		return r
+	}, name)
+}
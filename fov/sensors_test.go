@@ -0,0 +1,75 @@
+package fov
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewSensorPresetsFOV(t *testing.T) {
+	// Reference FOVs at 50mm: 2·atan(size / 100mm)
+	tests := []struct {
+		sensor                      SensorSize
+		widthDegrees, heightDegrees float64
+	}{
+		{MediumFormatGFX, 47.31, 36.42},
+		{APSHCanon, 32.03, 21.52},
+		{FoveonAPSC, 26.45, 17.62},
+		{FoveonAPSH, 29.90, 20.30},
+		{OneOverOnePointSeven, 8.69, 6.52},
+		{OneOverOnePointEight, 8.21, 6.09},
+		{OneOverTwoPointNine, 5.68, 4.26},
+		{SquareOneInch, 12.91, 12.91},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sensor.Name, func(t *testing.T) {
+			fov := CalculateFOV(50, tt.sensor)
+			if math.Abs(fov.WidthDegrees-tt.widthDegrees) > 0.01 || math.Abs(fov.HeightDegrees-tt.heightDegrees) > 0.01 {
+				t.Errorf("FOV at 50mm = %.2f° x %.2f°, want %.2f° x %.2f°",
+					fov.WidthDegrees, fov.HeightDegrees, tt.widthDegrees, tt.heightDegrees)
+			}
+			if tt.sensor.Width < tt.sensor.Height {
+				t.Errorf("sensor %.2f x %.2f mm is not landscape", tt.sensor.Width, tt.sensor.Height)
+			}
+		})
+	}
+}
+
+func TestSensorByName(t *testing.T) {
+	tests := []struct {
+		name string
+		want SensorSize
+	}{
+		{"gfx", MediumFormatGFX},
+		{"GFX", MediumFormatGFX},
+		{"medium-format", MediumFormatGFX},
+		{"APS-H", APSHCanon},
+		{"aps_h_canon", APSHCanon},
+		{"foveon", FoveonAPSC},
+		{"Foveon APS-H", FoveonAPSH},
+		{"full-frame", FullFrame},
+		{"APS-C Canon", APSCCanon},
+		{"m43", MicroFourThirds},
+		{"1inch", OneInch},
+		{`1"`, OneInch},
+		{`1/1.7"`, OneOverOnePointSeven},
+		{"1/1.8in", OneOverOnePointEight},
+		{"1/2.9", OneOverTwoPointNine},
+		{"IMX533", SquareOneInch},
+	}
+	for _, tt := range tests {
+		got, ok := SensorByName(tt.name)
+		if !ok || got != tt.want {
+			t.Errorf("SensorByName(%q) = %+v, %v; want %s", tt.name, got, ok, tt.want.Name)
+		}
+	}
+
+	if _, ok := SensorByName("super35"); ok {
+		t.Error("SensorByName accepted an unknown sensor")
+	}
+	for _, name := range SensorNames() {
+		if _, ok := SensorByName(name); !ok {
+			t.Errorf("SensorNames lists %q, which SensorByName rejects", name)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package fov
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
+
+// simbadTAPURL is the SIMBAD synchronous TAP endpoint. Tests point it at a
+// local server.
+var simbadTAPURL = "http://simbad.cds.unistra.fr/simbad/sim-tap/sync"
+
+const (
+	// minSimbadRadius is the smallest hint radius SimbadHint recommends, in
+	// degrees. Framing is rarely centered exactly on the object.
+	minSimbadRadius = 2.0
+
+	// simbadRadiusFactor scales the object's major axis into a hint radius.
+	simbadRadiusFactor = 1.5
+)
+
+// simbadCatalogWidths are the widths SIMBAD right-aligns the number of
+// common deep-sky catalog identifiers to, e.g. "M  42" and "NGC  1976".
+var simbadCatalogWidths = map[string]int{"M": 4, "NGC": 6, "IC": 5}
+
+var simbadCatalogID = regexp.MustCompile(`^(?i)(M|NGC|IC)\s*(\d+)$`)
+
+// simbadObject is one row of the SIMBAD basic table.
+type simbadObject struct {
+	ra, dec    float64
+	objectType string
+	majorAxis  float64 // arcminutes, 0 when unknown
+}
+
+// QuerySimbad looks up an object by identifier (e.g. "M42", "NGC 7000",
+// "Vega") in the SIMBAD database and returns its J2000 RA/Dec in degrees
+// and SIMBAD object type (e.g. "HII", "G", "*"). It requires network access.
+func QuerySimbad(ctx context.Context, identifier string) (ra, dec float64, objectType string, err error) {
+	obj, err := querySimbad(ctx, identifier)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return obj.ra, obj.dec, obj.objectType, nil
+}
+
+// SimbadHint looks up an object in SIMBAD and returns default solve options
+// with an RA/Dec hint at the object, along with the recommended search
+// radius in degrees (also set as the options' Radius). The radius grows
+// with the object's size so framing offsets on large nebulae and galaxies
+// are still covered.
+//
+// The options are a client.SolveOptions.
+//
+// Example:
+//
+//	opts, radius, err := fov.SimbadHint(ctx, "M31")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("searching within %.1f° of M31\n", radius)
+//	result, err := c.Solve(ctx, "andromeda.jpg", opts)
+func SimbadHint(ctx context.Context, identifier string) (*solver.SolveOptions, float64, error) {
+	obj, err := querySimbad(ctx, identifier)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	radius := math.Max(minSimbadRadius, simbadRadiusFactor*obj.majorAxis/60)
+	opts := solver.DefaultSolveOptions()
+	opts.RA = obj.ra
+	opts.Dec = obj.dec
+	opts.Radius = radius
+	return opts, radius, nil
+}
+
+// querySimbad runs the ADQL lookup against the SIMBAD TAP service.
+func querySimbad(ctx context.Context, identifier string) (*simbadObject, error) {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return nil, fmt.Errorf("SIMBAD identifier is empty")
+	}
+
+	query := "SELECT TOP 1 basic.ra, basic.dec, basic.otype, basic.galdim_majaxis " +
+		"FROM basic JOIN ident ON ident.oidref = basic.oid " +
+		"WHERE ident.id = '" + strings.ReplaceAll(simbadIdentifier(identifier), "'", "''") + "'"
+	form := url.Values{
+		"REQUEST": {"doQuery"},
+		"LANG":    {"ADQL"},
+		"FORMAT":  {"json"},
+		"QUERY":   {query},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, simbadTAPURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SIMBAD request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("SIMBAD query failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close() //nolint:errcheck // Response fully read, close error not critical
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SIMBAD response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("SIMBAD query failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return parseSimbadResponse(identifier, body)
+}
+
+// parseSimbadResponse decodes a TAP JSON response with columns ra, dec,
+// otype and galdim_majaxis.
+func parseSimbadResponse(identifier string, body []byte) (*simbadObject, error) {
+	var table struct {
+		Metadata []struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Data [][]any `json:"data"`
+	}
+	if err := json.Unmarshal(body, &table); err != nil {
+		return nil, fmt.Errorf("failed to parse SIMBAD response: %w", err)
+	}
+	if len(table.Data) == 0 {
+		return nil, fmt.Errorf("object %q not found in SIMBAD", identifier)
+	}
+
+	row := table.Data[0]
+	col := func(name string) any {
+		for i, m := range table.Metadata {
+			if strings.EqualFold(m.Name, name) && i < len(row) {
+				return row[i]
+			}
+		}
+		return nil
+	}
+
+	ra, raOK := col("ra").(float64)
+	dec, decOK := col("dec").(float64)
+	if !raOK || !decOK {
+		return nil, fmt.Errorf("SIMBAD has no coordinates for %q", identifier)
+	}
+	obj := &simbadObject{ra: ra, dec: dec}
+	obj.objectType, _ = col("otype").(string)
+	obj.majorAxis, _ = col("galdim_majaxis").(float64)
+	return obj, nil
+}
+
+// simbadIdentifier rewrites Messier, NGC and IC identifiers into the padded
+// form SIMBAD stores them in ("M42" -> "M  42"). Other identifiers are
+// returned unchanged.
+func simbadIdentifier(identifier string) string {
+	m := simbadCatalogID.FindStringSubmatch(identifier)
+	if m == nil {
+		return identifier
+	}
+	catalog := strings.ToUpper(m[1])
+	number := strings.TrimLeft(m[2], "0")
+	return fmt.Sprintf("%s%*s", catalog, simbadCatalogWidths[catalog], number)
+}
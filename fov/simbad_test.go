@@ -0,0 +1,142 @@
+package fov
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// m42Response is a SIMBAD TAP JSON response for M42.
+const m42Response = `{
+  "metadata": [
+    {"name": "ra", "datatype": "double", "unit": "deg"},
+    {"name": "dec", "datatype": "double", "unit": "deg"},
+    {"name": "otype", "datatype": "char"},
+    {"name": "galdim_majaxis", "datatype": "float", "unit": "arcmin"}
+  ],
+  "data": [[83.82208, -5.39111, "HII", 66.0]]
+}`
+
+// serveSimbad points simbadTAPURL at a test server for the duration of t.
+func serveSimbad(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	orig := simbadTAPURL
+	simbadTAPURL = server.URL
+	t.Cleanup(func() { simbadTAPURL = orig })
+}
+
+func TestQuerySimbad(t *testing.T) {
+	var query string
+	serveSimbad(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("bad form: %v", err)
+		}
+		if r.Form.Get("LANG") != "ADQL" || r.Form.Get("FORMAT") != "json" {
+			t.Errorf("unexpected TAP parameters: %v", r.Form)
+		}
+		query = r.Form.Get("QUERY")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(m42Response))
+	})
+
+	ra, dec, otype, err := QuerySimbad(context.Background(), "M42")
+	if err != nil {
+		t.Fatalf("QuerySimbad failed: %v", err)
+	}
+	if math.Abs(ra-83.82208) > 1e-9 || math.Abs(dec+5.39111) > 1e-9 || otype != "HII" {
+		t.Errorf("got (%v, %v, %q), want (83.82208, -5.39111, HII)", ra, dec, otype)
+	}
+	if !strings.Contains(query, "'M  42'") {
+		t.Errorf("query does not use SIMBAD identifier form: %s", query)
+	}
+}
+
+func TestSimbadHint(t *testing.T) {
+	serveSimbad(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(m42Response))
+	})
+
+	opts, radius, err := SimbadHint(context.Background(), "M 42")
+	if err != nil {
+		t.Fatalf("SimbadHint failed: %v", err)
+	}
+	// 66' major axis -> 1.65°, below the 2° minimum
+	if radius != minSimbadRadius || opts.Radius != radius {
+		t.Errorf("radius = %v (opts %v), want %v", radius, opts.Radius, minSimbadRadius)
+	}
+	if opts.RA != 83.82208 || opts.Dec != -5.39111 {
+		t.Errorf("hint = (%v, %v), want M42 coordinates", opts.RA, opts.Dec)
+	}
+	if opts.DownsampleFactor != 2 {
+		t.Errorf("expected default solve options, got %+v", opts)
+	}
+}
+
+func TestSimbadHint_LargeObject(t *testing.T) {
+	serveSimbad(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"metadata":[{"name":"ra"},{"name":"dec"},{"name":"otype"},{"name":"galdim_majaxis"}],
+			"data":[[10.684708, 41.26875, "AGN", 199.53]]}`))
+	})
+
+	_, radius, err := SimbadHint(context.Background(), "M31")
+	if err != nil {
+		t.Fatalf("SimbadHint failed: %v", err)
+	}
+	if want := 1.5 * 199.53 / 60; math.Abs(radius-want) > 1e-9 {
+		t.Errorf("radius = %v, want %v", radius, want)
+	}
+}
+
+func TestQuerySimbad_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{"not found", http.StatusOK, `{"metadata":[{"name":"ra"},{"name":"dec"}],"data":[]}`, "not found"},
+		{"no coordinates", http.StatusOK, `{"metadata":[{"name":"ra"},{"name":"dec"}],"data":[[null,null]]}`, "no coordinates"},
+		{"bad json", http.StatusOK, `<VOTABLE/>`, "parse"},
+		{"server error", http.StatusBadRequest, `bad ADQL`, "400"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serveSimbad(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+			_, _, _, err := QuerySimbad(context.Background(), "Nonexistent 1")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+
+	if _, _, _, err := QuerySimbad(context.Background(), "  "); err == nil {
+		t.Error("expected error for empty identifier")
+	}
+}
+
+func TestSimbadIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"M42":      "M  42",
+		"m 1":      "M   1",
+		"M101":     "M 101",
+		"NGC1976":  "NGC  1976",
+		"NGC 224":  "NGC   224",
+		"IC434":    "IC  434",
+		"IC 1805":  "IC 1805",
+		"Vega":     "Vega",
+		"HD 39801": "HD 39801",
+	}
+	for in, want := range tests {
+		if got := simbadIdentifier(in); got != want {
+			t.Errorf("simbadIdentifier(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
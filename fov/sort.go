@@ -0,0 +1,66 @@
+package fov
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortKey selects the ordering used by SortIndexFiles.
+type SortKey int
+
+const (
+	// SortByMinFOV orders by minimum field width, narrowest first.
+	SortByMinFOV SortKey = iota
+
+	// SortByMaxFOV orders by maximum field width, narrowest first.
+	SortByMaxFOV
+
+	// SortBySizeMB orders by file size, smallest first.
+	SortBySizeMB
+
+	// SortByPriority orders by IndexFile.Priority, lowest first, falling
+	// back to MinFOV for equal priorities.
+	SortByPriority
+
+	// SortByEfficiency orders by SizeMBPerDegree, smallest first: the
+	// indexes that cover the most field width per megabyte of disk.
+	SortByEfficiency
+)
+
+// SortIndexFiles returns a sorted copy of files, leaving files unchanged.
+// Ties keep their original order, except that SortByPriority breaks them
+// by MinFOV.
+//
+// Example:
+//
+//	rec := fov.RecommendIndexes(2.5, 1.5)
+//	for _, idx := range fov.SortIndexFiles(rec.Indexes, fov.SortBySizeMB) {
+//		fmt.Printf("%s: %.1f MB\n", idx.Name, idx.SizeMB)
+//	}
+func SortIndexFiles(files []IndexFile, by SortKey) []IndexFile {
+	sorted := slices.Clone(files)
+	slices.SortStableFunc(sorted, func(a, b IndexFile) int {
+		switch by {
+		case SortByMaxFOV:
+			return cmp.Compare(a.MaxFOV, b.MaxFOV)
+		case SortBySizeMB:
+			return cmp.Compare(a.SizeMB, b.SizeMB)
+		case SortByPriority:
+			return cmp.Or(cmp.Compare(a.Priority, b.Priority), cmp.Compare(a.MinFOV, b.MinFOV))
+		case SortByEfficiency:
+			return cmp.Compare(a.sizePerDegree(), b.sizePerDegree())
+		default:
+			return cmp.Compare(a.MinFOV, b.MinFOV)
+		}
+	})
+	return sorted
+}
+
+// sizePerDegree returns SizeMBPerDegree, computing it for IndexFile values
+// built outside AllIndexFiles that leave it unset.
+func (idx IndexFile) sizePerDegree() float64 {
+	if idx.SizeMBPerDegree > 0 || idx.MaxFOV <= idx.MinFOV {
+		return idx.SizeMBPerDegree
+	}
+	return idx.SizeMB / (idx.MaxFOV - idx.MinFOV)
+}
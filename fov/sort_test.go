@@ -0,0 +1,83 @@
+package fov
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func indexNames(files []IndexFile) []string {
+	names := make([]string, len(files))
+	for i, idx := range files {
+		names[i] = idx.Name
+	}
+	return names
+}
+
+func TestSortIndexFiles(t *testing.T) {
+	// index-4107 (8-11°, 165 MB), index-4110 (3-4.2°, 25 MB),
+	// index-4112 (1.6-2.2°, 5.3 MB), index-4116 (0.4-0.56°, 0.409 MB)
+	files := []IndexFile{AllIndexFiles[3], AllIndexFiles[0], AllIndexFiles[9], AllIndexFiles[5]}
+	files[0].Priority = 2
+	files[1].Priority = 1
+	files[2].Priority = 2
+
+	tests := []struct {
+		by   SortKey
+		want []string
+	}{
+		{SortByMinFOV, []string{"index-4116", "index-4112", "index-4110", "index-4107"}},
+		{SortByMaxFOV, []string{"index-4116", "index-4112", "index-4110", "index-4107"}},
+		{SortBySizeMB, []string{"index-4116", "index-4112", "index-4110", "index-4107"}},
+		// 4112 has no priority (0); 4116 and 4110 tie at 2 and fall back to MinFOV
+		{SortByPriority, []string{"index-4112", "index-4107", "index-4116", "index-4110"}},
+		// 2.56, 8.83, 20.8 and 55 MB per degree
+		{SortByEfficiency, []string{"index-4116", "index-4112", "index-4110", "index-4107"}},
+	}
+	for _, tt := range tests {
+		if got := indexNames(SortIndexFiles(files, tt.by)); !slices.Equal(got, tt.want) {
+			t.Errorf("SortIndexFiles(%d) = %v, want %v", tt.by, got, tt.want)
+		}
+	}
+	if got := indexNames(files); !slices.Equal(got, []string{"index-4110", "index-4107", "index-4116", "index-4112"}) {
+		t.Errorf("input was reordered: %v", got)
+	}
+}
+
+func TestSortIndexFiles_EfficiencyDiffersFromSize(t *testing.T) {
+	// Same size, but the wider range is cheaper per degree
+	files := []IndexFile{
+		{Name: "narrow", MinFOV: 1, MaxFOV: 1.5, SizeMB: 10},
+		{Name: "wide", MinFOV: 1, MaxFOV: 3, SizeMB: 10},
+	}
+	if got := indexNames(SortIndexFiles(files, SortByEfficiency)); !slices.Equal(got, []string{"wide", "narrow"}) {
+		t.Errorf("SortByEfficiency = %v, want [wide narrow]", got)
+	}
+}
+
+func TestSizeMBPerDegree(t *testing.T) {
+	for _, idx := range AllIndexFiles {
+		want := idx.SizeMB / (idx.MaxFOV - idx.MinFOV)
+		if math.Abs(idx.SizeMBPerDegree-want) > 1e-9 {
+			t.Errorf("%s: SizeMBPerDegree = %v, want %v", idx.Name, idx.SizeMBPerDegree, want)
+		}
+	}
+}
+
+func TestRecommendIndexesWithBudget(t *testing.T) {
+	ClearRecommendationCache()
+	full := RecommendIndexes(3.5, 1.5)
+	if got := RecommendIndexesWithBudget(3.5, 1.5, 0); !slices.Equal(indexNames(got.Indexes), indexNames(full.Indexes)) {
+		t.Errorf("no budget = %v, want %v", indexNames(got.Indexes), indexNames(full.Indexes))
+	}
+
+	// 2.33-5.25° brackets 4109 (50 MB), 4110 (25 MB) and 4111 (10 MB); the
+	// most efficient that fit in 40 MB are 4111 (12.5 MB/°) and 4110 (20.8)
+	rec := RecommendIndexesWithBudget(3.5, 1.5, 40)
+	if got := indexNames(rec.Indexes); !slices.Equal(got, []string{"index-4111", "index-4110"}) {
+		t.Errorf("40 MB budget = %v, want [index-4111 index-4110]", got)
+	}
+	if rec.TotalSizeMB > 40 {
+		t.Errorf("TotalSizeMB = %v, over the 40 MB budget", rec.TotalSizeMB)
+	}
+}
@@ -0,0 +1,170 @@
+package fov
+
+import (
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// DirectorySurvey summarises the fields of view of a directory of images
+// and the indexes needed to solve all of them. See SurveyDirectory.
+type DirectorySurvey struct {
+	Images  []SurveyedImage // Every JPEG, TIFF and FITS image found, in path order
+	Buckets []FOVBucket     // Images by field width, narrowest first; empty buckets are left out
+	Unknown []string        // Images with no FOV: unreadable, or no focal length or sensor
+
+	// Recommendation holds the union of the indexes recommended for every
+	// image's field width, with TargetFOV the widest field. Use its
+	// Script method for download formats other than DownloadScript's.
+	Recommendation IndexRecommendation
+}
+
+// SurveyedImage is one image found by SurveyDirectory.
+type SurveyedImage struct {
+	Path string
+	Info *ImageInfo // As returned by AnalyzeImage; partial when Err is set
+	Err  error      // AnalyzeImage's error, if any
+}
+
+// FOVBucket groups the surveyed images whose field width falls between
+// two consecutive index range boundaries of IndexCatalog.
+type FOVBucket struct {
+	MinFOV float64  // Field width in degrees, inclusive; 0 below the narrowest index
+	MaxFOV float64  // Field width in degrees, exclusive; +Inf above the widest index
+	Images []string // Paths of the images in the bucket
+}
+
+// SurveyDirectory analyzes every image under dir, including
+// subdirectories, and reports the range of fields of view present and the
+// indexes needed to cover them: for each image, the indexes RecommendIndexes
+// gives with the same 20% margin AnalyzeImage uses for scale bounds.
+// It is a dry run for an archive, before downloading any indexes.
+//
+// Images are recognised by their contents, as in AnalyzeImage; other files
+// and hidden files and directories are skipped. An image that cannot be
+// analyzed is listed in Unknown with its error in Images rather than
+// failing the survey. The error is for dir itself or a directory under it
+// that cannot be read.
+//
+// Example:
+//
+//	survey, err := fov.SurveyDirectory("/photos/astro")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(survey)
+//	os.WriteFile("download.sh", []byte(survey.Recommendation.DownloadScript), 0755)
+func SurveyDirectory(dir string) (DirectorySurvey, error) {
+	var survey DirectorySurvey
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !isImageFile(path) {
+			return nil
+		}
+		info, err := AnalyzeImage(path)
+		survey.Images = append(survey.Images, SurveyedImage{Path: path, Info: info, Err: err})
+		return nil
+	})
+	if err != nil {
+		return DirectorySurvey{}, fmt.Errorf("failed to survey directory: %w", err)
+	}
+
+	buckets := fovBuckets()
+	indexes := make(map[string]IndexFile)
+	var widest FieldOfView
+	for _, img := range survey.Images {
+		if img.Err != nil || img.Info == nil || img.Info.FOV.WidthDegrees <= 0 {
+			survey.Unknown = append(survey.Unknown, img.Path)
+			continue
+		}
+		width := img.Info.FOV.WidthDegrees
+		i, _ := slices.BinarySearchFunc(buckets, width, func(b FOVBucket, w float64) int {
+			if b.MaxFOV <= w {
+				return -1
+			}
+			return 1
+		})
+		buckets[i].Images = append(buckets[i].Images, img.Path)
+
+		for _, idx := range RecommendIndexes(width, analyzeScaleMargin).Indexes {
+			indexes[idx.Name] = idx
+		}
+		if width > widest.WidthDegrees {
+			widest = img.Info.FOV
+		}
+	}
+	for _, b := range buckets {
+		if len(b.Images) > 0 {
+			survey.Buckets = append(survey.Buckets, b)
+		}
+	}
+
+	rec := IndexRecommendation{TargetFOV: widest, Warning: coverageWarning(widest.WidthDegrees)}
+	for _, idx := range indexes {
+		rec.Indexes = append(rec.Indexes, idx)
+	}
+	rec.Indexes = SortIndexFiles(rec.Indexes, SortByMinFOV)
+	for _, idx := range rec.Indexes {
+		rec.TotalSizeMB += idx.SizeMB
+	}
+	rec.DownloadScript = rec.Script(ScriptBashWget)
+	survey.Recommendation = rec
+	return survey, nil
+}
+
+// isImageFile reports whether the file at path is in a format AnalyzeImage
+// reads. Unreadable files count as images, so their error is reported.
+func isImageFile(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return true
+	}
+	defer file.Close() //nolint:errcheck // Read-only file, close error not critical
+	return detectImageFormat(file) != ""
+}
+
+// fovBuckets returns empty buckets between every index range boundary in
+// IndexCatalog, from 0 to +Inf, narrowest first.
+func fovBuckets() []FOVBucket {
+	edges := []float64{0}
+	for _, idx := range IndexCatalog() {
+		edges = append(edges, idx.MinFOV, idx.MaxFOV)
+	}
+	slices.Sort(edges)
+	edges = append(slices.Compact(edges), math.Inf(1))
+
+	buckets := make([]FOVBucket, len(edges)-1)
+	for i := range buckets {
+		buckets[i] = FOVBucket{MinFOV: edges[i], MaxFOV: edges[i+1]}
+	}
+	return buckets
+}
+
+// String returns a human-readable summary of the survey.
+func (s DirectorySurvey) String() string {
+	result := fmt.Sprintf("Surveyed %d images, %d with no FOV\n", len(s.Images), len(s.Unknown))
+	for _, b := range s.Buckets {
+		result += fmt.Sprintf("  %.2f° - %.2f°: %d images\n", b.MinFOV, b.MaxFOV, len(b.Images))
+	}
+	if s.Recommendation.Warning != "" {
+		result += fmt.Sprintf("Warning: %s\n", s.Recommendation.Warning)
+	}
+	result += fmt.Sprintf("\n%d indexes needed, %.1f MB:\n", len(s.Recommendation.Indexes), s.Recommendation.TotalSizeMB)
+	for _, idx := range s.Recommendation.Indexes {
+		result += fmt.Sprintf("  %s: %.2f° - %.2f° (%.1f MB)\n",
+			idx.Name, idx.MinFOV, idx.MaxFOV, idx.SizeMB)
+	}
+	return result
+}
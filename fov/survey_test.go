@@ -0,0 +1,113 @@
+package fov
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// writeSurveyFixture writes files, keyed by slash-separated path, under a
+// new temporary directory and returns it.
+func writeSurveyFixture(t *testing.T, files map[string][]byte) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestSurveyDirectory(t *testing.T) {
+	// APS-C Nikon frames: 1000 mm is ~1.35°, 300 mm ~4.5° and 50 mm ~27°
+	nikon := func(focalLength uint32) []byte {
+		return jpegWithEXIF(tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, focalLength))
+	}
+	dir := writeSurveyFixture(t, map[string][]byte{
+		"m42.jpg":              nikon(300),
+		"2024/orion/m42-2.jpg": nikon(300),
+		"m57.tif":              tiffWithEXIF("NIKON CORPORATION", "NIKON D7500", 5900, 1000),
+		"milky-way.jpg":        nikon(50),
+		"no-exif.jpg":          {0xFF, 0xD8, 0xFF, 0xD9},
+		"notes.txt":            []byte("30x120s, Bortle 4"),
+		".thumbs/m42.jpg":      nikon(300),
+	})
+
+	survey, err := SurveyDirectory(dir)
+	if err != nil {
+		t.Fatalf("SurveyDirectory failed: %v", err)
+	}
+
+	var paths []string
+	for _, img := range survey.Images {
+		paths = append(paths, filepath.ToSlash(strings.TrimPrefix(img.Path, dir+string(filepath.Separator))))
+	}
+	wantPaths := []string{"2024/orion/m42-2.jpg", "m42.jpg", "m57.tif", "milky-way.jpg", "no-exif.jpg"}
+	if !slices.Equal(paths, wantPaths) {
+		t.Errorf("surveyed %q, want %q", paths, wantPaths)
+	}
+	if len(survey.Unknown) != 1 || filepath.Base(survey.Unknown[0]) != "no-exif.jpg" {
+		t.Errorf("Unknown = %q, want no-exif.jpg", survey.Unknown)
+	}
+
+	wantBuckets := []struct {
+		min, max float64
+		count    int
+	}{{1.1, 1.6, 1}, {4.2, 5.6, 2}, {11, math.Inf(1), 1}}
+	if len(survey.Buckets) != len(wantBuckets) {
+		t.Fatalf("Buckets = %+v, want %d buckets", survey.Buckets, len(wantBuckets))
+	}
+	for i, want := range wantBuckets {
+		b := survey.Buckets[i]
+		if b.MinFOV != want.min || b.MaxFOV != want.max || len(b.Images) != want.count {
+			t.Errorf("bucket %d = %.2f-%.2f with %d images, want %.2f-%.2f with %d",
+				i, b.MinFOV, b.MaxFOV, len(b.Images), want.min, want.max, want.count)
+		}
+	}
+
+	rec := survey.Recommendation
+	var names []string
+	var size float64
+	for _, idx := range rec.Indexes {
+		names = append(names, idx.Name)
+		size += idx.SizeMB
+	}
+	wantNames := []string{"index-4113", "index-4112", "index-4110", "index-4109"}
+	if !slices.Equal(names, wantNames) || rec.TotalSizeMB != size {
+		t.Errorf("indexes %q, %.1f MB; want %q, %.1f MB", names, rec.TotalSizeMB, wantNames, size)
+	}
+	if math.Abs(rec.TargetFOV.WidthDegrees-26.6) > 0.1 || rec.Warning == "" {
+		t.Errorf("TargetFOV %s, Warning %q; want the 27° field and a coverage warning",
+			rec.TargetFOV.String(), rec.Warning)
+	}
+	for _, name := range wantNames {
+		if !strings.Contains(rec.DownloadScript, name+".fits") {
+			t.Errorf("DownloadScript does not download %s:\n%s", name, rec.DownloadScript)
+		}
+	}
+	if s := survey.String(); !strings.Contains(s, "Surveyed 5 images, 1 with no FOV") || !strings.Contains(s, "4 indexes needed") {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestSurveyDirectory_Empty(t *testing.T) {
+	survey, err := SurveyDirectory(writeSurveyFixture(t, map[string][]byte{"notes.txt": []byte("clouded out")}))
+	if err != nil {
+		t.Fatalf("SurveyDirectory failed: %v", err)
+	}
+	if len(survey.Images) != 0 || len(survey.Buckets) != 0 || len(survey.Recommendation.Indexes) != 0 {
+		t.Errorf("survey of a directory without images = %+v", survey)
+	}
+
+	if _, err := SurveyDirectory(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("SurveyDirectory of a missing directory succeeded")
+	}
+}
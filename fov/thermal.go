@@ -0,0 +1,32 @@
+package fov
+
+// SiliconExpansionCoefficient is the linear thermal expansion coefficient
+// of silicon near room temperature, per °C, for ThermalFOVShift.
+const SiliconExpansionCoefficient = 2.6e-6
+
+// ThermalFOVShift returns sensor with its width and height scaled for
+// linear thermal expansion from referenceTemperatureCelsius, at which its
+// dimensions were measured, to temperatureCelsius:
+//
+//	size × (1 + linearExpansionCoeff × (temperature - reference))
+//
+// The change is tiny (about 0.01% for a silicon sensor cooled by 40°C) but
+// adds up across a wide field when comparing against a precise solve.
+func ThermalFOVShift(sensor SensorSize, temperatureCelsius, referenceTemperatureCelsius float64, linearExpansionCoeff float64) SensorSize {
+	scale := 1 + linearExpansionCoeff*(temperatureCelsius-referenceTemperatureCelsius)
+	sensor.Width *= scale
+	sensor.Height *= scale
+	return sensor
+}
+
+// CooledCameraFOV calculates the field of view of a cooled camera whose
+// silicon sensor, with the nominal dimensions in sensor at ambientTemp, is
+// running at cameraTemp (both in °C). Only the sensor is adjusted; thermal
+// changes in the optics' focal length are not modelled.
+//
+// Example:
+//
+//	fov := fov.CooledCameraFOV(530, fov.APSCNikon, -20, 20)
+func CooledCameraFOV(focalLengthMM float64, sensor SensorSize, cameraTemp, ambientTemp float64) FieldOfView {
+	return CalculateFOV(focalLengthMM, ThermalFOVShift(sensor, cameraTemp, ambientTemp, SiliconExpansionCoefficient))
+}
@@ -0,0 +1,45 @@
+package fov
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThermalFOVShift(t *testing.T) {
+	cooled := ThermalFOVShift(FullFrame, -20, 20, SiliconExpansionCoefficient)
+
+	// 40°C × 2.6e-6 = 0.0104% smaller
+	wantScale := 1 - 40*2.6e-6
+	if math.Abs(cooled.Width-FullFrame.Width*wantScale) > 1e-12 || math.Abs(cooled.Height-FullFrame.Height*wantScale) > 1e-12 {
+		t.Errorf("cooled sensor = %v x %v mm, want %v x %v", cooled.Width, cooled.Height,
+			FullFrame.Width*wantScale, FullFrame.Height*wantScale)
+	}
+	if change := (FullFrame.Width - cooled.Width) / FullFrame.Width * 100; math.Abs(change-0.0104) > 1e-9 {
+		t.Errorf("width change = %.6f%%, want 0.0104%%", change)
+	}
+	if cooled.Name != FullFrame.Name {
+		t.Errorf("Name = %q, want %q", cooled.Name, FullFrame.Name)
+	}
+
+	if warm := ThermalFOVShift(FullFrame, 20, 20, SiliconExpansionCoefficient); warm != FullFrame {
+		t.Errorf("no temperature change altered the sensor: %+v", warm)
+	}
+}
+
+func TestCooledCameraFOV(t *testing.T) {
+	const focal = 530.0
+	nominal := CalculateFOV(focal, APSCNikon)
+	cooled := CooledCameraFOV(focal, APSCNikon, -20, 20)
+
+	// At long focal lengths the FOV is nearly proportional to the sensor size
+	change := (nominal.WidthDegrees - cooled.WidthDegrees) / nominal.WidthDegrees
+	if math.Abs(change-40*SiliconExpansionCoefficient) > 1e-7 {
+		t.Errorf("FOV width change = %.3e, want %.3e", change, 40*SiliconExpansionCoefficient)
+	}
+	if cooled.HeightDegrees >= nominal.HeightDegrees || cooled.DiagonalDeg >= nominal.DiagonalDeg {
+		t.Errorf("cooled FOV %+v not smaller than nominal %+v", cooled, nominal)
+	}
+	if want := CalculateFOV(focal, ThermalFOVShift(APSCNikon, -20, 20, SiliconExpansionCoefficient)); cooled != want {
+		t.Errorf("CooledCameraFOV = %+v, want %+v", cooled, want)
+	}
+}
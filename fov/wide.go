@@ -0,0 +1,63 @@
+package fov
+
+import (
+	"math"
+	"strings"
+)
+
+// DefaultMaxFieldDegrees is the field width, in degrees, above which an
+// image is treated as all-sky: wider than any index series covers and too
+// distorted for the TAN projection solve-field fits.
+const DefaultMaxFieldDegrees = 120.0
+
+// fisheyeMarkers appear in the lens or camera names of fisheye lenses and
+// all-sky cameras.
+var fisheyeMarkers = []string{"FISHEYE", "FISH-EYE", "FISH EYE", "ALLSKY", "ALL-SKY", "ALL SKY"}
+
+// isFisheye reports whether any of names, a lens or camera model, marks a
+// fisheye lens or an all-sky camera.
+func isFisheye(names ...string) bool {
+	for _, name := range names {
+		name = strings.ToUpper(name)
+		for _, marker := range fisheyeMarkers {
+			if strings.Contains(name, marker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TooWide reports whether the image is too wide to solve whole: its lens
+// or camera is a fisheye or all-sky one, or its field is wider than
+// maxDegrees (DefaultMaxFieldDegrees when 0). Solve a central crop of such
+// images instead.
+func (i *ImageInfo) TooWide(maxDegrees float64) bool {
+	if maxDegrees <= 0 {
+		maxDegrees = DefaultMaxFieldDegrees
+	}
+	return i.Fisheye || i.FOV.WidthDegrees > maxDegrees
+}
+
+// CentralPixelsPerDegree returns the image scale at the optical axis in
+// pixels per degree, from the focal length, sensor width and image width.
+// Every lens projection has the same scale there, so it converts the
+// angular size of a small central crop to pixels even for a fisheye. ok is
+// false when any of the three is unknown.
+func (i *ImageInfo) CentralPixelsPerDegree() (pixelsPerDegree float64, ok bool) {
+	if i.FocalLength <= 0 || i.Sensor.Width <= 0 || i.FOV.WidthPixels <= 0 {
+		return 0, false
+	}
+	pixelPitch := i.Sensor.Width / float64(i.FOV.WidthPixels) // mm
+	return i.FocalLength / pixelPitch * math.Pi / 180, true
+}
+
+// widestIndexFOV returns the widest field, in degrees, any index in
+// IndexCatalog covers.
+func widestIndexFOV() float64 {
+	var widest float64
+	for _, idx := range IndexCatalog() {
+		widest = max(widest, idx.MaxFOV)
+	}
+	return widest
+}
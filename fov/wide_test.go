@@ -0,0 +1,88 @@
+package fov
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestIsFisheye(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  bool
+	}{
+		{[]string{"Samyang 8mm f/3.5 UMC Fish-eye CS II", ""}, true},
+		{[]string{"AF DX Fisheye-Nikkor 10.5mm f/2.8G ED", "NIKON D7500"}, true},
+		{[]string{"", "ZWO ASI178MC AllSky"}, true},
+		{[]string{"EF-S18-55mm f/3.5-5.6 IS STM", "Canon EOS M50m2"}, false},
+		{[]string{"", ""}, false},
+	}
+	for _, tt := range tests {
+		if got := isFisheye(tt.names...); got != tt.want {
+			t.Errorf("isFisheye(%q) = %v, want %v", tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestImageInfo_TooWide(t *testing.T) {
+	tests := []struct {
+		name  string
+		info  ImageInfo
+		limit float64
+		want  bool
+	}{
+		{"at the default limit", ImageInfo{FOV: FieldOfView{WidthDegrees: 120}}, 0, false},
+		{"over the default limit", ImageInfo{FOV: FieldOfView{WidthDegrees: 120.1}}, 0, true},
+		{"over a lower limit", ImageInfo{FOV: FieldOfView{WidthDegrees: 90}}, 80, true},
+		{"fisheye of unknown width", ImageInfo{Fisheye: true}, 0, true},
+		{"unknown width", ImageInfo{}, 0, false},
+	}
+	for _, tt := range tests {
+		if got := tt.info.TooWide(tt.limit); got != tt.want {
+			t.Errorf("%s: TooWide(%v) = %v, want %v", tt.name, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestImageInfo_CentralPixelsPerDegree(t *testing.T) {
+	// 8 mm on 36 mm / 6000 px: 6 µm pixels, 8/0.006 px per radian
+	info := ImageInfo{FocalLength: 8, Sensor: FullFrame, FOV: FieldOfView{WidthPixels: 6000}}
+	got, ok := info.CentralPixelsPerDegree()
+	if want := 8 / 0.006 * math.Pi / 180; !ok || math.Abs(got-want) > 1e-9 {
+		t.Errorf("CentralPixelsPerDegree = %v, %v; want %v", got, ok, want)
+	}
+	if _, ok := (&ImageInfo{FocalLength: 8, Sensor: FullFrame}).CentralPixelsPerDegree(); ok {
+		t.Error("CentralPixelsPerDegree without a width should not be ok")
+	}
+}
+
+func TestAnalyzeImage_AllSkyCamera(t *testing.T) {
+	path := writeFile(t, "allsky.jpg", jpegWithEXIF(tiffWithEXIF("Canon", "Canon EOS 6D AllSky", 5472, 8)))
+	info, err := AnalyzeImage(path)
+	if err != nil {
+		t.Fatalf("AnalyzeImage failed: %v", err)
+	}
+	if !info.Fisheye || info.Sensor.ProjectionType != ProjectionEquidistant {
+		t.Errorf("Fisheye = %v, projection %q; want an equidistant fisheye", info.Fisheye, info.Sensor.ProjectionType)
+	}
+	if !info.TooWide(0) {
+		t.Errorf("all-sky frame %.0f° wide is not TooWide", info.FOV.WidthDegrees)
+	}
+}
+
+func TestRecommendIndexes_CoverageWarning(t *testing.T) {
+	ClearRecommendationCache()
+	if rec := RecommendIndexes(5, 1.2); rec.Warning != "" {
+		t.Errorf("5° field warned: %s", rec.Warning)
+	}
+	rec := RecommendIndexes(180, 1.2)
+	if !strings.Contains(rec.Warning, "no index") || !strings.Contains(rec.String(), "Warning:") {
+		t.Errorf("180° field warning = %q", rec.Warning)
+	}
+
+	fisheye := FullFrame
+	fisheye.ProjectionType = ProjectionEquidistant
+	if rec := RecommendIndexesForLens(8, 8, fisheye, 1.2); rec.Warning == "" {
+		t.Error("fisheye lens recommendation has no warning")
+	}
+}
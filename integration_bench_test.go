@@ -0,0 +1,219 @@
+//go:build integration
+// +build integration
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// BenchmarkSolveThroughput solves a fixture image repeatedly to size a
+// solver host: for each concurrency it reports solves/s and the p50 and
+// p95 latency of a single solve. Configure it with environment variables:
+//
+//	ASTROMETRY_BENCH_CONCURRENCY  comma-separated worker counts (default "1,2,4")
+//	ASTROMETRY_BENCH_IMAGE        image to solve (default images/IMG_2820.JPG)
+//	ASTROMETRY_BENCH_SCALE        field width bounds in degrees (default "5,8")
+//	ASTROMETRY_BENCH_CONTAINER    solve in this running container with docker
+//	                              exec instead of docker run (optional)
+//
+// Example:
+//
+//	ASTROMETRY_BENCH_CONCURRENCY=1,4,8 go test -tags=integration \
+//		-run '^$' -bench SolveThroughput -benchtime 20x .
+func BenchmarkSolveThroughput(b *testing.B) {
+	indexPath, imagePath, opts := benchSetup(b)
+	concurrencies, err := parseBenchList(benchEnv("ASTROMETRY_BENCH_CONCURRENCY", "1,2,4"), strconv.Atoi)
+	if err != nil {
+		b.Fatalf("invalid ASTROMETRY_BENCH_CONCURRENCY: %v", err)
+	}
+
+	config := &ClientConfig{IndexPath: indexPath, Timeout: 3 * time.Minute}
+	if container := os.Getenv("ASTROMETRY_BENCH_CONTAINER"); container != "" {
+		config.UseDockerExec = true
+		config.ContainerName = container
+	}
+	client, err := NewClient(config)
+	if err != nil {
+		b.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	// One solve up front, so a broken setup fails fast and image pulls and
+	// cold index reads are not timed
+	if result, err := client.Solve(ctx, imagePath, opts); err != nil || !result.Solved {
+		b.Fatalf("warm-up solve failed: solved=%v err=%v", result != nil && result.Solved, err)
+	}
+
+	for _, workers := range concurrencies {
+		b.Run(fmt.Sprintf("concurrency=%d", workers), func(b *testing.B) {
+			latencies := make([]time.Duration, b.N)
+			var failures []error
+			var mu sync.Mutex
+			jobs := make(chan int)
+			var wg sync.WaitGroup
+
+			b.ResetTimer()
+			start := time.Now()
+			for w := 0; w < workers; w++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range jobs {
+						began := time.Now()
+						result, err := client.Solve(ctx, imagePath, opts)
+						latencies[i] = time.Since(began)
+						if err == nil && !result.Solved {
+							err = ErrNoSolution
+						}
+						if err != nil {
+							mu.Lock()
+							failures = append(failures, err)
+							mu.Unlock()
+						}
+					}
+				}()
+			}
+			for i := 0; i < b.N; i++ {
+				jobs <- i
+			}
+			close(jobs)
+			wg.Wait()
+			elapsed := time.Since(start)
+			b.StopTimer()
+
+			if len(failures) > 0 {
+				b.Fatalf("%d of %d solves failed: %v", len(failures), b.N, errors.Join(failures...))
+			}
+			slices.Sort(latencies)
+			b.ReportMetric(float64(b.N)/elapsed.Seconds(), "solves/s")
+			b.ReportMetric(percentile(latencies, 0.50).Seconds(), "p50-s")
+			b.ReportMetric(percentile(latencies, 0.95).Seconds(), "p95-s")
+		})
+	}
+}
+
+// BenchmarkSolveWarmPool compares the latency of one solve at a time in a
+// new docker run container with a solve in a warm pool container. It takes
+// the same ASTROMETRY_BENCH_IMAGE and ASTROMETRY_BENCH_SCALE settings as
+// BenchmarkSolveThroughput.
+//
+// Example:
+//
+//	go test -tags=integration -run '^$' -bench SolveWarmPool -benchtime 10x .
+func BenchmarkSolveWarmPool(b *testing.B) {
+	indexPath, imagePath, opts := benchSetup(b)
+
+	for _, mode := range []struct {
+		name     string
+		poolSize int
+	}{
+		{"run", 0},
+		{"pool", 1},
+	} {
+		b.Run("mode="+mode.name, func(b *testing.B) {
+			client, err := NewClient(&ClientConfig{IndexPath: indexPath, Timeout: 3 * time.Minute, WarmPoolSize: mode.poolSize})
+			if err != nil {
+				b.Fatalf("Failed to create client: %v", err)
+			}
+			defer client.Close()
+			ctx := context.Background()
+
+			// Untimed: pulls the image, reads the indexes and starts the
+			// pool container
+			if result, err := client.Solve(ctx, imagePath, opts); err != nil || !result.Solved {
+				b.Fatalf("warm-up solve failed: solved=%v err=%v", result != nil && result.Solved, err)
+			}
+
+			latencies := make([]time.Duration, b.N)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				began := time.Now()
+				result, err := client.Solve(ctx, imagePath, opts)
+				latencies[i] = time.Since(began)
+				if err != nil || !result.Solved {
+					b.Fatalf("solve %d failed: solved=%v err=%v", i, result != nil && result.Solved, err)
+				}
+			}
+			b.StopTimer()
+
+			slices.Sort(latencies)
+			b.ReportMetric(percentile(latencies, 0.50).Seconds(), "p50-s")
+			b.ReportMetric(percentile(latencies, 0.95).Seconds(), "p95-s")
+		})
+	}
+}
+
+// benchSetup skips without Docker or indexes, and returns the index path,
+// the image to solve and options bounding its scale from the environment.
+func benchSetup(b *testing.B) (indexPath, imagePath string, opts *SolveOptions) {
+	b.Helper()
+	if err := exec.Command("docker", "version").Run(); err != nil {
+		b.Skipf("Docker is not available: %v", err)
+	}
+	indexPath = os.Getenv("ASTROMETRY_INDEX_PATH")
+	if indexPath == "" {
+		indexPath = filepath.Join(os.Getenv("HOME"), "astrometry-data")
+	}
+	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+		b.Skipf("Index path does not exist: %s. Set ASTROMETRY_INDEX_PATH or download indexes.", indexPath)
+	}
+
+	imagePath = benchEnv("ASTROMETRY_BENCH_IMAGE", filepath.Join("images", "IMG_2820.JPG"))
+	if _, err := os.Stat(imagePath); err != nil {
+		b.Fatalf("Benchmark image not found: %v", err)
+	}
+	scale, err := parseBenchList(benchEnv("ASTROMETRY_BENCH_SCALE", "5,8"), func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+	if err != nil || len(scale) != 2 {
+		b.Fatalf("ASTROMETRY_BENCH_SCALE must be two widths in degrees: %v", err)
+	}
+
+	opts = DefaultSolveOptions()
+	opts.ScaleLow, opts.ScaleHigh, opts.ScaleUnits = scale[0], scale[1], "degwidth"
+	return indexPath, imagePath, opts
+}
+
+// benchEnv returns the environment variable key, or def when it is unset.
+func benchEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// parseBenchList parses a comma-separated list of numbers.
+func parseBenchList[T any](list string, parse func(string) (T, error)) ([]T, error) {
+	var values []T
+	for _, field := range strings.Split(list, ",") {
+		v, err := parse(strings.TrimSpace(field))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// percentile returns the p quantile (0-1) of sorted latencies, by the
+// nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
@@ -5,124 +5,24 @@ package client
 
 import (
 	"context"
-	"encoding/json"
 	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"testing"
 	"time"
-)
 
-// GroundTruth represents the expected solve results for a test image
-type GroundTruth struct {
-	Description string `json:"description"`
-	Source      string `json:"source"`
-	Camera      struct {
-		LensMM                  float64 `json:"lens_mm"`
-		Mount                   string  `json:"mount"`
-		Sensor                  string  `json:"sensor"`
-		CropFactor              float64 `json:"crop_factor"`
-		EffectiveFocalLengthMM  float64 `json:"effective_focal_length_mm"`
-		Notes                   string  `json:"notes"`
-	} `json:"camera"`
-	Solution struct {
-		RA                       float64 `json:"ra"`
-		Dec                      float64 `json:"dec"`
-		PixelScaleArcsecPerPixel float64 `json:"pixel_scale_arcsec_per_pixel"`
-		RotationDegrees          float64 `json:"rotation_degrees"`
-		FieldWidthDegrees        float64 `json:"field_width_degrees"`
-		FieldHeightDegrees       float64 `json:"field_height_degrees"`
-		ImageWidthPixels         int     `json:"image_width_pixels"`
-		ImageHeightPixels        int     `json:"image_height_pixels"`
-	} `json:"solution"`
-	Tolerance struct {
-		PositionArcsec     float64 `json:"position_arcsec"`
-		PixelScalePercent  float64 `json:"pixel_scale_percent"`
-		RotationDegrees    float64 `json:"rotation_degrees"`
-		FieldSizePercent   float64 `json:"field_size_percent"`
-	} `json:"tolerance"`
-	Notes []string `json:"notes"`
-}
+	"github.com/DiarmuidKelly/astrometry-go-client/testsupport"
+)
 
 // loadGroundTruth loads ground truth data from testdata/ground_truth.json
-func loadGroundTruth(t *testing.T, imageFilename string) *GroundTruth {
-	groundTruthPath := filepath.Join("testdata", "ground_truth.json")
-
-	data, err := os.ReadFile(groundTruthPath)
-	if err != nil {
-		t.Fatalf("Failed to read ground truth file: %v", err)
-	}
-
-	var groundTruths map[string]GroundTruth
-	if err := json.Unmarshal(data, &groundTruths); err != nil {
-		t.Fatalf("Failed to parse ground truth JSON: %v", err)
-	}
-
-	gt, ok := groundTruths[imageFilename]
-	if !ok {
-		t.Fatalf("No ground truth found for %s", imageFilename)
-	}
-
-	return &gt
-}
-
-// validateResult checks if a solve result matches ground truth within tolerance
-func validateResult(t *testing.T, result *Result, gt *GroundTruth) {
+func loadGroundTruth(t *testing.T, imageFilename string) *testsupport.GroundTruth {
 	t.Helper()
-
-	if !result.Solved {
-		t.Fatal("Image was not solved")
-	}
-
-	// Validate RA (convert to arcseconds for comparison)
-	raDiff := math.Abs(result.RA - gt.Solution.RA) * 3600.0
-	if raDiff > gt.Tolerance.PositionArcsec {
-		t.Errorf("RA difference %.2f arcsec exceeds tolerance %.2f arcsec (got %.6f, expected %.6f)",
-			raDiff, gt.Tolerance.PositionArcsec, result.RA, gt.Solution.RA)
-	}
-
-	// Validate Dec (convert to arcseconds)
-	decDiff := math.Abs(result.Dec - gt.Solution.Dec) * 3600.0
-	if decDiff > gt.Tolerance.PositionArcsec {
-		t.Errorf("Dec difference %.2f arcsec exceeds tolerance %.2f arcsec (got %.6f, expected %.6f)",
-			decDiff, gt.Tolerance.PositionArcsec, result.Dec, gt.Solution.Dec)
-	}
-
-	// Validate pixel scale (percent difference)
-	pixelScaleDiff := math.Abs((result.PixelScale - gt.Solution.PixelScaleArcsecPerPixel) / gt.Solution.PixelScaleArcsecPerPixel * 100.0)
-	if pixelScaleDiff > gt.Tolerance.PixelScalePercent {
-		t.Errorf("Pixel scale difference %.2f%% exceeds tolerance %.2f%% (got %.2f, expected %.2f)",
-			pixelScaleDiff, gt.Tolerance.PixelScalePercent, result.PixelScale, gt.Solution.PixelScaleArcsecPerPixel)
-	}
-
-	// Validate rotation (degrees)
-	rotationDiff := math.Abs(result.Rotation - gt.Solution.RotationDegrees)
-	if rotationDiff > gt.Tolerance.RotationDegrees {
-		t.Errorf("Rotation difference %.2f° exceeds tolerance %.2f° (got %.2f, expected %.2f)",
-			rotationDiff, gt.Tolerance.RotationDegrees, result.Rotation, gt.Solution.RotationDegrees)
-	}
-
-	// Validate field width (percent difference)
-	fieldWidthDiff := math.Abs((result.FieldWidth - gt.Solution.FieldWidthDegrees) / gt.Solution.FieldWidthDegrees * 100.0)
-	if fieldWidthDiff > gt.Tolerance.FieldSizePercent {
-		t.Errorf("Field width difference %.2f%% exceeds tolerance %.2f%% (got %.4f, expected %.4f)",
-			fieldWidthDiff, gt.Tolerance.FieldSizePercent, result.FieldWidth, gt.Solution.FieldWidthDegrees)
-	}
-
-	// Validate field height (percent difference)
-	fieldHeightDiff := math.Abs((result.FieldHeight - gt.Solution.FieldHeightDegrees) / gt.Solution.FieldHeightDegrees * 100.0)
-	if fieldHeightDiff > gt.Tolerance.FieldSizePercent {
-		t.Errorf("Field height difference %.2f%% exceeds tolerance %.2f%% (got %.4f, expected %.4f)",
-			fieldHeightDiff, gt.Tolerance.FieldSizePercent, result.FieldHeight, gt.Solution.FieldHeightDegrees)
+	gt, err := testsupport.LoadGroundTruth(filepath.Join("testdata", "ground_truth.json"), imageFilename)
+	if err != nil {
+		t.Fatalf("Failed to load ground truth: %v", err)
 	}
-
-	t.Logf("Validation passed:")
-	t.Logf("  RA:          %.6f° (diff: %.2f arcsec)", result.RA, raDiff)
-	t.Logf("  Dec:         %.6f° (diff: %.2f arcsec)", result.Dec, decDiff)
-	t.Logf("  Pixel scale: %.2f arcsec/px (diff: %.2f%%)", result.PixelScale, pixelScaleDiff)
-	t.Logf("  Rotation:    %.2f° (diff: %.2f°)", result.Rotation, rotationDiff)
-	t.Logf("  Field size:  %.4f° x %.4f°", result.FieldWidth, result.FieldHeight)
+	return gt
 }
 
 // TestM42WithGroundTruth tests all 3 Docker images against real M42 image with ground truth
@@ -199,7 +99,7 @@ func TestM42WithGroundTruth(t *testing.T) {
 			}
 
 			// Validate against ground truth
-			validateResult(t, result, gt)
+			testsupport.Validate(t, result, gt)
 
 			// Store result for cross-comparison
 			results[tc.name] = result
@@ -226,22 +126,15 @@ func TestM42WithGroundTruth(t *testing.T) {
 					continue
 				}
 
-				// RA/Dec should match within 5 arcsec
-				raDiff := math.Abs(result.RA - refResult.RA) * 3600.0
-				decDiff := math.Abs(result.Dec - refResult.Dec) * 3600.0
-
-				if raDiff > 5.0 {
-					t.Errorf("%s vs %s: RA differs by %.2f arcsec (%.6f vs %.6f)",
-						name, refName, raDiff, result.RA, refResult.RA)
-				}
-
-				if decDiff > 5.0 {
-					t.Errorf("%s vs %s: Dec differs by %.2f arcsec (%.6f vs %.6f)",
-						name, refName, decDiff, result.Dec, refResult.Dec)
+				// Field centers should match within 5 arcsec on the sky
+				c := Compare(result, refResult)
+				if c.SeparationArcsec > 5.0 {
+					t.Errorf("%s vs %s: position differs by %.2f arcsec (RA offset %.2f, Dec offset %.2f arcsec)",
+						name, refName, c.SeparationArcsec, c.RAOffsetArcsec, c.DecOffsetArcsec)
 				}
 
 				// Pixel scale should match within 2%
-				pixelScaleDiff := math.Abs((result.PixelScale - refResult.PixelScale) / refResult.PixelScale * 100.0)
+				pixelScaleDiff := math.Abs(c.PixelScalePercent)
 				if pixelScaleDiff > 2.0 {
 					t.Errorf("%s vs %s: Pixel scale differs by %.2f%% (%.2f vs %.2f)",
 						name, refName, pixelScaleDiff, result.PixelScale, refResult.PixelScale)
@@ -309,7 +202,117 @@ func TestConvertedJPEGWithGroundTruth(t *testing.T) {
 	}
 
 	// Validate against same ground truth as MPO
-	validateResult(t, result, gt)
+	testsupport.Validate(t, result, gt)
 
 	t.Logf("Converted JPEG produces results within tolerance of MPO ground truth")
 }
+
+// TestM42ExtractorComparison solves the M42 image with solve-field's simplexy
+// and with the pure Go ThresholdExtractor, checking both against ground truth
+func TestM42ExtractorComparison(t *testing.T) {
+	if !isDockerAvailable(t) {
+		t.Skip("Docker is not available")
+	}
+
+	indexPath := os.Getenv("ASTROMETRY_INDEX_PATH")
+	if indexPath == "" {
+		indexPath = filepath.Join(os.Getenv("HOME"), "astrometry-data")
+	}
+
+	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+		t.Skipf("Index path does not exist: %s. Set ASTROMETRY_INDEX_PATH or download indexes.", indexPath)
+	}
+
+	testImageFilename := "IMG_2820.JPG"
+	testImagePath := filepath.Join("images", testImageFilename)
+	gt := loadGroundTruth(t, testImageFilename)
+
+	extractors := []struct {
+		name      string
+		extractor Extractor
+	}{
+		{name: ExtractorSimplexy, extractor: nil},
+		{name: "threshold", extractor: &ThresholdExtractor{}},
+	}
+
+	for _, e := range extractors {
+		t.Run(e.name, func(t *testing.T) {
+			client, err := NewClient(&ClientConfig{
+				IndexPath:   indexPath,
+				DockerImage: "diarmuidk/astrometry-dockerised-solver:latest",
+				Timeout:     3 * time.Minute,
+				Extractor:   e.extractor,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			opts := DefaultSolveOptions()
+			opts.ScaleLow = 1.0
+			opts.ScaleHigh = 180.0
+			opts.ScaleUnits = "degwidth"
+			opts.DownsampleFactor = 2
+
+			result, err := client.Solve(context.Background(), testImagePath, opts)
+			if err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+			if result.Extractor != e.name {
+				t.Errorf("Extractor = %q, want %q", result.Extractor, e.name)
+			}
+
+			testsupport.Validate(t, result, gt)
+			t.Logf("Solve time with %s: %v", e.name, result.TotalTime)
+		})
+	}
+}
+
+// TestM42HintRadiusSchedule solves the M42 image with a hint ~6.5° off the
+// true center, which the first 2° radius misses and the 10° radius finds
+func TestM42HintRadiusSchedule(t *testing.T) {
+	if !isDockerAvailable(t) {
+		t.Skip("Docker is not available")
+	}
+
+	indexPath := os.Getenv("ASTROMETRY_INDEX_PATH")
+	if indexPath == "" {
+		indexPath = filepath.Join(os.Getenv("HOME"), "astrometry-data")
+	}
+
+	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+		t.Skipf("Index path does not exist: %s. Set ASTROMETRY_INDEX_PATH or download indexes.", indexPath)
+	}
+
+	testImageFilename := "IMG_2820.JPG"
+	testImagePath := filepath.Join("images", testImageFilename)
+	gt := loadGroundTruth(t, testImageFilename)
+
+	client, err := NewClient(&ClientConfig{
+		IndexPath:   indexPath,
+		DockerImage: "diarmuidk/astrometry-dockerised-solver:latest",
+		Timeout:     5 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	opts := DefaultSolveOptions()
+	opts.ScaleLow = 1.0
+	opts.ScaleHigh = 180.0
+	opts.ScaleUnits = "degwidth"
+	opts.DownsampleFactor = 2
+	opts.RA = gt.Solution.RA
+	opts.Dec = gt.Solution.Dec + 6.5
+	opts.HintRadiusSchedule = []float64{2, 10}
+
+	result, err := client.Solve(context.Background(), testImagePath, opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.HintRadius != 10 {
+		t.Errorf("HintRadius = %v, want the second radius 10", result.HintRadius)
+	}
+
+	testsupport.Validate(t, result, gt)
+	t.Logf("Solve time over both radii: %v", result.TotalTime)
+}
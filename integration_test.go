@@ -11,6 +11,8 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
 )
 
 // TestDockerRunMode tests the default docker run mode
@@ -68,7 +70,7 @@ func TestDockerRunMode(t *testing.T) {
 	}
 
 	if result != nil {
-		t.Logf("Solve completed: Solved=%v, Time=%.2fs", result.Solved, result.SolveTime)
+		t.Logf("Solve completed: Solved=%v, Time=%.2fs", result.Solved, result.TotalTime)
 		if result.Solved {
 			t.Logf("  RA=%.6f, Dec=%.6f, PixelScale=%.2f", result.RA, result.Dec, result.PixelScale)
 		}
@@ -162,7 +164,7 @@ func TestDockerExecMode(t *testing.T) {
 	}
 
 	if result != nil {
-		t.Logf("Solve completed: Solved=%v, Time=%.2fs", result.Solved, result.SolveTime)
+		t.Logf("Solve completed: Solved=%v, Time=%.2fs", result.Solved, result.TotalTime)
 		if result.Solved {
 			t.Logf("  RA=%.6f, Dec=%.6f, PixelScale=%.2f", result.RA, result.Dec, result.PixelScale)
 		}
@@ -248,12 +250,71 @@ func TestImageCompatibility(t *testing.T) {
 			}
 
 			if result != nil {
-				t.Logf("Image %s: Solved=%v, Time=%.2fs", tc.name, result.Solved, result.SolveTime)
+				t.Logf("Image %s: Solved=%v, Time=%.2fs", tc.name, result.Solved, result.TotalTime)
 			}
 		})
 	}
 }
 
+// TestSolveXYListMultiField solves a two-field xylist in one solve-field run
+func TestSolveXYListMultiField(t *testing.T) {
+	if !isDockerAvailable(t) {
+		t.Skip("Docker is not available")
+	}
+
+	indexPath := os.Getenv("ASTROMETRY_INDEX_PATH")
+	if indexPath == "" {
+		indexPath = filepath.Join(os.Getenv("HOME"), "astrometry-data")
+	}
+	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+		t.Skipf("Index path does not exist: %s. Set ASTROMETRY_INDEX_PATH or download indexes.", indexPath)
+	}
+
+	client, err := NewClient(&ClientConfig{IndexPath: indexPath, Timeout: 2 * time.Minute})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Two fields of synthetic stars; they need not solve, but both fields
+	// must come back from the single invocation
+	size := []fits.Card{{Key: "IMAGEW", Value: "1000"}, {Key: "IMAGEH", Value: "1000"}}
+	field := func(offset float64) fits.TableHDU {
+		var xs, ys, flux []float64
+		for i := 0; i < 30; i++ {
+			xs = append(xs, float64((i*137)%1000)+offset)
+			ys = append(ys, float64((i*311)%1000)+offset)
+			flux = append(flux, float64(1000-i*20))
+		}
+		return fits.TableHDU{Cards: size, Columns: []string{"X", "Y", "FLUX"}, Data: [][]float64{xs, ys, flux}}
+	}
+
+	xylistPath := filepath.Join(t.TempDir(), "two-field.xyls")
+	out, err := os.Create(xylistPath)
+	if err != nil {
+		t.Fatalf("Failed to create xylist: %v", err)
+	}
+	if err := fits.WriteTables(out, nil, field(0), field(0.5)); err != nil {
+		t.Fatalf("Failed to write xylist: %v", err)
+	}
+	_ = out.Close()
+
+	opts := DefaultSolveOptions()
+	opts.ScaleLow = 0.5
+	opts.ScaleHigh = 10.0
+	opts.ScaleUnits = "degwidth"
+
+	results, err := client.SolveXYList(context.Background(), xylistPath, opts)
+	if err != nil {
+		t.Fatalf("SolveXYList failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	for i, r := range results {
+		t.Logf("Field %d: Solved=%v, Time=%.2fs", i+1, r.Solved, r.TotalTime)
+	}
+}
+
 // Helper functions
 
 func isDockerAvailable(t *testing.T) bool {
@@ -0,0 +1,569 @@
+// Package fits implements the small subset of the FITS format needed to
+// exchange headers, images and source tables with the astrometry.net tools.
+//
+// It is intentionally minimal: 80-character header cards, primary images
+// (written as 8-bit, read in any standard BITPIX), and binary tables with
+// numeric columns.
+package fits
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// BlockSize is the size of a FITS logical record in bytes.
+	BlockSize = 2880
+
+	// CardSize is the size of a single header card in bytes.
+	CardSize = 80
+
+	// MaxDataSize is the largest data unit a header may describe. Readers
+	// reject larger sizes, so a corrupt or crafted header cannot make them
+	// allocate without bound.
+	MaxDataSize = 1 << 32
+)
+
+// ErrNoTable indicates that a file contains no binary table extension.
+var ErrNoTable = errors.New("no binary table extension found")
+
+// Card is a single FITS header record.
+type Card struct {
+	Key     string
+	Value   string // Unquoted value (strings have surrounding quotes removed)
+	Comment string
+}
+
+// Header is an ordered list of header cards.
+type Header struct {
+	Cards []Card
+}
+
+// Get returns the value of the first card with the given key.
+func (h *Header) Get(key string) (string, bool) {
+	for _, c := range h.Cards {
+		if c.Key == key {
+			return c.Value, true
+		}
+	}
+	return "", false
+}
+
+// Float returns the value of key parsed as a float64.
+func (h *Header) Float(key string) (float64, bool) {
+	val, ok := h.Get(key)
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(strings.Replace(val, "D", "E", 1), 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// Int returns the value of key parsed as an int.
+func (h *Header) Int(key string) (int, bool) {
+	v, ok := h.Float(key)
+	if !ok {
+		return 0, false
+	}
+	return int(v), true
+}
+
+// Map returns the header as a key/value map. Later duplicates win.
+func (h *Header) Map() map[string]string {
+	m := make(map[string]string, len(h.Cards))
+	for _, c := range h.Cards {
+		m[c.Key] = c.Value
+	}
+	return m
+}
+
+// ParseCard parses a single 80-character header record.
+// It returns false for records without a value indicator (COMMENT, HISTORY, END, blanks).
+func ParseCard(line string) (Card, bool) {
+	if len(line) < 10 || line[8:10] != "= " {
+		return Card{}, false
+	}
+
+	card := Card{Key: strings.TrimSpace(line[:8])}
+	rest := strings.TrimSpace(line[10:])
+
+	if strings.HasPrefix(rest, "'") {
+		// String value: find the closing quote, honoring '' escapes
+		var sb strings.Builder
+		i := 1
+		for i < len(rest) {
+			if rest[i] == '\'' {
+				if i+1 < len(rest) && rest[i+1] == '\'' {
+					sb.WriteByte('\'')
+					i += 2
+					continue
+				}
+				break
+			}
+			sb.WriteByte(rest[i])
+			i++
+		}
+		card.Value = strings.TrimRight(sb.String(), " ")
+		if i < len(rest) {
+			rest = rest[i+1:]
+		} else {
+			rest = ""
+		}
+	} else {
+		value := rest
+		if idx := strings.Index(rest, "/"); idx != -1 {
+			value = rest[:idx]
+			rest = rest[idx:]
+		} else {
+			rest = ""
+		}
+		card.Value = strings.TrimSpace(value)
+	}
+
+	if idx := strings.Index(rest, "/"); idx != -1 {
+		card.Comment = strings.TrimSpace(rest[idx+1:])
+	}
+	return card, true
+}
+
+// ReadHeader reads header blocks from r up to and including the END card.
+// The reader is left positioned at the start of the data unit.
+func ReadHeader(r io.Reader) (*Header, error) {
+	h := &Header{}
+	block := make([]byte, BlockSize)
+	for {
+		if _, err := io.ReadFull(r, block); err != nil {
+			if errors.Is(err, io.EOF) && len(h.Cards) == 0 {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("failed to read FITS header: %w", err)
+		}
+		for i := 0; i < BlockSize; i += CardSize {
+			line := string(block[i : i+CardSize])
+			if strings.TrimSpace(line[:8]) == "END" {
+				return h, nil
+			}
+			if card, ok := ParseCard(line); ok {
+				h.Cards = append(h.Cards, card)
+			}
+		}
+	}
+}
+
+// DataSize returns the size in bytes of the data unit described by h,
+// excluding padding to the block boundary. It fails for negative or
+// non-integer axis lengths and for sizes over MaxDataSize.
+func (h *Header) DataSize() (int64, error) {
+	naxis, _ := h.Int("NAXIS")
+	if naxis == 0 {
+		return 0, nil
+	}
+	if naxis < 0 || naxis > 999 {
+		return 0, fmt.Errorf("invalid NAXIS %d", naxis)
+	}
+	bitpix, _ := h.Int("BITPIX")
+	if bitpix < 0 {
+		bitpix = -bitpix
+	}
+
+	// Counted in float64, which cannot wrap around like int64
+	count := func(key string, def float64) (float64, error) {
+		n, ok := h.Float(key)
+		if !ok {
+			return def, nil
+		}
+		if n < 0 || n != math.Trunc(n) {
+			return 0, fmt.Errorf("invalid %s %v", key, n)
+		}
+		return n, nil
+	}
+	elems := 1.0
+	for i := 1; i <= naxis; i++ {
+		n, err := count(fmt.Sprintf("NAXIS%d", i), 0)
+		if err != nil {
+			return 0, err
+		}
+		elems *= n
+	}
+	pcount, err := count("PCOUNT", 0)
+	if err != nil {
+		return 0, err
+	}
+	gcount, err := count("GCOUNT", 1)
+	if err != nil {
+		return 0, err
+	}
+	size := float64(bitpix/8) * gcount * (pcount + elems)
+	if !(size <= MaxDataSize) {
+		return 0, fmt.Errorf("FITS data unit of %g bytes exceeds the %d byte limit", size, int64(MaxDataSize))
+	}
+	return int64(size), nil
+}
+
+// readData reads an n byte data unit from r. The buffer grows with the
+// bytes actually read, so a header claiming more data than r holds fails
+// without allocating the claimed size up front.
+func readData(r io.Reader, n int64) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r, n); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// padded returns n rounded up to a whole number of blocks.
+func padded(n int64) int64 {
+	return (n + BlockSize - 1) / BlockSize * BlockSize
+}
+
+// FormatCard formats a key/value/comment triple as an 80-character record.
+// Values are written verbatim; use Quote for string values. Strings are
+// left-aligned from column 11 and other values right-aligned to column 30,
+// as required by the fixed format.
+func FormatCard(key, value, comment string) string {
+	format := "%-8s= %20s"
+	if strings.HasPrefix(value, "'") {
+		format = "%-8s= %-20s"
+	}
+	line := fmt.Sprintf(format, key, value)
+	if comment != "" {
+		line += " / " + comment
+	}
+	if len(line) > CardSize {
+		line = line[:CardSize]
+	}
+	return fmt.Sprintf("%-80s", line)
+}
+
+// Quote formats s as a FITS string value.
+func Quote(s string) string {
+	return fmt.Sprintf("'%-8s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+// EncodeHeader encodes cards followed by END, padded to a block boundary.
+func EncodeHeader(cards []Card) []byte {
+	var buf bytes.Buffer
+	for _, c := range cards {
+		buf.WriteString(FormatCard(c.Key, c.Value, c.Comment))
+	}
+	buf.WriteString(fmt.Sprintf("%-80s", "END"))
+	for buf.Len()%BlockSize != 0 {
+		buf.WriteByte(' ')
+	}
+	return buf.Bytes()
+}
+
+// WriteImage writes a single-HDU FITS file containing an 8-bit grayscale image.
+// pix is in row-major order starting at the top-left pixel; FITS stores the
+// bottom row first, so rows are flipped on output.
+func WriteImage(w io.Writer, width, height int, pix []uint8) error {
+	if len(pix) != width*height {
+		return fmt.Errorf("pixel buffer has %d bytes, want %d", len(pix), width*height)
+	}
+	cards := []Card{
+		{Key: "SIMPLE", Value: "T"},
+		{Key: "BITPIX", Value: "8"},
+		{Key: "NAXIS", Value: "2"},
+		{Key: "NAXIS1", Value: strconv.Itoa(width)},
+		{Key: "NAXIS2", Value: strconv.Itoa(height)},
+	}
+	if _, err := w.Write(EncodeHeader(cards)); err != nil {
+		return err
+	}
+	for y := height - 1; y >= 0; y-- {
+		if _, err := w.Write(pix[y*width : (y+1)*width]); err != nil {
+			return err
+		}
+	}
+	return writePadding(w, int64(width*height))
+}
+
+// Image is the first 2D plane of a primary image HDU, kept in its on-disk
+// encoding and decoded per pixel by At.
+type Image struct {
+	Header        *Header
+	Width, Height int
+
+	bitpix        int
+	bzero, bscale float64
+	data          []byte
+}
+
+// ReadImage reads the primary HDU image from r. Integer (BITPIX 8, 16, 32)
+// and floating point (-32, -64) data are supported; for cubes only the
+// first plane is read. Headers describing more than MaxDataSize bytes are
+// rejected before any pixel data is read.
+func ReadImage(r io.Reader) (*Image, error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	naxis, _ := h.Int("NAXIS")
+	width, _ := h.Int("NAXIS1")
+	height, _ := h.Int("NAXIS2")
+	if naxis < 2 || width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("primary HDU has no 2D image")
+	}
+	bitpix, _ := h.Int("BITPIX")
+	switch bitpix {
+	case 8, 16, 32, -32, -64:
+	default:
+		return nil, fmt.Errorf("unsupported BITPIX %d", bitpix)
+	}
+	if _, err := h.DataSize(); err != nil {
+		return nil, err
+	}
+
+	img := &Image{Header: h, Width: width, Height: height, bitpix: bitpix, bscale: 1}
+	if v, ok := h.Float("BZERO"); ok {
+		img.bzero = v
+	}
+	if v, ok := h.Float("BSCALE"); ok {
+		img.bscale = v
+	}
+	img.data, err = readData(r, int64(width)*int64(height)*int64(max(bitpix, -bitpix)/8))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read FITS image data: %w", err)
+	}
+	return img, nil
+}
+
+// At returns the physical value (BZERO + BSCALE × stored value) of the
+// pixel at 0-based column x and row y, counting rows in file order (FITS
+// row 1 first).
+func (img *Image) At(x, y int) float64 {
+	i := y*img.Width + x
+	var v float64
+	switch img.bitpix {
+	case 8:
+		v = float64(img.data[i])
+	case 16:
+		v = float64(int16(binary.BigEndian.Uint16(img.data[2*i:])))
+	case 32:
+		v = float64(int32(binary.BigEndian.Uint32(img.data[4*i:])))
+	case -32:
+		v = float64(math.Float32frombits(binary.BigEndian.Uint32(img.data[4*i:])))
+	case -64:
+		v = math.Float64frombits(binary.BigEndian.Uint64(img.data[8*i:]))
+	}
+	return img.bzero + img.bscale*v
+}
+
+func writePadding(w io.Writer, n int64) error {
+	if pad := padded(n) - n; pad > 0 {
+		_, err := w.Write(make([]byte, pad))
+		return err
+	}
+	return nil
+}
+
+// Table holds the numeric columns of a binary table extension.
+type Table struct {
+	Header  *Header
+	Columns map[string][]float64
+	Rows    int
+}
+
+// ReadTable reads the first binary table extension from r.
+func ReadTable(r io.Reader) (*Table, error) {
+	tables, err := ReadTables(r)
+	if err != nil {
+		return nil, err
+	}
+	return tables[0], nil
+}
+
+// ReadTables reads every binary table extension from r.
+func ReadTables(r io.Reader) ([]*Table, error) {
+	var tables []*Table
+	for {
+		h, err := ReadHeader(r)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := h.DataSize()
+		if err != nil {
+			return nil, err
+		}
+		if xt, _ := h.Get("XTENSION"); xt != "BINTABLE" {
+			if _, err := io.CopyN(io.Discard, r, padded(size)); err != nil {
+				return nil, fmt.Errorf("failed to skip FITS data unit: %w", err)
+			}
+			continue
+		}
+
+		data, err := readData(r, padded(size))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read FITS table data: %w", err)
+		}
+		t, err := decodeTable(h, data)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	if len(tables) == 0 {
+		return nil, ErrNoTable
+	}
+	return tables, nil
+}
+
+type column struct {
+	name   string
+	kind   byte
+	repeat int
+	width  int
+}
+
+var columnWidths = map[byte]int{'L': 1, 'B': 1, 'I': 2, 'J': 4, 'K': 8, 'E': 4, 'D': 8, 'A': 1}
+
+func decodeTable(h *Header, data []byte) (*Table, error) {
+	rowLen, _ := h.Int("NAXIS1")
+	rows, _ := h.Int("NAXIS2")
+	nfields, _ := h.Int("TFIELDS")
+	if rows < 0 || (rows > 0 && rowLen <= 0) || int64(rows)*int64(rowLen) > int64(len(data)) {
+		return nil, fmt.Errorf("table of %d rows of %d bytes does not fit its %d byte data unit", rows, rowLen, len(data))
+	}
+
+	cols := make([]column, 0, nfields)
+	for i := 1; i <= nfields; i++ {
+		name, _ := h.Get(fmt.Sprintf("TTYPE%d", i))
+		form, _ := h.Get(fmt.Sprintf("TFORM%d", i))
+		form = strings.TrimSpace(form)
+		if form == "" {
+			return nil, fmt.Errorf("missing TFORM%d", i)
+		}
+		kind := form[len(form)-1]
+		repeat := 1
+		if len(form) > 1 {
+			n, err := strconv.Atoi(form[:len(form)-1])
+			if err != nil {
+				return nil, fmt.Errorf("unsupported TFORM%d %q", i, form)
+			}
+			repeat = n
+		}
+		width, ok := columnWidths[kind]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TFORM%d %q", i, form)
+		}
+		cols = append(cols, column{name: strings.TrimSpace(name), kind: kind, repeat: repeat, width: width})
+	}
+
+	t := &Table{Header: h, Columns: make(map[string][]float64), Rows: rows}
+	for row := 0; row < rows; row++ {
+		off := row * rowLen
+		for _, c := range cols {
+			if c.repeat == 1 && c.kind != 'A' && c.kind != 'L' {
+				if off+c.width > len(data) {
+					return nil, fmt.Errorf("table data truncated at row %d", row)
+				}
+				t.Columns[c.name] = append(t.Columns[c.name], decodeValue(c.kind, data[off:off+c.width]))
+			}
+			off += c.repeat * c.width
+		}
+	}
+	return t, nil
+}
+
+func decodeValue(kind byte, b []byte) float64 {
+	switch kind {
+	case 'B':
+		return float64(b[0])
+	case 'I':
+		return float64(int16(binary.BigEndian.Uint16(b)))
+	case 'J':
+		return float64(int32(binary.BigEndian.Uint32(b)))
+	case 'K':
+		return float64(int64(binary.BigEndian.Uint64(b)))
+	case 'E':
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+	case 'D':
+		return math.Float64frombits(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+// TableHDU describes one binary table extension to be written.
+type TableHDU struct {
+	Cards   []Card      // Extra header cards (e.g. IMAGEW, IMAGEH)
+	Columns []string    // Column names, written as 32-bit floats
+	Data    [][]float64 // Data[i] holds the values for Columns[i]
+}
+
+// WriteTables writes an empty primary HDU followed by one binary table
+// extension per entry in hdus.
+func WriteTables(w io.Writer, primary []Card, hdus ...TableHDU) error {
+	cards := append([]Card{
+		{Key: "SIMPLE", Value: "T"},
+		{Key: "BITPIX", Value: "8"},
+		{Key: "NAXIS", Value: "0"},
+		{Key: "EXTEND", Value: "T"},
+	}, primary...)
+	if _, err := w.Write(EncodeHeader(cards)); err != nil {
+		return err
+	}
+
+	for _, hdu := range hdus {
+		rows := 0
+		if len(hdu.Data) > 0 {
+			rows = len(hdu.Data[0])
+		}
+		for i, col := range hdu.Data {
+			if len(col) != rows {
+				return fmt.Errorf("column %s has %d rows, want %d", hdu.Columns[i], len(col), rows)
+			}
+		}
+
+		rowLen := 4 * len(hdu.Columns)
+		cards := []Card{
+			{Key: "XTENSION", Value: Quote("BINTABLE")},
+			{Key: "BITPIX", Value: "8"},
+			{Key: "NAXIS", Value: "2"},
+			{Key: "NAXIS1", Value: strconv.Itoa(rowLen)},
+			{Key: "NAXIS2", Value: strconv.Itoa(rows)},
+			{Key: "PCOUNT", Value: "0"},
+			{Key: "GCOUNT", Value: "1"},
+			{Key: "TFIELDS", Value: strconv.Itoa(len(hdu.Columns))},
+		}
+		for i, name := range hdu.Columns {
+			cards = append(cards,
+				Card{Key: fmt.Sprintf("TTYPE%d", i+1), Value: Quote(name)},
+				Card{Key: fmt.Sprintf("TFORM%d", i+1), Value: Quote("E")},
+			)
+		}
+		cards = append(cards, hdu.Cards...)
+		if _, err := w.Write(EncodeHeader(cards)); err != nil {
+			return err
+		}
+
+		buf := make([]byte, 0, rowLen*rows)
+		for row := 0; row < rows; row++ {
+			for _, col := range hdu.Data {
+				buf = binary.BigEndian.AppendUint32(buf, math.Float32bits(float32(col[row])))
+			}
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+		if err := writePadding(w, int64(len(buf))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+package fits
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		line   string
+		want   Card
+		wantOK bool
+	}{
+		{
+			line:   FormatCard("CRVAL1", "83.423", "RA of reference point"),
+			want:   Card{Key: "CRVAL1", Value: "83.423", Comment: "RA of reference point"},
+			wantOK: true,
+		},
+		{
+			line:   FormatCard("CTYPE1", Quote("RA---TAN"), "projection"),
+			want:   Card{Key: "CTYPE1", Value: "RA---TAN", Comment: "projection"},
+			wantOK: true,
+		},
+		{
+			line:   FormatCard("OBJECT", Quote("it's/here"), ""),
+			want:   Card{Key: "OBJECT", Value: "it's/here"},
+			wantOK: true,
+		},
+		{
+			line:   FormatCard("COMMENT", "", "")[:8] + "  plain comment",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want.Key, func(t *testing.T) {
+			got, ok := ParseCard(tt.line)
+			if ok != tt.wantOK {
+				t.Fatalf("ParseCard(%q) ok = %v, want %v", tt.line, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("ParseCard(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCard_Length(t *testing.T) {
+	line := FormatCard("HISTORY", Quote("a very long string value that keeps going and going past the end of the card"), "comment")
+	if len(line) != CardSize {
+		t.Errorf("FormatCard length = %d, want %d", len(line), CardSize)
+	}
+}
+
+func TestReadHeader(t *testing.T) {
+	data := EncodeHeader([]Card{
+		{Key: "SIMPLE", Value: "T"},
+		{Key: "NAXIS", Value: "0"},
+		{Key: "IMAGEW", Value: "6000"},
+	})
+	if len(data)%BlockSize != 0 {
+		t.Fatalf("encoded header length %d is not a multiple of %d", len(data), BlockSize)
+	}
+
+	h, err := ReadHeader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ReadHeader failed: %v", err)
+	}
+	if w, ok := h.Int("IMAGEW"); !ok || w != 6000 {
+		t.Errorf("IMAGEW = %d (ok=%v), want 6000", w, ok)
+	}
+	if _, ok := h.Get("MISSING"); ok {
+		t.Error("expected MISSING key to be absent")
+	}
+}
+
+func TestTables_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteTables(&buf, nil,
+		TableHDU{
+			Cards:   []Card{{Key: "IMAGEW", Value: "640"}, {Key: "IMAGEH", Value: "480"}},
+			Columns: []string{"X", "Y", "FLUX"},
+			Data:    [][]float64{{1.5, 2.5}, {10, 20}, {100, 50}},
+		},
+		TableHDU{
+			Columns: []string{"X", "Y"},
+			Data:    [][]float64{{3}, {4}},
+		},
+	)
+	if err != nil {
+		t.Fatalf("WriteTables failed: %v", err)
+	}
+	if buf.Len()%BlockSize != 0 {
+		t.Errorf("file length %d is not a multiple of %d", buf.Len(), BlockSize)
+	}
+
+	tables, err := ReadTables(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadTables failed: %v", err)
+	}
+	if len(tables) != 2 {
+		t.Fatalf("got %d tables, want 2", len(tables))
+	}
+
+	first := tables[0]
+	if first.Rows != 2 {
+		t.Errorf("Rows = %d, want 2", first.Rows)
+	}
+	if got := first.Columns["X"]; len(got) != 2 || got[0] != 1.5 || got[1] != 2.5 {
+		t.Errorf("X column = %v, want [1.5 2.5]", got)
+	}
+	if got := first.Columns["FLUX"]; len(got) != 2 || got[1] != 50 {
+		t.Errorf("FLUX column = %v, want [100 50]", got)
+	}
+	if w, _ := first.Header.Int("IMAGEW"); w != 640 {
+		t.Errorf("IMAGEW = %d, want 640", w)
+	}
+	if got := tables[1].Columns["Y"]; len(got) != 1 || got[0] != 4 {
+		t.Errorf("second table Y column = %v, want [4]", got)
+	}
+}
+
+func TestReadTable_NoTable(t *testing.T) {
+	data := EncodeHeader([]Card{{Key: "SIMPLE", Value: "T"}, {Key: "NAXIS", Value: "0"}})
+	if _, err := ReadTable(bytes.NewReader(data)); !errors.Is(err, ErrNoTable) {
+		t.Errorf("expected ErrNoTable, got %v", err)
+	}
+}
+
+func TestReadTables_ImplausibleSize(t *testing.T) {
+	table := func(naxis1, naxis2 string) []byte {
+		var buf bytes.Buffer
+		buf.Write(EncodeHeader([]Card{{Key: "SIMPLE", Value: "T"}, {Key: "NAXIS", Value: "0"}}))
+		buf.Write(EncodeHeader([]Card{
+			{Key: "XTENSION", Value: "BINTABLE"},
+			{Key: "BITPIX", Value: "8"},
+			{Key: "NAXIS", Value: "2"},
+			{Key: "NAXIS1", Value: naxis1},
+			{Key: "NAXIS2", Value: naxis2},
+			{Key: "TFIELDS", Value: "1"},
+			{Key: "TTYPE1", Value: "X"},
+			{Key: "TFORM1", Value: "E"},
+		}))
+		buf.Write(make([]byte, BlockSize))
+		return buf.Bytes()
+	}
+	tests := []struct {
+		name           string
+		naxis1, naxis2 string
+	}{
+		{"huge row count", "4", "100000000000000"},
+		{"overflowing product", "9223372036854775807", "9223372036854775807"},
+		{"negative row count", "4", "-1"},
+		{"rows without width", "0", "1e300"},
+		{"more rows than data", "4", "1000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadTables(bytes.NewReader(table(tt.naxis1, tt.naxis2))); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func FuzzReadTables(f *testing.F) {
+	var buf bytes.Buffer
+	if err := WriteTables(&buf, nil, TableHDU{Columns: []string{"X", "Y"}, Data: [][]float64{{1, 2}, {3, 4}}}); err != nil {
+		f.Fatal(err)
+	}
+	f.Add(buf.Bytes())
+	f.Add(bytes.Replace(buf.Bytes(), []byte("NAXIS2  =                    2"), []byte("NAXIS2  =      100000000000000"), 1))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = ReadTables(bytes.NewReader(data))
+	})
+}
+
+func TestWriteImage(t *testing.T) {
+	var buf bytes.Buffer
+	pix := []uint8{1, 2, 3, 4, 5, 6}
+	if err := WriteImage(&buf, 3, 2, pix); err != nil {
+		t.Fatalf("WriteImage failed: %v", err)
+	}
+
+	r := bytes.NewReader(buf.Bytes())
+	h, err := ReadHeader(r)
+	if err != nil {
+		t.Fatalf("ReadHeader failed: %v", err)
+	}
+	if size, err := h.DataSize(); size != 6 || err != nil {
+		t.Errorf("DataSize = %d, %v, want 6", size, err)
+	}
+
+	data := make([]byte, 6)
+	if _, err := r.Read(data); err != nil {
+		t.Fatalf("failed to read data: %v", err)
+	}
+	// Bottom row is stored first
+	if !bytes.Equal(data, []byte{4, 5, 6, 1, 2, 3}) {
+		t.Errorf("pixel data = %v, want rows flipped", data)
+	}
+
+	if err := WriteImage(&buf, 4, 4, pix); err == nil {
+		t.Error("expected error for mismatched pixel buffer")
+	}
+}
+
+func TestReadImage(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteImage(&buf, 3, 2, []uint8{1, 2, 3, 4, 5, 6}); err != nil {
+		t.Fatal(err)
+	}
+	img, err := ReadImage(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadImage failed: %v", err)
+	}
+	if img.Width != 3 || img.Height != 2 {
+		t.Fatalf("size = %dx%d, want 3x2", img.Width, img.Height)
+	}
+	// Rows are in file order, so the bottom row comes first
+	if img.At(0, 0) != 4 || img.At(2, 1) != 3 {
+		t.Errorf("At(0,0) = %v, At(2,1) = %v, want 4 and 3", img.At(0, 0), img.At(2, 1))
+	}
+}
+
+func TestReadImage_Scaled16Bit(t *testing.T) {
+	// Unsigned 16-bit data is stored signed with BZERO 32768
+	var buf bytes.Buffer
+	buf.Write(EncodeHeader([]Card{
+		{Key: "SIMPLE", Value: "T"},
+		{Key: "BITPIX", Value: "16"},
+		{Key: "NAXIS", Value: "2"},
+		{Key: "NAXIS1", Value: "2"},
+		{Key: "NAXIS2", Value: "1"},
+		{Key: "BZERO", Value: "32768"},
+	}))
+	buf.Write([]byte{0x80, 0x00, 0x7f, 0xff}) // -32768, 32767
+	buf.Write(make([]byte, BlockSize-4))
+
+	img, err := ReadImage(&buf)
+	if err != nil {
+		t.Fatalf("ReadImage failed: %v", err)
+	}
+	if img.At(0, 0) != 0 || img.At(1, 0) != 65535 {
+		t.Errorf("pixels = %v, %v, want 0 and 65535", img.At(0, 0), img.At(1, 0))
+	}
+}
+
+func TestReadImage_ImplausibleSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height string
+		bitpix        string
+	}{
+		{"over the limit", "100000", "100000", "-64"},
+		{"overflowing product", "9223372036854775807", "9223372036854775807", "8"},
+		// Under the limit, but the file ends long before the claimed data
+		{"truncated", "30000", "30000", "-32"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			buf.Write(EncodeHeader([]Card{
+				{Key: "SIMPLE", Value: "T"},
+				{Key: "BITPIX", Value: tt.bitpix},
+				{Key: "NAXIS", Value: "2"},
+				{Key: "NAXIS1", Value: tt.width},
+				{Key: "NAXIS2", Value: tt.height},
+			}))
+			buf.Write(make([]byte, BlockSize))
+			if _, err := ReadImage(&buf); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestReadImage_NoImage(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTables(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadImage(&buf); err == nil {
+		t.Error("expected an error for a primary HDU without an image")
+	}
+}
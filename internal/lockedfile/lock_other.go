@@ -0,0 +1,18 @@
+//go:build !unix && !windows
+
+package lockedfile
+
+import (
+	"errors"
+	"os"
+)
+
+// tryLock is not implemented on this platform.
+func tryLock(file *os.File, mode Mode) (bool, error) {
+	return false, errors.ErrUnsupported
+}
+
+// unlock is not implemented on this platform.
+func unlock(file *os.File) error {
+	return errors.ErrUnsupported
+}
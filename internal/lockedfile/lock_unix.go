@@ -0,0 +1,31 @@
+//go:build unix
+
+package lockedfile
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// tryLock takes a flock on file without blocking, reporting false when
+// another lock conflicts.
+func tryLock(file *os.File, mode Mode) (bool, error) {
+	how := syscall.LOCK_SH
+	if mode == Exclusive {
+		how = syscall.LOCK_EX
+	}
+	err := syscall.Flock(int(file.Fd()), how|syscall.LOCK_NB) //nolint:gosec // File descriptors fit in an int
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, syscall.EWOULDBLOCK), errors.Is(err, syscall.EINTR):
+		return false, nil
+	}
+	return false, err
+}
+
+// unlock releases the flock on file.
+func unlock(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN) //nolint:gosec // File descriptors fit in an int
+}
@@ -0,0 +1,58 @@
+//go:build windows
+
+package lockedfile
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32         = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx   = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx = kernel32.NewProc("UnlockFileEx")
+)
+
+const (
+	lockfileFailImmediately = 0x1
+	lockfileExclusiveLock   = 0x2
+
+	// errorLockViolation is ERROR_LOCK_VIOLATION, returned when
+	// LOCKFILE_FAIL_IMMEDIATELY finds the range locked.
+	errorLockViolation syscall.Errno = 33
+
+	// allBytes locks the whole file, however large it grows.
+	allBytes = ^uint32(0)
+)
+
+// tryLock takes a LockFileEx lock on file without blocking, reporting
+// false when another lock conflicts.
+func tryLock(file *os.File, mode Mode) (bool, error) {
+	flags := uint32(lockfileFailImmediately)
+	if mode == Exclusive {
+		flags |= lockfileExclusiveLock
+	}
+	overlapped := new(syscall.Overlapped)
+	r, _, err := procLockFileEx.Call(file.Fd(), uintptr(flags), 0,
+		uintptr(allBytes), uintptr(allBytes), uintptr(unsafe.Pointer(overlapped)))
+	if r != 0 {
+		return true, nil
+	}
+	if errors.Is(err, errorLockViolation) {
+		return false, nil
+	}
+	return false, err
+}
+
+// unlock releases the LockFileEx lock on file.
+func unlock(file *os.File) error {
+	overlapped := new(syscall.Overlapped)
+	r, _, err := procUnlockFileEx.Call(file.Fd(), 0,
+		uintptr(allBytes), uintptr(allBytes), uintptr(unsafe.Pointer(overlapped)))
+	if r == 0 {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Package lockedfile provides advisory file locking and atomic
+// read-modify-write of small state files shared between processes, such as
+// caches in the user's cache directory. Locks use flock on Unix and
+// LockFileEx on Windows; they are advisory, so they only exclude other
+// users of this package.
+package lockedfile
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultTimeout bounds how long Read and Update wait for a lock.
+const DefaultTimeout = 30 * time.Second
+
+// maxPollInterval caps the wait between attempts to take a busy lock.
+const maxPollInterval = 50 * time.Millisecond
+
+// lockSuffix names the lock file guarding a data file.
+const lockSuffix = ".lock"
+
+// ErrTimeout indicates a lock was still held by another process or
+// goroutine when the timeout expired.
+var ErrTimeout = errors.New("timed out waiting for file lock")
+
+// Mode selects how a lock is shared.
+type Mode int
+
+const (
+	// Shared locks may be held by many readers at once.
+	Shared Mode = iota
+
+	// Exclusive locks exclude all other locks.
+	Exclusive
+)
+
+// Lock is a held advisory lock on a file.
+type Lock struct {
+	file *os.File
+}
+
+// Acquire locks the file at path in mode, creating it if needed, and
+// waits up to timeout while another process or goroutine holds a
+// conflicting lock. A timeout of 0 makes a single attempt. It returns
+// ErrTimeout when the lock stays busy. Locks are held per Acquire, so two
+// Acquires in one process exclude each other like two processes do.
+func Acquire(path string, mode Mode, timeout time.Duration) (*Lock, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	interval := time.Millisecond
+	for {
+		locked, err := tryLock(file, mode)
+		if err != nil {
+			_ = file.Close() //nolint:errcheck // Best effort cleanup on error path
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+		if locked {
+			return &Lock{file: file}, nil
+		}
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			_ = file.Close() //nolint:errcheck // Best effort cleanup on error path
+			return nil, fmt.Errorf("%w: %s after %v", ErrTimeout, path, timeout)
+		}
+		time.Sleep(min(interval, wait))
+		interval = min(interval*2, maxPollInterval)
+	}
+}
+
+// Release unlocks and closes the lock file.
+func (l *Lock) Release() error {
+	err := unlock(l.file)
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Read returns the contents of the file at path under a shared lock, so
+// it never sees a write by Update in progress. A missing file returns an
+// error wrapping fs.ErrNotExist.
+func Read(path string) ([]byte, error) {
+	// Without the directory there is no file, nor anywhere for a lock file
+	if _, err := os.Stat(filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	lock, err := Acquire(path+lockSuffix, Shared, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = lock.Release() //nolint:errcheck // Read-only, unlock error not critical
+	}()
+	return os.ReadFile(path)
+}
+
+// Update replaces the contents of the file at path with fn's result under
+// an exclusive lock, so concurrent updates from any process are applied
+// one after another and none is lost. fn receives the current contents,
+// nil when the file does not exist yet. When fn returns an error the file
+// is left unchanged and Update returns it. The new contents are written
+// to a temporary file and renamed into place, so readers and crashes never
+// see a partial file. The directory is created if needed, and a path.lock
+// file is left beside path.
+func Update(path string, fn func([]byte) ([]byte, error)) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	lock, err := Acquire(path+lockSuffix, Exclusive, DefaultTimeout)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = lock.Release() //nolint:errcheck // Update already written, unlock error not critical
+	}()
+
+	old, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	data, err := fn(old)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0o644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name()) //nolint:errcheck // Best effort cleanup on error path
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
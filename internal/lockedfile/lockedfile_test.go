@@ -0,0 +1,204 @@
+package lockedfile
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// counterEnv makes the test binary a child process incrementing the
+// counter file it names; see TestUpdate_Processes.
+const counterEnv = "LOCKEDFILE_TEST_COUNTER"
+
+// increments is how many times each goroutine or child process
+// increments the counter.
+const increments = 50
+
+// increment adds one to the decimal counter in path.
+func increment(path string) error {
+	return Update(path, func(data []byte) ([]byte, error) {
+		n := 0
+		if len(data) > 0 {
+			var err error
+			if n, err = strconv.Atoi(string(data)); err != nil {
+				return nil, fmt.Errorf("corrupt counter %q: %w", data, err)
+			}
+		}
+		return []byte(strconv.Itoa(n + 1)), nil
+	})
+}
+
+// readCounter returns the counter in path.
+func readCounter(t *testing.T, path string) int {
+	t.Helper()
+	data, err := Read(path)
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	n, err := strconv.Atoi(string(data))
+	if err != nil {
+		t.Fatalf("corrupt counter %q", data)
+	}
+	return n
+}
+
+func TestUpdate_Goroutines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "counter")
+	const workers = 8
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				if err := increment(path); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("increment failed: %v", err)
+	}
+
+	if got := readCounter(t, path); got != workers*increments {
+		t.Errorf("counter = %d, want %d: updates were lost", got, workers*increments)
+	}
+}
+
+func TestUpdate_Processes(t *testing.T) {
+	if path := os.Getenv(counterEnv); path != "" {
+		for i := 0; i < increments; i++ {
+			if err := increment(path); err != nil {
+				t.Fatalf("child increment failed: %v", err)
+			}
+		}
+		return
+	}
+	if testing.Short() {
+		t.Skip("spawns child processes")
+	}
+
+	path := filepath.Join(t.TempDir(), "counter")
+	const children = 4
+	cmds := make([]*exec.Cmd, children)
+	for i := range cmds {
+		cmds[i] = exec.Command(os.Args[0], "-test.run=^TestUpdate_Processes$", "-test.count=1")
+		cmds[i].Env = append(os.Environ(), counterEnv+"="+path)
+		if err := cmds[i].Start(); err != nil {
+			t.Fatalf("failed to start child: %v", err)
+		}
+	}
+	// Update from this process too, interleaved with the children
+	for i := 0; i < increments; i++ {
+		if err := increment(path); err != nil {
+			t.Fatalf("increment failed: %v", err)
+		}
+	}
+	for _, cmd := range cmds {
+		if err := cmd.Wait(); err != nil {
+			t.Fatalf("child failed: %v", err)
+		}
+	}
+
+	if got, want := readCounter(t, path), (children+1)*increments; got != want {
+		t.Errorf("counter = %d, want %d: updates were lost", got, want)
+	}
+}
+
+func TestUpdate_Error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	if err := Update(path, func([]byte) ([]byte, error) { return []byte("v1"), nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	errAbort := errors.New("abort")
+	if err := Update(path, func(old []byte) ([]byte, error) {
+		if string(old) != "v1" {
+			t.Errorf("fn got %q, want v1", old)
+		}
+		return []byte("v2"), errAbort
+	}); !errors.Is(err, errAbort) {
+		t.Errorf("err = %v, want fn's error", err)
+	}
+	if data, err := Read(path); err != nil || string(data) != "v1" {
+		t.Errorf("after failed update: %q, %v; want v1 unchanged", data, err)
+	}
+
+	// Temporary files are renamed or removed
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("directory has %d entries, want the file and its lock file", len(entries))
+	}
+}
+
+func TestRead_Missing(t *testing.T) {
+	dir := t.TempDir()
+	for _, path := range []string{filepath.Join(dir, "missing"), filepath.Join(dir, "no", "dir")} {
+		if _, err := Read(path); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Read(%s) err = %v, want fs.ErrNotExist", path, err)
+		}
+	}
+}
+
+func TestAcquire_Modes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock")
+
+	// Shared locks coexist
+	r1, err := Acquire(path, Shared, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := Acquire(path, Shared, 0)
+	if err != nil {
+		t.Fatalf("second shared lock failed: %v", err)
+	}
+
+	// An exclusive lock waits for both readers, then times out
+	start := time.Now()
+	if _, err := Acquire(path, Exclusive, 100*time.Millisecond); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("exclusive with readers: err = %v, want ErrTimeout", err)
+	}
+	if waited := time.Since(start); waited < 100*time.Millisecond || waited > 5*time.Second {
+		t.Errorf("waited %v, want about the 100ms timeout", waited)
+	}
+
+	// Once they are released it gets through, and blocks readers
+	if err := r1.Release(); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = r2.Release()
+	}()
+	w, err := Acquire(path, Exclusive, 5*time.Second)
+	if err != nil {
+		t.Fatalf("exclusive after release: %v", err)
+	}
+	if _, err := Acquire(path, Shared, 0); !errors.Is(err, ErrTimeout) {
+		t.Errorf("shared under a writer: err = %v, want ErrTimeout", err)
+	}
+	if err := w.Release(); err != nil {
+		t.Fatal(err)
+	}
+	r3, err := Acquire(path, Shared, 0)
+	if err != nil {
+		t.Fatalf("shared after writer released: %v", err)
+	}
+	_ = r3.Release()
+}
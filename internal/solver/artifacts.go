@@ -0,0 +1,192 @@
+package solver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// OutputKind identifies a solve-field output file by its suffix after the
+// image's base name, e.g. ".wcs" for stars.wcs.
+type OutputKind string
+
+// Solve-field output files collected into Result.Outputs.
+const (
+	OutputWCS       OutputKind = ".wcs"       // WCS header of the solution
+	OutputSolved    OutputKind = ".solved"    // marker with a byte per solved field
+	OutputCorr      OutputKind = ".corr"      // matched image and index stars
+	OutputMatch     OutputKind = ".match"     // the matched quad
+	OutputRDLS      OutputKind = ".rdls"      // index stars in the field, RA/Dec
+	OutputAXY       OutputKind = ".axy"       // detected sources, augmented xylist
+	OutputIndexXYLS OutputKind = "-indx.xyls" // index stars in the field, pixels
+	OutputNew       OutputKind = ".new"       // the image with the WCS header added
+	OutputKMZ       OutputKind = ".kmz"       // Google Sky overlay, when plots are on
+)
+
+// outputKinds lists every OutputKind in the order OutputFiles reports them.
+var outputKinds = []OutputKind{
+	OutputWCS, OutputCorr, OutputSolved, OutputMatch, OutputRDLS, OutputAXY, OutputIndexXYLS, OutputNew, OutputKMZ,
+}
+
+// requiredOutputs are written by every successful solve.
+var requiredOutputs = []OutputKind{OutputWCS, OutputSolved}
+
+// collectOutputs finds solve-field's output files for the image baseName in
+// dir. Files are matched by their whole suffix, case-insensitively, so
+// stars-indx.xyls is never mistaken for another kind and files of other
+// images sharing the directory are ignored. Unknown suffixes are skipped.
+func collectOutputs(dir, baseName string) map[OutputKind]string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	outputs := make(map[OutputKind]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, baseName) {
+			continue
+		}
+		kind := OutputKind(strings.ToLower(name[len(baseName):]))
+		if slices.Contains(outputKinds, kind) {
+			outputs[kind] = filepath.Join(dir, name)
+		}
+	}
+	return outputs
+}
+
+// outputFiles returns the paths in outputs in outputKinds order.
+func outputFiles(outputs map[OutputKind]string) []string {
+	var files []string
+	for _, kind := range outputKinds {
+		if path, ok := outputs[kind]; ok {
+			files = append(files, path)
+		}
+	}
+	return files
+}
+
+// missingOutputs returns the required kinds absent from outputs.
+func missingOutputs(outputs map[OutputKind]string) []OutputKind {
+	var missing []OutputKind
+	for _, kind := range requiredOutputs {
+		if _, ok := outputs[kind]; !ok {
+			missing = append(missing, kind)
+		}
+	}
+	return missing
+}
+
+// normalizeExtension adds the leading dot to an extension written without
+// one, so "wcs" and ".wcs" both match; suffixes such as "-indx.xyls" are
+// kept as given.
+func normalizeExtension(ext string) OutputKind {
+	if strings.HasPrefix(ext, ".") || strings.HasPrefix(ext, "-") {
+		return OutputKind(strings.ToLower(ext))
+	}
+	return OutputKind("." + strings.ToLower(ext))
+}
+
+// validateKeepExtensions checks that every entry of
+// SolveOptions.KeepExtensions names a solve-field output file, so a typo
+// does not silently drop everything.
+func validateKeepExtensions(exts []string) error {
+	for _, ext := range exts {
+		if !slices.Contains(outputKinds, normalizeExtension(ext)) {
+			kinds := make([]string, len(outputKinds))
+			for i, kind := range outputKinds {
+				kinds[i] = string(kind)
+			}
+			return fmt.Errorf("%w: KeepExtensions entry %q is not a solve output (want one of %s)",
+				ErrInvalidInput, ext, strings.Join(kinds, ", "))
+		}
+	}
+	return nil
+}
+
+// filterArtifacts splits outputs into those whose kind is listed in keep
+// and the rest. An empty keep keeps everything.
+func filterArtifacts(outputs map[OutputKind]string, keep []string) (kept, dropped map[OutputKind]string) {
+	if len(keep) == 0 {
+		return outputs, nil
+	}
+	kept = make(map[OutputKind]string)
+	dropped = make(map[OutputKind]string)
+	for kind, path := range outputs {
+		if slices.ContainsFunc(keep, func(k string) bool { return normalizeExtension(k) == kind }) {
+			kept[kind] = path
+		} else {
+			dropped[kind] = path
+		}
+	}
+	return kept, dropped
+}
+
+// exportArtifacts copies output files to outputDir, creating it if needed,
+// and returns the paths of the copies. prefix, the shared work directory's
+// per-call prefix, is removed from the names so they match the image.
+func exportArtifacts(outputs map[OutputKind]string, prefix, outputDir string) (map[OutputKind]string, error) {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+	copied := make(map[OutputKind]string, len(outputs))
+	for kind, file := range outputs {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read solve output: %w", err)
+		}
+		dst := filepath.Join(outputDir, strings.TrimPrefix(filepath.Base(file), prefix))
+		if err := os.WriteFile(dst, data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write solve output: %w", err)
+		}
+		copied[kind] = dst
+	}
+	return copied, nil
+}
+
+// OutputPath returns the path of the output file of the given kind, or ""
+// if the solve did not produce or keep one.
+func (r *Result) OutputPath(kind OutputKind) string {
+	return r.Outputs[kind]
+}
+
+// WCSPath returns the path of the .wcs file, or "" if there is none.
+func (r *Result) WCSPath() string { return r.OutputPath(OutputWCS) }
+
+// SolvedPath returns the path of the .solved marker, or "" if there is none.
+func (r *Result) SolvedPath() string { return r.OutputPath(OutputSolved) }
+
+// CorrPath returns the path of the .corr file, or "" if there is none.
+func (r *Result) CorrPath() string { return r.OutputPath(OutputCorr) }
+
+// MatchPath returns the path of the .match file, or "" if there is none.
+func (r *Result) MatchPath() string { return r.OutputPath(OutputMatch) }
+
+// RDLSPath returns the path of the .rdls file, or "" if there is none.
+func (r *Result) RDLSPath() string { return r.OutputPath(OutputRDLS) }
+
+// AXYPath returns the path of the .axy file, or "" if there is none.
+func (r *Result) AXYPath() string { return r.OutputPath(OutputAXY) }
+
+// IndexXYLSPath returns the path of the -indx.xyls file, or "" if there is
+// none.
+func (r *Result) IndexXYLSPath() string { return r.OutputPath(OutputIndexXYLS) }
+
+// CheckOutputs returns an error wrapping ErrMissingOutputs that names the
+// missing files when MissingOutputs is not empty, so a partial solve can be
+// rejected like a failed one:
+//
+//	if err := result.CheckOutputs(); err != nil {
+//		return err
+//	}
+func (r *Result) CheckOutputs() error {
+	if len(r.MissingOutputs) == 0 {
+		return nil
+	}
+	kinds := make([]string, len(r.MissingOutputs))
+	for i, kind := range r.MissingOutputs {
+		kinds[i] = string(kind)
+	}
+	return fmt.Errorf("%w: %s", ErrMissingOutputs, strings.Join(kinds, ", "))
+}
@@ -0,0 +1,260 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// newArtifactClient returns a test client whose solve writes a solved WCS
+// and the other solve-field outputs, recording the work directory.
+func newArtifactClient(t *testing.T, workDir *string) *Client {
+	t.Helper()
+	return newTestClient(t, func(dir string, args []string, w io.Writer) error {
+		*workDir = dir
+		files := map[string][]byte{
+			"stars.wcs":        fits.EncodeHeader(wcsCards(0.001)),
+			"stars.solved":     {1},
+			"stars.corr":       []byte("corr"),
+			"stars.axy":        []byte("axy"),
+			"stars-indx.xyls":  []byte("indx"),
+			"stars.unexpected": []byte("not collected"),
+		}
+		for name, data := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// dirNames returns the sorted names of the files in dir.
+func dirNames(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", dir, err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+func TestSolve_OutputDirKeepExtensions(t *testing.T) {
+	tests := []struct {
+		name string
+		keep []string
+		want []string
+	}{
+		{"listed types only", []string{".wcs", "corr"}, []string{"stars.corr", "stars.wcs"}},
+		{"index stars", []string{"-indx.xyls"}, []string{"stars-indx.xyls"}},
+		{"all by default", nil, []string{"stars-indx.xyls", "stars.axy", "stars.corr", "stars.solved", "stars.wcs"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var workDir string
+			client := newArtifactClient(t, &workDir)
+			outputDir := filepath.Join(t.TempDir(), "archive", "night1")
+
+			opts := DefaultSolveOptions()
+			opts.OutputDir = outputDir
+			opts.KeepExtensions = tt.keep
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+			if err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+			if !result.Solved {
+				t.Fatal("expected solved result")
+			}
+
+			if got := dirNames(t, outputDir); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("output dir = %v, want %v", got, tt.want)
+			}
+			for _, file := range result.OutputFiles {
+				if filepath.Dir(file) != outputDir {
+					t.Errorf("OutputFiles entry %s not in OutputDir", file)
+				}
+			}
+			if len(result.OutputFiles) != len(tt.want) {
+				t.Errorf("OutputFiles = %v, want %d files", result.OutputFiles, len(tt.want))
+			}
+			if _, err := os.Stat(workDir); !os.IsNotExist(err) {
+				t.Errorf("workspace not removed: %v", err)
+			}
+		})
+	}
+}
+
+func TestSolve_KeepTempFilesKeepExtensions(t *testing.T) {
+	var workDir string
+	client := newArtifactClient(t, &workDir)
+
+	opts := DefaultSolveOptions()
+	opts.KeepTempFiles = true
+	opts.KeepExtensions = []string{".wcs"}
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+
+	if want := []string{filepath.Join(workDir, "stars.wcs")}; !reflect.DeepEqual(result.OutputFiles, want) {
+		t.Errorf("OutputFiles = %v, want %v", result.OutputFiles, want)
+	}
+	// Unlisted outputs are pruned; the staged image and unknown files stay
+	if got, want := dirNames(t, workDir), []string{"stars.png", "stars.unexpected", "stars.wcs"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("preserved workspace = %v, want %v", got, want)
+	}
+}
+
+func TestSolve_InvalidKeepExtension(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		t.Error("solver ran despite invalid options")
+		return nil
+	})
+
+	opts := DefaultSolveOptions()
+	opts.KeepExtensions = []string{".wcs", ".fits"}
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestExportArtifacts_StripsPrefix(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "ab12_stars.wcs")
+	if err := os.WriteFile(src, []byte("wcs"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	outputDir := t.TempDir()
+
+	copied, err := exportArtifacts(map[OutputKind]string{OutputWCS: src}, "ab12_", outputDir)
+	if err != nil {
+		t.Fatalf("exportArtifacts failed: %v", err)
+	}
+	if want := filepath.Join(outputDir, "stars.wcs"); len(copied) != 1 || copied[OutputWCS] != want {
+		t.Errorf("copied = %v, want [%s]", copied, want)
+	}
+	if data, err := os.ReadFile(copied[OutputWCS]); err != nil || string(data) != "wcs" {
+		t.Errorf("copy = %q, %v", data, err)
+	}
+}
+
+func TestCollectOutputs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"stars.wcs", "stars.solved", "stars-indx.xyls", "stars.new", "stars.KMZ",
+		"stars.xyls", "stars-objs.png", "stars2.wcs", "other.corr",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "stars.corr"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := collectOutputs(dir, "stars")
+	want := map[OutputKind]string{
+		OutputWCS:       filepath.Join(dir, "stars.wcs"),
+		OutputSolved:    filepath.Join(dir, "stars.solved"),
+		OutputIndexXYLS: filepath.Join(dir, "stars-indx.xyls"),
+		OutputNew:       filepath.Join(dir, "stars.new"),
+		OutputKMZ:       filepath.Join(dir, "stars.KMZ"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("collectOutputs = %v, want %v", got, want)
+	}
+
+	files := outputFiles(got)
+	wantFiles := []string{want[OutputWCS], want[OutputSolved], want[OutputIndexXYLS], want[OutputNew], want[OutputKMZ]}
+	if !reflect.DeepEqual(files, wantFiles) {
+		t.Errorf("outputFiles = %v, want %v", files, wantFiles)
+	}
+}
+
+func TestSolve_OutputAccessors(t *testing.T) {
+	var workDir string
+	client := newArtifactClient(t, &workDir)
+
+	opts := DefaultSolveOptions()
+	opts.KeepTempFiles = true
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+
+	for name, tt := range map[string]struct{ got, want string }{
+		"WCSPath":       {result.WCSPath(), filepath.Join(workDir, "stars.wcs")},
+		"SolvedPath":    {result.SolvedPath(), filepath.Join(workDir, "stars.solved")},
+		"CorrPath":      {result.CorrPath(), filepath.Join(workDir, "stars.corr")},
+		"AXYPath":       {result.AXYPath(), filepath.Join(workDir, "stars.axy")},
+		"IndexXYLSPath": {result.IndexXYLSPath(), filepath.Join(workDir, "stars-indx.xyls")},
+		"MatchPath":     {result.MatchPath(), ""},
+		"RDLSPath":      {result.RDLSPath(), ""},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", name, tt.got, tt.want)
+		}
+	}
+	if len(result.OutputFiles) != 5 {
+		t.Errorf("OutputFiles = %v, want 5 files", result.OutputFiles)
+	}
+	if result.MissingOutputs != nil || result.CheckOutputs() != nil {
+		t.Errorf("MissingOutputs = %v, CheckOutputs = %v", result.MissingOutputs, result.CheckOutputs())
+	}
+}
+
+func TestSolve_MissingOutputs(t *testing.T) {
+	wcs := fits.EncodeHeader(wcsCards(0.001))
+	tests := []struct {
+		name        string
+		files       map[string][]byte
+		wantSolved  bool
+		wantMissing []OutputKind
+	}{
+		{"complete", map[string][]byte{"stars.wcs": wcs, "stars.solved": {1}}, true, nil},
+		{"no solved marker", map[string][]byte{"stars.wcs": wcs}, true, []OutputKind{OutputSolved}},
+		{"solved marker but no wcs", map[string][]byte{"stars.solved": {1}, "stars.axy": nil}, false, []OutputKind{OutputWCS}},
+		{"unset marker and no wcs", map[string][]byte{"stars.solved": {0}}, false, nil},
+		{"no solution", map[string][]byte{"stars.axy": nil}, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				for name, data := range tt.files {
+					if err := os.WriteFile(filepath.Join(workDir, name), data, 0644); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+			if err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+			if result.Solved != tt.wantSolved {
+				t.Errorf("Solved = %v, want %v", result.Solved, tt.wantSolved)
+			}
+			if !reflect.DeepEqual(result.MissingOutputs, tt.wantMissing) {
+				t.Errorf("MissingOutputs = %v, want %v", result.MissingOutputs, tt.wantMissing)
+			}
+			err = result.CheckOutputs()
+			if (err != nil) != (tt.wantMissing != nil) || (err != nil && !errors.Is(err, ErrMissingOutputs)) {
+				t.Errorf("CheckOutputs = %v", err)
+			}
+			if err != nil && !strings.Contains(err.Error(), string(tt.wantMissing[0])) {
+				t.Errorf("CheckOutputs = %v, want it to name %s", err, tt.wantMissing[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package solver
+
+import "math"
+
+// Comparison holds the differences between two solutions of the same
+// field, as returned by Compare. Differences are got minus want.
+type Comparison struct {
+	// SeparationArcsec is the great-circle distance between the field
+	// centers. It is the value to check a position tolerance against.
+	SeparationArcsec float64
+
+	// RAOffsetArcsec and DecOffsetArcsec split the separation into on-sky
+	// offsets along RA (scaled by cos Dec) and Dec, for diagnostics.
+	RAOffsetArcsec  float64
+	DecOffsetArcsec float64
+
+	// RADiffArcsec is the raw RA coordinate difference, wrapped to ±180°.
+	// Away from the equator it overstates the on-sky distance by 1/cos Dec,
+	// so near the poles large values are expected for close positions.
+	RADiffArcsec float64
+
+	// PixelScalePercent, FieldWidthPercent and FieldHeightPercent are
+	// relative differences in percent of want; 0 when want's value is 0.
+	PixelScalePercent  float64
+	FieldWidthPercent  float64
+	FieldHeightPercent float64
+
+	// RotationDegrees is the rotation difference wrapped to ±180°.
+	RotationDegrees float64
+}
+
+// Compare returns the differences between a solution and a reference
+// solution of the same field, such as a ground truth or the same image
+// solved by another solver. Only the fields of want that are set need be
+// meaningful; see Comparison for how zero values are handled.
+func Compare(got, want *Result) Comparison {
+	raDiff := wrapDegrees(got.RA - want.RA)
+	return Comparison{
+		SeparationArcsec:   angularSeparation(want.RA, want.Dec, got.RA, got.Dec) * 3600,
+		RAOffsetArcsec:     raDiff * math.Cos(want.Dec*deg2rad) * 3600,
+		DecOffsetArcsec:    (got.Dec - want.Dec) * 3600,
+		RADiffArcsec:       raDiff * 3600,
+		PixelScalePercent:  percentDiff(got.PixelScale, want.PixelScale),
+		FieldWidthPercent:  percentDiff(got.FieldWidth, want.FieldWidth),
+		FieldHeightPercent: percentDiff(got.FieldHeight, want.FieldHeight),
+		RotationDegrees:    wrapDegrees(got.Rotation - want.Rotation),
+	}
+}
+
+// wrapDegrees wraps an angle difference into the range [-180, 180).
+func wrapDegrees(d float64) float64 {
+	return normalizeRA(d+180) - 180
+}
+
+// percentDiff returns got's difference from want in percent of want, or 0
+// when want is 0.
+func percentDiff(got, want float64) float64 {
+	if want == 0 {
+		return 0
+	}
+	return (got - want) / want * 100
+}
@@ -0,0 +1,63 @@
+package solver
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	want := validResult()
+	want.Rotation = 179
+
+	got := *want
+	got.RA += 10.0 / 3600
+	got.Dec -= 5.0 / 3600
+	got.PixelScale *= 1.02
+	got.FieldWidth *= 0.97
+	got.Rotation = -179
+
+	c := Compare(&got, want)
+	cosDec := math.Cos(want.Dec * deg2rad)
+	if math.Abs(c.RADiffArcsec-10) > 1e-6 {
+		t.Errorf("RADiffArcsec = %v, want 10", c.RADiffArcsec)
+	}
+	if math.Abs(c.RAOffsetArcsec-10*cosDec) > 1e-6 {
+		t.Errorf("RAOffsetArcsec = %v, want %v", c.RAOffsetArcsec, 10*cosDec)
+	}
+	if math.Abs(c.DecOffsetArcsec+5) > 1e-6 {
+		t.Errorf("DecOffsetArcsec = %v, want -5", c.DecOffsetArcsec)
+	}
+	if wantSep := math.Hypot(10*cosDec, 5); math.Abs(c.SeparationArcsec-wantSep) > 0.001 {
+		t.Errorf("SeparationArcsec = %v, want %v", c.SeparationArcsec, wantSep)
+	}
+	if math.Abs(c.PixelScalePercent-2) > 1e-9 || math.Abs(c.FieldWidthPercent+3) > 1e-9 || c.FieldHeightPercent != 0 {
+		t.Errorf("percent diffs = %v, %v, %v, want 2, -3, 0", c.PixelScalePercent, c.FieldWidthPercent, c.FieldHeightPercent)
+	}
+	if math.Abs(c.RotationDegrees-2) > 1e-9 {
+		t.Errorf("RotationDegrees = %v, want 2 (wrapped)", c.RotationDegrees)
+	}
+}
+
+func TestCompare_HighDec(t *testing.T) {
+	// At Dec 80 an RA step of 30" is only about 5" on the sky
+	want := &Result{Solved: true, RA: 12, Dec: 80}
+	got := &Result{Solved: true, RA: 12 + 30.0/3600, Dec: 80}
+
+	c := Compare(got, want)
+	if math.Abs(c.RADiffArcsec-30) > 1e-6 {
+		t.Errorf("RADiffArcsec = %v, want 30", c.RADiffArcsec)
+	}
+	if wantSep := 30 * math.Cos(80*deg2rad); math.Abs(c.SeparationArcsec-wantSep) > 0.001 {
+		t.Errorf("SeparationArcsec = %v, want %v", c.SeparationArcsec, wantSep)
+	}
+}
+
+func TestCompare_RAWrap(t *testing.T) {
+	want := &Result{Solved: true, RA: 359.999, Dec: 0}
+	got := &Result{Solved: true, RA: 0.001, Dec: 0}
+
+	c := Compare(got, want)
+	if math.Abs(c.RADiffArcsec-7.2) > 1e-6 || math.Abs(c.SeparationArcsec-7.2) > 1e-6 {
+		t.Errorf("RADiffArcsec = %v, SeparationArcsec = %v, want 7.2 across 0h", c.RADiffArcsec, c.SeparationArcsec)
+	}
+}
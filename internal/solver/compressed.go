@@ -0,0 +1,244 @@
+package solver
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// Compressed FITS formats reported by fitsCompression.
+const (
+	compressionGzip  = "gzip"  // gzip stream of a FITS file (.fits.gz)
+	compressionFpack = "fpack" // tile-compressed FITS, e.g. Rice (.fits.fz)
+)
+
+// gzipMagic starts every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// fitsCompression reports how the FITS image at path is compressed, judged
+// by its contents rather than its name: compressionGzip for a gzip stream
+// of a FITS file, compressionFpack for a tile-compressed image (ZIMAGE = T
+// in the first extension), or "" for anything else.
+func fitsCompression(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	r := bufio.NewReader(file)
+	magic, err := r.Peek(len(gzipMagic))
+	if err != nil {
+		return ""
+	}
+	if bytes.Equal(magic, gzipMagic) {
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return ""
+		}
+		simple := make([]byte, 9)
+		if _, err := io.ReadFull(zr, simple); err != nil || string(simple) != "SIMPLE  =" {
+			return ""
+		}
+		return compressionGzip
+	}
+
+	if _, ext, ok := readFirstExtension(r); ok {
+		if zimage, _ := ext.Get("ZIMAGE"); zimage == "T" {
+			return compressionFpack
+		}
+	}
+	return ""
+}
+
+// readFirstExtension reads the primary header from r, skips its data and
+// reads the header of the first extension.
+func readFirstExtension(r io.Reader) (primary, ext *fits.Header, ok bool) {
+	primary, err := fits.ReadHeader(r)
+	if err != nil {
+		return nil, nil, false
+	}
+	if simple, _ := primary.Get("SIMPLE"); simple != "T" {
+		return nil, nil, false
+	}
+	size, err := primary.DataSize()
+	if err != nil {
+		return nil, nil, false
+	}
+	if _, err := io.CopyN(io.Discard, r, (size+fits.BlockSize-1)/fits.BlockSize*fits.BlockSize); err != nil {
+		return nil, nil, false
+	}
+	ext, err = fits.ReadHeader(r)
+	if err != nil {
+		return nil, nil, false
+	}
+	return primary, ext, true
+}
+
+// decompressedName returns the name a compressed FITS file is staged
+// under: m42.fits.gz and m42.fits.fz become m42.fits, and m42.fz becomes
+// m42.fits.
+func decompressedName(name string) string {
+	for _, suffix := range []string{".gz", ".fz"} {
+		if strings.HasSuffix(strings.ToLower(name), suffix) {
+			name = name[:len(name)-len(suffix)]
+			break
+		}
+	}
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".fits", ".fit", ".fts":
+		return name
+	}
+	return name + ".fits"
+}
+
+// uncompressedSize estimates the size of the FITS file compressed at path,
+// so the decompressed copy counts in the workspace free-space check: the
+// gzip trailer's length (modulo 4 GiB, as gzip records it) or an fpack
+// image's pixel data size. It returns 0 when unknown or uncompressed.
+func uncompressedSize(path, compression string) int64 {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	switch compression {
+	case compressionGzip:
+		trailer := make([]byte, 4)
+		if _, err := file.ReadAt(trailer, fileSize(file)-4); err != nil {
+			return 0
+		}
+		return int64(binary.LittleEndian.Uint32(trailer))
+	case compressionFpack:
+		_, ext, ok := readFirstExtension(bufio.NewReader(file))
+		if !ok {
+			return 0
+		}
+		bitpix, _ := ext.Int("ZBITPIX")
+		naxis, _ := ext.Int("ZNAXIS")
+		if naxis == 0 {
+			return 0
+		}
+		size := int64(max(bitpix, -bitpix) / 8)
+		for i := 1; i <= naxis; i++ {
+			n, _ := ext.Int(fmt.Sprintf("ZNAXIS%d", i))
+			size *= int64(n)
+		}
+		return size
+	}
+	return 0
+}
+
+// fileSize returns the size of file, or 0 if it cannot be determined.
+func fileSize(file *os.File) int64 {
+	info, err := file.Stat()
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// openFITS opens a FITS file for reading its header, decompressing gzip
+// transparently so hints are read from the decompressed header.
+func openFITS(path string) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if fitsCompression(path) != compressionGzip {
+		return file, nil
+	}
+	zr, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		_ = file.Close() //nolint:errcheck // Best effort cleanup on error path
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{zr, file}, nil
+}
+
+// stageDecompressed writes the compressed FITS image src to name in the
+// workspace, decompressing gzip in Go and fpack with funpack in the solver
+// container. The result gets the source's mtime and permissions like any
+// staged image, and is removed with the workspace.
+func (c *Client) stageDecompressed(ctx context.Context, src, compression, tempDir, name string, absIndexPaths []string) error {
+	dst := filepath.Join(tempDir, name)
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("failed to stat image: %w", err)
+	}
+
+	switch compression {
+	case compressionGzip:
+		in, err := openFITS(src)
+		if err != nil {
+			return fmt.Errorf("failed to open compressed image: %w", err)
+		}
+		defer func() {
+			_ = in.Close() //nolint:errcheck // Read-only file, close error not critical
+		}()
+		out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, stagedPerm(info.Mode(), c.config.AllowContainerRead))
+		if err != nil {
+			return fmt.Errorf("failed to create decompressed image: %w", err)
+		}
+		_, err = io.Copy(out, in)
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("%w: failed to decompress gzip image: %v", ErrInvalidInput, err)
+		}
+
+	case compressionFpack:
+		if !c.hasTool(ctx, tempDir, absIndexPaths, "funpack") {
+			return fmt.Errorf("%w: %s is fpack tile-compressed and the solver image has no funpack; "+
+				"add cfitsio's funpack to the image or unpack it with funpack first", ErrUnsupportedCompression, filepath.Base(src))
+		}
+		packed := name + ".fz"
+		if err := copyFile(src, filepath.Join(tempDir, packed), c.config.AllowContainerRead); err != nil {
+			return fmt.Errorf("failed to copy image to temp directory: %w", err)
+		}
+		output := newBoundedBuffer(c.config.MaxOutputBytes)
+		runErr := c.runDocker(ctx, tempDir, absIndexPaths,
+			[]string{"funpack", "-O", c.containerPath(tempDir, name), c.containerPath(tempDir, packed)}, output)
+		if _, err := os.Stat(dst); err != nil {
+			return fmt.Errorf("%w: funpack produced no image (%v)\nOutput: %s", ErrDockerFailed, runErr, output.String())
+		}
+	}
+	return stageAttrs(info, dst, c.config.AllowContainerRead)
+}
+
+// hasTool reports whether tool is on the PATH in the solver container. The
+// answer is cached per image (or exec container) for the client's lifetime.
+func (c *Client) hasTool(ctx context.Context, tempDir string, absIndexPaths []string, tool string) bool {
+	key := c.config.DockerImage + "|" + tool
+	if c.config.UseDockerExec {
+		key = "exec:" + c.config.ContainerName + "|" + tool
+	}
+	if c.probes != nil {
+		if found, ok := c.probes.Load(key); ok {
+			return found.(bool)
+		}
+	}
+	found := c.runDocker(ctx, tempDir, absIndexPaths, []string{"sh", "-c", "command -v " + tool}, io.Discard) == nil
+	if c.probes != nil && ctx.Err() == nil {
+		c.probes.Store(key, found)
+	}
+	return found
+}
@@ -0,0 +1,269 @@
+package solver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// writeGzip gzips the file at src to name in a new temp directory.
+func writeGzip(t *testing.T, src, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// writeFpackFITS writes the headers of a tile-compressed 100x80 16-bit
+// image. The compressed data column is a stand-in; only funpack reads it.
+func writeFpackFITS(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stars.fits.fz")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	err = fits.WriteTables(file, nil, fits.TableHDU{
+		Cards: []fits.Card{
+			{Key: "ZIMAGE", Value: "T"}, {Key: "ZCMPTYPE", Value: fits.Quote("RICE_1")},
+			{Key: "ZBITPIX", Value: "16"}, {Key: "ZNAXIS", Value: "2"},
+			{Key: "ZNAXIS1", Value: "100"}, {Key: "ZNAXIS2", Value: "80"},
+		},
+		Columns: []string{"COMPRESSED_DATA"},
+		Data:    [][]float64{{0}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFitsCompression(t *testing.T) {
+	plain := writeSolvedFITS(t, nil)
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"plain FITS", plain, ""},
+		{"gzip FITS", writeGzip(t, plain, "stars.fits.gz"), compressionGzip},
+		{"gzip FITS without extension", writeGzip(t, plain, "stars.dat"), compressionGzip},
+		{"gzip PNG", writeGzip(t, writeTestPNG(t, 8, 8), "stars.png.gz"), ""},
+		{"fpack", writeFpackFITS(t), compressionFpack},
+		{"PNG", writeTestPNG(t, 8, 8), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fitsCompression(tt.path); got != tt.want {
+				t.Errorf("fitsCompression = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecompressedName(t *testing.T) {
+	tests := map[string]string{
+		"m42.fits.gz": "m42.fits",
+		"m42.FIT.GZ":  "m42.FIT",
+		"m42.fits.fz": "m42.fits",
+		"m42.fz":      "m42.fits",
+		"m42.dat":     "m42.dat.fits",
+	}
+	for name, want := range tests {
+		if got := decompressedName(name); got != want {
+			t.Errorf("decompressedName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestUncompressedSize(t *testing.T) {
+	plain := writeSolvedFITS(t, nil)
+	info, err := os.Stat(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := uncompressedSize(writeGzip(t, plain, "stars.fits.gz"), compressionGzip); got != info.Size() {
+		t.Errorf("gzip size = %d, want %d", got, info.Size())
+	}
+	if got := uncompressedSize(writeFpackFITS(t), compressionFpack); got != 100*80*2 {
+		t.Errorf("fpack size = %d, want %d", got, 100*80*2)
+	}
+	if got := uncompressedSize(plain, ""); got != 0 {
+		t.Errorf("uncompressed size = %d, want 0", got)
+	}
+}
+
+func TestSolve_GzipFITS(t *testing.T) {
+	plain := writeSolvedFITS(t, nil)
+	want, err := os.ReadFile(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotArgs []string
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		gotArgs = args
+		staged, err := os.ReadFile(filepath.Join(workDir, "stars.fits"))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(staged, want) {
+			t.Error("staged image differs from the decompressed original")
+		}
+		return nil
+	})
+
+	if _, err := client.Solve(context.Background(), writeGzip(t, plain, "stars.fits.gz"), nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if gotArgs[len(gotArgs)-1] != "/data/stars.fits" {
+		t.Errorf("solve-field image = %q, want the decompressed /data/stars.fits", gotArgs[len(gotArgs)-1])
+	}
+	// The decompressed copy is removed with the workspace
+	if entries, _ := os.ReadDir(client.config.TempDir); len(entries) != 0 {
+		t.Errorf("temp dir holds %d entries after Solve, want 0", len(entries))
+	}
+}
+
+func TestSolve_GzipFITSSkipIfSolved(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		t.Error("solve-field should not run for an already solved image")
+		return nil
+	})
+
+	imagePath := writeGzip(t, writeSolvedFITS(t, wcsCards(0.001)), "stars.fits.gz")
+	result, err := client.Solve(context.Background(), imagePath, &SolveOptions{SkipIfSolved: true})
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if !result.FromExisting {
+		t.Error("FromExisting = false, want the WCS read from the decompressed header")
+	}
+}
+
+func TestSolve_GzipFITSCountsAgainstScratchSpace(t *testing.T) {
+	// Large but highly compressible: the gzip file is tiny
+	plain := filepath.Join(t.TempDir(), "stars.fits")
+	header := fits.EncodeHeader([]fits.Card{
+		{Key: "SIMPLE", Value: "T"}, {Key: "BITPIX", Value: "8"},
+		{Key: "NAXIS", Value: "2"}, {Key: "NAXIS1", Value: "1000"}, {Key: "NAXIS2", Value: "1000"},
+	})
+	if err := os.WriteFile(plain, append(header, make([]byte, 1000*1000)...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	imagePath := writeGzip(t, plain, "stars.fits.gz")
+	info, err := os.Stat(imagePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scratch := t.TempDir()
+	client := newTestClient(t, func(string, []string, io.Writer) error { return nil })
+	client.config.ScratchDirs = []string{scratch}
+	client.statfs = fakeStatfs(map[string]uint64{scratch: uint64(4 * info.Size())})
+
+	if _, err := client.Solve(context.Background(), imagePath, nil); !errors.Is(err, ErrNoScratchSpace) {
+		t.Errorf("error = %v, want ErrNoScratchSpace for the decompressed size", err)
+	}
+}
+
+func TestSolve_Fpack(t *testing.T) {
+	unpacked, err := os.ReadFile(writeSolvedFITS(t, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var probes, solves int
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		switch {
+		case slices.Contains(args, "sh"):
+			probes++
+			if !strings.HasSuffix(args[len(args)-1], "command -v funpack") {
+				t.Errorf("probe args = %q", args)
+			}
+			return nil
+		case slices.Contains(args, "funpack"):
+			i := slices.Index(args, "-O")
+			if args[len(args)-1] != "/data/stars.fits.fz" {
+				t.Errorf("funpack input = %q, want the staged compressed image", args[len(args)-1])
+			}
+			return os.WriteFile(filepath.Join(workDir, strings.TrimPrefix(args[i+1], "/data/")), unpacked, 0600)
+		default:
+			solves++
+			if args[len(args)-1] != "/data/stars.fits" {
+				t.Errorf("solve-field image = %q, want the unpacked /data/stars.fits", args[len(args)-1])
+			}
+			return nil
+		}
+	})
+
+	for range 2 {
+		if _, err := client.Solve(context.Background(), writeFpackFITS(t), nil); err != nil {
+			t.Fatalf("Solve failed: %v", err)
+		}
+	}
+	if probes != 1 || solves != 2 {
+		t.Errorf("probes = %d, solves = %d; want funpack probed once and two solves", probes, solves)
+	}
+}
+
+func TestSolve_FpackWithoutFunpack(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		if slices.Contains(args, "sh") {
+			return errors.New("exit status 1")
+		}
+		t.Errorf("unexpected command %q without funpack", args)
+		return nil
+	})
+
+	_, err := client.Solve(context.Background(), writeFpackFITS(t), nil)
+	if !errors.Is(err, ErrUnsupportedCompression) || !strings.Contains(err.Error(), "funpack") {
+		t.Errorf("error = %v, want ErrUnsupportedCompression naming funpack", err)
+	}
+}
+
+func TestExtractSources_GzipFITS(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		if args[len(args)-1] != "/data/stars.fits" || !isFITS(filepath.Join(workDir, "stars.fits")) {
+			t.Errorf("image2xy input = %q, want the decompressed FITS", args[len(args)-1])
+		}
+		out, err := os.Create(filepath.Join(workDir, "stars.xy"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		return fits.WriteTables(out, nil, fits.TableHDU{Columns: []string{"X", "Y"}, Data: [][]float64{{1}, {2}}})
+	})
+
+	list, err := client.ExtractSources(context.Background(), writeGzip(t, writeSolvedFITS(t, nil), "stars.fits.gz"), nil)
+	if err != nil {
+		t.Fatalf("ExtractSources failed: %v", err)
+	}
+	if list.ImageWidth != 8 || list.ImageHeight != 8 {
+		t.Errorf("image size = %dx%d, want 8x8 from the decompressed header", list.ImageWidth, list.ImageHeight)
+	}
+}
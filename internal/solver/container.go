@@ -0,0 +1,82 @@
+package solver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+)
+
+// containerRemoveTimeout bounds the docker rm -f issued after a cancelled
+// or timed-out docker run.
+const containerRemoveTimeout = 30 * time.Second
+
+// runDocker runs a tool command line in a container via dockerArgs,
+// streaming its output to w.
+//
+// In run mode the container gets a generated name, and if ctx ends before
+// docker run returns, the container is removed with docker rm -f. Killing
+// the docker run client does not stop the container, and --rm only
+// removes it once it exits, so timed-out solves could otherwise keep
+// running and pile up under load. Exec mode runs in the caller's
+// long-lived container, which is never removed, and a leased warm pool
+// container is replaced by the pool after a timeout.
+func (c *Client) runDocker(ctx context.Context, tempDir string, absIndexPaths []string, args []string, w io.Writer) error {
+	name := ""
+	if !c.usesExec() {
+		name = newContainerName()
+	}
+	err := c.run(ctx, "docker", c.dockerArgs(name, tempDir, absIndexPaths, args), w)
+	if name != "" && ctx.Err() != nil {
+		c.removeContainer(context.WithoutCancel(ctx), name)
+	}
+	return err
+}
+
+// usesExec reports whether tools run with docker exec in an existing
+// container, in exec mode or in the warm pool container leased by Solve,
+// rather than each in a new container with docker run.
+func (c *Client) usesExec() bool {
+	return c.config.UseDockerExec || c.leased != nil
+}
+
+// execContainer returns the container tools run in when usesExec.
+func (c *Client) execContainer() string {
+	if c.leased != nil {
+		return c.leased.name
+	}
+	return c.config.ContainerName
+}
+
+// removeContainer force-removes a run-mode container, logging a failure.
+// A container already removed by --rm is not an error.
+func (c *Client) removeContainer(ctx context.Context, name string) {
+	if err := c.forceRemove(ctx, name); err != nil {
+		log.Printf("warning: %v", err)
+	}
+}
+
+// forceRemove runs docker rm -f on the named container, bounded by
+// containerRemoveTimeout. A container that does not exist is not an error.
+func (c *Client) forceRemove(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, containerRemoveTimeout)
+	defer cancel()
+
+	var output strings.Builder
+	if err := c.run(ctx, "docker", []string{"rm", "-f", name}, &output); err != nil &&
+		!strings.Contains(output.String(), "No such container") {
+		return fmt.Errorf("failed to remove container %s: %v: %s", name, err, strings.TrimSpace(output.String()))
+	}
+	return nil
+}
+
+// newContainerName returns a unique name for a run-mode container.
+func newContainerName() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b) //nolint:errcheck // crypto/rand.Read never returns an error
+	return fmt.Sprintf("astrometry-go-%s", hex.EncodeToString(b))
+}
@@ -0,0 +1,105 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// containerRecorder is a fake runtime whose docker run blocks until
+// killed by its context, recording the run and rm calls it sees.
+type containerRecorder struct {
+	mu      sync.Mutex
+	block   bool
+	names   []string // --name of each docker run
+	removed []string // containers passed to docker rm -f
+}
+
+func (r *containerRecorder) run(ctx context.Context, name string, args []string, w io.Writer) error {
+	if args[0] == "rm" {
+		r.mu.Lock()
+		r.removed = append(r.removed, args[len(args)-1])
+		r.mu.Unlock()
+		if ctx.Err() != nil {
+			return errors.New("rm ran with a cancelled context")
+		}
+		_, _ = io.WriteString(w, "Error response from daemon: No such container\n") //nolint:errcheck // Test output
+		return errors.New("exit status 1")
+	}
+
+	if i := slices.Index(args, "--name"); i != -1 {
+		r.mu.Lock()
+		r.names = append(r.names, args[i+1])
+		r.mu.Unlock()
+	}
+	if r.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return nil
+}
+
+func TestSolve_RemovesContainerOnTimeout(t *testing.T) {
+	rec := &containerRecorder{block: true}
+	client := newTestClient(t, nil)
+	client.run = rec.run
+	client.config.Timeout = 20 * time.Millisecond
+
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+	if len(rec.names) != 1 || !strings.HasPrefix(rec.names[0], "astrometry-go-") {
+		t.Fatalf("docker run names = %q, want one generated name", rec.names)
+	}
+	if !slices.Equal(rec.removed, rec.names) {
+		t.Errorf("removed containers = %q, want %q", rec.removed, rec.names)
+	}
+}
+
+func TestExtractSources_RemovesContainerOnCancel(t *testing.T) {
+	rec := &containerRecorder{block: true}
+	client := newTestClient(t, nil)
+	client.run = rec.run
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	_, _ = client.ExtractSources(ctx, writeTestPNG(t, 8, 8), nil) //nolint:errcheck // Only the cleanup matters here
+	if len(rec.names) != 1 || !slices.Equal(rec.removed, rec.names) {
+		t.Errorf("run names %q, removed %q: want the cancelled container removed", rec.names, rec.removed)
+	}
+}
+
+func TestRunDocker_NoRemoval(t *testing.T) {
+	rec := &containerRecorder{}
+	client := newTestClient(t, nil)
+	client.run = rec.run
+
+	// A run that finishes leaves cleanup to --rm
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if len(rec.names) != 1 || len(rec.removed) != 0 {
+		t.Errorf("run names %q, removed %q: want one named run and no removal", rec.names, rec.removed)
+	}
+
+	// Exec mode containers are the caller's and never named or removed
+	rec = &containerRecorder{block: true}
+	client.run = rec.run
+	client.config.UseDockerExec = true
+	client.config.ContainerName = "astrometry"
+	client.config.Timeout = 20 * time.Millisecond
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+	if len(rec.names) != 0 || len(rec.removed) != 0 {
+		t.Errorf("exec mode: run names %q, removed %q, want none", rec.names, rec.removed)
+	}
+}
@@ -0,0 +1,232 @@
+package solver
+
+import (
+	"encoding/csv"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// maxPixelRadius0 bounds the distance in degrees from a HEALPix pixel's
+// center to its farthest corner at order 0 (48.2°, with a margin); the
+// bound halves with each order.
+const maxPixelRadius0 = 58.0
+
+// CoverageCell is the coverage of one HEALPix pixel of a CoverageMap.
+type CoverageCell struct {
+	// Count is the number of frames covering the pixel.
+	Count int
+
+	// Exposure is the summed exposure of those frames, zero when none was
+	// given to Add.
+	Exposure time.Duration
+}
+
+// CoverageMap counts how often each part of the sky was imaged, in
+// NESTED-scheme HEALPix pixels at Order (Nside = 2^Order), the same keys
+// as Result.HealPix.
+//
+// Each frame's footprint is the polygon through its four corners, and a
+// pixel is counted fully or not at all: a frame adds 1 to every pixel
+// whose center lies inside its footprint. A pixel half covered by a frame
+// is therefore not counted, but over many frames at varied positions the
+// total matches the covered area. A frame too small to contain any pixel
+// center counts in the pixel holding its own center, so no frame is lost.
+type CoverageMap struct {
+	Order  int
+	Frames int
+	Cells  map[int64]CoverageCell
+}
+
+// NewCoverageMap returns an empty CoverageMap at order 0..MaxHealPixOrder.
+// Order 4 (Nside 16, 3.7° pixels) suits wide-field archives, and order 6
+// (0.9°) telescope archives.
+func NewCoverageMap(order int) (*CoverageMap, error) {
+	if order < 0 || order > MaxHealPixOrder {
+		return nil, fmt.Errorf("%w: HEALPix order %d outside 0..%d", ErrInvalidInput, order, MaxHealPixOrder)
+	}
+	return &CoverageMap{Order: order, Cells: make(map[int64]CoverageCell)}, nil
+}
+
+// SkyCoverage builds a CoverageMap at order from a batch of results,
+// skipping those without a WCS solution, such as failed solves.
+//
+// Example:
+//
+//	coverage, err := client.SkyCoverage(results, 5)
+//	if err != nil {
+//		return err
+//	}
+//	err = coverage.WriteCSV(csvFile)
+func SkyCoverage(results []*Result, order int) (*CoverageMap, error) {
+	m, err := NewCoverageMap(order)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		if r == nil || !r.Solved {
+			continue
+		}
+		if _, ok := r.CornerCoords(); !ok {
+			continue
+		}
+		if err := m.Add(r, 0); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Add counts the footprint of a solved frame, adding exposure (zero when
+// unknown) to each pixel it covers. It returns ErrInvalidInput when the
+// result has no WCS solution.
+func (m *CoverageMap) Add(r *Result, exposure time.Duration) error {
+	corners, ok := r.CornerCoords()
+	if !r.Solved || !ok {
+		return fmt.Errorf("%w: result has no WCS solution", ErrInvalidInput)
+	}
+
+	var polygon [4][3]float64
+	radius := 0.0
+	for i, c := range corners {
+		polygon[i] = unitVector(c[0], c[1])
+		radius = max(radius, angularSeparation(r.RA, r.Dec, c[0], c[1]))
+	}
+
+	var cells []int64
+	var visit func(order int, pix int64)
+	visit = func(order int, pix int64) {
+		ra, dec := healPixCenter(order, pix)
+		if angularSeparation(r.RA, r.Dec, ra, dec) > radius+maxPixelRadius0/float64(int64(1)<<order) {
+			return
+		}
+		if order < m.Order {
+			for child := pix << 2; child < pix<<2+4; child++ {
+				visit(order+1, child)
+			}
+			return
+		}
+		if insidePolygon(polygon, unitVector(ra, dec)) {
+			cells = append(cells, pix)
+		}
+	}
+	for base := int64(0); base < 12; base++ {
+		visit(0, base)
+	}
+	if len(cells) == 0 {
+		cells = append(cells, healPixNest(m.Order, r.RA, r.Dec))
+	}
+
+	for _, pix := range cells {
+		cell := m.Cells[pix]
+		cell.Count++
+		cell.Exposure += exposure
+		m.Cells[pix] = cell
+	}
+	m.Frames++
+	return nil
+}
+
+// unitVector returns the Cartesian unit vector of RA and Dec in degrees.
+func unitVector(ra, dec float64) [3]float64 {
+	ra, dec = ra*deg2rad, dec*deg2rad
+	return [3]float64{math.Cos(dec) * math.Cos(ra), math.Cos(dec) * math.Sin(ra), math.Sin(dec)}
+}
+
+// insidePolygon reports whether p lies inside the convex spherical polygon
+// with great-circle edges between its vertices, in either winding. A TAN
+// footprint's straight edges are great circles.
+func insidePolygon(polygon [4][3]float64, p [3]float64) bool {
+	var pos, neg bool
+	for i, a := range polygon {
+		b := polygon[(i+1)%len(polygon)]
+		normal := [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+		switch side := normal[0]*p[0] + normal[1]*p[1] + normal[2]*p[2]; {
+		case side > 0:
+			pos = true
+		case side < 0:
+			neg = true
+		}
+	}
+	return !(pos && neg)
+}
+
+// WriteCSV writes one row per covered pixel in pixel order, after a
+// "cell,count,exposure_seconds" header.
+func (m *CoverageMap) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"cell", "count", "exposure_seconds"}); err != nil {
+		return err
+	}
+	cells := make([]int64, 0, len(m.Cells))
+	for pix := range m.Cells {
+		cells = append(cells, pix)
+	}
+	slices.Sort(cells)
+	for _, pix := range cells {
+		cell := m.Cells[pix]
+		if err := cw.Write([]string{
+			strconv.FormatInt(pix, 10),
+			strconv.Itoa(cell.Count),
+			strconv.FormatFloat(cell.Exposure.Seconds(), 'f', -1, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WritePNG draws the map as a heat map in the Mollweide projection, width
+// pixels wide and half as high. RA 0 is at the center and increases to the
+// left, as the sky is seen from inside; north is up. Uncovered sky is dark
+// gray, and covered pixels run from red at one frame to white at the most
+// covered pixel. Outside the ellipse is transparent.
+func (m *CoverageMap) WritePNG(w io.Writer, width int) error {
+	if width < 2 {
+		return fmt.Errorf("%w: image width %d too small", ErrInvalidInput, width)
+	}
+	height := width / 2
+	maxCount := 0
+	for _, cell := range m.Cells {
+		maxCount = max(maxCount, cell.Count)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for py := 0; py < height; py++ {
+		for px := 0; px < width; px++ {
+			x := (float64(px) + 0.5 - float64(width)/2) / (float64(width) / 2) * 2 * math.Sqrt2
+			y := (float64(height)/2 - float64(py) - 0.5) / (float64(height) / 2) * math.Sqrt2
+			if x*x/8+y*y/2 > 1 {
+				continue
+			}
+			// Inverse Mollweide through the auxiliary angle theta
+			theta := math.Asin(y / math.Sqrt2)
+			dec := math.Asin((2*theta+math.Sin(2*theta))/math.Pi) * rad2deg
+			lon := math.Pi * x / (2 * math.Sqrt2 * math.Cos(theta)) * rad2deg
+			count := m.Cells[healPixNest(m.Order, normalizeRA(-lon), dec)].Count
+			img.SetNRGBA(px, py, heatColor(count, maxCount))
+		}
+	}
+	return png.Encode(w, img)
+}
+
+// heatColor maps a pixel's frame count to black-body-like colors, with
+// dark gray for no coverage.
+func heatColor(count, maxCount int) color.NRGBA {
+	if count == 0 {
+		return color.NRGBA{R: 40, G: 40, B: 48, A: 255}
+	}
+	// One frame is visible as dark red even beside heavily covered pixels
+	t := 0.15 + 0.85*float64(count)/float64(maxCount)
+	channel := func(v float64) uint8 {
+		return uint8(255 * math.Min(1, math.Max(0, v)))
+	}
+	return color.NRGBA{R: channel(3 * t), G: channel(3*t - 1), B: channel(3*t - 2), A: 255}
+}
@@ -0,0 +1,156 @@
+package solver
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// tanResult returns a solved result for a north-up TAN frame centered on
+// ra, dec, width by height pixels at scale degrees per pixel.
+func tanResult(t *testing.T, ra, dec, scale float64, width, height int) *Result {
+	t.Helper()
+	r, err := ResultFromWCSHeader(map[string]string{
+		"CTYPE1": "RA---TAN", "CTYPE2": "DEC--TAN",
+		"CRVAL1": formatFloat(ra), "CRVAL2": formatFloat(dec),
+		"CRPIX1": formatFloat(float64(width) / 2), "CRPIX2": formatFloat(float64(height) / 2),
+		"CD1_1": formatFloat(-scale), "CD1_2": "0", "CD2_1": "0", "CD2_2": formatFloat(scale),
+	}, width, height)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	return r
+}
+
+func TestCoverageMap_NSIDE4(t *testing.T) {
+	m, err := NewCoverageMap(2) // Nside 4
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A 30°x24° tangent plane at RA 22.5° Dec 0 reaches 14.7° east and
+	// west and 11.8° north and south. At Nside 4 the pixel centers near it
+	// are (11.25, 0) and (33.75, 0) on the equator ring and (22.5, ±9.59)
+	// on the rings at z = ±1/6; the next centers, (0, ±9.59), (45, ±9.59)
+	// and (22.5, ±19.47), are outside. Those four pixels are 68-71, the
+	// children of Nside 2 pixel 17.
+	if err := m.Add(tanResult(t, 22.5, 0, 0.1, 300, 240), 2*time.Minute); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	// A 20°x20° frame at (11.25, 0) contains only the center of pixel 70
+	if err := m.Add(tanResult(t, 11.25, 0, 0.1, 200, 200), time.Minute); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	// A 1° frame contains no pixel center and counts where its center is
+	if err := m.Add(tanResult(t, 33.0, 1.0, 0.01, 100, 100), 0); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	want := map[int64]CoverageCell{
+		68: {1, 2 * time.Minute},
+		69: {2, 2 * time.Minute},
+		70: {2, 3 * time.Minute},
+		71: {1, 2 * time.Minute},
+	}
+	if !maps.Equal(m.Cells, want) {
+		t.Errorf("cells = %v, want %v", m.Cells, want)
+	}
+	if m.Frames != 3 {
+		t.Errorf("Frames = %d, want 3", m.Frames)
+	}
+
+	var csv bytes.Buffer
+	if err := m.WriteCSV(&csv); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	wantCSV := "cell,count,exposure_seconds\n68,1,120\n69,2,120\n70,2,180\n71,1,120\n"
+	if csv.String() != wantCSV {
+		t.Errorf("CSV = %q, want %q", csv.String(), wantCSV)
+	}
+}
+
+func TestCoverageMap_Pole(t *testing.T) {
+	// A frame on the pole spans all longitudes; the four order 1 pixels
+	// meeting at the pole have centers 19.5° away, inside a 50° frame
+	m, err := NewCoverageMap(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add(tanResult(t, 0, 90, 0.5, 100, 100), 0); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if got := slices.Sorted(maps.Keys(m.Cells)); !slices.Equal(got, []int64{3, 7, 11, 15}) {
+		t.Errorf("cells = %v, want the polar pixels [3 7 11 15]", got)
+	}
+}
+
+func TestSkyCoverage(t *testing.T) {
+	results := []*Result{
+		tanResult(t, 22.5, 0, 0.1, 300, 240),
+		{Solved: false},
+		nil,
+		{Solved: true, RA: 10, Dec: 10}, // no WCS header
+	}
+	m, err := SkyCoverage(results, 2)
+	if err != nil {
+		t.Fatalf("SkyCoverage failed: %v", err)
+	}
+	if m.Frames != 1 || len(m.Cells) != 4 {
+		t.Errorf("Frames = %d, cells = %v; want 1 frame in 4 cells", m.Frames, m.Cells)
+	}
+
+	if _, err := SkyCoverage(nil, MaxHealPixOrder+1); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("invalid order: err = %v, want ErrInvalidInput", err)
+	}
+	if err := m.Add(&Result{}, 0); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Add unsolved: err = %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestCoverageMap_WritePNG(t *testing.T) {
+	m, err := SkyCoverage([]*Result{tanResult(t, 22.5, 0, 0.1, 300, 240)}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := m.WritePNG(&buf, 400); err != nil {
+		t.Fatalf("WritePNG failed: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("invalid PNG: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 400 || b.Dy() != 200 {
+		t.Fatalf("size = %v, want 400x200", b.Size())
+	}
+
+	// RA 22.5° plots left of center on the equator, RA 337.5° right of it
+	covered := heatColor(1, 1)
+	empty := heatColor(0, 1)
+	at := func(x, y int) [4]uint32 {
+		r, g, b, a := img.At(x, y).RGBA()
+		return [4]uint32{r, g, b, a}
+	}
+	rgba := func(c interface{ RGBA() (r, g, b, a uint32) }) [4]uint32 {
+		r, g, b, a := c.RGBA()
+		return [4]uint32{r, g, b, a}
+	}
+	if got := at(175, 100); got != rgba(covered) {
+		t.Errorf("pixel at RA 22.5 = %v, want covered %v", got, rgba(covered))
+	}
+	if got := at(225, 100); got != rgba(empty) {
+		t.Errorf("pixel at RA 337.5 = %v, want empty %v", got, rgba(empty))
+	}
+	if _, _, _, a := img.At(2, 2).RGBA(); a != 0 {
+		t.Errorf("corner outside the ellipse has alpha %d, want transparent", a)
+	}
+
+	if err := m.WritePNG(&buf, 1); err == nil || !strings.Contains(err.Error(), "width") {
+		t.Errorf("width 1: err = %v, want width error", err)
+	}
+}
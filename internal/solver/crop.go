@@ -0,0 +1,121 @@
+package solver
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// defaultMaxFieldDegrees is the default ClientConfig.MaxFieldDegrees, the
+// same as fov.DefaultMaxFieldDegrees (fov imports this package).
+const defaultMaxFieldDegrees = 120.0
+
+// cropSuffix replaces the extension of a staged image cropped for
+// SolveOptions.CropPixels, so its outputs are named stars-crop.wcs etc.
+const cropSuffix = "-crop.fits"
+
+// CropGeometry is the part of an image a SolveOptions.CropPixels solve
+// covered. X and Y are the 0-based column and row of the crop's first
+// pixel in the full image, counting rows in file order (top first for
+// JPEG and PNG, FITS row 1 first), so crop pixel (x, y) is full-image
+// pixel (x+X, y+Y).
+type CropGeometry struct {
+	X, Y          int
+	Width, Height int
+}
+
+// checkFieldWidth returns ErrFieldTooWide when opts searches only fields
+// wider than MaxFieldDegrees. Bounds in arcsecperpix are not checked, as
+// the width also depends on the image size.
+func (c *Client) checkFieldWidth(opts *SolveOptions) error {
+	limit := c.config.MaxFieldDegrees
+	if limit == 0 {
+		limit = defaultMaxFieldDegrees
+	}
+	low := opts.ScaleLow
+	switch opts.ScaleUnits {
+	case "degwidth":
+	case "arcminwidth":
+		low /= 60
+	default:
+		return nil
+	}
+	if low > limit {
+		return fmt.Errorf("%w: scale bounds start at %.0f°, wider than MaxFieldDegrees %.0f°; "+
+			"all-sky and fisheye frames cannot be solved whole, so set CropPixels to solve a central crop",
+			ErrFieldTooWide, low, limit)
+	}
+	return nil
+}
+
+// cropCentral writes the central size × size pixels (fewer along a shorter
+// side) of the image at src to dst as 8-bit grayscale FITS, keeping file
+// row order, and returns where the crop lies in src. ok is false, and dst
+// is not written, when the crop would cover the whole image. Pixel values
+// outside 0-255, as in 16-bit FITS, are stretched linearly over the crop.
+func cropCentral(src, dst string, size int, containerRead bool) (crop CropGeometry, ok bool, err error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return CropGeometry{}, false, fmt.Errorf("failed to stat image: %w", err)
+	}
+	p, err := readPlane(src)
+	if err != nil {
+		return CropGeometry{}, false, err
+	}
+	crop.Width, crop.Height = min(size, p.width), min(size, p.height)
+	if crop.Width == p.width && crop.Height == p.height {
+		return CropGeometry{}, false, nil
+	}
+	crop.X, crop.Y = (p.width-crop.Width)/2, (p.height-crop.Height)/2
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	values := make([]float64, crop.Width*crop.Height)
+	for y := 0; y < crop.Height; y++ {
+		for x := 0; x < crop.Width; x++ {
+			v := p.at(crop.X+x, crop.Y+y)
+			values[y*crop.Width+x] = v
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+	}
+	offset, scale := 0.0, 1.0
+	if (lo < 0 || hi > 255) && hi > lo {
+		offset, scale = lo, 255/(hi-lo)
+	}
+
+	// WriteImage writes the last row first, so reverse the rows to keep
+	// the source's file order
+	pix := make([]uint8, len(values))
+	for y := 0; y < crop.Height; y++ {
+		for x := 0; x < crop.Width; x++ {
+			v := (values[(crop.Height-1-y)*crop.Width+x] - offset) * scale
+			pix[y*crop.Width+x] = uint8(math.Round(math.Max(0, math.Min(255, v))))
+		}
+	}
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, stagedPerm(info.Mode(), containerRead))
+	if err != nil {
+		return CropGeometry{}, false, fmt.Errorf("failed to create cropped image: %w", err)
+	}
+	w := bufio.NewWriter(out)
+	err = fits.WriteImage(w, crop.Width, crop.Height, pix)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return CropGeometry{}, false, fmt.Errorf("failed to write cropped image: %w", err)
+	}
+	return crop, true, stageAttrs(info, dst, containerRead)
+}
+
+// croppedName returns the name of the crop of the staged image name.
+func croppedName(name string) string {
+	return strings.TrimSuffix(name, filepath.Ext(name)) + cropSuffix
+}
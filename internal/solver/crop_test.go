@@ -0,0 +1,148 @@
+package solver
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// writeMarkedPNG writes a black width × height PNG named stars.png with one
+// white pixel at (x, y), counting rows from the top.
+func writeMarkedPNG(t *testing.T, width, height, x, y int) string {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	img.SetGray(x, y, color.Gray{Y: 255})
+	path := filepath.Join(t.TempDir(), "stars.png")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCropCentral(t *testing.T) {
+	src := writeMarkedPNG(t, 100, 60, 35, 12)
+	dst := filepath.Join(t.TempDir(), "stars-crop.fits")
+
+	crop, ok, err := cropCentral(src, dst, 40, false)
+	if err != nil || !ok {
+		t.Fatalf("cropCentral = %v, %v", ok, err)
+	}
+	if want := (CropGeometry{X: 30, Y: 10, Width: 40, Height: 40}); crop != want {
+		t.Errorf("crop = %+v, want %+v", crop, want)
+	}
+
+	file, err := os.Open(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	img, err := fits.ReadImage(bufio.NewReader(file))
+	if err != nil {
+		t.Fatalf("crop is not FITS: %v", err)
+	}
+	if img.Width != 40 || img.Height != 40 {
+		t.Errorf("crop is %dx%d, want 40x40", img.Width, img.Height)
+	}
+	// Rows keep the source's file order: crop (5, 2) is source (35, 12)
+	if img.At(5, 2) != 255 {
+		t.Errorf("marked pixel not at crop (5, 2) in file order")
+	}
+
+	// A crop covering the whole image is skipped
+	if _, ok, err := cropCentral(src, dst+"2", 100, false); ok || err != nil {
+		t.Errorf("whole-image crop = %v, %v; want skipped", ok, err)
+	}
+	if _, err := os.Stat(dst + "2"); !os.IsNotExist(err) {
+		t.Errorf("skipped crop wrote a file")
+	}
+}
+
+func TestSolve_CropPixels(t *testing.T) {
+	var solvedImage string
+	client := newTestClient(t, func(dir string, args []string, w io.Writer) error {
+		solvedImage = args[slices.IndexFunc(args, func(a string) bool { return strings.HasPrefix(a, "/data/") })]
+		base := strings.TrimSuffix(filepath.Base(solvedImage), filepath.Ext(solvedImage))
+		if err := os.WriteFile(filepath.Join(dir, base+".wcs"), fits.EncodeHeader(wcsCards(0.001)), 0644); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dir, base+".solved"), []byte{1}, 0644)
+	})
+	imagePath := writeMarkedPNG(t, 100, 60, 50, 30)
+
+	opts := DefaultSolveOptions()
+	opts.CropPixels = 40
+	result, err := client.Solve(context.Background(), imagePath, opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if filepath.Base(solvedImage) != "stars-crop.fits" {
+		t.Errorf("solved %s, want the crop", solvedImage)
+	}
+	if !result.Solved || !result.PartialField || result.Crop != (CropGeometry{X: 30, Y: 10, Width: 40, Height: 40}) {
+		t.Errorf("result = solved %v, partial %v, crop %+v; want a solved 40x40 crop at (30, 10)",
+			result.Solved, result.PartialField, result.Crop)
+	}
+
+	// A crop no smaller than the image solves it whole
+	opts.CropPixels = 200
+	result, err = client.Solve(context.Background(), imagePath, opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if filepath.Base(solvedImage) != "stars.png" || result.PartialField {
+		t.Errorf("solved %s with PartialField %v, want the whole image", solvedImage, result.PartialField)
+	}
+
+	opts.CropPixels = -1
+	if _, err := client.Solve(context.Background(), imagePath, opts); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("negative CropPixels: err = %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestSolve_MaxFieldDegrees(t *testing.T) {
+	client := newTestClient(t, func(string, []string, io.Writer) error { return nil })
+	imagePath := writeTestPNG(t, 8, 8)
+
+	tests := []struct {
+		name    string
+		limit   float64
+		low     float64
+		units   ScaleUnits
+		tooWide bool
+	}{
+		{"at the default limit", 0, 120, "degwidth", false},
+		{"over the default limit", 0, 121, "degwidth", true},
+		{"arcminutes over the limit", 0, 121 * 60, "arcminwidth", true},
+		{"arcsecperpix unchecked", 0, 500, "arcsecperpix", false},
+		{"raised limit", 180, 150, "degwidth", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.MaxFieldDegrees = tt.limit }); err != nil {
+				t.Fatal(err)
+			}
+			opts := DefaultSolveOptions()
+			opts.ScaleLow, opts.ScaleHigh, opts.ScaleUnits = tt.low, tt.low*1.2, tt.units
+			_, err := client.Solve(context.Background(), imagePath, opts)
+			if got := errors.Is(err, ErrFieldTooWide); got != tt.tooWide {
+				t.Errorf("err = %v, want ErrFieldTooWide %v", err, tt.tooWide)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+)
+
+// SolveError is returned by Solve when a solve failed for a reason other
+// than an image that simply did not solve: a known setup problem shown in
+// solve-field's output, an exit status that marks a Docker or solver
+// failure, or a solution rejected by SolveOptions.RotationTolerance. It wraps ErrInvalidInput, ErrNoSolution or ErrDockerFailed, so
+// errors.Is works as usual, and carries a hint on how to fix it.
+type SolveError struct {
+	// Err is the sentinel error wrapped: ErrInvalidInput, ErrNoSolution
+	// or ErrDockerFailed.
+	Err error
+
+	// Reason is a short description of the problem.
+	Reason string
+
+	// Hint suggests how to fix it.
+	Hint string
+
+	// Output is the solve-field output the problem was recognised in.
+	Output string
+
+	// ExitCode is the exit status of the solve command: solve-field's, or
+	// docker's own for ErrDockerFailed with 125, 126 or 127. It is -1 when
+	// the command did not run or was killed.
+	ExitCode int
+}
+
+// Error returns the reason and the hint.
+func (e *SolveError) Error() string {
+	return fmt.Sprintf("%v: %s (hint: %s)", e.Err, e.Reason, e.Hint)
+}
+
+// Unwrap returns the wrapped sentinel error.
+func (e *SolveError) Unwrap() error {
+	return e.Err
+}
+
+// diagnostics maps solve-field messages for setup problems to errors,
+// checked in order. Messages differ between astrometry.net versions, so the
+// patterns are loose.
+var diagnostics = []struct {
+	pattern *regexp.Regexp
+	err     error
+	reason  string
+	hint    string
+}{
+	{
+		regexp.MustCompile(`(?i)no index files (were )?found|must list at least one index|couldn'?t find any index`),
+		ErrInvalidInput,
+		"solve-field found no index files",
+		"check that IndexPath contains index-*.fits files (set ValidateIndexes to check at startup) " +
+			"and that IndexMountPath is a directory the image's astrometry.cfg lists with add_path",
+	},
+	{
+		regexp.MustCompile(`(?i)field scale.*(doesn'?t|does not|didn'?t) match any index|no index (files? )?(overlap|cover)s? the (image )?scale`),
+		ErrNoSolution,
+		"the scale bounds exclude every installed index",
+		"widen ScaleLow/ScaleHigh or check ScaleUnits, or install index files covering the field of view " +
+			"(fov.RecommendIndexes lists them)",
+	},
+	{
+		regexp.MustCompile(`(?i)failed to (read|open|convert) (the )?(input )?(image|file)|unknown image (type|format)`),
+		ErrInvalidInput,
+		"solve-field could not read the image",
+		"convert it to FITS, PNG or JPEG, or check that the container user can read the workspace (AllowContainerRead)",
+	},
+}
+
+// diagnoseFailure looks for a known setup problem in the output of a solve
+// that found no solution, returning a *SolveError for the first match or
+// nil when the image simply did not solve.
+func diagnoseFailure(output string) *SolveError {
+	for _, d := range diagnostics {
+		if d.pattern.MatchString(output) {
+			return &SolveError{Err: d.err, Reason: d.reason, Hint: d.hint, Output: output}
+		}
+	}
+	return nil
+}
+
+// dockerExitReasons describes the exit statuses docker run and docker exec
+// use for their own failures, as opposed to the exit status of solve-field
+// in the container.
+var dockerExitReasons = map[int]struct{ reason, hint string }{
+	125: {
+		"docker failed before solve-field ran",
+		"check that the Docker daemon is running, and the image, mounts and exec container are valid (see Output)",
+	},
+	126: {
+		"solve-field could not be executed in the container",
+		"check that DockerImage is an astrometry.net image and its solve-field is executable",
+	},
+	127: {
+		"solve-field was not found in the container",
+		"check that DockerImage, or the exec container, has astrometry.net installed",
+	},
+}
+
+// exitStatus returns the exit status of a command whose runner returned
+// err: 0 for nil, the status of an exited process, and -1 when the
+// command did not run or was killed by a signal.
+func exitStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr interface{ ExitCode() int }
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+// classifyUnsolved returns the error for a solve that ran with runErr and
+// output and produced no solution, or nil when the image simply did not
+// solve. The exit status is checked first:
+//
+//   - 125, 126 or 127 is docker failing to run solve-field (ErrDockerFailed)
+//   - 0 is a miss, unless the output shows a setup problem
+//   - any other status is a miss when the output shows a known setup problem
+//     or solve-field failure message (see ParseSolveFieldLog), and
+//     otherwise a Docker or solver failure (ErrDockerFailed)
+func classifyUnsolved(runErr error, output string) error {
+	code := exitStatus(runErr)
+	if d, ok := dockerExitReasons[code]; ok {
+		return &SolveError{Err: ErrDockerFailed, Reason: d.reason, Hint: d.hint, Output: output, ExitCode: code}
+	}
+	// Output text varies between versions, so it only refines the status
+	if diagErr := diagnoseFailure(output); diagErr != nil {
+		diagErr.ExitCode = code
+		return diagErr
+	}
+	if code == 0 || knownFailure(output) {
+		return nil
+	}
+	if code < 0 {
+		return &SolveError{Err: ErrDockerFailed, Reason: fmt.Sprintf("the solve command did not complete: %v", runErr),
+			Hint: "check that docker is installed and on PATH (see Output)", Output: output, ExitCode: code}
+	}
+	return &SolveError{Err: ErrDockerFailed,
+		Reason:   fmt.Sprintf("solve-field exited with status %d without a recognised failure message", code),
+		Hint:     "see Output for the solver's error; set Verbose for the command line",
+		Output:   output,
+		ExitCode: code}
+}
+
+// checkRotation returns a *SolveError wrapping ErrNoSolution when
+// opts.RotationTolerance is set and the solved rotation is further than it
+// from opts.ExpectedRotation, or nil when the solution is accepted.
+func checkRotation(result *Result, opts *SolveOptions, output string, code int) *SolveError {
+	if opts.RotationTolerance <= 0 || !result.Solved {
+		return nil
+	}
+	offset := wrapDegrees(result.Rotation - opts.ExpectedRotation)
+	if math.Abs(offset) <= opts.RotationTolerance {
+		return nil
+	}
+	reason := fmt.Sprintf("solved rotation %.1f° is %.1f° from ExpectedRotation %.1f°, beyond RotationTolerance %g°",
+		result.Rotation, math.Abs(offset), opts.ExpectedRotation, opts.RotationTolerance)
+	hint := "check ExpectedRotation, or widen RotationTolerance if the camera was moved"
+	if isMeridianFlip(opts.ExpectedRotation, result.Rotation) {
+		reason += " (flipped by ~180°)"
+		hint = "a field flipped by ~180° is usually a false match: narrow the scale bounds or add an RA/Dec hint; " +
+			"after a meridian flip, add 180° to ExpectedRotation"
+	}
+	return &SolveError{Err: ErrNoSolution, Reason: reason, Hint: hint, Output: output, ExitCode: code}
+}
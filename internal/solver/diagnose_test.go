@@ -0,0 +1,240 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// exitError is a fake runner error for a command that exited with a
+// status, like *exec.ExitError.
+type exitError int
+
+func (e exitError) Error() string { return fmt.Sprintf("exit status %d", int(e)) }
+
+func (e exitError) ExitCode() int { return int(e) }
+
+func TestSolve_DiagnosesFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		wantErr    error
+		wantReason string
+	}{
+		{
+			name: "no index files",
+			output: "Reading input file 1 of 1: \"/data/stars.png\"...\n" +
+				"simplexy: found 412 sources.\n" +
+				"You must list at least one index in the config file (/usr/local/astrometry/etc/astrometry.cfg)\n",
+			wantErr:    ErrInvalidInput,
+			wantReason: "no index files",
+		},
+		{
+			name:       "no index files found",
+			output:     "solve-field: No index files found in /usr/local/astrometry/data\n",
+			wantErr:    ErrInvalidInput,
+			wantReason: "no index files",
+		},
+		{
+			name: "scale excludes indexes",
+			output: "simplexy: found 412 sources.\n" +
+				"Field scale lower bound 0.1 arcsec/pix, upper bound 0.2 arcsec/pix doesn't match any index\n" +
+				"Did not solve (or no WCS file was written).\n",
+			wantErr:    ErrNoSolution,
+			wantReason: "scale bounds exclude every installed index",
+		},
+		{
+			name:       "unreadable image",
+			output:     "Failed to read image file /data/stars.png\nDid not solve (or no WCS file was written).\n",
+			wantErr:    ErrInvalidInput,
+			wantReason: "could not read the image",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				_, _ = io.WriteString(w, tt.output)
+				return exitError(1)
+			})
+
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+			if result != nil || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Solve = %+v, %v, want %v", result, err, tt.wantErr)
+			}
+			var solveErr *SolveError
+			if !errors.As(err, &solveErr) {
+				t.Fatalf("error %T is not a *SolveError", err)
+			}
+			if !strings.Contains(solveErr.Reason, tt.wantReason) || solveErr.Hint == "" || solveErr.Output != tt.output {
+				t.Errorf("SolveError = %+v", solveErr)
+			}
+			if !strings.Contains(err.Error(), "hint: ") {
+				t.Errorf("error message %q has no hint", err)
+			}
+		})
+	}
+}
+
+func TestSolve_PlainFailureNotDiagnosed(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		_, _ = io.WriteString(w, "simplexy: found 3 sources.\nDid not solve (or no WCS file was written).\n")
+		return exitError(1)
+	})
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.Solved {
+		t.Error("expected unsolved result")
+	}
+}
+
+func TestSolve_ExitStatusClassification(t *testing.T) {
+	const didNotSolve = "simplexy: found 412 sources.\nDid not solve (or no WCS file was written).\n"
+	tests := []struct {
+		name       string
+		exec       bool   // Exec mode rather than docker run
+		runErr     error  // Returned by the solve command
+		output     string // Written by the solve command
+		solution   bool   // The command writes .wcs and .solved
+		wantSolved bool
+		wantErr    error // nil for a Result
+		wantCode   int   // SolveError.ExitCode
+	}{
+		// The output files decide a solution, whatever the status
+		{name: "exit 0 with solution", solution: true, wantSolved: true},
+		{name: "exit 1 with solution", runErr: exitError(1), solution: true, wantSolved: true},
+
+		// Exit 0 without a solution is a miss, unless the output shows a setup problem
+		{name: "exit 0 without solution"},
+		{name: "exit 0 with setup problem", output: "solve-field: No index files found\n", wantErr: ErrInvalidInput},
+
+		// Non-zero with solve-field's own failure messages is a miss or a setup problem
+		{name: "exit 1 did not solve", runErr: exitError(1), output: didNotSolve},
+		{name: "exit 1 per-index miss", runErr: exitError(1),
+			output: "did not solve (index index-4110.fits, field objects 1-10)\n"},
+		{name: "exit 1 no sources", runErr: exitError(1), output: "simplexy: found 0 sources.\n"},
+		{name: "exit 1 time limit", runErr: exitError(1), output: "CPU time limit reached\n"},
+		{name: "exit 1 setup problem", runErr: exitError(1), output: "Failed to read image file /data/stars.png\n",
+			wantErr: ErrInvalidInput, wantCode: 1},
+
+		// Other non-zero statuses are solver failures
+		{name: "exit 1 unrecognised output", runErr: exitError(1), output: "terminate called after throwing\n",
+			wantErr: ErrDockerFailed, wantCode: 1},
+		{name: "exit 139 crash", runErr: exitError(139), output: "Segmentation fault (core dumped)\n",
+			wantErr: ErrDockerFailed, wantCode: 139},
+
+		// docker's own statuses are Docker failures, even with a miss in the output
+		{name: "exit 125 docker error", runErr: exitError(125), output: "docker: Error response from daemon\n",
+			wantErr: ErrDockerFailed, wantCode: 125},
+		{name: "exit 126 not executable", runErr: exitError(126), output: didNotSolve,
+			wantErr: ErrDockerFailed, wantCode: 126},
+		{name: "exit 127 not found", runErr: exitError(127), output: "exec: \"solve-field\": not found\n",
+			wantErr: ErrDockerFailed, wantCode: 127},
+		{name: "exec mode exit 127", exec: true, runErr: exitError(127), wantErr: ErrDockerFailed, wantCode: 127},
+		{name: "exec mode exit 1 did not solve", exec: true, runErr: exitError(1), output: didNotSolve},
+
+		// A command that never ran has no status
+		{name: "docker missing", runErr: errors.New(`exec: "docker": executable file not found in $PATH`),
+			wantErr: ErrDockerFailed, wantCode: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				_, _ = io.WriteString(w, tt.output)
+				if tt.solution {
+					if err := os.WriteFile(filepath.Join(workDir, "stars.wcs"), fits.EncodeHeader(wcsCards(0.001)), 0644); err != nil {
+						return err
+					}
+					if err := os.WriteFile(filepath.Join(workDir, "stars.solved"), []byte{1}, 0644); err != nil {
+						return err
+					}
+				}
+				return tt.runErr
+			})
+			if tt.exec {
+				if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.UseDockerExec, cfg.ContainerName = true, "solver" }); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Solve failed: %v", err)
+				}
+				if result.Solved != tt.wantSolved {
+					t.Errorf("Solved = %v, want %v", result.Solved, tt.wantSolved)
+				}
+				return
+			}
+			var solveErr *SolveError
+			if !errors.Is(err, tt.wantErr) || !errors.As(err, &solveErr) {
+				t.Fatalf("Solve = %+v, %v; want a *SolveError wrapping %v", result, err, tt.wantErr)
+			}
+			if solveErr.ExitCode != tt.wantCode || solveErr.Output != tt.output || solveErr.Hint == "" {
+				t.Errorf("SolveError = %+v, want exit code %d and the output", solveErr, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestSolve_RotationTolerance(t *testing.T) {
+	// With the image size, wcsCards(0.001) solves at rotation 0° and
+	// wcsCards(-0.001) is the same field flipped to 180°
+	tests := []struct {
+		name      string
+		cd        float64
+		expected  float64
+		tolerance float64
+		wantErr   bool
+		wantFlip  bool
+	}{
+		{"in tolerance", 0.001, 3, 5, false, false},
+		{"in tolerance across 0°", 0.001, 357, 5, false, false},
+		{"negative expected rotation", -0.001, -178, 5, false, false},
+		{"beyond tolerance", 0.001, 20, 5, true, false},
+		{"flipped", -0.001, 0, 10, true, true},
+		{"no tolerance", -0.001, 0, 0, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				cards := append(wcsCards(tt.cd), fits.Card{Key: "IMAGEW", Value: "8"}, fits.Card{Key: "IMAGEH", Value: "8"})
+				if err := os.WriteFile(filepath.Join(workDir, "stars.wcs"), fits.EncodeHeader(cards), 0644); err != nil {
+					return err
+				}
+				return os.WriteFile(filepath.Join(workDir, "stars.solved"), []byte{1}, 0644)
+			})
+			opts := DefaultSolveOptions()
+			opts.ExpectedRotation, opts.RotationTolerance = tt.expected, tt.tolerance
+
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+			if !tt.wantErr {
+				if err != nil || !result.Solved {
+					t.Fatalf("Solve = %+v, %v; want solved", result, err)
+				}
+				return
+			}
+			var solveErr *SolveError
+			if !errors.Is(err, ErrNoSolution) || !errors.As(err, &solveErr) {
+				t.Fatalf("Solve = %+v, %v; want a *SolveError wrapping ErrNoSolution", result, err)
+			}
+			if flipped := strings.Contains(solveErr.Reason, "flipped"); flipped != tt.wantFlip {
+				t.Errorf("Reason = %q, want flipped %v", solveErr.Reason, tt.wantFlip)
+			}
+			if !strings.Contains(solveErr.Reason, "ExpectedRotation") || solveErr.Hint == "" || solveErr.ExitCode != 0 {
+				t.Errorf("SolveError = %+v, want the rotations, a hint and exit code 0", solveErr)
+			}
+		})
+	}
+}
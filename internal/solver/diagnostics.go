@@ -0,0 +1,313 @@
+package solver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxDiagnosticArtifact is the largest solver output file copied into a
+// diagnostics bundle; larger ones are listed but left out.
+const maxDiagnosticArtifact = 1 << 20
+
+// DiagnosticsOptions controls what DiagnosticsBundle writes.
+type DiagnosticsOptions struct {
+	// IncludeImage adds the image itself, and solve-field's .new copy of
+	// it. Without it the bundle holds no pixel data.
+	// Default: false
+	IncludeImage bool
+
+	// KeepHomePaths leaves the user's home directory in paths. By default
+	// it is replaced with "~" in every text file of the bundle.
+	// Default: false
+	KeepHomePaths bool
+
+	// Extra holds additional text files to add, by name, such as notes or
+	// a capture program's log. They are redacted like the rest.
+	Extra map[string]string
+}
+
+// solveTrace records what a diagnostics solve ran and left behind.
+type solveTrace struct {
+	mu        sync.Mutex
+	ws        *workspace
+	commands  []tracedCommand
+	artifacts map[string][]byte
+	skipped   []string
+}
+
+// tracedCommand is one command run during a diagnostics solve.
+type tracedCommand struct {
+	argv   []string
+	output string
+	err    error
+}
+
+// DiagnosticsBundle solves imagePath with verbose output and writes a zip
+// archive for support requests to w. It contains:
+//
+//	system.json        OS/arch, Go, docker and solve-field versions
+//	config.json        the client configuration, hooks and interfaces by type
+//	options.json       the solve options as run
+//	commands.txt       every command run, with its output
+//	solver-output.txt  solve-field's output
+//	result.json        the result or error of the solve
+//	indexes.txt        the index files with their sizes
+//	artifacts/         solver output files up to 1 MiB (.wcs, .corr, .axy...)
+//
+// The solve runs without PostSolveHooks, Metrics or OutputDir. The image's
+// pixels are only included with diag.IncludeImage. A solve
+// that fails or finds no solution is recorded in the bundle rather than
+// returned; the error is only for a missing image or a failed write. diag
+// may be nil for the defaults.
+func (c *Client) DiagnosticsBundle(ctx context.Context, imagePath string, opts *SolveOptions, w io.Writer, diag *DiagnosticsOptions) error {
+	if diag == nil {
+		diag = &DiagnosticsOptions{}
+	}
+	if opts == nil {
+		opts = DefaultSolveOptions()
+	}
+	if _, err := os.Stat(imagePath); err != nil {
+		return fmt.Errorf("%w: image file does not exist: %s", ErrInvalidInput, imagePath)
+	}
+	snap := c.snapshot()
+	trace := &solveTrace{artifacts: make(map[string][]byte)}
+	// Support solves have no side effects: no hooks, metrics or copies
+	cfg := snap.config.clone()
+	cfg.PostSolveHooks, cfg.Metrics = nil, nil
+	dc := &Client{config: cfg, statfs: snap.statfs, probes: snap.probes, trace: trace}
+	dc.run = trace.runner(snap.run, imagePath, diag.IncludeImage)
+
+	runOpts := *opts
+	runOpts.Verbose = true
+	runOpts.KeepTempFiles = false
+	runOpts.OutputDir = ""
+	systemInfo := dc.systemInfo(ctx)
+	result, solveErr := dc.solve(ctx, imagePath, &runOpts)
+
+	redact := func(s string) string { return s }
+	if home, err := os.UserHomeDir(); err == nil && home != "" && home != "/" && !diag.KeepHomePaths {
+		redact = func(s string) string { return strings.ReplaceAll(s, home, "~") }
+	}
+
+	zw := zip.NewWriter(w)
+	add := func(name string, data []byte) error {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}
+	addText := func(name, text string) error {
+		return add(name, []byte(redact(text)))
+	}
+	addJSON := func(name string, v any) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		return addText(name, string(data)+"\n")
+	}
+
+	optionsJSON, err := json.MarshalIndent(&runOpts, "", "  ")
+	if err != nil {
+		return err
+	}
+	var commands, solverOutput strings.Builder
+	for _, cmd := range trace.commands {
+		fmt.Fprintf(&commands, "$ %s\n%s", strings.Join(cmd.argv, " "), cmd.output)
+		if cmd.err != nil {
+			fmt.Fprintf(&commands, "[exit: %v]\n", cmd.err)
+		}
+		commands.WriteString("\n")
+		if slices.Contains(cmd.argv, "solve-field") {
+			solverOutput.WriteString(cmd.output)
+		}
+	}
+	outcome := map[string]any{"result": result}
+	if solveErr != nil {
+		outcome = map[string]any{"error": solveErr.Error()}
+	}
+
+	files := []struct {
+		name  string
+		write func() error
+	}{
+		{"system.json", func() error { return addJSON("system.json", systemInfo) }},
+		{"config.json", func() error { return addJSON("config.json", sanitizedConfig(snap.config)) }},
+		{"options.json", func() error { return addText("options.json", string(optionsJSON)+"\n") }},
+		{"commands.txt", func() error { return addText("commands.txt", commands.String()) }},
+		{"solver-output.txt", func() error { return addText("solver-output.txt", solverOutput.String()) }},
+		{"result.json", func() error { return addJSON("result.json", outcome) }},
+		{"indexes.txt", func() error { return addText("indexes.txt", indexListing(snap.config.indexPaths())) }},
+	}
+	for _, f := range files {
+		if err := f.write(); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(trace.artifacts)) {
+		if err := add("artifacts/"+name, trace.artifacts[name]); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	if len(trace.skipped) > 0 {
+		if err := addText("artifacts/SKIPPED.txt", strings.Join(trace.skipped, "\n")+"\n"); err != nil {
+			return err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(diag.Extra)) {
+		if err := addText(name, diag.Extra[name]); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	if diag.IncludeImage {
+		data, err := os.ReadFile(imagePath)
+		if err != nil {
+			return fmt.Errorf("failed to read image: %w", err)
+		}
+		if err := add("image/"+filepath.Base(imagePath), data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// runner wraps run to record each command and, after each, the solve's
+// output files, which are gone once solve returns.
+func (t *solveTrace) runner(run commandRunner, imagePath string, includeImage bool) commandRunner {
+	staged := decompressedName(filepath.Base(imagePath))
+	images := []string{filepath.Base(imagePath), staged, staged + ".fz"}
+	return func(ctx context.Context, name string, args []string, w io.Writer) error {
+		var output bytes.Buffer
+		err := run(ctx, name, args, io.MultiWriter(w, &output))
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.commands = append(t.commands, tracedCommand{argv: append([]string{name}, args...), output: output.String(), err: err})
+		if t.ws == nil {
+			return err
+		}
+		entries, _ := os.ReadDir(t.ws.dir)
+		for _, e := range entries {
+			file, ok := strings.CutPrefix(e.Name(), t.ws.prefix)
+			if !ok || e.IsDir() {
+				continue
+			}
+			if !includeImage && (slices.Contains(images, file) || strings.HasSuffix(file, string(OutputNew)) ||
+				strings.HasSuffix(file, cropSuffix)) {
+				continue
+			}
+			info, infoErr := e.Info()
+			if infoErr != nil {
+				continue
+			}
+			if info.Size() > maxDiagnosticArtifact {
+				t.skipped = append(t.skipped, fmt.Sprintf("%s (%d bytes)", file, info.Size()))
+				continue
+			}
+			if data, readErr := os.ReadFile(filepath.Join(t.ws.dir, e.Name())); readErr == nil {
+				t.artifacts[file] = data
+			}
+		}
+		return err
+	}
+}
+
+// systemInfo reports the host and the docker and solve-field versions.
+func (c *Client) systemInfo(ctx context.Context) map[string]string {
+	info := map[string]string{
+		"os":           runtime.GOOS,
+		"arch":         runtime.GOARCH,
+		"go":           runtime.Version(),
+		"docker_image": c.config.DockerImage,
+	}
+	if c.config.UseDockerExec {
+		info["docker_image"] = "exec:" + c.config.ContainerName
+	}
+
+	var docker strings.Builder
+	if err := c.run(ctx, "docker", []string{"version", "--format", "{{.Server.Version}}"}, &docker); err != nil {
+		info["docker"] = fmt.Sprintf("unavailable: %v", err)
+	} else {
+		info["docker"] = strings.TrimSpace(docker.String())
+	}
+
+	dir, err := os.MkdirTemp(c.config.TempDir, "astrometry-diag-")
+	if err != nil {
+		return info
+	}
+	defer func() {
+		_ = os.RemoveAll(dir) //nolint:errcheck // Best effort cleanup
+	}()
+	var solveField strings.Builder
+	if absIndexPaths, absErr := c.absIndexPaths(); absErr == nil {
+		if err := c.runDocker(ctx, dir, absIndexPaths, []string{"solve-field", "--version"}, &solveField); err != nil {
+			info["solve_field"] = fmt.Sprintf("unavailable: %v", err)
+		} else {
+			info["solve_field"] = strings.TrimSpace(solveField.String())
+		}
+	}
+	return info
+}
+
+// sanitizedConfig returns the config as JSON-friendly values, with
+// interfaces and hooks given by type.
+func sanitizedConfig(cfg *ClientConfig) map[string]any {
+	out := make(map[string]any)
+	v := reflect.ValueOf(*cfg)
+	for i := 0; i < v.NumField(); i++ {
+		field, value := v.Type().Field(i), v.Field(i)
+		switch {
+		case value.Kind() == reflect.Interface:
+			if value.IsNil() {
+				out[field.Name] = nil
+			} else {
+				out[field.Name] = fmt.Sprintf("%T", value.Interface())
+			}
+		case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Interface:
+			types := make([]string, value.Len())
+			for j := range types {
+				types[j] = fmt.Sprintf("%T", value.Index(j).Interface())
+			}
+			out[field.Name] = types
+		case value.Kind() == reflect.Int64 && value.Type() == reflect.TypeOf(time.Duration(0)):
+			out[field.Name] = time.Duration(value.Int()).String()
+		default:
+			out[field.Name] = value.Interface()
+		}
+	}
+	return out
+}
+
+// indexListing lists the files in each of indexPaths with their sizes.
+func indexListing(indexPaths []string) string {
+	var b strings.Builder
+	for _, indexPath := range indexPaths {
+		entries, err := os.ReadDir(indexPath)
+		if err != nil {
+			fmt.Fprintf(&b, "%s: %v\n", indexPath, err)
+			continue
+		}
+		fmt.Fprintf(&b, "%s\n", indexPath)
+		for _, e := range entries {
+			if info, err := e.Info(); err == nil && !e.IsDir() {
+				fmt.Fprintf(&b, "%12d  %s\n", info.Size(), e.Name())
+			}
+		}
+	}
+	return b.String()
+}
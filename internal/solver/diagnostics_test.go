@@ -0,0 +1,159 @@
+package solver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// readBundle returns the files in a diagnostics bundle by name.
+func readBundle(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("bundle is not a zip: %v", err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", f.Name, err)
+		}
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func TestDiagnosticsBundle(t *testing.T) {
+	hookRan := false
+	client := newTestClient(t, func(dir string, args []string, w io.Writer) error {
+		switch {
+		case slices.Contains(args, "version"):
+			fmt.Fprintln(w, "27.1.0")
+		case slices.Contains(args, "--version"):
+			fmt.Fprintln(w, "0.97")
+		case slices.Contains(args, "solve-field"):
+			fmt.Fprintf(w, "Reading %s\nField 1: solved with index index-4107.fits.\n", dir)
+			files := map[string][]byte{
+				"stars.wcs":    fits.EncodeHeader(wcsCards(0.001)),
+				"stars.solved": {1},
+				"stars.corr":   []byte("corr"),
+				"stars.new":    []byte("pixels"),
+			}
+			for name, data := range files {
+				if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	client.config.PostSolveHooks = []Hook{HookFunc(func(context.Context, string, *Result) error {
+		hookRan = true
+		return nil
+	})}
+	if err := os.WriteFile(filepath.Join(client.config.IndexPath, "index-4107.fits"), make([]byte, 2880), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Every test directory is under the home directory for redaction
+	home := filepath.Dir(filepath.Dir(client.config.TempDir))
+	t.Setenv("HOME", home)
+	imagePath := writeTestPNG(t, 32, 32)
+
+	var buf bytes.Buffer
+	if err := client.DiagnosticsBundle(context.Background(), imagePath, nil, &buf, nil); err != nil {
+		t.Fatalf("DiagnosticsBundle failed: %v", err)
+	}
+	files := readBundle(t, buf.Bytes())
+
+	for _, name := range []string{"system.json", "config.json", "options.json", "commands.txt",
+		"solver-output.txt", "result.json", "indexes.txt", "artifacts/stars.wcs", "artifacts/stars.corr"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("bundle has no %s", name)
+		}
+	}
+	for name := range files {
+		if strings.HasPrefix(name, "image/") || name == "artifacts/stars.png" || name == "artifacts/stars.new" {
+			t.Errorf("bundle includes image data %s without IncludeImage", name)
+		}
+		if strings.Contains(files[name], home) && !strings.HasPrefix(name, "artifacts/") {
+			t.Errorf("%s contains the home directory %s", name, home)
+		}
+	}
+	if !strings.Contains(files["system.json"], `"docker": "27.1.0"`) || !strings.Contains(files["system.json"], `"solve_field": "0.97"`) {
+		t.Errorf("system.json missing versions:\n%s", files["system.json"])
+	}
+	if !strings.Contains(files["solver-output.txt"], "Reading ~/") {
+		t.Errorf("solver output not redacted:\n%s", files["solver-output.txt"])
+	}
+	if !strings.Contains(files["commands.txt"], "solve-field") || strings.Contains(files["commands.txt"], "--no-verify") {
+		t.Errorf("commands.txt missing the verbose solve-field argv:\n%s", files["commands.txt"])
+	}
+	if !strings.Contains(files["indexes.txt"], "2880  index-4107.fits") {
+		t.Errorf("indexes.txt missing index size:\n%s", files["indexes.txt"])
+	}
+	if !strings.Contains(files["result.json"], `"Solved": true`) {
+		t.Errorf("result.json missing solved result:\n%s", files["result.json"])
+	}
+	if !strings.Contains(files["config.json"], "HookFunc") {
+		t.Errorf("config.json should list hooks by type:\n%s", files["config.json"])
+	}
+	if hookRan {
+		t.Error("post-solve hook ran during a diagnostics solve")
+	}
+
+	buf.Reset()
+	diag := &DiagnosticsOptions{IncludeImage: true, KeepHomePaths: true, Extra: map[string]string{"notes.txt": "seen at dusk"}}
+	if err := client.DiagnosticsBundle(context.Background(), imagePath, nil, &buf, diag); err != nil {
+		t.Fatalf("DiagnosticsBundle failed: %v", err)
+	}
+	files = readBundle(t, buf.Bytes())
+	for _, name := range []string{"image/stars.png", "artifacts/stars.new", "notes.txt"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("bundle has no %s with IncludeImage", name)
+		}
+	}
+	if !strings.Contains(files["solver-output.txt"], "Reading "+home) {
+		t.Errorf("KeepHomePaths should keep the home directory:\n%s", files["solver-output.txt"])
+	}
+}
+
+func TestDiagnosticsBundle_DockerFailure(t *testing.T) {
+	client := newTestClient(t, func(dir string, args []string, w io.Writer) error {
+		if slices.Contains(args, "solve-field") {
+			fmt.Fprintln(w, "docker: Error response from daemon")
+			return exitError(125)
+		}
+		return nil
+	})
+	var buf bytes.Buffer
+	if err := client.DiagnosticsBundle(context.Background(), writeTestPNG(t, 32, 32), nil, &buf, nil); err != nil {
+		t.Fatalf("a failed run should be recorded, not returned: %v", err)
+	}
+	files := readBundle(t, buf.Bytes())
+	if !strings.Contains(files["result.json"], "docker failed before solve-field ran") {
+		t.Errorf("result.json should hold the docker failure:\n%s", files["result.json"])
+	}
+	if !strings.Contains(files["commands.txt"], "[exit: exit status 125]") {
+		t.Errorf("commands.txt should record the exit error:\n%s", files["commands.txt"])
+	}
+
+	if err := client.DiagnosticsBundle(context.Background(), "missing.png", nil, &buf, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("missing image: got %v, want ErrInvalidInput", err)
+	}
+}
@@ -0,0 +1,142 @@
+package solver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"io"
+	"os"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// TIFF tags holding the image size.
+const (
+	tiffTagImageWidth  = 256
+	tiffTagImageLength = 257
+)
+
+// imageDimensions returns the pixel dimensions of an image without decoding
+// its pixel data. FITS dimensions come from NAXIS1/NAXIS2 in the primary
+// header (ZNAXIS1/ZNAXIS2 for fpack, after decompressing the header of
+// gzip), TIFF and BMP dimensions from their headers, and other formats are
+// read with image.DecodeConfig.
+func imageDimensions(path string) (width, height int, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to open image: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	if isFITS(path) {
+		if fitsCompression(path) == compressionFpack {
+			_, ext, ok := readFirstExtension(bufio.NewReader(file))
+			if !ok {
+				return 0, 0, fmt.Errorf("%w: failed to read fpack image header: %s", ErrInvalidInput, path)
+			}
+			return fitsImageSize(ext, "Z", path)
+		}
+		header, err := fits.ReadHeader(bufio.NewReader(file))
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to read FITS header: %w", err)
+		}
+		return fitsImageSize(header, "", path)
+	}
+	if fitsCompression(path) == compressionGzip {
+		in, err := openFITS(path)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to open compressed image: %w", err)
+		}
+		defer func() {
+			_ = in.Close() //nolint:errcheck // Read-only file, close error not critical
+		}()
+		header, err := fits.ReadHeader(bufio.NewReader(in))
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to read FITS header: %w", err)
+		}
+		return fitsImageSize(header, "", path)
+	}
+
+	switch sniffFormat(path) {
+	case "tiff":
+		return tiffDimensions(file)
+	case "bmp":
+		return bmpDimensions(file)
+	}
+
+	config, _, err := image.DecodeConfig(bufio.NewReader(file))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: unsupported image format: %v", ErrInvalidInput, err)
+	}
+	return config.Width, config.Height, nil
+}
+
+// fitsImageSize returns the size of the 2D image described by header, whose
+// keywords start with prefix ("Z" for tile-compressed images).
+func fitsImageSize(header *fits.Header, prefix, path string) (width, height int, err error) {
+	naxis, _ := header.Int(prefix + "NAXIS")
+	width, _ = header.Int(prefix + "NAXIS1")
+	height, _ = header.Int(prefix + "NAXIS2")
+	if naxis < 2 || width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("%w: FITS primary HDU has no 2D image: %s", ErrInvalidInput, path)
+	}
+	return width, height, nil
+}
+
+// tiffDimensions reads the size of the first image in a TIFF file from its
+// first IFD.
+func tiffDimensions(r io.ReaderAt) (width, height int, err error) {
+	head := make([]byte, 8)
+	if _, err := r.ReadAt(head, 0); err != nil {
+		return 0, 0, fmt.Errorf("%w: truncated TIFF header", ErrInvalidInput)
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if head[0] == 'M' {
+		order = binary.BigEndian
+	}
+	ifd := int64(order.Uint32(head[4:]))
+	count := make([]byte, 2)
+	if _, err := r.ReadAt(count, ifd); err != nil {
+		return 0, 0, fmt.Errorf("%w: truncated TIFF IFD", ErrInvalidInput)
+	}
+	entry := make([]byte, 12)
+	for i := int64(0); i < int64(order.Uint16(count)); i++ {
+		if _, err := r.ReadAt(entry, ifd+2+12*i); err != nil {
+			return 0, 0, fmt.Errorf("%w: truncated TIFF IFD", ErrInvalidInput)
+		}
+		// SHORT (3) values sit in the first two bytes of the value field
+		value := int(order.Uint32(entry[8:]))
+		if order.Uint16(entry[2:]) == 3 {
+			value = int(order.Uint16(entry[8:]))
+		}
+		switch order.Uint16(entry) {
+		case tiffTagImageWidth:
+			width = value
+		case tiffTagImageLength:
+			height = value
+		}
+	}
+	if width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("%w: TIFF has no image size", ErrInvalidInput)
+	}
+	return width, height, nil
+}
+
+// bmpDimensions reads the size of a BMP image from its info header. A
+// negative height marks a top-down bitmap.
+func bmpDimensions(r io.ReaderAt) (width, height int, err error) {
+	head := make([]byte, 26)
+	if _, err := r.ReadAt(head, 0); err != nil {
+		return 0, 0, fmt.Errorf("%w: truncated BMP header", ErrInvalidInput)
+	}
+	width = int(int32(binary.LittleEndian.Uint32(head[18:])))
+	height = int(int32(binary.LittleEndian.Uint32(head[22:])))
+	height = max(height, -height)
+	if width <= 0 || height == 0 {
+		return 0, 0, fmt.Errorf("%w: BMP has no image size", ErrInvalidInput)
+	}
+	return width, height, nil
+}
@@ -0,0 +1,110 @@
+package solver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/jpeg"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+func TestImageDimensions(t *testing.T) {
+	dir := t.TempDir()
+
+	jpegPath := filepath.Join(dir, "frame.jpg")
+	file, err := os.Create(jpegPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := jpeg.Encode(file, image.NewGray(image.Rect(0, 0, 64, 48)), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fitsPath := filepath.Join(dir, "frame.fits")
+	file, err = os.Create(fitsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fits.WriteImage(file, 40, 30, make([]uint8, 40*30)); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Little-endian TIFF with a SHORT width and a LONG height
+	var tiff bytes.Buffer
+	tiff.WriteString("II*\x00")
+	for _, v := range []any{uint32(8), uint16(2),
+		uint16(256), uint16(3), uint32(1), uint32(50),
+		uint16(257), uint16(4), uint32(1), uint32(20)} {
+		_ = binary.Write(&tiff, binary.LittleEndian, v)
+	}
+	tiffPath := filepath.Join(dir, "frame.tif")
+	if err := os.WriteFile(tiffPath, tiff.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Top-down BMP, with a negative height
+	bmp := make([]byte, 26)
+	copy(bmp, "BM")
+	binary.LittleEndian.PutUint32(bmp[18:], 70)
+	binary.LittleEndian.PutUint32(bmp[22:], uint32(0xffffffff-35+1))
+	bmpPath := filepath.Join(dir, "frame.bmp")
+	if err := os.WriteFile(bmpPath, bmp, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantWidth  int
+		wantHeight int
+	}{
+		{"JPEG", jpegPath, 64, 48},
+		{"PNG", writeTestPNG(t, 32, 16), 32, 16},
+		{"FITS", fitsPath, 40, 30},
+		{"gzip FITS", writeGzip(t, fitsPath, "frame.fits.gz"), 40, 30},
+		{"fpack FITS", writeFpackFITS(t), 100, 80},
+		{"TIFF", tiffPath, 50, 20},
+		{"BMP", bmpPath, 70, 35},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h, err := imageDimensions(tt.path)
+			if err != nil {
+				t.Fatalf("imageDimensions failed: %v", err)
+			}
+			if w != tt.wantWidth || h != tt.wantHeight {
+				t.Errorf("dimensions = %dx%d, want %dx%d", w, h, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestImageDimensions_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte("not an image"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := imageDimensions(path); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+
+	headerOnly := filepath.Join(t.TempDir(), "table.fits")
+	cards := []fits.Card{{Key: "SIMPLE", Value: "T"}, {Key: "BITPIX", Value: "8"}, {Key: "NAXIS", Value: "0"}}
+	if err := os.WriteFile(headerOnly, fits.EncodeHeader(cards), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := imageDimensions(headerOnly); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput for FITS without image, got %v", err)
+	}
+}
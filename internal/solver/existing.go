@@ -0,0 +1,90 @@
+package solver
+
+import (
+	"bufio"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// existingSolution returns a Result built from a WCS the image already
+// carries: the primary header of a FITS image, or a sibling .wcs file next
+// to any other image. It returns nil when there is none or the WCS fails
+// hasValidWCS.
+func existingSolution(imagePath string) *Result {
+	var header map[string]string
+	if isFITS(imagePath) || fitsCompression(imagePath) == compressionGzip {
+		header = readPrimaryHeader(imagePath)
+	} else {
+		sidecar := strings.TrimSuffix(imagePath, filepath.Ext(imagePath)) + ".wcs"
+		header = readPrimaryHeader(sidecar)
+	}
+	if header == nil || !hasValidWCS(header) {
+		return nil
+	}
+
+	// Sidecars from other tools may omit IMAGEW/IMAGEH (and, header-only,
+	// have no NAXIS1/NAXIS2 either); the image has them
+	var width, height int
+	if headerDimension(header, "IMAGEW", "NAXIS1") == 0 || headerDimension(header, "IMAGEH", "NAXIS2") == 0 {
+		width, height, _ = imageDimensions(imagePath)
+	}
+	result, err := ResultFromWCSHeader(header, width, height)
+	if err != nil {
+		return nil
+	}
+	result.FromExisting = true
+	return result
+}
+
+// readPrimaryHeader returns the cards of the first header in path, or nil
+// if it cannot be read. A gzip-compressed file's header is read through
+// the decompressor.
+func readPrimaryHeader(path string) map[string]string {
+	file, err := openFITS(path)
+	if err != nil {
+		return nil
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	h, err := fits.ReadHeader(bufio.NewReader(file))
+	if err != nil {
+		return nil
+	}
+	return h.Map()
+}
+
+// hasValidWCS reports whether header holds a usable celestial WCS: RA/Dec
+// CTYPEs, finite CRVAL and CRPIX values with a Dec in range, and a CD
+// matrix with a non-zero determinant. Capture software often writes
+// placeholder headers (pointing coordinates in CRVAL, CD all zeros) before
+// any solve, and those must not count.
+func hasValidWCS(header map[string]string) bool {
+	if !strings.HasPrefix(header["CTYPE1"], "RA") || !strings.HasPrefix(header["CTYPE2"], "DEC") {
+		return false
+	}
+
+	values := make(map[string]float64)
+	for _, key := range []string{"CRVAL1", "CRVAL2", "CRPIX1", "CRPIX2", "CD1_1", "CD1_2", "CD2_1", "CD2_2"} {
+		val, ok := header[key]
+		if !ok {
+			return false
+		}
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+		values[key] = v
+	}
+
+	if math.Abs(values["CRVAL2"]) > 90 {
+		return false
+	}
+	det := values["CD1_1"]*values["CD2_2"] - values["CD1_2"]*values["CD2_1"]
+	return det != 0
+}
@@ -0,0 +1,113 @@
+package solver
+
+import (
+	"context"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// wcsCards returns WCS header cards centred on (83.8, -5.4) at 3.6"/px for
+// an 8x8 image, with the given CD diagonal.
+func wcsCards(cd float64) []fits.Card {
+	return []fits.Card{
+		{Key: "CTYPE1", Value: fits.Quote("RA---TAN")}, {Key: "CTYPE2", Value: fits.Quote("DEC--TAN")},
+		{Key: "CRVAL1", Value: "83.8"}, {Key: "CRVAL2", Value: "-5.4"},
+		{Key: "CRPIX1", Value: "4"}, {Key: "CRPIX2", Value: "4"},
+		{Key: "CD1_1", Value: strconv.FormatFloat(-cd, 'g', -1, 64)}, {Key: "CD1_2", Value: "0"},
+		{Key: "CD2_1", Value: "0"}, {Key: "CD2_2", Value: strconv.FormatFloat(cd, 'g', -1, 64)},
+	}
+}
+
+// writeSolvedFITS writes an 8x8 FITS image whose primary header carries cards.
+func writeSolvedFITS(t *testing.T, cards []fits.Card) string {
+	t.Helper()
+	header := append([]fits.Card{
+		{Key: "SIMPLE", Value: "T"}, {Key: "BITPIX", Value: "8"},
+		{Key: "NAXIS", Value: "2"}, {Key: "NAXIS1", Value: "8"}, {Key: "NAXIS2", Value: "8"},
+	}, cards...)
+	data := append(fits.EncodeHeader(header), make([]byte, fits.BlockSize)...)
+	path := filepath.Join(t.TempDir(), "stars.fits")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSolve_SkipIfSolved(t *testing.T) {
+	sidecarPNG := writeTestPNG(t, 8, 8)
+	sidecar := fits.EncodeHeader(wcsCards(0.001))
+	if err := os.WriteFile(filepath.Join(filepath.Dir(sidecarPNG), "stars.wcs"), sidecar, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		imagePath string
+		force     bool
+		wantSkip  bool
+	}{
+		{"solved FITS", writeSolvedFITS(t, wcsCards(0.001)), false, true},
+		{"placeholder WCS", writeSolvedFITS(t, wcsCards(0)), false, false},
+		{"no WCS", writeSolvedFITS(t, nil), false, false},
+		{"sidecar", sidecarPNG, false, true},
+		{"no sidecar", writeTestPNG(t, 8, 8), false, false},
+		{"force", writeSolvedFITS(t, wcsCards(0.001)), true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ran := false
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				ran = true
+				return nil
+			})
+
+			opts := DefaultSolveOptions()
+			opts.SkipIfSolved = true
+			opts.Force = tt.force
+			result, err := client.Solve(context.Background(), tt.imagePath, opts)
+			if err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+
+			if ran == tt.wantSkip {
+				t.Errorf("solve-field ran = %v, want %v", ran, !tt.wantSkip)
+			}
+			if result.FromExisting != tt.wantSkip {
+				t.Errorf("FromExisting = %v, want %v", result.FromExisting, tt.wantSkip)
+			}
+			if !tt.wantSkip {
+				return
+			}
+			if !result.Solved || math.Abs(result.PixelScale-3.6) > 1e-9 {
+				t.Errorf("Solved = %v, PixelScale = %v; want true, 3.6", result.Solved, result.PixelScale)
+			}
+			if result.ImageWidth != 8 || result.ImageHeight != 8 {
+				t.Errorf("image size = %dx%d, want 8x8", result.ImageWidth, result.ImageHeight)
+			}
+			if math.Abs(result.Dec+5.4) > 0.01 {
+				t.Errorf("Dec = %v, want about -5.4", result.Dec)
+			}
+		})
+	}
+}
+
+func TestSolve_SkipIfSolvedDisabled(t *testing.T) {
+	ran := false
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		ran = true
+		return nil
+	})
+	if _, err := client.Solve(context.Background(), writeSolvedFITS(t, wcsCards(0.001)), nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if !ran {
+		t.Error("solve-field should run when SkipIfSolved is not set")
+	}
+}
@@ -0,0 +1,263 @@
+package solver
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// wcsKeyOrder is the order the standard WCS keywords are written in.
+// Any other keywords in WCSHeader (SIP terms, comments) follow sorted by key.
+var wcsKeyOrder = []string{
+	"SIMPLE", "BITPIX", "NAXIS", "NAXIS1", "NAXIS2", "EXTEND", "WCSAXES",
+	"CTYPE1", "CTYPE2", "EQUINOX", "LONPOLE", "LATPOLE",
+	"CRVAL1", "CRVAL2", "CRPIX1", "CRPIX2", "CUNIT1", "CUNIT2",
+	"CD1_1", "CD1_2", "CD2_1", "CD2_2", "IMAGEW", "IMAGEH",
+}
+
+// FITSCard is one card of a FITS header. Value is the value field as
+// written: numbers and logicals (T or F) bare, and strings in single
+// quotes, such as 'RA---TAN'.
+type FITSCard struct {
+	Key     string
+	Value   string
+	Comment string
+}
+
+// ToFITSHeader returns the solution's WCS header as the cards WriteWCS
+// writes: the standard WCS keywords in canonical order, then any others
+// (SIP terms and the like) sorted by key. The cards can be edited, for
+// example to add OBJECT or DATE-OBS, and written with WriteFITSHeader. It
+// returns nil for a result with no WCS solution.
+//
+// The header is rebuilt from WCSHeader when it holds a solve-field solution.
+// Otherwise a TAN header is synthesized from RA, Dec, PixelScale, Rotation
+// and the image dimensions, as a dataless primary header (SIMPLE, BITPIX 8,
+// NAXIS 0).
+func (r *Result) ToFITSHeader() []FITSCard {
+	header := r.wcsHeader()
+	if header == nil {
+		return nil
+	}
+
+	var cards []FITSCard
+	seen := make(map[string]bool, len(header))
+	add := func(key string) {
+		if val, ok := header[key]; ok && !seen[key] {
+			seen[key] = true
+			cards = append(cards, FITSCard{Key: key, Value: fitsValue(val)})
+		}
+	}
+
+	for _, key := range wcsKeyOrder {
+		add(key)
+	}
+	rest := make([]string, 0, len(header))
+	for key := range header {
+		if !seen[key] && key != "END" && len(key) <= 8 {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	for _, key := range rest {
+		add(key)
+	}
+	return cards
+}
+
+// WriteFITSHeader writes cards as a FITS header, followed by END and padded
+// to a 2880-byte block. It returns ErrInvalidInput for a card whose key is
+// empty, END, or longer than 8 characters.
+func WriteFITSHeader(w io.Writer, cards []FITSCard) error {
+	encoded := make([]fits.Card, len(cards))
+	for i, c := range cards {
+		if c.Key == "" || c.Key == "END" || len(c.Key) > 8 {
+			return fmt.Errorf("%w: invalid FITS keyword %q", ErrInvalidInput, c.Key)
+		}
+		encoded[i] = fits.Card{Key: c.Key, Value: c.Value, Comment: c.Comment}
+	}
+	_, err := w.Write(fits.EncodeHeader(encoded))
+	return err
+}
+
+// WriteWCS writes the solution as a FITS WCS header, padded to a 2880-byte
+// block, suitable for loading into DS9 or Aladin. It writes the cards of
+// ToFITSHeader.
+func (r *Result) WriteWCS(w io.Writer) error {
+	cards := r.ToFITSHeader()
+	if cards == nil {
+		return fmt.Errorf("%w: result has no WCS solution", ErrInvalidInput)
+	}
+	return WriteFITSHeader(w, cards)
+}
+
+// WriteDS9Region writes the field footprint polygon and center point as a
+// DS9 region file in fk5 coordinates. A solution in a projection
+// PixelToSky does not implement returns ErrUnsupportedProjection.
+func (r *Result) WriteDS9Region(w io.Writer) error {
+	if _, err := wcsProjection(r.wcsHeader()); err != nil {
+		return err
+	}
+	corners, ok := r.CornerCoords()
+	if !ok {
+		return fmt.Errorf("%w: result has no WCS solution", ErrInvalidInput)
+	}
+
+	coords := make([]string, 0, len(corners)*2)
+	for _, c := range corners {
+		coords = append(coords, strconv.FormatFloat(c[0], 'f', 6, 64), strconv.FormatFloat(c[1], 'f', 6, 64))
+	}
+
+	_, err := fmt.Fprintf(w, "# Region file format: DS9 version 4.1\n"+
+		"global color=green width=1\n"+
+		"fk5\n"+
+		"polygon(%s) # text={field}\n"+
+		"point(%.6f,%.6f) # point=cross text={center}\n",
+		strings.Join(coords, ","), r.RA, r.Dec)
+	return err
+}
+
+// CornerCoords returns the RA/Dec in degrees of the image corners in pixel
+// order (0,0), (W,0), (W,H), (0,H), projected through the full WCS. ok is
+// false when the result has no WCS solution or image dimensions, or its
+// projection is not one PixelToSky implements.
+func (r *Result) CornerCoords() (corners [4][2]float64, ok bool) {
+	wcs, width, height, ok := r.skyWCS()
+	if !ok {
+		return corners, false
+	}
+	for i, p := range [4][2]float64{{0, 0}, {width, 0}, {width, height}, {0, height}} {
+		corners[i][0], corners[i][1] = wcs.pixelToSky(p[0], p[1])
+	}
+	return corners, true
+}
+
+// skyWCS returns the WCS and image dimensions of the solution. ok is
+// false for a projection skyWCS does not implement, rather than
+// approximating it as TAN.
+func (r *Result) skyWCS() (w skyWCS, width, height float64, ok bool) {
+	header := r.wcsHeader()
+	if header == nil {
+		return skyWCS{}, 0, 0, false
+	}
+	projection, err := wcsProjection(header)
+	if err != nil {
+		return skyWCS{}, 0, 0, false
+	}
+	get := func(key string) float64 {
+		v, _ := strconv.ParseFloat(header[key], 64)
+		return v
+	}
+	width = headerDimension(header, "IMAGEW", "NAXIS1")
+	height = headerDimension(header, "IMAGEH", "NAXIS2")
+	w = skyWCS{
+		crval1: get("CRVAL1"), crval2: get("CRVAL2"),
+		crpix1: get("CRPIX1"), crpix2: get("CRPIX2"),
+	}
+	w.cd11, w.cd12, w.cd21, w.cd22 = cdMatrix(header)
+	w.sip = parseSIP(header)
+	w.projection = projection
+	return w, width, height, width > 0 && height > 0
+}
+
+// parseSIP reads SIP distortion coefficients from a WCS header, returning
+// nil when the header has none.
+func parseSIP(header map[string]string) *sipDistortion {
+	aOrder, _ := strconv.Atoi(header["A_ORDER"])
+	bOrder, _ := strconv.Atoi(header["B_ORDER"])
+	if aOrder <= 0 && bOrder <= 0 {
+		return nil
+	}
+
+	sip := &sipDistortion{a: map[[2]int]float64{}, b: map[[2]int]float64{}}
+	read := func(prefix string, order int, coeffs map[[2]int]float64) {
+		for p := 0; p <= order; p++ {
+			for q := 0; p+q <= order; q++ {
+				val, ok := header[fmt.Sprintf("%s_%d_%d", prefix, p, q)]
+				if !ok {
+					continue
+				}
+				if c, err := strconv.ParseFloat(val, 64); err == nil && c != 0 {
+					coeffs[[2]int{p, q}] = c
+				}
+			}
+		}
+	}
+	read("A", aOrder, sip.a)
+	read("B", bOrder, sip.b)
+	return sip
+}
+
+// AccurateFieldSize measures the field width and height in degrees as the
+// great-circle distance between opposite edge midpoints, projected through
+// the full WCS including SIP distortion terms when present. Unlike
+// pixels × PixelScale it accounts for projection foreshortening and lens
+// distortion, which matter for wide fields. ok is false when the result has
+// no WCS solution or image dimensions, or an unsupported projection.
+func (r *Result) AccurateFieldSize() (width, height float64, ok bool) {
+	wcs, w, h, ok := r.skyWCS()
+	if !ok {
+		return 0, 0, false
+	}
+	return wcs.separation(0, h/2, w, h/2), wcs.separation(w/2, 0, w/2, h), true
+}
+
+// wcsHeader returns WCSHeader if it holds a solution, or a TAN header
+// synthesized from the result fields. It returns nil for unsolved results.
+func (r *Result) wcsHeader() map[string]string {
+	if _, ok := r.WCSHeader["CRVAL1"]; ok {
+		return r.WCSHeader
+	}
+	if !r.Solved || r.PixelScale <= 0 {
+		return nil
+	}
+
+	// Invert ParseWCSFile: Rotation = 180 - atan2(CD1_2, CD1_1), with the
+	// reference pixel at the image center and normal (east-left) parity.
+	// SIMPLE, BITPIX and NAXIS make it a valid dataless primary header.
+	scale := r.PixelScale / 3600
+	phi := (180 - r.Rotation) * deg2rad
+	format := func(v float64) string { return strconv.FormatFloat(v, 'E', 12, 64) }
+
+	header := map[string]string{
+		"SIMPLE":  "T",
+		"BITPIX":  "8",
+		"NAXIS":   "0",
+		"WCSAXES": "2",
+		"CTYPE1":  "RA---TAN",
+		"CTYPE2":  "DEC--TAN",
+		"EQUINOX": "2000.0",
+		"CUNIT1":  "deg",
+		"CUNIT2":  "deg",
+		"CRVAL1":  format(r.RA),
+		"CRVAL2":  format(r.Dec),
+		"CRPIX1":  format(float64(r.ImageWidth) / 2),
+		"CRPIX2":  format(float64(r.ImageHeight) / 2),
+		"CD1_1":   format(scale * math.Cos(phi)),
+		"CD1_2":   format(scale * math.Sin(phi)),
+		"CD2_1":   format(scale * math.Sin(phi)),
+		"CD2_2":   format(-scale * math.Cos(phi)),
+	}
+	if r.ImageWidth > 0 && r.ImageHeight > 0 {
+		header["IMAGEW"] = strconv.Itoa(r.ImageWidth)
+		header["IMAGEH"] = strconv.Itoa(r.ImageHeight)
+	}
+	return header
+}
+
+// fitsValue formats a raw header value for output, quoting anything that
+// is not a number or logical.
+func fitsValue(val string) string {
+	if val == "T" || val == "F" {
+		return val
+	}
+	if _, err := strconv.ParseFloat(val, 64); err == nil {
+		return val
+	}
+	return fits.Quote(val)
+}
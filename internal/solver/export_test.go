@@ -0,0 +1,285 @@
+package solver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+func TestWriteWCS_RoundTrip(t *testing.T) {
+	path := writeWCSFile(t, []fits.Card{
+		{Key: "SIMPLE", Value: "T"},
+		{Key: "CTYPE1", Value: fits.Quote("RA---TAN-SIP")},
+		{Key: "CTYPE2", Value: fits.Quote("DEC--TAN-SIP")},
+		{Key: "CRVAL1", Value: "83.8221"},
+		{Key: "CRVAL2", Value: "-5.3911"},
+		{Key: "CRPIX1", Value: "2048.5"},
+		{Key: "CRPIX2", Value: "1365.25"},
+		{Key: "CD1_1", Value: "-1.1E-03"},
+		{Key: "CD1_2", Value: "2.0E-05"},
+		{Key: "CD2_1", Value: "-2.1E-05"},
+		{Key: "CD2_2", Value: "-1.1E-03"},
+		{Key: "IMAGEW", Value: "4096"},
+		{Key: "IMAGEH", Value: "2730"},
+		{Key: "A_ORDER", Value: "2"},
+	})
+	original, err := ParseWCSFile(path)
+	if err != nil {
+		t.Fatalf("ParseWCSFile failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := original.WriteWCS(&buf); err != nil {
+		t.Fatalf("WriteWCS failed: %v", err)
+	}
+	if buf.Len()%fits.BlockSize != 0 {
+		t.Errorf("header length %d is not a multiple of %d", buf.Len(), fits.BlockSize)
+	}
+
+	rewritten := filepath.Join(t.TempDir(), "rewritten.wcs")
+	if err := os.WriteFile(rewritten, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseWCSFile(rewritten)
+	if err != nil {
+		t.Fatalf("ParseWCSFile on rewritten header failed: %v", err)
+	}
+
+	if got.RA != original.RA || got.Dec != original.Dec || got.PixelScale != original.PixelScale ||
+		got.Rotation != original.Rotation || got.FieldWidth != original.FieldWidth {
+		t.Errorf("round trip changed solution: got %+v, want %+v", got, original)
+	}
+	for key, want := range original.WCSHeader {
+		if got.WCSHeader[key] != want {
+			t.Errorf("header %s = %q, want %q", key, got.WCSHeader[key], want)
+		}
+	}
+}
+
+func TestWriteWCS_Synthesized(t *testing.T) {
+	original := &Result{
+		Solved:      true,
+		RA:          210.8,
+		Dec:         54.35,
+		PixelScale:  2.5,
+		Rotation:    37,
+		ImageWidth:  3000,
+		ImageHeight: 2000,
+	}
+
+	var buf bytes.Buffer
+	if err := original.WriteWCS(&buf); err != nil {
+		t.Fatalf("WriteWCS failed: %v", err)
+	}
+	// Viewers need a primary header: SIMPLE, BITPIX and NAXIS come first
+	cards := original.ToFITSHeader()
+	if len(cards) < 3 || cards[0].Key != "SIMPLE" || cards[1].Key != "BITPIX" || cards[2].Key != "NAXIS" {
+		t.Errorf("cards = %+v, want SIMPLE, BITPIX and NAXIS first", cards)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("SIMPLE  =                    T")) {
+		t.Errorf("header starts %q, want the SIMPLE card", buf.Bytes()[:fits.CardSize])
+	}
+	path := filepath.Join(t.TempDir(), "synth.wcs")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseWCSFile(path)
+	if err != nil {
+		t.Fatalf("ParseWCSFile failed: %v", err)
+	}
+
+	if sep := angularSeparation(got.RA, got.Dec, original.RA, original.Dec) * 3600; sep > 1e-6 {
+		t.Errorf("center moved by %.3g arcsec", sep)
+	}
+	if math.Abs(got.PixelScale-original.PixelScale) > 1e-9 {
+		t.Errorf("PixelScale = %f, want %f", got.PixelScale, original.PixelScale)
+	}
+	if math.Abs(got.Rotation-original.Rotation) > 1e-9 {
+		t.Errorf("Rotation = %f, want %f", got.Rotation, original.Rotation)
+	}
+	if got.ImageWidth != original.ImageWidth || got.ImageHeight != original.ImageHeight {
+		t.Errorf("dimensions = %dx%d, want %dx%d", got.ImageWidth, got.ImageHeight, original.ImageWidth, original.ImageHeight)
+	}
+}
+
+func TestWriteWCS_Unsolved(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&Result{}).WriteWCS(&buf); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+	if err := (&Result{}).WriteDS9Region(&buf); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestToFITSHeader(t *testing.T) {
+	solved, err := ParseWCSFile(writeWCSFile(t, []fits.Card{
+		{Key: "SIMPLE", Value: "T"},
+		{Key: "A_ORDER", Value: "2"},
+		{Key: "CTYPE1", Value: fits.Quote("RA---TAN-SIP")},
+		{Key: "CTYPE2", Value: fits.Quote("DEC--TAN-SIP")},
+		{Key: "EQUINOX", Value: "2000.0"},
+		{Key: "CRVAL1", Value: "83.8221"},
+		{Key: "CRVAL2", Value: "-5.3911"},
+		{Key: "CRPIX1", Value: "2048.5"},
+		{Key: "CRPIX2", Value: "1365.25"},
+		{Key: "CUNIT1", Value: fits.Quote("deg")},
+		{Key: "CUNIT2", Value: fits.Quote("deg")},
+		{Key: "CD1_1", Value: "-1.1E-03"},
+		{Key: "CD1_2", Value: "2.0E-05"},
+		{Key: "CD2_1", Value: "-2.1E-05"},
+		{Key: "CD2_2", Value: "-1.1E-03"},
+		{Key: "IMAGEW", Value: "4096"},
+		{Key: "IMAGEH", Value: "2730"},
+	}))
+	if err != nil {
+		t.Fatalf("ParseWCSFile failed: %v", err)
+	}
+	synthesized := &Result{Solved: true, RA: 210.8, Dec: 54.35, PixelScale: 2.5, Rotation: 37,
+		ImageWidth: 3000, ImageHeight: 2000}
+
+	isString := regexp.MustCompile(`^'.*'$`)
+	for _, result := range []*Result{solved, synthesized} {
+		cards := result.ToFITSHeader()
+		values := make(map[string]string, len(cards))
+		var keys []string
+		for _, c := range cards {
+			values[c.Key] = c.Value
+			keys = append(keys, c.Key)
+		}
+
+		// Standard keywords, typed as the WCS standard requires
+		for _, key := range []string{"CTYPE1", "CTYPE2", "CUNIT1", "CUNIT2"} {
+			if !isString.MatchString(values[key]) {
+				t.Errorf("%s = %q, want a quoted string", key, values[key])
+			}
+		}
+		for _, key := range []string{"EQUINOX", "CRVAL1", "CRVAL2", "CRPIX1", "CRPIX2", "CD1_1", "CD1_2", "CD2_1", "CD2_2"} {
+			if _, err := strconv.ParseFloat(values[key], 64); err != nil {
+				t.Errorf("%s = %q, want a number", key, values[key])
+			}
+		}
+		for _, key := range []string{"IMAGEW", "IMAGEH"} {
+			if _, err := strconv.Atoi(values[key]); err != nil {
+				t.Errorf("%s = %q, want an integer", key, values[key])
+			}
+		}
+		if i := slices.Index(keys, "CTYPE1"); i < 0 || i > slices.Index(keys, "CRVAL1") || i > slices.Index(keys, "CD1_1") {
+			t.Errorf("keywords out of canonical order: %q", keys)
+		}
+
+		// WriteWCS writes the same cards
+		var wcs, header bytes.Buffer
+		if err := result.WriteWCS(&wcs); err != nil {
+			t.Fatalf("WriteWCS failed: %v", err)
+		}
+		if err := WriteFITSHeader(&header, cards); err != nil {
+			t.Fatalf("WriteFITSHeader failed: %v", err)
+		}
+		if !bytes.Equal(wcs.Bytes(), header.Bytes()) {
+			t.Error("WriteWCS and WriteFITSHeader(ToFITSHeader()) differ")
+		}
+	}
+	if keys := solved.ToFITSHeader(); keys[len(keys)-1].Key != "A_ORDER" || keys[0].Key != "SIMPLE" {
+		t.Errorf("cards = %+v, want SIMPLE first and the non-standard A_ORDER last", keys)
+	}
+	if cards := (&Result{}).ToFITSHeader(); cards != nil {
+		t.Errorf("unsolved ToFITSHeader = %+v, want nil", cards)
+	}
+}
+
+func TestWriteFITSHeader_AddedCards(t *testing.T) {
+	result := &Result{Solved: true, RA: 83.8, Dec: -5.4, PixelScale: 1.5, ImageWidth: 100, ImageHeight: 80}
+	cards := append(result.ToFITSHeader(),
+		FITSCard{Key: "OBJECT", Value: fits.Quote("M42"), Comment: "Orion Nebula"},
+		FITSCard{Key: "DATE-OBS", Value: fits.Quote("2024-01-15T21:30:00")})
+
+	var buf bytes.Buffer
+	if err := WriteFITSHeader(&buf, cards); err != nil {
+		t.Fatalf("WriteFITSHeader failed: %v", err)
+	}
+	header, err := fits.ReadHeader(&buf)
+	if err != nil {
+		t.Fatalf("ReadHeader failed: %v", err)
+	}
+	if object, _ := header.Get("OBJECT"); object != "M42" {
+		t.Errorf("OBJECT = %q, want M42", object)
+	}
+	if date, _ := header.Get("DATE-OBS"); date != "2024-01-15T21:30:00" {
+		t.Errorf("DATE-OBS = %q", date)
+	}
+	if ra, ok := header.Float("CRVAL1"); !ok || math.Abs(ra-83.8) > 1e-9 {
+		t.Errorf("CRVAL1 = %v, want 83.8", ra)
+	}
+
+	for _, key := range []string{"", "END", "TOOLONGKEY"} {
+		if err := WriteFITSHeader(io.Discard, []FITSCard{{Key: key, Value: "1"}}); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("key %q: error %v, want ErrInvalidInput", key, err)
+		}
+	}
+}
+
+// ds9Line matches the subset of the DS9 region grammar written by
+// WriteDS9Region: comments, global properties, coordinate systems and
+// shapes with numeric arguments and an optional property comment.
+var ds9Line = regexp.MustCompile(`^(#.*|global( \w+=\w+)+|fk5|(polygon|point)\((-?\d+(\.\d+)?)(,-?\d+(\.\d+)?)*\)( # (\w+=(\{[^}]*\}|\w+) ?)+)?)$`)
+
+func TestWriteDS9Region(t *testing.T) {
+	result := &Result{
+		Solved:      true,
+		RA:          83.82,
+		Dec:         -5.39,
+		PixelScale:  4,
+		Rotation:    90,
+		ImageWidth:  6000,
+		ImageHeight: 4000,
+	}
+
+	var buf bytes.Buffer
+	if err := result.WriteDS9Region(&buf); err != nil {
+		t.Fatalf("WriteDS9Region failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if !strings.HasPrefix(lines[0], "# Region file format: DS9") {
+		t.Errorf("missing DS9 header line, got %q", lines[0])
+	}
+	var polygon, point int
+	for _, line := range lines {
+		if !ds9Line.MatchString(line) {
+			t.Errorf("line does not match DS9 region grammar: %q", line)
+		}
+		switch {
+		case strings.HasPrefix(line, "polygon("):
+			polygon++
+			args := strings.Split(line[len("polygon("):strings.Index(line, ")")], ",")
+			if len(args) != 8 {
+				t.Errorf("polygon has %d coordinates, want 8", len(args))
+			}
+		case strings.HasPrefix(line, "point("):
+			point++
+		}
+	}
+	if polygon != 1 || point != 1 {
+		t.Errorf("got %d polygons and %d points, want 1 of each", polygon, point)
+	}
+
+	// Every corner lies half a field diagonal from the center
+	corners, _ := result.CornerCoords()
+	want := math.Hypot(3000, 2000) * 4 / 3600
+	for _, c := range corners {
+		if d := angularSeparation(result.RA, result.Dec, c[0], c[1]); math.Abs(d-want) > 0.01 {
+			t.Errorf("corner (%.4f, %.4f) is %.4f° from center, want ~%.4f°", c[0], c[1], d, want)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package solver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"  // Register GIF decoder for source extraction
+	_ "image/jpeg" // Register JPEG decoder for source extraction
+	_ "image/png"  // Register PNG decoder for source extraction
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// Source is a single star detected by source extraction.
+// Coordinates are in FITS pixel convention as written by image2xy.
+type Source struct {
+	X          float64
+	Y          float64
+	Flux       float64
+	Background float64
+
+	// FWHM is the star's full width at half maximum in pixels, read from
+	// an FWHM or FWHM_IMAGE column when the extractor writes one (image2xy
+	// does not) and measured from the image by MeasureStars. 0 if unknown.
+	FWHM float64
+}
+
+// SourceList holds the sources detected in an image.
+type SourceList struct {
+	Sources     []Source
+	ImageWidth  int
+	ImageHeight int
+}
+
+// ExtractOptions holds parameters for a source extraction operation.
+type ExtractOptions struct {
+	// DownsampleFactor reduces the image resolution by this factor before
+	// detecting sources. Values of 0 or 1 disable downsampling.
+	// Default: 2
+	DownsampleFactor int
+}
+
+// DefaultExtractOptions returns ExtractOptions with sensible defaults.
+func DefaultExtractOptions() *ExtractOptions {
+	return &ExtractOptions{
+		DownsampleFactor: 2,
+	}
+}
+
+// ExtractSources detects stars in an image using astrometry.net's image2xy.
+//
+// image2xy only reads FITS images, so other formats (JPEG, PNG, GIF) are
+// converted to an 8-bit grayscale FITS file in the working directory first.
+func (c *Client) ExtractSources(ctx context.Context, imagePath string, opts *ExtractOptions) (*SourceList, error) {
+	return c.snapshot().extractSources(ctx, imagePath, opts, nil)
+}
+
+// extractSources runs image2xy for ExtractSources. When inspect is set it
+// is called with the staged FITS file and the parsed list before the
+// workspace is removed.
+func (c *Client) extractSources(ctx context.Context, imagePath string, opts *ExtractOptions,
+	inspect func(fitsPath string, list *SourceList) error) (*SourceList, error) {
+	if opts == nil {
+		opts = DefaultExtractOptions()
+	}
+
+	imageInfo, err := os.Stat(imagePath)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: image file does not exist: %s", ErrInvalidInput, imagePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat image: %w", err)
+	}
+
+	absIndexPaths, err := c.absIndexPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	compression := fitsCompression(imagePath)
+	ws, err := c.createWorkspace(imageInfo.Size() + uncompressedSize(imagePath, compression))
+	if err != nil {
+		return nil, err
+	}
+	tempDir := ws.dir
+	defer func() {
+		if removeErr := ws.remove(); removeErr != nil {
+			log.Printf("warning: failed to remove temp directory: %v", removeErr)
+		}
+	}()
+
+	name := filepath.Base(imagePath)
+	if compression != "" {
+		name = decompressedName(name)
+	}
+	baseName := ws.prefix + strings.TrimSuffix(name, filepath.Ext(name))
+	fitsName := baseName + ".fits"
+	if compression != "" {
+		err = c.stageDecompressed(ctx, imagePath, compression, tempDir, fitsName, absIndexPaths)
+	} else {
+		err = stageFITS(imagePath, filepath.Join(tempDir, fitsName), c.config.AllowContainerRead)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	xyName := baseName + ".xy"
+	args := []string{"image2xy", "-O", "-o", c.containerPath(tempDir, xyName)}
+	if opts.DownsampleFactor > 1 {
+		args = append(args, "-d", fmt.Sprintf("%d", opts.DownsampleFactor))
+	}
+	args = append(args, c.containerPath(tempDir, fitsName))
+
+	extractCtx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	output := newBoundedBuffer(c.config.MaxOutputBytes)
+	runErr := c.runDocker(extractCtx, tempDir, absIndexPaths, args, output)
+	if extractCtx.Err() == context.DeadlineExceeded {
+		return nil, ErrTimeout
+	}
+
+	file, err := os.Open(filepath.Join(tempDir, xyName))
+	if err != nil {
+		return nil, fmt.Errorf("%w: image2xy produced no source list (%v)\nOutput: %s", ErrDockerFailed, runErr, output.String())
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	list, err := readSourceList(bufio.NewReader(file))
+	if err != nil {
+		return nil, err
+	}
+	if list.ImageWidth == 0 || list.ImageHeight == 0 {
+		// Older image2xy builds omit IMAGEW/IMAGEH; read them from the input
+		if w, h, dimErr := imageDimensions(filepath.Join(tempDir, fitsName)); dimErr == nil {
+			list.ImageWidth, list.ImageHeight = w, h
+		}
+	}
+	if inspect != nil {
+		if err := inspect(filepath.Join(tempDir, fitsName), list); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// readSourceList parses an image2xy xylist FITS table.
+func readSourceList(r io.Reader) (*SourceList, error) {
+	table, err := fits.ReadTable(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse source list: %w", err)
+	}
+
+	list := &SourceList{Sources: make([]Source, table.Rows)}
+	list.ImageWidth, _ = table.Header.Int("IMAGEW")
+	list.ImageHeight, _ = table.Header.Int("IMAGEH")
+
+	xs, ys := table.Columns["X"], table.Columns["Y"]
+	flux, bg := table.Columns["FLUX"], table.Columns["BACKGROUND"]
+	fwhm, ok := table.Columns["FWHM"]
+	if !ok {
+		fwhm = table.Columns["FWHM_IMAGE"]
+	}
+	for i := range list.Sources {
+		s := &list.Sources[i]
+		if i < len(xs) {
+			s.X = xs[i]
+		}
+		if i < len(ys) {
+			s.Y = ys[i]
+		}
+		if i < len(flux) {
+			s.Flux = flux[i]
+		}
+		if i < len(bg) {
+			s.Background = bg[i]
+		}
+		if i < len(fwhm) {
+			s.FWHM = fwhm[i]
+		}
+	}
+	return list, nil
+}
+
+// stageFITS writes imagePath to dst as a FITS file, copying FITS input
+// unchanged and converting other decodable formats to 8-bit grayscale.
+// Either way dst gets the source's mtime and permissions via stageAttrs.
+func stageFITS(imagePath, dst string, containerRead bool) error {
+	if isFITS(imagePath) {
+		return copyFile(imagePath, dst, containerRead)
+	}
+
+	info, err := os.Stat(imagePath)
+	if err != nil {
+		return fmt.Errorf("failed to stat image: %w", err)
+	}
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return fmt.Errorf("failed to open image: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	img, _, err := image.Decode(bufio.NewReader(file))
+	if err != nil {
+		return fmt.Errorf("%w: unsupported image format: %v", ErrInvalidInput, err)
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	pix := make([]uint8, 0, width*height)
+	if ycc, ok := img.(*image.YCbCr); ok {
+		// Fast path for JPEG: the luma plane is already grayscale
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			off := ycc.YOffset(bounds.Min.X, y)
+			pix = append(pix, ycc.Y[off:off+width]...)
+		}
+	} else {
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				pix = append(pix, color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y)
+			}
+		}
+	}
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, stagedPerm(info.Mode(), containerRead))
+	if err != nil {
+		return fmt.Errorf("failed to create FITS file: %w", err)
+	}
+	w := bufio.NewWriter(out)
+	if err := fits.WriteImage(w, width, height, pix); err != nil {
+		_ = out.Close() //nolint:errcheck // Best effort cleanup on error path
+		return fmt.Errorf("failed to write FITS file: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		_ = out.Close() //nolint:errcheck // Best effort cleanup on error path
+		return fmt.Errorf("failed to write FITS file: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to write FITS file: %w", err)
+	}
+	return stageAttrs(info, dst, containerRead)
+}
+
+// isFITS reports whether the file starts with the FITS SIMPLE card.
+func isFITS(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	magic := make([]byte, 9)
+	if _, err := io.ReadFull(file, magic); err != nil {
+		return false
+	}
+	return string(magic) == "SIMPLE  ="
+}
@@ -0,0 +1,139 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// fakeRunner replaces the Docker runtime in tests. The handler receives the
+// host working directory (resolved from the /data mount) and the tool
+// arguments, and may plant output files or write tool output.
+func fakeRunner(handler func(workDir string, args []string, w io.Writer) error) commandRunner {
+	return func(ctx context.Context, name string, args []string, w io.Writer) error {
+		workDir := ""
+		for i, arg := range args {
+			if arg == "-v" && i+1 < len(args) && strings.HasSuffix(args[i+1], ":/data") {
+				workDir = strings.TrimSuffix(args[i+1], ":/data")
+			}
+		}
+		return handler(workDir, args, w)
+	}
+}
+
+// newTestClient returns a Client with a fake runner and temporary index/temp dirs.
+func newTestClient(t *testing.T, handler func(workDir string, args []string, w io.Writer) error) *Client {
+	t.Helper()
+	client, err := NewClient(&ClientConfig{
+		IndexPath: t.TempDir(),
+		TempDir:   t.TempDir(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	client.run = fakeRunner(handler)
+	return client
+}
+
+// writeTestPNG writes a small grayscale PNG and returns its path.
+func writeTestPNG(t *testing.T, width, height int) string {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	img.SetGray(width/2, height/2, color.Gray{Y: 255})
+	path := filepath.Join(t.TempDir(), "stars.png")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("failed to create test image: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	return path
+}
+
+func TestExtractSources(t *testing.T) {
+	imagePath := writeTestPNG(t, 64, 48)
+
+	var gotArgs []string
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		gotArgs = args
+
+		// The PNG must have been converted to FITS for image2xy
+		if !isFITS(filepath.Join(workDir, "stars.fits")) {
+			t.Error("expected staged FITS input in working directory")
+		}
+
+		out, err := os.Create(filepath.Join(workDir, "stars.xy"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		return fits.WriteTables(out, nil, fits.TableHDU{
+			Cards:   []fits.Card{{Key: "IMAGEW", Value: "64"}, {Key: "IMAGEH", Value: "48"}},
+			Columns: []string{"X", "Y", "FLUX", "BACKGROUND"},
+			Data:    [][]float64{{33, 10}, {25, 40}, {900, 120}, {5, 6}},
+		})
+	})
+
+	list, err := client.ExtractSources(context.Background(), imagePath, nil)
+	if err != nil {
+		t.Fatalf("ExtractSources failed: %v", err)
+	}
+
+	argsStr := strings.Join(gotArgs, " ")
+	if !strings.Contains(argsStr, "image2xy -O -o /data/stars.xy -d 2 /data/stars.fits") {
+		t.Errorf("unexpected image2xy arguments: %s", argsStr)
+	}
+
+	if len(list.Sources) != 2 {
+		t.Fatalf("got %d sources, want 2", len(list.Sources))
+	}
+	if list.ImageWidth != 64 || list.ImageHeight != 48 {
+		t.Errorf("image size = %dx%d, want 64x48", list.ImageWidth, list.ImageHeight)
+	}
+	want := Source{X: 33, Y: 25, Flux: 900, Background: 5}
+	if list.Sources[0] != want {
+		t.Errorf("first source = %+v, want %+v", list.Sources[0], want)
+	}
+}
+
+func TestExtractSources_NoOutput(t *testing.T) {
+	imagePath := writeTestPNG(t, 8, 8)
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		_, _ = io.WriteString(w, "image2xy: simplexy failed")
+		return errors.New("exit status 1")
+	})
+
+	_, err := client.ExtractSources(context.Background(), imagePath, nil)
+	if !errors.Is(err, ErrDockerFailed) {
+		t.Fatalf("expected ErrDockerFailed, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "simplexy failed") {
+		t.Errorf("expected tool output in error, got %v", err)
+	}
+}
+
+func TestExtractSources_UnsupportedFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte("not an image"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		t.Error("runner should not be invoked for unsupported input")
+		return nil
+	})
+
+	if _, err := client.ExtractSources(context.Background(), path, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// Extractor detects stars for Solve in place of solve-field's built-in
+// simplexy, for images it handles badly such as nebulous narrowband frames.
+// Set it as ClientConfig.Extractor.
+//
+// Extract receives the image as staged in the solve workspace, in its
+// original format, and returns the stars in FITS pixel convention: 1-based,
+// with row 1 the first row stored in the file (the top row of a JPEG or
+// PNG). The brightest stars should come first, as solve-field tries them
+// in order. Name identifies the extractor in Result.Extractor.
+type Extractor interface {
+	Extract(ctx context.Context, imagePath string) ([]Source, error)
+	Name() string
+}
+
+// ExtractorSimplexy is the Result.Extractor value when solve-field
+// detected the stars itself.
+const ExtractorSimplexy = "simplexy"
+
+// writeExtractedList runs the configured Extractor on the staged image and
+// writes its sources as an xylist next to the image, returning the list's
+// name and the image size. The xylist shares the image's base name, so
+// solve-field's outputs do too.
+func (c *Client) writeExtractedList(ctx context.Context, imageFilename, tempDir string) (xyName string, width, height int, err error) {
+	imagePath := filepath.Join(tempDir, imageFilename)
+	sources, err := c.config.Extractor.Extract(ctx, imagePath)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("%s extractor failed: %w", c.config.Extractor.Name(), err)
+	}
+	width, height, err = imageDimensions(imagePath)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	xs, ys, flux := make([]float64, len(sources)), make([]float64, len(sources)), make([]float64, len(sources))
+	for i, s := range sources {
+		xs[i], ys[i], flux[i] = s.X, s.Y, s.Flux
+	}
+	xyName = strings.TrimSuffix(imageFilename, filepath.Ext(imageFilename)) + ".xyls"
+	if err := c.writeSourceList(filepath.Join(tempDir, xyName), width, height, xs, ys, flux); err != nil {
+		return "", 0, 0, err
+	}
+	return xyName, width, height, nil
+}
+
+// writeSourceList writes star positions and fluxes as a single-field
+// xylist at path.
+func (c *Client) writeSourceList(path string, width, height int, xs, ys, flux []float64) error {
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, stagedPerm(0600, c.config.AllowContainerRead))
+	if err != nil {
+		return fmt.Errorf("failed to create xylist: %w", err)
+	}
+	err = fits.WriteTables(out, nil, fits.TableHDU{
+		Cards:   []fits.Card{{Key: "IMAGEW", Value: strconv.Itoa(width)}, {Key: "IMAGEH", Value: strconv.Itoa(height)}},
+		Columns: []string{"X", "Y", "FLUX"},
+		Data:    [][]float64{xs, ys, flux},
+	})
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write xylist: %w", err)
+	}
+	return nil
+}
+
+// sourceListArgs returns the solve-field arguments to solve the xylist
+// xyName, written by writeExtractedList or writeSourceList, in place of
+// the image.
+func (c *Client) sourceListArgs(xyName, tempDir string, width, height int, opts *SolveOptions) []string {
+	xyOpts := *opts
+	xyOpts.ExtraArgs = append([]string{"--width", strconv.Itoa(width), "--height", strconv.Itoa(height)}, opts.ExtraArgs...)
+	return c.buildSolveArgs(xyName, tempDir, &xyOpts)
+}
@@ -0,0 +1,107 @@
+package solver
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+// imageSignatures maps the leading bytes of each format AllowedFormats can
+// name to its canonical name. FITS is recognised separately, including
+// gzip-compressed FITS.
+var imageSignatures = []struct {
+	magic  []byte
+	format string
+}{
+	{[]byte{0xff, 0xd8, 0xff}, "jpg"},
+	{[]byte("\x89PNG\r\n\x1a\n"), "png"},
+	{[]byte("II*\x00"), "tiff"},
+	{[]byte("MM\x00*"), "tiff"},
+	{[]byte("GIF87a"), "gif"},
+	{[]byte("GIF89a"), "gif"},
+	{[]byte("BM"), "bmp"},
+}
+
+// formatAliases maps alternative AllowedFormats names to canonical ones.
+var formatAliases = map[string]string{
+	"jpeg": "jpg",
+	"tif":  "tiff",
+	"fit":  "fits",
+	"fts":  "fits",
+}
+
+// knownFormats lists the canonical format names, in the order reported by
+// errors.
+var knownFormats = []string{"jpg", "png", "tiff", "gif", "bmp", "fits"}
+
+// canonicalFormat returns the canonical name of a format such as "JPEG" or
+// ".fit", or "" if it is not one AllowedFormats supports.
+func canonicalFormat(format string) string {
+	format = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(format)), ".")
+	if alias, ok := formatAliases[format]; ok {
+		format = alias
+	}
+	if !slices.Contains(knownFormats, format) {
+		return ""
+	}
+	return format
+}
+
+// validateAllowedFormats checks that every AllowedFormats entry names a
+// supported format.
+func validateAllowedFormats(formats []string) error {
+	for _, format := range formats {
+		if canonicalFormat(format) == "" {
+			return fmt.Errorf("%w: unknown AllowedFormats entry %q (supported: %s)",
+				ErrInvalidInput, format, strings.Join(knownFormats, ", "))
+		}
+	}
+	return nil
+}
+
+// sniffFormat returns the canonical format of the image at path judged by
+// its contents, ignoring its name, or "" when unrecognised.
+func sniffFormat(path string) string {
+	if isFITS(path) || fitsCompression(path) == compressionGzip {
+		return "fits"
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	head := make([]byte, 8)
+	n, _ := io.ReadFull(file, head)
+	for _, sig := range imageSignatures {
+		if bytes.HasPrefix(head[:n], sig.magic) {
+			return sig.format
+		}
+	}
+	return ""
+}
+
+// checkFormat returns ErrInvalidInput when AllowedFormats is set and the
+// image's contents are not one of them.
+func (c *Client) checkFormat(imagePath string) error {
+	allowed := c.config.AllowedFormats
+	if len(allowed) == 0 {
+		return nil
+	}
+	format := sniffFormat(imagePath)
+	for _, a := range allowed {
+		if format != "" && canonicalFormat(a) == format {
+			return nil
+		}
+	}
+	if format == "" {
+		format = "unrecognised"
+	}
+	return fmt.Errorf("%w: image format %s is not allowed (allowed: %s)",
+		ErrInvalidInput, format, strings.Join(allowed, ", "))
+}
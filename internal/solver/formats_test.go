@@ -0,0 +1,87 @@
+package solver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSniffFormat(t *testing.T) {
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write([]byte("SIMPLE  =                    T"))
+	_ = zw.Close()
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"jpeg", []byte{0xff, 0xd8, 0xff, 0xe0, 0, 0x10, 'J', 'F'}, "jpg"},
+		{"png", []byte("\x89PNG\r\n\x1a\n\x00\x00"), "png"},
+		{"tiff little-endian", []byte("II*\x00\x08\x00\x00\x00"), "tiff"},
+		{"tiff big-endian", []byte("MM\x00*\x00\x00\x00\x08"), "tiff"},
+		{"gif", []byte("GIF89a\x01\x00"), "gif"},
+		{"bmp", []byte("BM\x36\x00\x00\x00"), "bmp"},
+		{"fits", []byte("SIMPLE  =                    T"), "fits"},
+		{"gzip fits", gz.Bytes(), "fits"},
+		{"html", []byte("<!DOCTYPE html><html>"), ""},
+		{"elf", []byte("\x7fELF\x02\x01\x01"), ""},
+		{"empty", nil, ""},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "upload.jpg")
+		if err := os.WriteFile(path, tt.data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := sniffFormat(path); got != tt.want {
+			t.Errorf("%s: sniffFormat = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSolve_AllowedFormats(t *testing.T) {
+	runs := 0
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		runs++
+		return nil
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.AllowedFormats = []string{"JPEG", ".png", "fits"} }); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	// A PNG is allowed whatever it is called
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); err != nil {
+		t.Fatalf("allowed PNG rejected: %v", err)
+	}
+	if runs != 1 {
+		t.Fatalf("solver ran %d times for an allowed image, want 1", runs)
+	}
+
+	// A disguised upload is rejected before anything runs
+	for name, data := range map[string][]byte{
+		"tiff named .jpg": []byte("II*\x00\x08\x00\x00\x00"),
+		"script":          []byte("#!/bin/sh\nrm -rf /\n"),
+	} {
+		_, err := client.SolveBytes(context.Background(), data, "jpg", nil)
+		if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), "not allowed") {
+			t.Errorf("%s: err = %v, want a disallowed format error", name, err)
+		}
+	}
+	if runs != 1 {
+		t.Errorf("solver ran %d times, want disallowed images rejected before solving", runs)
+	}
+}
+
+func TestValidateConfig_AllowedFormats(t *testing.T) {
+	_, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), AllowedFormats: []string{"jpg", "exe"}})
+	if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), `"exe"`) {
+		t.Errorf("err = %v, want ErrInvalidInput naming the unknown format", err)
+	}
+}
@@ -0,0 +1,67 @@
+package solver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// ImageHash returns the hex SHA-256 of the file's content. Use it as a
+// dedup or cache key when the key must change whenever any pixel changes.
+func ImageHash(imagePath string) (string, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open image: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+	return ImageHashFromReader(file)
+}
+
+// ImageHashFromReader returns the hex SHA-256 of everything read from r.
+// It is equivalent to ImageHash for data that is not on disk, such as an
+// upload passed to SolveBytes.
+func ImageHashFromReader(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("failed to read image: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ImageHashFromHeader returns the hex SHA-256 of the first 2880-byte header
+// block of a FITS file, without reading the pixel data.
+//
+// It is a fast approximate key for large FITS files: capture software
+// writes DATE-OBS, exposure and pointing keywords into the header, so
+// distinct frames almost always differ there. Two files with identical
+// headers but different pixels hash the same; use ImageHash when that
+// matters. Non-FITS files are rejected with ErrInvalidInput.
+func ImageHashFromHeader(imagePath string) (string, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open image: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	block := make([]byte, fits.BlockSize)
+	n, err := io.ReadFull(file, block)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", fmt.Errorf("failed to read FITS header: %w", err)
+	}
+	if !bytes.HasPrefix(block[:n], []byte("SIMPLE  =")) {
+		return "", fmt.Errorf("%w: not a FITS file: %s", ErrInvalidInput, imagePath)
+	}
+
+	sum := sha256.Sum256(block[:n])
+	return hex.EncodeToString(sum[:]), nil
+}
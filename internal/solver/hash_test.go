@@ -0,0 +1,106 @@
+package solver
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+func TestImageHash(t *testing.T) {
+	dir := t.TempDir()
+	content := bytes.Repeat([]byte("starfield"), 1000)
+	changed := append([]byte(nil), content...)
+	changed[len(changed)/2] ^= 1
+
+	write := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	a := write("a.jpg", content)
+	b := write("b.jpg", content)
+	c := write("c.jpg", changed)
+
+	hashA, err := ImageHash(a)
+	if err != nil {
+		t.Fatalf("ImageHash failed: %v", err)
+	}
+	if len(hashA) != 64 {
+		t.Errorf("hash %q is not hex SHA-256", hashA)
+	}
+	if hashB, _ := ImageHash(b); hashB != hashA {
+		t.Errorf("identical files hash differently: %s vs %s", hashA, hashB)
+	}
+	if hashC, _ := ImageHash(c); hashC == hashA {
+		t.Error("files differing by one byte hash the same")
+	}
+	if fromReader, _ := ImageHashFromReader(bytes.NewReader(content)); fromReader != hashA {
+		t.Errorf("ImageHashFromReader = %s, want %s", fromReader, hashA)
+	}
+
+	if _, err := ImageHash(filepath.Join(dir, "missing.jpg")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestImageHashFromHeader(t *testing.T) {
+	dir := t.TempDir()
+	image := func(name string, pix []uint8) string {
+		path := filepath.Join(dir, name)
+		var buf bytes.Buffer
+		if err := fits.WriteImage(&buf, 40, 30, pix); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	pix := make([]uint8, 40*30)
+	a := image("a.fits", pix)
+	b := image("b.fits", pix)
+	pix[0] = 255
+	samePixelsChanged := image("c.fits", pix)
+
+	hashA, err := ImageHashFromHeader(a)
+	if err != nil {
+		t.Fatalf("ImageHashFromHeader failed: %v", err)
+	}
+	if hashB, _ := ImageHashFromHeader(b); hashB != hashA {
+		t.Error("identical headers hash differently")
+	}
+	// Only the header is hashed, so pixel changes are not seen
+	if hashC, _ := ImageHashFromHeader(samePixelsChanged); hashC != hashA {
+		t.Error("header hash changed with pixel data")
+	}
+
+	// A one-byte header change is seen
+	data, err := os.ReadFile(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := bytes.Index(data, []byte("NAXIS1  ="))
+	data[i+29] = '1' // NAXIS1 40 -> 41
+	d := filepath.Join(dir, "d.fits")
+	if err := os.WriteFile(d, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if hashD, _ := ImageHashFromHeader(d); hashD == hashA {
+		t.Error("header differing by one byte hashes the same")
+	}
+
+	jpg := filepath.Join(dir, "frame.jpg")
+	if err := os.WriteFile(jpg, []byte(strings.Repeat("x", 100)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ImageHashFromHeader(jpg); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput for non-FITS file, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package solver
+
+import "math"
+
+// MaxHealPixOrder is the highest HEALPix order HealPix supports; at order 29
+// pixels are about 0.4 milliarcseconds across and ids still fit an int64.
+const MaxHealPixOrder = 29
+
+// HealPix returns the NESTED-scheme HEALPix pixel containing the solved
+// center at the given order (Nside = 2^order), for use as a spatial key in
+// an archive of solves. In the NESTED scheme a pixel's parent at order-1 is
+// pix >> 2, so a coarse key is a prefix of a fine one and neighbouring
+// solves share leading bits:
+//
+//	key := result.HealPix(10) // ~3.4 arcminute pixels
+//
+// It returns -1 for an unsolved result or an order outside
+// 0..MaxHealPixOrder.
+func (r *Result) HealPix(order int) int64 {
+	if !r.Solved || order < 0 || order > MaxHealPixOrder {
+		return -1
+	}
+	return healPixNest(order, r.RA, r.Dec)
+}
+
+// healPixNest is the HEALPix ang2pix_nest algorithm (Górski et al. 2005)
+// for RA and Dec in degrees.
+func healPixNest(order int, ra, dec float64) int64 {
+	nside := int64(1) << order
+	z := math.Sin(dec * math.Pi / 180)
+	za := math.Abs(z)
+	tt := math.Mod(math.Mod(ra, 360)+360, 360) / 90 // in [0, 4)
+	if tt >= 4 {
+		tt = 0
+	}
+
+	var face, ix, iy int64
+	if za <= 2.0/3 {
+		// Equatorial region
+		temp1 := float64(nside) * (0.5 + tt)
+		temp2 := float64(nside) * z * 0.75
+		jp := int64(temp1 - temp2) // index of ascending edge line
+		jm := int64(temp1 + temp2) // index of descending edge line
+		ifp, ifm := jp>>order, jm>>order
+		switch {
+		case ifp == ifm:
+			face = ifp | 4
+		case ifp < ifm:
+			face = ifp
+		default:
+			face = ifm + 8
+		}
+		ix = jm & (nside - 1)
+		iy = nside - (jp & (nside - 1)) - 1
+	} else {
+		// Polar caps
+		ntt := min(int64(tt), 3)
+		tp := tt - float64(ntt)
+		// √(3(1-|z|)) written via the colatitude to keep precision near the poles
+		tmp := float64(nside) * math.Sqrt(6) * math.Sin((90-math.Abs(dec))*math.Pi/360)
+		jp := min(int64(tp*tmp), nside-1)
+		jm := min(int64((1-tp)*tmp), nside-1)
+		if z >= 0 {
+			face, ix, iy = ntt, nside-jm-1, nside-jp-1
+		} else {
+			face, ix, iy = ntt+8, jp, jm
+		}
+	}
+	return face<<(2*order) | interleaveBits(ix) | interleaveBits(iy)<<1
+}
+
+// interleaveBits spreads the low 32 bits of v onto the even bits of the
+// result.
+func interleaveBits(v int64) int64 {
+	x := uint64(v) & 0xffffffff
+	x = (x | x<<16) & 0x0000ffff0000ffff
+	x = (x | x<<8) & 0x00ff00ff00ff00ff
+	x = (x | x<<4) & 0x0f0f0f0f0f0f0f0f
+	x = (x | x<<2) & 0x3333333333333333
+	x = (x | x<<1) & 0x5555555555555555
+	return int64(x)
+}
+
+// healPixCenter is the HEALPix pix2ang_nest algorithm, returning the RA and
+// Dec in degrees of the center of NESTED pixel pix at the given order.
+func healPixCenter(order int, pix int64) (ra, dec float64) {
+	// Ring and longitude offsets of the 12 base pixels' southern corners
+	jrll := [12]int64{2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4}
+	jpll := [12]int64{1, 3, 5, 7, 0, 2, 4, 6, 1, 3, 5, 7}
+
+	nside := int64(1) << order
+	face := pix >> (2 * order)
+	ipf := pix & (nside*nside - 1)
+	ix, iy := deinterleaveBits(ipf), deinterleaveBits(ipf>>1)
+
+	jr := jrll[face]*nside - ix - iy - 1 // ring number counted from the north pole
+	var nr, kshift int64
+	var z float64
+	switch {
+	case jr < nside:
+		nr = jr
+		z = 1 - float64(nr*nr)/float64(3*nside*nside)
+	case jr > 3*nside:
+		nr = 4*nside - jr
+		z = float64(nr*nr)/float64(3*nside*nside) - 1
+	default:
+		nr = nside
+		z = float64(2*nside-jr) * 2 / float64(3*nside)
+		kshift = (jr - nside) & 1
+	}
+
+	jp := (jpll[face]*nr + ix - iy + 1 + kshift) / 2
+	if jp > 4*nside {
+		jp -= 4 * nside
+	} else if jp < 1 {
+		jp += 4 * nside
+	}
+	phi := (float64(jp) - float64(kshift+1)/2) * 90 / float64(nr)
+	return normalizeRA(phi), math.Asin(z) * rad2deg
+}
+
+// deinterleaveBits gathers the even bits of v, inverting interleaveBits.
+func deinterleaveBits(v int64) int64 {
+	x := uint64(v) & 0x5555555555555555
+	x = (x | x>>1) & 0x3333333333333333
+	x = (x | x>>2) & 0x0f0f0f0f0f0f0f0f
+	x = (x | x>>4) & 0x00ff00ff00ff00ff
+	x = (x | x>>8) & 0x0000ffff0000ffff
+	x = (x | x>>16) & 0x00000000ffffffff
+	return int64(x)
+}
@@ -0,0 +1,84 @@
+package solver
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResultHealPix(t *testing.T) {
+	tests := []struct {
+		name    string
+		ra, dec float64
+		order   int
+		want    int64
+	}{
+		// The 12 base pixels: four northern, four equatorial, four southern
+		{"north face 0", 45, 41.8, 0, 0},
+		{"north face 1", 135, 41.8, 0, 1},
+		{"north face 3", 315, 41.8, 0, 3},
+		{"equatorial face 5", 90, 0.5, 0, 5},
+		{"equatorial face 6", 180, -0.5, 0, 6},
+		{"equatorial face 7", 270, 0.5, 0, 7},
+		{"south face 8", 45, -41.8, 0, 8},
+		{"south face 11", 315, -41.8, 0, 11},
+		// The poles are corners of the polar faces
+		{"north pole", 10, 90, 1, 3},
+		{"south pole", 10, -90, 1, 32},
+		{"north pole order 10", 10, 90, 10, 1<<20 - 1},
+		// Face 4, ix = iy = 8 of 16
+		{"near ra 0 dec 0", 1, 1, 4, 4<<8 | 192},
+		{"negative ra wraps", -359, 1, 4, 4<<8 | 192},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Result{Solved: true, RA: tt.ra, Dec: tt.dec}
+			if got := r.HealPix(tt.order); got != tt.want {
+				t.Errorf("HealPix(%d) at (%g, %g) = %d, want %d", tt.order, tt.ra, tt.dec, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResultHealPix_Nested(t *testing.T) {
+	// Each pixel's parent at the next order down is pix >> 2
+	for _, pos := range [][2]float64{{83.82, -5.39}, {10.68, 41.27}, {201.37, -43.02}, {0.01, 89.99}, {279.23, 38.78}} {
+		r := &Result{Solved: true, RA: pos[0], Dec: pos[1]}
+		for order := 1; order <= MaxHealPixOrder; order++ {
+			if got, parent := r.HealPix(order), r.HealPix(order-1); got>>2 != parent {
+				t.Errorf("(%g, %g): order %d pixel %d has parent %d, want %d", pos[0], pos[1], order, got, got>>2, parent)
+			}
+			if npix := int64(12) << (2 * order); r.HealPix(order) >= npix {
+				t.Errorf("(%g, %g): order %d pixel %d exceeds %d pixels", pos[0], pos[1], order, r.HealPix(order), npix)
+			}
+		}
+	}
+}
+
+func TestResultHealPix_Invalid(t *testing.T) {
+	solved := &Result{Solved: true, RA: 83.82, Dec: -5.39}
+	for _, order := range []int{-1, MaxHealPixOrder + 1} {
+		if got := solved.HealPix(order); got != -1 {
+			t.Errorf("HealPix(%d) = %d, want -1", order, got)
+		}
+	}
+	if got := (&Result{RA: 83.82, Dec: -5.39}).HealPix(4); got != -1 {
+		t.Errorf("unsolved HealPix = %d, want -1", got)
+	}
+}
+
+func TestHealPixCenter(t *testing.T) {
+	// Every pixel's center maps back to the pixel
+	for order := 0; order <= 4; order++ {
+		for pix := int64(0); pix < 12<<(2*order); pix++ {
+			ra, dec := healPixCenter(order, pix)
+			if got := healPixNest(order, ra, dec); got != pix {
+				t.Errorf("order %d: center (%g, %g) of pixel %d is in pixel %d", order, ra, dec, pix, got)
+			}
+		}
+	}
+
+	// NSIDE 4 equatorial centers: dec 0 at odd multiples of 11.25°
+	if ra, dec := healPixCenter(2, 70); math.Abs(ra-11.25) > 1e-9 || math.Abs(dec) > 1e-9 {
+		t.Errorf("center of pixel 70 = (%g, %g), want (11.25, 0)", ra, dec)
+	}
+}
@@ -0,0 +1,57 @@
+package solver
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// hintRadii returns the search radius of each solve attempt from
+// opts.HintRadiusSchedule, or nil for a single attempt with opts as given.
+func hintRadii(opts *SolveOptions) []float64 {
+	if len(opts.HintRadiusSchedule) == 0 || (opts.RA == 0 && opts.Dec == 0) {
+		return nil
+	}
+	return opts.HintRadiusSchedule
+}
+
+// withHintRadius returns a copy of opts searching radius degrees around
+// the hint, or solving blind when radius is 0.
+func withHintRadius(opts *SolveOptions, radius float64) *SolveOptions {
+	o := *opts
+	o.Radius = radius
+	if radius == 0 {
+		o.RA, o.Dec = 0, 0
+	}
+	return &o
+}
+
+// reuseSources rewrites the stars solve-field detected in an attempt, from
+// its .axy at axyPath, as an xylist at path for the next attempt, and
+// returns the image size recorded in it.
+func (c *Client) reuseSources(axyPath, path string) (width, height int, err error) {
+	file, err := os.Open(axyPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	table, err := fits.ReadTable(file)
+	_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	if err != nil {
+		return 0, 0, err
+	}
+
+	xs, ys, flux := table.Columns["X"], table.Columns["Y"], table.Columns["FLUX"]
+	width, _ = table.Header.Int("IMAGEW")
+	height, _ = table.Header.Int("IMAGEH")
+	if xs == nil || ys == nil || width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("%s has no X/Y columns or image size", axyPath)
+	}
+	if flux == nil {
+		flux = make([]float64, len(xs))
+	}
+	if err := c.writeSourceList(path, width, height, xs, ys, flux); err != nil {
+		return 0, 0, err
+	}
+	return width, height, nil
+}
@@ -0,0 +1,159 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// argValue returns the value following flag in args, or "" when absent.
+func argValue(args []string, flag string) string {
+	if i := slices.Index(args, flag); i >= 0 && i+1 < len(args) {
+		return args[i+1]
+	}
+	return ""
+}
+
+func TestSolve_HintRadiusSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		schedule   []float64
+		solveAt    string // --radius value that solves, "blind" for no hint, "" for none
+		output     string // Output of attempts that do not solve
+		wantRadii  []string
+		wantSolved bool
+		wantRadius float64
+		wantErr    error
+	}{
+		{
+			name:       "second radius solves",
+			schedule:   []float64{2, 10, 0},
+			solveAt:    "10.000000",
+			wantRadii:  []string{"2.000000", "10.000000"},
+			wantSolved: true,
+			wantRadius: 10,
+		},
+		{
+			name:       "first radius solves",
+			schedule:   []float64{2, 10},
+			solveAt:    "2.000000",
+			wantRadii:  []string{"2.000000"},
+			wantSolved: true,
+			wantRadius: 2,
+		},
+		{
+			name:       "blind solves",
+			schedule:   []float64{2, 0},
+			solveAt:    "blind",
+			wantRadii:  []string{"2.000000", "blind"},
+			wantSolved: true,
+			wantRadius: 0,
+		},
+		{
+			name:       "nothing solves",
+			schedule:   []float64{2, 10},
+			wantRadii:  []string{"2.000000", "10.000000"},
+			wantRadius: 10,
+		},
+		{
+			name:      "setup problem is not retried",
+			schedule:  []float64{2, 10},
+			output:    "solve-field: no index files were found",
+			wantRadii: []string{"2.000000"},
+			wantErr:   ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var radii, inputs []string
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				radius := argValue(args, "--radius")
+				if argValue(args, "--ra") == "" {
+					radius = "blind"
+				}
+				radii = append(radii, radius)
+				inputs = append(inputs, filepath.Base(args[len(args)-1]))
+				if _, err := os.Stat(filepath.Join(workDir, "stars.axy")); len(radii) > 1 && err == nil {
+					t.Errorf("attempt %d: previous attempt's outputs not removed", len(radii))
+				}
+				if radius != tt.solveAt {
+					// Like solve-field, leave the detected stars behind
+					var axy bytes.Buffer
+					if err := fits.WriteTables(&axy, nil, fits.TableHDU{
+						Cards:   []fits.Card{{Key: "IMAGEW", Value: "8"}, {Key: "IMAGEH", Value: "8"}},
+						Columns: []string{"X", "Y", "FLUX", "BACKGROUND"},
+						Data:    [][]float64{{4}, {4}, {255}, {0}},
+					}); err != nil {
+						return err
+					}
+					_, _ = io.WriteString(w, tt.output)
+					return os.WriteFile(filepath.Join(workDir, "stars.axy"), axy.Bytes(), 0644)
+				}
+				if err := os.WriteFile(filepath.Join(workDir, "stars.wcs"), fits.EncodeHeader(wcsCards(0.001)), 0644); err != nil {
+					return err
+				}
+				return os.WriteFile(filepath.Join(workDir, "stars.solved"), []byte{1}, 0644)
+			})
+
+			opts := DefaultSolveOptions()
+			opts.RA, opts.Dec, opts.Radius = 83.8, 0.6, 1
+			opts.HintRadiusSchedule = tt.schedule
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+			if !slices.Equal(radii, tt.wantRadii) {
+				t.Errorf("attempted radii %q, want %q", radii, tt.wantRadii)
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Solve error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+			if result.Solved != tt.wantSolved || result.HintRadius != tt.wantRadius {
+				t.Errorf("Solved = %v, HintRadius = %v; want %v, %v", result.Solved, result.HintRadius, tt.wantSolved, tt.wantRadius)
+			}
+
+			// Only the first attempt extracts stars from the image
+			for i, input := range inputs {
+				want := "stars.xyls"
+				if i == 0 {
+					want = "stars.png"
+				}
+				if input != want {
+					t.Errorf("attempt %d solved %s, want %s", i+1, input, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSolve_HintRadiusScheduleWithoutHint(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		calls++
+		if argValue(args, "--radius") != "" {
+			t.Errorf("blind solve given --radius: %q", args)
+		}
+		return nil
+	})
+	opts := DefaultSolveOptions()
+	opts.HintRadiusSchedule = []float64{2, 10}
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if calls != 1 || result.HintRadius != 0 {
+		t.Errorf("%d attempts with HintRadius %v, want one attempt and no radius", calls, result.HintRadius)
+	}
+}
@@ -0,0 +1,203 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultHookTimeout bounds each post-solve hook that does not set its own
+// NamedHook.Timeout.
+const DefaultHookTimeout = 30 * time.Second
+
+// Hook is an action run after Solve, such as archiving the WCS or
+// notifying a catalog service. It receives a copy of the result, so it
+// cannot change what Solve returns. Its output files are still in the
+// workspace while it runs.
+type Hook interface {
+	Run(ctx context.Context, imagePath string, r *Result) error
+}
+
+// HookFunc adapts a function to the Hook interface.
+type HookFunc func(ctx context.Context, imagePath string, r *Result) error
+
+// Run calls f.
+func (f HookFunc) Run(ctx context.Context, imagePath string, r *Result) error {
+	return f(ctx, imagePath, r)
+}
+
+// NamedHook registers a Hook with a name for HookError, its own timeout,
+// and whether it also runs when no solution was found. Plain hooks in
+// ClientConfig.PostSolveHooks run only after successful solves, with
+// DefaultHookTimeout.
+type NamedHook struct {
+	Name string
+	Hook Hook
+
+	// Timeout bounds the hook's run. Default: DefaultHookTimeout
+	Timeout time.Duration
+
+	// OnFailure also runs the hook when Solve returns an unsolved result.
+	// Hooks never run when Solve returns an error.
+	OnFailure bool
+}
+
+// Run calls the registered hook.
+func (h NamedHook) Run(ctx context.Context, imagePath string, r *Result) error {
+	return h.Hook.Run(ctx, imagePath, r)
+}
+
+// HookError records a post-solve hook that failed, timed out or panicked,
+// in Result.HookErrors.
+type HookError struct {
+	// Hook is the NamedHook's Name, or "#n" for the nth hook without one.
+	Hook string
+	Err  error
+}
+
+// Error returns the hook name and its error.
+func (e *HookError) Error() string {
+	return fmt.Sprintf("post-solve hook %s: %v", e.Hook, e.Err)
+}
+
+// Unwrap returns the hook's error.
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// runPostSolveHooks runs the configured hooks in order for a solve that
+// returned r and err, appending failures to r.HookErrors. A hook that
+// ignores its context is abandoned at its timeout and keeps running in the
+// background.
+func (c *Client) runPostSolveHooks(ctx context.Context, imagePath string, r *Result, err error) {
+	if err != nil || r == nil {
+		return
+	}
+	for i, hook := range c.config.PostSolveHooks {
+		name, timeout, onFailure := fmt.Sprintf("#%d", i+1), DefaultHookTimeout, false
+		if named, ok := hook.(NamedHook); ok {
+			if named.Name != "" {
+				name = named.Name
+			}
+			if named.Timeout > 0 {
+				timeout = named.Timeout
+			}
+			onFailure = named.OnFailure
+		}
+		if !r.Solved && !onFailure {
+			continue
+		}
+		if hookErr := runHook(ctx, hook, timeout, imagePath, r.Clone()); hookErr != nil {
+			r.HookErrors = append(r.HookErrors, &HookError{Hook: name, Err: hookErr})
+		}
+	}
+}
+
+// runHook runs a single hook with a timeout, converting a panic to an
+// error.
+func runHook(ctx context.Context, hook Hook, timeout time.Duration, imagePath string, r *Result) error {
+	hookCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- fmt.Errorf("panic: %v", p)
+			}
+		}()
+		done <- hook.Run(hookCtx, imagePath, r)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-hookCtx.Done():
+		return hookCtx.Err()
+	}
+}
+
+// CopyArtifactsHook returns a Hook that copies the solve's output files to
+// dir, named after the image (m42.jpg gives m42.wcs, m42.corr, ...), for
+// example to keep the .wcs next to the original. dir is created if needed.
+func CopyArtifactsHook(dir string) Hook {
+	return HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		base := strings.TrimSuffix(filepath.Base(imagePath), filepath.Ext(imagePath))
+		for _, kind := range outputKinds {
+			src, ok := r.Outputs[kind]
+			if !ok {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			data, err := os.ReadFile(src)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", kind, err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, base+string(kind)), data, 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", kind, err)
+			}
+		}
+		return nil
+	})
+}
+
+// webhookPayload is the JSON body WebhookHook posts.
+type webhookPayload struct {
+	Image       string            `json:"image"`
+	Solved      bool              `json:"solved"`
+	RA          float64           `json:"ra"`
+	Dec         float64           `json:"dec"`
+	PixelScale  float64           `json:"pixel_scale"`
+	Rotation    float64           `json:"rotation"`
+	FieldWidth  float64           `json:"field_width"`
+	FieldHeight float64           `json:"field_height"`
+	ImageWidth  int               `json:"image_width"`
+	ImageHeight int               `json:"image_height"`
+	SolveTime   float64           `json:"solve_time"`
+	WCSHeader   map[string]string `json:"wcs_header,omitempty"`
+}
+
+// WebhookHook returns a Hook that POSTs the result as JSON to url: the
+// image path, solved flag, center, scale, rotation, field and image size,
+// solve time and WCS header. Any status other than 2xx is an error.
+func WebhookHook(url string) Hook {
+	return HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+		body, err := json.Marshal(webhookPayload{
+			Image: imagePath, Solved: r.Solved, RA: r.RA, Dec: r.Dec,
+			PixelScale: r.PixelScale, Rotation: r.Rotation,
+			FieldWidth: r.FieldWidth, FieldHeight: r.FieldHeight,
+			ImageWidth: r.ImageWidth, ImageHeight: r.ImageHeight,
+			SolveTime: r.TotalTime, WCSHeader: r.WCSHeader,
+		})
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = resp.Body.Close() //nolint:errcheck // Response body fully handled
+		}()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("webhook returned %s", resp.Status)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,162 @@
+package solver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSolve_PostSolveHooks(t *testing.T) {
+	var workDir string
+	client := newArtifactClient(t, &workDir)
+
+	var ran []string
+	client.config.PostSolveHooks = []Hook{
+		HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+			ran = append(ran, "mutate")
+			r.RA = 0
+			r.WCSHeader["CRVAL1"] = "0"
+			r.OutputFiles = nil
+			return nil
+		}),
+		HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+			return errors.New("catalog unavailable")
+		}),
+		NamedHook{Name: "slow", Timeout: 20 * time.Millisecond, Hook: HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})},
+		NamedHook{Name: "stuck", Timeout: 20 * time.Millisecond, Hook: HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+			time.Sleep(time.Second)
+			return nil
+		})},
+		HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+			panic("hook bug")
+		}),
+		HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+			ran = append(ran, "last")
+			return nil
+		}),
+	}
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+	if err != nil {
+		t.Fatalf("hook failures must not fail the solve: %v", err)
+	}
+	if !result.Solved || result.RA != 83.8 || result.WCSHeader["CRVAL1"] != "83.8" || len(result.OutputFiles) == 0 {
+		t.Errorf("hook changed the result: RA %v, CRVAL1 %q, OutputFiles %v", result.RA, result.WCSHeader["CRVAL1"], result.OutputFiles)
+	}
+	if !slices.Equal(ran, []string{"mutate", "last"}) {
+		t.Errorf("hooks ran %v, want [mutate last] in order", ran)
+	}
+
+	var names []string
+	for _, hookErr := range result.HookErrors {
+		var he *HookError
+		if !errors.As(hookErr, &he) {
+			t.Fatalf("HookErrors entry %v is not a *HookError", hookErr)
+		}
+		names = append(names, he.Hook)
+	}
+	if !slices.Equal(names, []string{"#2", "slow", "stuck", "#5"}) {
+		t.Fatalf("HookErrors from %v, want [#2 slow stuck #5]", names)
+	}
+	for _, i := range []int{1, 2} {
+		if !errors.Is(result.HookErrors[i], context.DeadlineExceeded) {
+			t.Errorf("HookErrors[%d] = %v, want deadline exceeded", i, result.HookErrors[i])
+		}
+	}
+	if !strings.Contains(result.HookErrors[3].Error(), "panic: hook bug") {
+		t.Errorf("HookErrors[3] = %v, want the panic", result.HookErrors[3])
+	}
+}
+
+func TestSolve_PostSolveHooksOnFailure(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		return nil // no .wcs: the image did not solve
+	})
+	var ran []string
+	record := func(name string) Hook {
+		return HookFunc(func(ctx context.Context, imagePath string, r *Result) error {
+			ran = append(ran, name)
+			return nil
+		})
+	}
+	client.config.PostSolveHooks = []Hook{
+		record("success only"),
+		NamedHook{Name: "always", Hook: record("always"), OnFailure: true},
+	}
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+	if err != nil || result.Solved {
+		t.Fatalf("Solve = %+v, %v, want an unsolved result", result, err)
+	}
+	if !slices.Equal(ran, []string{"always"}) {
+		t.Errorf("hooks ran %v for an unsolved result, want [always]", ran)
+	}
+
+	// Errors are not solve outcomes, so no hook runs
+	ran = nil
+	if _, err := client.Solve(context.Background(), "missing.png", nil); err == nil {
+		t.Fatal("expected error for missing image")
+	}
+	if len(ran) != 0 {
+		t.Errorf("hooks ran %v after an error", ran)
+	}
+}
+
+func TestCopyArtifactsHook(t *testing.T) {
+	var workDir string
+	client := newArtifactClient(t, &workDir)
+	dir := filepath.Join(t.TempDir(), "solved")
+	client.config.PostSolveHooks = []Hook{CopyArtifactsHook(dir)}
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.HookErrors != nil {
+		t.Fatalf("HookErrors = %v", result.HookErrors)
+	}
+	want := []string{"stars-indx.xyls", "stars.axy", "stars.corr", "stars.solved", "stars.wcs"}
+	if got := dirNames(t, dir); !slices.Equal(got, want) {
+		t.Errorf("copied %v, want %v", got, want)
+	}
+}
+
+func TestWebhookHook(t *testing.T) {
+	var got webhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("request %s with Content-Type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("invalid payload: %v", err)
+		}
+		if strings.HasSuffix(r.URL.Path, "/fail") {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	result := &Result{Solved: true, RA: 83.8, Dec: -5.4, PixelScale: 3.6}
+	if err := WebhookHook(server.URL).Run(context.Background(), "/images/m42.jpg", result); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got.Image != "/images/m42.jpg" || !got.Solved || got.RA != 83.8 || got.Dec != -5.4 || got.PixelScale != 3.6 {
+		t.Errorf("payload = %+v", got)
+	}
+
+	err := WebhookHook(server.URL+"/fail").Run(context.Background(), "/images/m42.jpg", result)
+	if err == nil || !strings.Contains(err.Error(), "500") {
+		t.Errorf("err = %v, want the 500 status", err)
+	}
+}
@@ -0,0 +1,89 @@
+package solver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// indexConfigName is the astrometry-engine config Solve writes into the
+// workspace when there is more than one index directory.
+const indexConfigName = "astrometry-indexes.cfg"
+
+// indexPaths returns the host index directories: IndexPath, if set,
+// followed by IndexPaths, without repeats.
+func (cfg *ClientConfig) indexPaths() []string {
+	var paths []string
+	for _, p := range append([]string{cfg.IndexPath}, cfg.IndexPaths...) {
+		if p != "" && !slices.Contains(paths, p) {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// indexMountPath returns the container path the i'th index directory is
+// mounted at: IndexMountPath for the first, which the image's
+// astrometry.cfg searches, and IndexMountPath-2, -3, ... for the rest.
+func (cfg *ClientConfig) indexMountPath(i int) string {
+	if i == 0 {
+		return cfg.IndexMountPath
+	}
+	return fmt.Sprintf("%s-%d", strings.TrimSuffix(cfg.IndexMountPath, "/"), i+1)
+}
+
+// absIndexPaths returns the absolute host path of every index directory.
+func (c *Client) absIndexPaths() ([]string, error) {
+	paths := c.config.indexPaths()
+	abs := make([]string, len(paths))
+	for i, p := range paths {
+		a, err := filepath.Abs(p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute index path: %w", err)
+		}
+		abs[i] = a
+	}
+	return abs, nil
+}
+
+// indexMountArgs returns the docker -v flags mounting each of
+// absIndexPaths at its indexMountPath, with mode (such as ":ro") appended.
+func (c *Client) indexMountArgs(absIndexPaths []string, mode string) []string {
+	var args []string
+	for i, p := range absIndexPaths {
+		args = append(args, "-v", fmt.Sprintf("%s:%s%s", p, c.config.indexMountPath(i), mode))
+	}
+	return args
+}
+
+// writeIndexConfig writes an astrometry-engine config searching every
+// index mount into tempDir as name, when there is more than one index
+// directory, and returns the solve-field flags that select it. The
+// image's own astrometry.cfg only lists IndexMountPath. It returns nil
+// flags for a single directory, leaving the image's config in use.
+func (c *Client) writeIndexConfig(tempDir, name string) ([]string, error) {
+	paths := c.config.indexPaths()
+	if len(paths) < 2 {
+		return nil, nil
+	}
+	var cfg strings.Builder
+	for i := range paths {
+		fmt.Fprintf(&cfg, "add_path %s\n", c.config.indexMountPath(i))
+	}
+	cfg.WriteString("autoindex\n")
+	if err := os.WriteFile(filepath.Join(tempDir, name), []byte(cfg.String()),
+		stagedPerm(0600, c.config.AllowContainerRead)); err != nil {
+		return nil, fmt.Errorf("failed to write index config: %w", err)
+	}
+	return []string{"--backend-config", c.containerPath(tempDir, name)}, nil
+}
+
+// withFlags inserts flags after the program name at the start of args.
+func withFlags(args, flags []string) []string {
+	if len(flags) == 0 {
+		return args
+	}
+	return slices.Concat(args[:1], flags, args[1:])
+}
@@ -0,0 +1,136 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSolve_IndexPaths(t *testing.T) {
+	var got []string
+	var indexConfig string
+	handler := func(workDir string, args []string, w io.Writer) error {
+		got = args
+		if i := slices.Index(args, "--backend-config"); i >= 0 {
+			data, err := os.ReadFile(filepath.Join(workDir, filepath.Base(args[i+1])))
+			if err != nil {
+				return err
+			}
+			indexConfig = string(data)
+		}
+		return nil
+	}
+	series4100, series5200 := t.TempDir(), t.TempDir()
+	client, err := NewClient(&ClientConfig{IndexPath: series4100, IndexPaths: []string{series5200}, TempDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	client.run = fakeRunner(handler)
+
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	argsStr := strings.Join(got, " ")
+	for _, want := range []string{
+		fmt.Sprintf("-v %s:%s ", series4100, DefaultIndexMountPath),
+		fmt.Sprintf("-v %s:%s-2 ", series5200, DefaultIndexMountPath),
+		DefaultDockerImage + " solve-field --backend-config /data/" + indexConfigName,
+	} {
+		if !strings.Contains(argsStr, want) {
+			t.Errorf("docker args %q missing %q", argsStr, want)
+		}
+	}
+	wantConfig := fmt.Sprintf("add_path %s\nadd_path %s-2\nautoindex\n", DefaultIndexMountPath, DefaultIndexMountPath)
+	if indexConfig != wantConfig {
+		t.Errorf("index config = %q, want %q", indexConfig, wantConfig)
+	}
+
+	// A single directory keeps the image's own astrometry.cfg
+	client = newTestClient(t, handler)
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if slices.Contains(got, "--backend-config") || strings.Contains(strings.Join(got, " "), DefaultIndexMountPath+"-2") {
+		t.Errorf("single index directory args = %q", got)
+	}
+}
+
+func TestNewClient_IndexPaths(t *testing.T) {
+	series4100, series5200 := t.TempDir(), t.TempDir()
+	if err := os.WriteFile(filepath.Join(series4100, "index-4110.fits"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// IndexPaths alone is enough, and repeats are mounted once
+	client, err := NewClient(&ClientConfig{IndexPaths: []string{series4100, series5200, series4100}})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if got := client.config.indexPaths(); !slices.Equal(got, []string{series4100, series5200}) {
+		t.Errorf("indexPaths() = %q, want both directories once", got)
+	}
+
+	tests := []struct {
+		name    string
+		config  ClientConfig
+		wantErr string
+	}{
+		{"missing directory", ClientConfig{IndexPath: series4100, IndexPaths: []string{filepath.Join(series5200, "missing")}},
+			"does not exist"},
+		{"empty directory validated", ClientConfig{IndexPath: series4100, IndexPaths: []string{series5200}, ValidateIndexes: true},
+			"no index-*.fits files in IndexPath " + series5200},
+		{"no directories", ClientConfig{IndexPaths: []string{""}}, "IndexPath is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(&tt.config)
+			if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewClient error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStartContainer_IndexPaths(t *testing.T) {
+	f := &fakeDocker{indexes: "index-4110.fits\n"}
+	client := newExecTestClient(t, f)
+	series5200 := t.TempDir()
+	if err := client.UpdateConfig(func(cfg *ClientConfig) {
+		cfg.IndexPaths = []string{series5200}
+		cfg.IndexMountPath = "/indexes/"
+	}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	if err := client.StartContainer(context.Background(), nil); err != nil {
+		t.Fatalf("StartContainer failed: %v", err)
+	}
+	var run []string
+	var listed []string
+	for _, args := range f.calls {
+		switch {
+		case args[0] == "run":
+			run = args
+		case args[0] == "exec" && args[2] == "ls":
+			listed = append(listed, args[len(args)-1])
+		}
+	}
+	runStr := strings.Join(run, " ")
+	for _, want := range []string{
+		fmt.Sprintf("-v %s:/indexes/:ro", client.Config().IndexPath),
+		fmt.Sprintf("-v %s:/indexes-2:ro", series5200),
+	} {
+		if !strings.Contains(runStr, want) {
+			t.Errorf("docker run args %q missing %q", run, want)
+		}
+	}
+	if want := []string{"/indexes/", "/indexes-2"}; !slices.Equal(listed, want) {
+		t.Errorf("listed %q in the container, want %q", listed, want)
+	}
+}
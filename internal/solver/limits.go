@@ -0,0 +1,41 @@
+package solver
+
+import "fmt"
+
+// validateImageLimits checks that MaxImagePixels, MaxImageBytes and
+// MaxFieldDegrees are not negative.
+func validateImageLimits(config *ClientConfig) error {
+	if config.MaxImagePixels < 0 {
+		return fmt.Errorf("%w: MaxImagePixels must not be negative", ErrInvalidInput)
+	}
+	if config.MaxImageBytes < 0 {
+		return fmt.Errorf("%w: MaxImageBytes must not be negative", ErrInvalidInput)
+	}
+	if config.MaxFieldDegrees < 0 {
+		return fmt.Errorf("%w: MaxFieldDegrees must not be negative", ErrInvalidInput)
+	}
+	return nil
+}
+
+// checkImageLimits returns ErrInvalidInput when the image at imagePath,
+// size bytes long, exceeds MaxImageBytes or MaxImagePixels. Dimensions are
+// read from the image header without decoding pixels; an image whose
+// dimensions cannot be read is rejected while MaxImagePixels is set.
+func (c *Client) checkImageLimits(imagePath string, size int64) error {
+	if limit := c.config.MaxImageBytes; limit > 0 && size > limit {
+		return fmt.Errorf("%w: image is %d bytes, more than MaxImageBytes %d", ErrInvalidInput, size, limit)
+	}
+	limit := c.config.MaxImagePixels
+	if limit == 0 {
+		return nil
+	}
+	width, height, err := imageDimensions(imagePath)
+	if err != nil {
+		return fmt.Errorf("%w: cannot read image dimensions to check MaxImagePixels: %v", ErrInvalidInput, err)
+	}
+	if pixels := int64(width) * int64(height); pixels > limit {
+		return fmt.Errorf("%w: image is %dx%d (%d pixels), more than MaxImagePixels %d",
+			ErrInvalidInput, width, height, pixels, limit)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSolve_MaxImageBytes(t *testing.T) {
+	runs := 0
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		runs++
+		return nil
+	})
+	imagePath := writeTestPNG(t, 16, 16)
+	info, err := os.Stat(imagePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.MaxImageBytes = info.Size() - 1 }); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	_, err = client.Solve(context.Background(), imagePath, nil)
+	if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), "MaxImageBytes") {
+		t.Errorf("err = %v, want ErrInvalidInput for MaxImageBytes", err)
+	}
+	if runs != 0 {
+		t.Errorf("solver ran %d times for an oversized image, want 0", runs)
+	}
+
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.MaxImageBytes = info.Size() }); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if _, err := client.Solve(context.Background(), imagePath, nil); err != nil {
+		t.Errorf("image at the limit rejected: %v", err)
+	}
+}
+
+func TestSolve_MaxImagePixels(t *testing.T) {
+	runs := 0
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		runs++
+		return nil
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.MaxImagePixels = 40 * 30 }); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 40, 30), nil); err != nil {
+		t.Errorf("image at the limit rejected: %v", err)
+	}
+
+	_, err := client.Solve(context.Background(), writeTestPNG(t, 41, 30), nil)
+	if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), "41x30") {
+		t.Errorf("err = %v, want ErrInvalidInput naming the dimensions", err)
+	}
+
+	// A compressed FITS is limited by its decompressed size
+	_, err = client.Solve(context.Background(), writeFpackFITS(t), nil)
+	if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), "100x80") {
+		t.Errorf("fpack: err = %v, want ErrInvalidInput naming the dimensions", err)
+	}
+
+	// Unreadable dimensions cannot be checked, so they are rejected
+	unknown := filepath.Join(t.TempDir(), "frame.cr2")
+	if err := os.WriteFile(unknown, []byte("raw sensor data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Solve(context.Background(), unknown, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("unreadable dimensions: err = %v, want ErrInvalidInput", err)
+	}
+	if runs != 1 {
+		t.Errorf("solver ran %d times, want 1 for the image within the limit", runs)
+	}
+}
+
+func TestValidateConfig_ImageLimits(t *testing.T) {
+	for name, cfg := range map[string]*ClientConfig{
+		"MaxImageBytes":  {IndexPath: t.TempDir(), MaxImageBytes: -1},
+		"MaxImagePixels": {IndexPath: t.TempDir(), MaxImagePixels: -1},
+	} {
+		if _, err := NewClient(cfg); !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), name) {
+			t.Errorf("%s: err = %v, want ErrInvalidInput", name, err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// StreamContainerLogs follows the exec-mode container's output with
+// docker logs -f, copying each line to w as it arrives, until ctx is
+// cancelled or the container stops. When ClientConfig.ContainerLogLevel is
+// set, only lines starting with it are written.
+//
+// It blocks while streaming and returns nil once ctx is cancelled, so run
+// it in its own goroutine alongside long solves:
+//
+//	ctx, stop := context.WithCancel(context.Background())
+//	defer stop()
+//	go func() {
+//		if err := c.StreamContainerLogs(ctx, os.Stderr); err != nil {
+//			log.Printf("log stream: %v", err)
+//		}
+//	}()
+//
+// Writes to w come from a single goroutine. It returns ErrInvalidInput
+// unless UseDockerExec is enabled with a ContainerName.
+func (c *Client) StreamContainerLogs(ctx context.Context, w io.Writer) error {
+	c = c.snapshot()
+	if !c.config.UseDockerExec || c.config.ContainerName == "" {
+		return fmt.Errorf("%w: log streaming requires UseDockerExec and ContainerName", ErrInvalidInput)
+	}
+
+	lw := &logLineWriter{w: w, prefix: []byte(c.config.ContainerLogLevel)}
+	err := c.run(ctx, "docker", []string{"logs", "-f", c.config.ContainerName}, lw)
+	if flushErr := lw.flush(); err == nil {
+		err = flushErr
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: docker logs: %v", ErrDockerFailed, err)
+	}
+	return nil
+}
+
+// logLineWriter splits output into lines and forwards those starting with
+// prefix (all lines when prefix is empty), holding back a partial line
+// until it is completed or flushed.
+type logLineWriter struct {
+	w       io.Writer
+	prefix  []byte
+	partial []byte
+}
+
+func (lw *logLineWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i == -1 {
+			lw.partial = append(lw.partial, p...)
+			break
+		}
+		line := p[:i+1]
+		if len(lw.partial) > 0 {
+			line = append(lw.partial, line...)
+			lw.partial = lw.partial[:0]
+		}
+		if err := lw.emit(line); err != nil {
+			return 0, err
+		}
+		p = p[i+1:]
+	}
+	return n, nil
+}
+
+// flush writes any trailing line that did not end in a newline.
+func (lw *logLineWriter) flush() error {
+	if len(lw.partial) == 0 {
+		return nil
+	}
+	line := lw.partial
+	lw.partial = nil
+	return lw.emit(line)
+}
+
+func (lw *logLineWriter) emit(line []byte) error {
+	if !bytes.HasPrefix(line, lw.prefix) {
+		return nil
+	}
+	_, err := lw.w.Write(line)
+	return err
+}
@@ -0,0 +1,136 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lineCollector is a goroutine-safe writer that signals each write.
+type lineCollector struct {
+	mu    sync.Mutex
+	buf   bytes.Buffer
+	wrote chan struct{}
+}
+
+func (l *lineCollector) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buf.Write(p)
+	select {
+	case l.wrote <- struct{}{}:
+	default:
+	}
+	return len(p), nil
+}
+
+func (l *lineCollector) lines() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return strings.Split(strings.TrimSuffix(l.buf.String(), "\n"), "\n")
+}
+
+func TestStreamContainerLogs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake docker binary is a shell script")
+	}
+
+	// A fake docker that prints five log lines, then follows forever
+	bin := t.TempDir()
+	script := "#!/bin/sh\n" +
+		"[ \"$1 $2 $3\" = \"logs -f astrometry\" ] || exit 2\n" +
+		"for i in 1 2 3 4 5; do echo \"solve-field: line $i\"; done\n" +
+		"exec sleep 60\n"
+	if err := os.WriteFile(filepath.Join(bin, "docker"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	client, err := NewClient(&ClientConfig{
+		IndexPath:     t.TempDir(),
+		UseDockerExec: true,
+		ContainerName: "astrometry",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := &lineCollector{wrote: make(chan struct{}, 1)}
+	done := make(chan error, 1)
+	go func() { done <- client.StreamContainerLogs(ctx, out) }()
+
+	deadline := time.After(5 * time.Second)
+	for len(out.lines()) < 5 {
+		select {
+		case <-out.wrote:
+		case err := <-done:
+			t.Fatalf("stream ended early: %v (got %q)", err, out.lines())
+		case <-deadline:
+			t.Fatalf("timed out waiting for log lines, got %q", out.lines())
+		}
+	}
+	for i, line := range out.lines() {
+		if want := "solve-field: line " + string(rune('1'+i)); line != want {
+			t.Errorf("line %d = %q, want %q", i, line, want)
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("StreamContainerLogs returned %v after cancel, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not stop after cancel")
+	}
+}
+
+func TestStreamContainerLogs_LevelFilter(t *testing.T) {
+	client := newTestClient(t, nil)
+	client.config.UseDockerExec = true
+	client.config.ContainerName = "astrometry"
+	client.config.ContainerLogLevel = "ERROR"
+	client.run = func(ctx context.Context, name string, args []string, w io.Writer) error {
+		// Lines split across writes, and a final line without a newline
+		for _, chunk := range []string{"INFO start\nERR", "OR no index\nINFO ", "done\n", "ERROR exiting"} {
+			if _, err := io.WriteString(w, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var out bytes.Buffer
+	if err := client.StreamContainerLogs(context.Background(), &out); err != nil {
+		t.Fatalf("StreamContainerLogs failed: %v", err)
+	}
+	if want := "ERROR no index\nERROR exiting"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestStreamContainerLogs_Errors(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		return errors.New("exit status 1")
+	})
+	if err := client.StreamContainerLogs(context.Background(), io.Discard); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("run mode: expected ErrInvalidInput, got %v", err)
+	}
+
+	client.config.UseDockerExec = true
+	client.config.ContainerName = "missing"
+	if err := client.StreamContainerLogs(context.Background(), io.Discard); !errors.Is(err, ErrDockerFailed) {
+		t.Errorf("failed docker logs: expected ErrDockerFailed, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Metrics receives an observation for every Solve call. Implementations
+// must be safe for concurrent use.
+//
+// reason is empty for solved images. Otherwise it is one of a fixed set of
+// values, so it can be used directly as a metric label:
+//
+//	"no_solution"       solve-field ran but found no solution
+//	"timeout"           the solve exceeded its Timeout
+//	"canceled"          the caller's context was cancelled
+//	"invalid_input"     the image or options were rejected
+//	"no_scratch_space"  no scratch directory had enough free space
+//	"wcs_parse_failed"  the solution file could not be parsed
+//	"error"             any other failure
+type Metrics interface {
+	ObserveSolve(duration time.Duration, solved bool, reason string)
+}
+
+// TimingMetrics is a Metrics that also receives the solver's own wall and
+// CPU time for each solve that reported them, so dashboards can separate
+// infrastructure overhead (duration minus solverWall) from solver
+// performance. ClientConfig.Metrics values implementing it get both calls.
+type TimingMetrics interface {
+	Metrics
+	ObserveSolverTime(solverWall, solverCPU time.Duration, solved bool)
+}
+
+// PoolMetrics is a Metrics that also learns, for each Solve when
+// ClientConfig.WarmPoolSize is set, whether it was a warm pool hit (an idle
+// container was leased) or a cold start (a container had to be started,
+// or the solve fell back to docker run because every container was
+// leased). ClientConfig.Metrics values implementing it get both calls.
+type PoolMetrics interface {
+	Metrics
+	ObservePoolLease(hit bool)
+}
+
+// NopMetrics is a Metrics that discards all observations. It is used when
+// ClientConfig.Metrics is nil.
+type NopMetrics struct{}
+
+// ObserveSolve does nothing.
+func (NopMetrics) ObserveSolve(time.Duration, bool, string) {}
+
+// observeSolve reports the outcome of a solve to the configured Metrics.
+func (c *Client) observeSolve(duration time.Duration, result *Result, err error) {
+	metrics := c.config.Metrics
+	if metrics == nil {
+		return
+	}
+	solved := err == nil && result != nil && result.Solved
+	reason := ""
+	if !solved {
+		reason = failureReason(err)
+	}
+	metrics.ObserveSolve(duration, solved, reason)
+	if timing, ok := metrics.(TimingMetrics); ok && result != nil && result.SolverWallTime > 0 {
+		timing.ObserveSolverTime(seconds(result.SolverWallTime), seconds(result.SolverCPUTime), solved)
+	}
+}
+
+// seconds converts seconds to a time.Duration.
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// failureReason maps a Solve error to a Metrics reason label.
+func failureReason(err error) string {
+	switch {
+	case err == nil, errors.Is(err, ErrNoSolution):
+		return "no_solution"
+	case errors.Is(err, ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, ErrInvalidInput):
+		return "invalid_input"
+	case errors.Is(err, ErrNoScratchSpace):
+		return "no_scratch_space"
+	case errors.Is(err, ErrWCSParseFailed):
+		return "wcs_parse_failed"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,204 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// recordingMetrics records every ObserveSolve call.
+type recordingMetrics struct {
+	mu           sync.Mutex
+	observations []observation
+}
+
+type observation struct {
+	duration time.Duration
+	solved   bool
+	reason   string
+}
+
+func (m *recordingMetrics) ObserveSolve(duration time.Duration, solved bool, reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.observations = append(m.observations, observation{duration, solved, reason})
+}
+
+func TestSolve_Metrics(t *testing.T) {
+	wcs := fits.EncodeHeader([]fits.Card{
+		{Key: "CRVAL1", Value: "83.8"}, {Key: "CRVAL2", Value: "-5.4"},
+		{Key: "CRPIX1", Value: "4"}, {Key: "CRPIX2", Value: "4"},
+		{Key: "CD1_1", Value: "-0.001"}, {Key: "CD1_2", Value: "0"},
+		{Key: "CD2_1", Value: "0"}, {Key: "CD2_2", Value: "0.001"},
+		{Key: "IMAGEW", Value: "8"}, {Key: "IMAGEH", Value: "8"},
+	})
+
+	tests := []struct {
+		name       string
+		solve      bool
+		timeout    time.Duration
+		imagePath  func(t *testing.T) string
+		wantErr    error
+		wantSolved bool
+		wantReason string
+	}{
+		{
+			name:       "solved",
+			solve:      true,
+			wantSolved: true,
+		},
+		{
+			name:       "no solution",
+			wantReason: "no_solution",
+		},
+		{
+			name:       "timeout",
+			timeout:    time.Nanosecond,
+			wantErr:    ErrTimeout,
+			wantReason: "timeout",
+		},
+		{
+			name:       "missing image",
+			imagePath:  func(t *testing.T) string { return filepath.Join(t.TempDir(), "missing.png") },
+			wantErr:    ErrInvalidInput,
+			wantReason: "invalid_input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := &recordingMetrics{}
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				time.Sleep(time.Millisecond)
+				if tt.solve {
+					return os.WriteFile(filepath.Join(workDir, "stars.wcs"), wcs, 0644)
+				}
+				return nil
+			})
+			if err := client.UpdateConfig(func(cfg *ClientConfig) {
+				cfg.Metrics = metrics
+				if tt.timeout > 0 {
+					cfg.Timeout = tt.timeout
+				}
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			imagePath := writeTestPNG(t, 8, 8)
+			if tt.imagePath != nil {
+				imagePath = tt.imagePath(t)
+			}
+			result, err := client.Solve(context.Background(), imagePath, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Solve error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && result.Solved != tt.wantSolved {
+				t.Fatalf("Solved = %v, want %v", result.Solved, tt.wantSolved)
+			}
+
+			if len(metrics.observations) != 1 {
+				t.Fatalf("ObserveSolve called %d times, want 1", len(metrics.observations))
+			}
+			got := metrics.observations[0]
+			if got.solved != tt.wantSolved || got.reason != tt.wantReason {
+				t.Errorf("ObserveSolve(solved=%v, reason=%q), want (%v, %q)",
+					got.solved, got.reason, tt.wantSolved, tt.wantReason)
+			}
+			if got.duration <= 0 {
+				t.Errorf("duration = %v, want > 0", got.duration)
+			}
+		})
+	}
+}
+
+func TestSolveBytes_MetricsObservedOnce(t *testing.T) {
+	metrics := &recordingMetrics{}
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error { return nil })
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.Metrics = metrics }); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.SolveBytes(context.Background(), []byte("not an image"), "jpg", nil); err != nil {
+		t.Fatalf("SolveBytes failed: %v", err)
+	}
+	if len(metrics.observations) != 1 || metrics.observations[0].reason != "no_solution" {
+		t.Errorf("observations = %+v, want one no_solution", metrics.observations)
+	}
+}
+
+func TestNopMetrics(t *testing.T) {
+	var m Metrics = NopMetrics{}
+	m.ObserveSolve(time.Second, true, "")
+}
+
+// timingMetrics records ObserveSolverTime calls as well.
+type timingMetrics struct {
+	recordingMetrics
+	wall, cpu []time.Duration
+}
+
+func (m *timingMetrics) ObserveSolverTime(solverWall, solverCPU time.Duration, solved bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.wall, m.cpu = append(m.wall, solverWall), append(m.cpu, solverCPU)
+}
+
+func TestSolve_SolverTime(t *testing.T) {
+	// Longer than the fake solve takes, so the overhead is clamped to zero
+	const log = "Field 1: solved with index index-4107.fits.\n" +
+		"Spent 2.84 s user, 0.19 s system, 3.03 s total, 3.11 s wall time.\n"
+	metrics := &timingMetrics{}
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		if _, err := io.WriteString(w, log); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(workDir, "stars.wcs"), fits.EncodeHeader(wcsCards(0.001)), 0644)
+	})
+	client.config.Metrics = metrics
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.SolverWallTime != 3.11 || result.SolverCPUTime != 3.03 {
+		t.Errorf("solver times = %v wall, %v CPU, want 3.11, 3.03", result.SolverWallTime, result.SolverCPUTime)
+	}
+	if result.TotalTime <= 0 || result.SolveTime != result.TotalTime {
+		t.Errorf("TotalTime = %v, SolveTime = %v, want equal and positive", result.TotalTime, result.SolveTime)
+	}
+	// The fake runner returns at once, so the reported time exceeds the total
+	if result.Overhead() != 0 {
+		t.Errorf("Overhead = %v, want 0", result.Overhead())
+	}
+
+	if len(metrics.observations) != 1 || len(metrics.wall) != 1 {
+		t.Fatalf("observed %d solves and %d solver times, want 1 each", len(metrics.observations), len(metrics.wall))
+	}
+	if metrics.wall[0] != 3110*time.Millisecond || metrics.cpu[0] != 3030*time.Millisecond {
+		t.Errorf("ObserveSolverTime(%v, %v), want (3.11s, 3.03s)", metrics.wall[0], metrics.cpu[0])
+	}
+}
+
+func TestResult_Overhead(t *testing.T) {
+	tests := []struct {
+		total, solverWall, want float64
+	}{
+		{6, 3.5, 2.5},
+		{6, 0, 0}, // not reported
+		{3, 3.1, 0},
+	}
+	for _, tt := range tests {
+		r := &Result{TotalTime: tt.total, SolverWallTime: tt.solverWall}
+		if got := r.Overhead(); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Overhead() with total %v, solver %v = %v, want %v", tt.total, tt.solverWall, got, tt.want)
+		}
+	}
+}
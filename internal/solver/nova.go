@@ -0,0 +1,58 @@
+package solver
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// novaCalibration is the body of nova.astrometry.net's
+// /api/jobs/<id>/calibration/ response.
+type novaCalibration struct {
+	RA          float64 `json:"ra"`
+	Dec         float64 `json:"dec"`
+	Radius      float64 `json:"radius"`
+	PixScale    float64 `json:"pixscale"`
+	Orientation float64 `json:"orientation"`
+	Parity      float64 `json:"parity"`
+}
+
+// NovaJSON returns the solution in the shape of nova.astrometry.net's job
+// calibration response, so code written against nova's API can read local
+// solves unchanged:
+//
+//	{"ra": 83.82, "dec": -5.39, "radius": 3.83, "pixscale": 4.0, "orientation": 90.0, "parity": 1.0}
+//
+// ra and dec are the field center in degrees, radius the distance from the
+// center to a corner in degrees, and pixscale in arcseconds per pixel. The
+// orientation (degrees) and parity (1.0 when the CD matrix determinant is
+// positive, which is ParityNegative here, otherwise -1.0) follow nova's
+// own definitions, so compare them with nova output rather than Rotation
+// and Parity. A projection PixelToSky does not implement returns
+// ErrUnsupportedProjection.
+func (r *Result) NovaJSON() ([]byte, error) {
+	if _, err := wcsProjection(r.wcsHeader()); err != nil {
+		return nil, err
+	}
+	wcs, width, height, ok := r.skyWCS()
+	if !r.Solved || !ok {
+		return nil, fmt.Errorf("%w: result has no WCS solution", ErrInvalidInput)
+	}
+
+	// As in nova's Calibration.get_parity and get_orientation
+	parity := -1.0
+	if wcs.cd11*wcs.cd22-wcs.cd12*wcs.cd21 >= 0 {
+		parity = 1.0
+	}
+	t := parity*wcs.cd11 + wcs.cd22
+	a := parity*wcs.cd21 - wcs.cd12
+
+	return json.Marshal(novaCalibration{
+		RA:          r.RA,
+		Dec:         r.Dec,
+		Radius:      r.PixelScale * math.Hypot(width, height) / 2 / 3600,
+		PixScale:    r.PixelScale,
+		Orientation: -math.Atan2(a, t) * rad2deg,
+		Parity:      parity,
+	})
+}
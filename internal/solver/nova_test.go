@@ -0,0 +1,84 @@
+package solver
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// novaExample is the calibration response documented in nova.astrometry.net's
+// API guide.
+const novaExample = `{"parity": 1.0, "orientation": 105.74942079091929, "pixscale": 1.0906710701159739,
+	"radius": 0.8106715896625917, "ra": 169.77880039180235, "dec": 13.09118758244306}`
+
+// jsonShape returns the sorted keys of a JSON object and the Go type each
+// value decodes to.
+func jsonShape(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("invalid JSON %s: %v", data, err)
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	shape := make(map[string]string, len(keys))
+	for _, k := range keys {
+		shape[k] = reflect.TypeOf(fields[k]).String()
+	}
+	return shape
+}
+
+func TestNovaJSON(t *testing.T) {
+	// 4"/px, 6000x4000, north up and east left (negative determinant)
+	s := 4.0 / 3600
+	result, err := ResultFromWCSHeader(map[string]string{
+		"CTYPE1": "RA---TAN", "CTYPE2": "DEC--TAN",
+		"CRVAL1": "83.82", "CRVAL2": "-5.39", "CRPIX1": "3000.5", "CRPIX2": "2000.5",
+		"CD1_1": formatFloat(-s), "CD1_2": "0", "CD2_1": "0", "CD2_2": formatFloat(s),
+	}, 6000, 4000)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+
+	data, err := result.NovaJSON()
+	if err != nil {
+		t.Fatalf("NovaJSON failed: %v", err)
+	}
+	if got, want := jsonShape(t, data), jsonShape(t, []byte(novaExample)); !reflect.DeepEqual(got, want) {
+		t.Errorf("shape = %v, want nova's %v", got, want)
+	}
+
+	var cal novaCalibration
+	if err := json.Unmarshal(data, &cal); err != nil {
+		t.Fatal(err)
+	}
+	wantRadius := 4 * math.Hypot(6000, 4000) / 2 / 3600
+	if cal.RA != result.RA || cal.Dec != result.Dec || math.Abs(cal.PixScale-4) > 1e-6 ||
+		math.Abs(cal.Radius-wantRadius) > 1e-6 || math.Abs(cal.Orientation) > 1e-6 || cal.Parity != -1 {
+		t.Errorf("calibration = %+v, want center %.4f/%.4f, 4\"/px, radius %.3f, orientation 0, parity -1",
+			cal, result.RA, result.Dec, wantRadius)
+	}
+
+	// A mirror image has nova parity 1
+	result.WCSHeader["CD1_1"] = formatFloat(s)
+	data, err = result.NovaJSON()
+	if err != nil {
+		t.Fatalf("NovaJSON failed: %v", err)
+	}
+	if err := json.Unmarshal(data, &cal); err != nil {
+		t.Fatal(err)
+	}
+	if cal.Parity != 1 || math.Abs(cal.Orientation) > 1e-6 {
+		t.Errorf("mirrored: parity %v orientation %v, want 1 and 0", cal.Parity, cal.Orientation)
+	}
+
+	if _, err := (&Result{}).NovaJSON(); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("unsolved: err = %v, want ErrInvalidInput", err)
+	}
+}
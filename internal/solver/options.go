@@ -2,12 +2,27 @@
 // via Docker containers (dm90/astrometry or ghcr.io/diarmuidkelly/astrometry-dockerised-solver).
 package solver
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
 
 const (
 	// DefaultDockerImage is the default Docker image used for plate-solving
 	// Compatible images: "dm90/astrometry", "diarmuidk/astrometry-dockerised-solver", "ghcr.io/diarmuidkelly/astrometry-dockerised-solver"
 	DefaultDockerImage = "diarmuidk/astrometry-dockerised-solver"
+
+	// DefaultDataMountPath is where docker run mounts the working directory
+	// in the container.
+	DefaultDataMountPath = "/data"
+
+	// DefaultIndexMountPath is where docker run mounts IndexPath in the
+	// container, the index directory of the compatible images.
+	DefaultIndexMountPath = "/usr/local/astrometry/data"
 )
 
 // ClientConfig holds configuration for the Astrometry client.
@@ -17,11 +32,32 @@ type ClientConfig struct {
 	// Default: "diarmuidk/astrometry-dockerised-solver"
 	DockerImage string
 
+	// FallbackImages are solver images Solve tries in turn, in run mode,
+	// when DockerImage finds no solution or fails with ErrDockerFailed, as a
+	// workaround for a bug in one image version. The first image to solve
+	// wins and is recorded in Result.DockerImage. Ignored with
+	// UseDockerExec, whose container is fixed.
+	// Default: nil (DockerImage only)
+	FallbackImages []string
+
 	// IndexPath is the host path to the astrometry index files.
 	// This directory will be mounted into the Docker container.
-	// Required.
+	// Required unless IndexPaths is set.
 	IndexPath string
 
+	// IndexPaths lists further index directories, such as the 4100 and
+	// 5200 series kept apart, searched along with IndexPath. The first
+	// directory (IndexPath when set) is mounted at IndexMountPath and the
+	// others at IndexMountPath-2, -3 and so on. With more than one
+	// directory, solve-field is given a generated astrometry-engine
+	// config (--backend-config) listing every mount in place of the
+	// image's astrometry.cfg, so settings made there, such as inparallel
+	// or cpulimit, no longer apply. ArgTemplate command lines do not get
+	// the generated config. In exec mode the container must have the
+	// directories mounted at those paths, as StartContainer does.
+	// Default: nil (IndexPath only)
+	IndexPaths []string
+
 	// TempDir is the working directory for images and output files.
 	// If empty, the system temporary directory will be used.
 	TempDir string
@@ -32,16 +68,191 @@ type ClientConfig struct {
 
 	// UseDockerExec enables using docker exec on an existing container
 	// instead of spawning new containers with docker run.
-	// When true, ContainerName must be specified.
+	// When true, ContainerName must be specified; NewClient returns
+	// ErrInvalidInput otherwise.
 	// Default: false
 	UseDockerExec bool
 
 	// ContainerName is the name of the running container to exec commands in.
 	// Only used when UseDockerExec is true.
 	ContainerName string
+
+	// WarmPoolSize, in run mode, keeps up to this many solver containers
+	// idling for Solve to docker exec into, saving docker run's start-up
+	// cost on each solve. Containers are started by WarmPool or on first
+	// use, mount IndexPath, TempDir and ScratchDirs like StartContainer,
+	// and are removed by Close. A solve that finds every container leased
+	// runs in a plain docker run container. Only the DockerImage attempt of
+	// Solve uses the pool. Ignored with UseDockerExec.
+	// Default: 0 (no pool)
+	WarmPoolSize int
+
+	// WarmPoolMaxUses replaces a pooled container after this many solves.
+	// A container is also replaced after a solve that timed out, was
+	// cancelled or failed with ErrDockerFailed.
+	// Default: 0 (no limit)
+	WarmPoolMaxUses int
+
+	// WarmPoolIdleTimeout removes pooled containers left idle this long.
+	// Default: 0 (kept until Close)
+	WarmPoolIdleTimeout time.Duration
+
+	// DataMountPath is the absolute path in the container where docker run
+	// mounts the working directory; image and output paths passed to the
+	// tools are under it. Only used when UseDockerExec is false.
+	// Default: "/data"
+	DataMountPath string
+
+	// IndexMountPath is the absolute path in the container where docker run
+	// mounts IndexPath (or the first of IndexPaths). It must be a directory
+	// the image's astrometry.cfg searches (add_path). Only used when
+	// UseDockerExec is false, and by StartContainer.
+	// Default: "/usr/local/astrometry/data"
+	IndexMountPath string
+
+	// ContainerLogLevel filters StreamContainerLogs output to lines that
+	// start with this prefix (e.g. "ERROR" or "solve-field"). Only used
+	// when UseDockerExec is true.
+	// Default: "" (all lines)
+	ContainerLogLevel string
+
+	// Extractor replaces solve-field's built-in star detection in Solve:
+	// its sources are written as an xylist and solved like SolveXYList.
+	// ThresholdExtractor is a pure Go implementation. It cannot be combined
+	// with ArgTemplate.
+	// Default: nil (solve-field detects stars with simplexy)
+	Extractor Extractor
+
+	// ArgTemplate replaces the generated solve-field command line with a
+	// caller-validated one, for edge cases the options do not cover. The
+	// first element is the program, normally "solve-field". Placeholders
+	// are substituted in each element:
+	//
+	//	{image}       the image path inside the container (required)
+	//	{dir}         the working directory inside the container
+	//	{scale_low}   SolveOptions.ScaleLow
+	//	{scale_high}  SolveOptions.ScaleHigh
+	//	{scale_units} SolveOptions.ScaleUnits
+	//	{downsample}  SolveOptions.DownsampleFactor
+	//	{depth_low}   SolveOptions.DepthLow
+	//	{depth_high}  SolveOptions.DepthHigh
+	//	{ra}          SolveOptions.RA
+	//	{dec}         SolveOptions.Dec
+	//	{radius}      SolveOptions.Radius
+	//
+	// Other SolveOptions fields, including ExtraArgs, are ignored. Output
+	// files must keep solve-field's default names in {dir} (its default
+	// when --dir is omitted) so the result can be read. SolveXYList does
+	// not use the template.
+	// Default: nil (command line built from SolveOptions)
+	ArgTemplate []string
+
+	// ReuseWorkDir makes every operation in exec mode share a single
+	// working directory (astrometry-shared under TempDir or the chosen
+	// scratch directory), isolating calls by a unique filename prefix
+	// instead of creating and removing a directory per call. This avoids
+	// mkdir/rmdir churn on network volumes at very high throughput.
+	//
+	// Isolation is by name only: concurrent solves are safe with each
+	// other, but anything else writing into the shared directory can
+	// collide with them, files from crashed or KeepTempFiles runs
+	// accumulate there until removed by hand, and a very large number of
+	// concurrent solves means a very large directory.
+	// Only used when UseDockerExec is true.
+	// Default: false
+	ReuseWorkDir bool
+
+	// AllowContainerRead makes staged images world-readable (0644 instead
+	// of at most 0600) and workspace directories 0755 instead of 0700, for
+	// containers whose user cannot otherwise read host files, such as
+	// user-namespace remapped Docker. It only grants read access; the
+	// container user still needs write access to the workspace for its
+	// output files.
+	// Default: false
+	AllowContainerRead bool
+
+	// ScratchDirs lists fast local directories for per-solve workspaces,
+	// tried in order. A directory is skipped when its free space is below
+	// ScratchMinFreeBytes (or twice the image size, whichever is larger).
+	// If none qualify, solving fails with ErrNoScratchSpace.
+	// When empty, TempDir is used without a free-space check.
+	ScratchDirs []string
+
+	// ScratchMinFreeBytes is the minimum free space required in a scratch directory.
+	// Default: twice the size of the image being solved
+	ScratchMinFreeBytes uint64
+
+	// ValidateIndexes makes NewClient check that every index directory
+	// (IndexPath and IndexPaths) contains at least one index-*.fits file,
+	// failing early with ErrInvalidInput instead of deep inside the first
+	// solve.
+	// Default: false
+	ValidateIndexes bool
+
+	// Metrics receives the duration and outcome of every Solve call, for
+	// exporting to Prometheus or another metrics system.
+	// Default: nil (no metrics are recorded)
+	Metrics Metrics
+
+	// MaxOutputBytes caps how much solve-field/image2xy output is kept in
+	// memory. Beyond it, the start and the most recent output are kept and
+	// the middle is dropped with a truncation marker, so very verbose solves
+	// cannot exhaust memory.
+	// Default: 4 MiB
+	MaxOutputBytes int
+
+	// PostSolveHooks run in order after every successful Solve, each on a
+	// copy of the result with DefaultHookTimeout, while the output files
+	// still exist. Wrap a hook in NamedHook to name it, set its timeout or
+	// also run it for unsolved results. Hook failures are recorded in
+	// Result.HookErrors and never fail the solve.
+	// Default: nil (no hooks)
+	PostSolveHooks []Hook
+
+	// AllowedFormats restricts Solve and SolveBytes to these image formats,
+	// detected from the file's contents rather than its name or the format
+	// argument, so a service can reject unexpected uploads before any work
+	// is done. Entries are "jpg", "png", "tiff", "gif", "bmp" or "fits"
+	// (gzip-compressed FITS included); "jpeg", "tif", "fit" and "fts" are
+	// accepted as aliases. Other images fail with ErrInvalidInput.
+	// SolveXYList is not affected.
+	// Default: nil (any format)
+	AllowedFormats []string
+
+	// MaxImageBytes rejects images larger than this many bytes in Solve and
+	// SolveBytes with ErrInvalidInput before any work is done. For
+	// compressed FITS it limits the compressed file; use MaxImagePixels to
+	// limit what it decompresses to.
+	// Default: 0 (no limit)
+	MaxImageBytes int64
+
+	// MaxImagePixels rejects images with more pixels (width × height) than
+	// this in Solve and SolveBytes with ErrInvalidInput, protecting the host
+	// from decompression bombs. Dimensions are read from the header without
+	// decoding the image; images whose dimensions cannot be read are
+	// rejected while it is set.
+	// Default: 0 (no limit)
+	MaxImagePixels int64
+
+	// MaxFieldDegrees rejects Solve calls whose scale bounds (in degwidth
+	// or arcminwidth) only search fields wider than this with
+	// ErrFieldTooWide: all-sky frames defeat the TAN projection and no
+	// index series covers them. Solve a central crop with CropPixels
+	// instead.
+	// Default: 120
+	MaxFieldDegrees float64
 }
 
 // SolveOptions holds parameters for a plate-solving operation.
+//
+// Solving is deterministic, so there is no seed to set: solve-field has no
+// random seed option, and given the same image, options, index files and
+// solver image it extracts the same sources and tries the same quads in
+// the same order, so it finds the same solution. The command line built
+// from a SolveOptions is likewise the same on every call. Runs can still
+// differ when a solve is cut short by Timeout or a --cpulimit in
+// ExtraArgs, since how far it gets depends on machine speed and load;
+// reproducible tests should keep those generous.
 type SolveOptions struct {
 	// ScaleLow is the lower bound of the image scale in the specified units.
 	ScaleLow float64
@@ -49,10 +260,12 @@ type SolveOptions struct {
 	// ScaleHigh is the upper bound of the image scale in the specified units.
 	ScaleHigh float64
 
-	// ScaleUnits specifies the units for ScaleLow and ScaleHigh.
-	// Valid values: "degwidth", "arcminwidth", "arcsecperpix"
-	// Default: "arcminwidth"
-	ScaleUnits string
+	// ScaleUnits specifies the units for ScaleLow and ScaleHigh. See
+	// Warnings for bounds that look meant for other units.
+	// Valid values: UnitDegWidth, UnitArcminWidth, UnitArcsecPerPix,
+	// UnitFocalMM, or solve-field's abbreviations of them
+	// Default: UnitArcminWidth
+	ScaleUnits ScaleUnits
 
 	// DownsampleFactor reduces the image resolution by this factor.
 	// Higher values speed up solving but reduce accuracy.
@@ -77,10 +290,98 @@ type SolveOptions struct {
 	Dec    float64
 	Radius float64
 
+	// HintRadiusSchedule retries a hinted solve with each search radius in
+	// turn, in degrees around RA and Dec, until one solves: []float64{2,
+	// 10, 0} tries 2°, then 10°, then blind, for a hint from a drifting
+	// mount. Radii must increase, and 0 (blind) may only come last. Later
+	// attempts reuse the stars the first one detected rather than
+	// extracting them again (except with ArgTemplate), and all attempts
+	// share one Timeout. Result.HintRadius records the radius that solved.
+	// It replaces Radius, and is ignored without an RA/Dec hint.
+	// Default: nil (one attempt with Radius)
+	HintRadiusSchedule []float64
+
+	// ExpectedRotation and RotationTolerance reject solutions whose
+	// Rotation is more than RotationTolerance degrees from ExpectedRotation,
+	// for a permanently mounted camera whose angle is known. This catches
+	// false matches, which are often flipped by ~180°. solve-field has no
+	// rotation constraint, so the check runs after solving and does not
+	// speed it up. A rejected solution is a *SolveError wrapping
+	// ErrNoSolution, so FallbackImages are still tried. Rotation is in
+	// degrees as in Result.Rotation. Solutions read by SkipIfSolved and
+	// SolveXYList are not checked. SolveSequence frames after a meridian
+	// flip fail the check.
+	// Default: 0 tolerance (no check)
+	ExpectedRotation  float64
+	RotationTolerance float64
+
 	// OverwriteExisting allows overwriting existing output files.
 	// Default: false
 	OverwriteExisting bool
 
+	// NoFITS2FITS skips solve-field's fits2fits sanitizing of FITS input
+	// (--no-fits2fits), for clean FITS files the rewrite would damage or
+	// slow down. It has no effect on other formats.
+	// Default: false
+	NoFITS2FITS bool
+
+	// FITSExtension reads the image from this FITS extension (--extension)
+	// instead of the primary HDU, e.g. 1 for tile-compressed files left
+	// packed.
+	// Default: 0 (primary HDU)
+	FITSExtension int
+
+	// Invert inverts the image before source extraction (--invert), for
+	// images with dark stars on a light sky.
+	// Default: false
+	Invert bool
+
+	// NoRemoveLines skips removing horizontal and vertical lines of
+	// sources (--no-remove-lines), which otherwise drops stars from
+	// sparse, clean fields along with satellite trails and bad columns.
+	// Default: false
+	NoRemoveLines bool
+
+	// NoUniformize skips selecting sources uniformly across the image
+	// (--uniformize 0), keeping the brightest sources wherever they are.
+	// Default: false
+	NoUniformize bool
+
+	// CropPixels solves only the central CropPixels × CropPixels square of
+	// the image (fewer along a shorter side), staged as 8-bit grayscale
+	// FITS, for all-sky and fisheye frames too wide to solve whole. The
+	// result has PartialField set and Crop giving the square's position,
+	// and its WCS applies to the crop. Ignored when the image is no larger.
+	// Default: 0 (whole image)
+	CropPixels int
+
+	// XYListPath solves a star list on disk instead of an image: a FITS
+	// binary table of source positions, such as an .xyls or .axy from
+	// image2xy or a table exported by capture software (Siril,
+	// PixInsight). Solve's image path must then be empty, and SolveBytes
+	// rejects it. Only the first table extension is solved; use
+	// SolveXYList for multi-field lists. The list replaces star detection,
+	// so ClientConfig.Extractor and ArgTemplate, CropPixels and
+	// SkipIfSolved do not apply, and the Result has FromXYList set.
+	// Default: "" (solve the image)
+	XYListPath string
+
+	// ImageWidth and ImageHeight are the size in pixels of the image the
+	// stars of XYListPath or SolveXYList came from, overriding the list's
+	// IMAGEW/IMAGEH cards. Required when the list has no such cards.
+	// Default: 0 (read IMAGEW/IMAGEH from the list)
+	ImageWidth  int
+	ImageHeight int
+
+	// XColumn, YColumn and SortColumn name the star list's columns for
+	// XYListPath and SolveXYList, for tools that do not use image2xy's
+	// names. When SortColumn is set, solve-field tries stars in decreasing
+	// order of it, so it should be a brightness such as FLUX.
+	// Default: "X", "Y" and "" (the list's own order)
+	XColumn    string
+	YColumn    string
+	SortColumn string
+
 	// Verbose enables verbose output from solve-field.
 	// Default: false
 	Verbose bool
@@ -89,6 +390,51 @@ type SolveOptions struct {
 	// When true, temp directory and all solve output files are not deleted.
 	// Default: false
 	KeepTempFiles bool
+
+	// OutputDir, when set, receives a copy of the solve-field output files
+	// (.wcs, .corr, ...) after a successful Solve, named after the image,
+	// and Result.OutputFiles lists the copies. The directory is created if
+	// needed and existing files are overwritten.
+	// Default: "" (outputs are removed with the workspace)
+	OutputDir string
+
+	// KeepExtensions limits which output files Solve keeps, e.g.
+	// []string{".wcs", ".corr"} to skip the large .axy and -indx.xyls:
+	// only these are copied to OutputDir, left in the workspace by
+	// KeepTempFiles, and listed in Result.OutputFiles. Entries must be an
+	// OutputKind such as .wcs, .corr, .axy or -indx.xyls; the leading dot
+	// may be omitted.
+	// Default: nil (all output files)
+	KeepExtensions []string
+
+	// Timeout, when non-zero, overrides ClientConfig.Timeout for this solve.
+	// A deadline on the context passed to Solve still applies if it is
+	// sooner.
+	// Default: 0 (use ClientConfig.Timeout)
+	Timeout time.Duration
+
+	// SkipIfSolved returns a Result from a WCS the image already carries,
+	// without launching a container: the header of a FITS image, or a
+	// sibling .wcs file (image.wcs next to image.jpg) for other formats.
+	// The WCS must pass a minimal check (RA/Dec CTYPEs, CRVAL, CRPIX and a
+	// non-singular CD matrix), so placeholder headers written before any
+	// solve are ignored and the image is solved normally.
+	// Default: false
+	SkipIfSolved bool
+
+	// Force always runs solve-field, overriding SkipIfSolved.
+	// Default: false
+	Force bool
+
+	// ExtraArgs are appended to the solve-field command line after the
+	// options above, for solve-field flags this struct does not cover.
+	ExtraArgs []string
+
+	// Extra holds JSON fields this version does not know, read by
+	// UnmarshalJSON or MigrateOptions from options stored by a newer
+	// version. MarshalJSON writes them back unchanged, so a load/store
+	// cycle loses nothing. They do not affect solving.
+	Extra map[string]json.RawMessage
 }
 
 // DefaultClientConfig returns a ClientConfig with sensible defaults.
@@ -102,7 +448,7 @@ func DefaultClientConfig() *ClientConfig {
 // DefaultSolveOptions returns SolveOptions with sensible defaults.
 func DefaultSolveOptions() *SolveOptions {
 	return &SolveOptions{
-		ScaleUnits:       "arcminwidth",
+		ScaleUnits:       UnitArcminWidth,
 		DownsampleFactor: 2,
 		DepthLow:         10,
 		DepthHigh:        20,
@@ -110,3 +456,157 @@ func DefaultSolveOptions() *SolveOptions {
 		Verbose:          false,
 	}
 }
+
+// ScaleUnits are the units of SolveOptions.ScaleLow and ScaleHigh, as
+// passed to solve-field's --scale-units. String constants convert
+// implicitly, so plain strings such as "degwidth" keep working.
+type ScaleUnits string
+
+// The units solve-field accepts for the scale bounds.
+const (
+	UnitDegWidth     ScaleUnits = "degwidth"     // Field width in degrees
+	UnitArcminWidth  ScaleUnits = "arcminwidth"  // Field width in arcminutes
+	UnitArcsecPerPix ScaleUnits = "arcsecperpix" // Pixel scale in arcseconds per pixel
+	UnitFocalMM      ScaleUnits = "focalmm"      // 35mm-equivalent focal length in millimetres
+)
+
+// scaleUnitAliases maps solve-field's abbreviations to the units they
+// stand for.
+var scaleUnitAliases = map[ScaleUnits]ScaleUnits{
+	"degw": UnitDegWidth, "dw": UnitDegWidth,
+	"amw": UnitArcminWidth, "aw": UnitArcminWidth,
+	"app": UnitArcsecPerPix,
+}
+
+// canonical returns the unit u abbreviates, or u itself.
+func (u ScaleUnits) canonical() ScaleUnits {
+	if full, ok := scaleUnitAliases[u]; ok {
+		return full
+	}
+	return u
+}
+
+// Valid reports whether solve-field accepts u, including abbreviations
+// such as "app". Misspellings like "arcsecperpixel" are not valid.
+func (u ScaleUnits) Valid() bool {
+	switch u.canonical() {
+	case UnitDegWidth, UnitArcminWidth, UnitArcsecPerPix, UnitFocalMM:
+		return true
+	}
+	return false
+}
+
+// String returns u as passed to solve-field.
+func (u ScaleUnits) String() string {
+	return string(u)
+}
+
+// Scale bounds beyond these are suspicious for their ScaleUnits; see
+// SolveOptions.Warnings.
+const (
+	minPlausibleArcminWidth = 5     // Below, likely arcsec/pixel (commonly 1-2)
+	maxPlausibleArcminWidth = 10000 // Above (~167°), likely arcseconds of width
+	maxPlausibleDegWidth    = 180   // Above, wider than the sky; likely arcminutes
+)
+
+// plotArgs are the solve-field flags that configure plots, which NoPlots
+// turns off.
+var plotArgs = []string{"--plot-scale", "--plot-bg"}
+
+// Validate checks the options for out-of-range values and for fields that
+// contradict each other, which solve-field would otherwise resolve
+// silently: only one scale or depth bound set (both are then ignored), a
+// lower bound above the upper, plot flags in ExtraArgs with NoPlots,
+// CropPixels with XYListPath, or a HintRadiusSchedule that does not
+// widen. Solve, SolveBytes and SolveXYList call it before any work is
+// done; call it directly to check options as they are entered. Failures
+// wrap ErrInvalidInput.
+func (o *SolveOptions) Validate() error {
+	switch {
+	case o.Timeout < 0:
+		return fmt.Errorf("%w: Timeout must not be negative", ErrInvalidInput)
+	case o.FITSExtension < 0:
+		return fmt.Errorf("%w: FITSExtension must not be negative", ErrInvalidInput)
+	case o.CropPixels < 0:
+		return fmt.Errorf("%w: CropPixels must not be negative", ErrInvalidInput)
+	case o.DownsampleFactor < 0:
+		return fmt.Errorf("%w: DownsampleFactor must not be negative", ErrInvalidInput)
+	case o.ScaleLow < 0 || o.ScaleHigh < 0:
+		return fmt.Errorf("%w: ScaleLow and ScaleHigh must not be negative", ErrInvalidInput)
+	case (o.ScaleLow > 0) != (o.ScaleHigh > 0):
+		return fmt.Errorf("%w: ScaleLow and ScaleHigh must be set together", ErrInvalidInput)
+	case o.ScaleLow > o.ScaleHigh:
+		return fmt.Errorf("%w: ScaleLow %g is above ScaleHigh %g", ErrInvalidInput, o.ScaleLow, o.ScaleHigh)
+	case o.ScaleLow > 0 && !o.ScaleUnits.Valid():
+		return fmt.Errorf("%w: unknown ScaleUnits %q; want degwidth, arcminwidth, arcsecperpix or focalmm",
+			ErrInvalidInput, o.ScaleUnits)
+	case o.DepthLow < 0 || o.DepthHigh < 0:
+		return fmt.Errorf("%w: DepthLow and DepthHigh must not be negative", ErrInvalidInput)
+	case (o.DepthLow > 0) != (o.DepthHigh > 0):
+		return fmt.Errorf("%w: DepthLow and DepthHigh must be set together", ErrInvalidInput)
+	case o.DepthLow > o.DepthHigh:
+		return fmt.Errorf("%w: DepthLow %d is above DepthHigh %d", ErrInvalidInput, o.DepthLow, o.DepthHigh)
+	case o.Dec < -90 || o.Dec > 90:
+		return fmt.Errorf("%w: Dec %g outside -90..90", ErrInvalidInput, o.Dec)
+	case o.RA < 0 || o.RA >= 360:
+		return fmt.Errorf("%w: RA %g outside 0..360", ErrInvalidInput, o.RA)
+	case o.Radius < 0:
+		return fmt.Errorf("%w: Radius must not be negative", ErrInvalidInput)
+	case o.RotationTolerance < 0:
+		return fmt.Errorf("%w: RotationTolerance must not be negative", ErrInvalidInput)
+	case math.IsNaN(o.ExpectedRotation) || math.IsInf(o.ExpectedRotation, 0):
+		return fmt.Errorf("%w: ExpectedRotation must be finite", ErrInvalidInput)
+	case o.XYListPath != "" && o.CropPixels > 0:
+		return fmt.Errorf("%w: CropPixels cannot be used with XYListPath", ErrInvalidInput)
+	}
+	for i, radius := range o.HintRadiusSchedule {
+		switch {
+		case radius < 0:
+			return fmt.Errorf("%w: HintRadiusSchedule radii must not be negative", ErrInvalidInput)
+		case radius == 0 && i < len(o.HintRadiusSchedule)-1:
+			return fmt.Errorf("%w: HintRadiusSchedule may only end with a blind (0) attempt", ErrInvalidInput)
+		case radius > 0 && i > 0 && radius <= o.HintRadiusSchedule[i-1]:
+			return fmt.Errorf("%w: HintRadiusSchedule radii must increase", ErrInvalidInput)
+		}
+	}
+	if o.NoPlots {
+		for _, arg := range o.ExtraArgs {
+			flag, _, _ := strings.Cut(arg, "=")
+			if slices.Contains(plotArgs, flag) {
+				return fmt.Errorf("%w: ExtraArgs %s configures plots, which NoPlots disables", ErrInvalidInput, flag)
+			}
+		}
+	}
+	return validateKeepExtensions(o.KeepExtensions)
+}
+
+// Warnings returns problems with the options that Validate lets through
+// because they might be intended: scale bounds implausible for their
+// ScaleUnits, such as arcsec/pixel values left at the default
+// arcminwidth, which make solve-field search a nonsensical range and fail
+// slowly. Solve records them in Result.Warnings and logs them when Verbose
+// is set.
+func (o *SolveOptions) Warnings() []string {
+	if o.ScaleLow <= 0 {
+		return nil
+	}
+	var warnings []string
+	bounds := fmt.Sprintf("ScaleLow/ScaleHigh %g-%g %s", o.ScaleLow, o.ScaleHigh, o.ScaleUnits)
+	switch o.ScaleUnits.canonical() {
+	case UnitArcminWidth:
+		if o.ScaleHigh < minPlausibleArcminWidth {
+			warnings = append(warnings, fmt.Sprintf("%s is a field under %d arcmin wide; if these are arcsec/pixel, set ScaleUnits to %s",
+				bounds, minPlausibleArcminWidth, UnitArcsecPerPix))
+		}
+		if o.ScaleLow > maxPlausibleArcminWidth {
+			warnings = append(warnings, fmt.Sprintf("%s is a field over %d arcmin wide; if these are arcseconds of width, divide by 60",
+				bounds, maxPlausibleArcminWidth))
+		}
+	case UnitDegWidth:
+		if o.ScaleLow > maxPlausibleDegWidth {
+			warnings = append(warnings, fmt.Sprintf("%s is a field wider than the sky; if these are arcminutes, set ScaleUnits to %s",
+				bounds, UnitArcminWidth))
+		}
+	}
+	return warnings
+}
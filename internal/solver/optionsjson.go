@@ -0,0 +1,276 @@
+package solver
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SolveOptionsVersion is the options_version SolveOptions.MarshalJSON
+// writes. Version 0 is the unversioned encoding/json default of the struct
+// (Go field names, Timeout in nanoseconds) that callers stored before the
+// envelope existed; MigrateOptions and UnmarshalJSON upgrade it.
+const SolveOptionsVersion = 1
+
+// optionsVersionKey is the JSON key holding the envelope version.
+const optionsVersionKey = "options_version"
+
+// solveOptionsJSON is the version 1 encoding of SolveOptions. Every field
+// is written, so a stored record does not depend on the defaults of the
+// binary that reads it.
+type solveOptionsJSON struct {
+	ScaleLow           float64      `json:"scale_low"`
+	ScaleHigh          float64      `json:"scale_high"`
+	ScaleUnits         ScaleUnits   `json:"scale_units"`
+	DownsampleFactor   int          `json:"downsample_factor"`
+	DepthLow           int          `json:"depth_low"`
+	DepthHigh          int          `json:"depth_high"`
+	NoPlots            bool         `json:"no_plots"`
+	RA                 float64      `json:"ra"`
+	Dec                float64      `json:"dec"`
+	Radius             float64      `json:"radius"`
+	HintRadiusSchedule []float64    `json:"hint_radius_schedule"`
+	ExpectedRotation   float64      `json:"expected_rotation"`
+	RotationTolerance  float64      `json:"rotation_tolerance"`
+	OverwriteExisting  bool         `json:"overwrite_existing"`
+	NoFITS2FITS        bool         `json:"no_fits2fits"`
+	FITSExtension      int          `json:"fits_extension"`
+	Invert             bool         `json:"invert"`
+	NoRemoveLines      bool         `json:"no_remove_lines"`
+	NoUniformize       bool         `json:"no_uniformize"`
+	CropPixels         int          `json:"crop_pixels"`
+	XYListPath         string       `json:"xylist_path"`
+	ImageWidth         int          `json:"image_width"`
+	ImageHeight        int          `json:"image_height"`
+	XColumn            string       `json:"x_column"`
+	YColumn            string       `json:"y_column"`
+	SortColumn         string       `json:"sort_column"`
+	Verbose            bool         `json:"verbose"`
+	KeepTempFiles      bool         `json:"keep_temp_files"`
+	OutputDir          string       `json:"output_dir"`
+	KeepExtensions     []string     `json:"keep_extensions"`
+	Timeout            jsonDuration `json:"timeout"`
+	SkipIfSolved       bool         `json:"skip_if_solved"`
+	Force              bool         `json:"force"`
+	ExtraArgs          []string     `json:"extra_args"`
+}
+
+// newSolveOptionsJSON returns the version 1 encoding of o.
+func newSolveOptionsJSON(o *SolveOptions) solveOptionsJSON {
+	return solveOptionsJSON{
+		ScaleLow:           o.ScaleLow,
+		ScaleHigh:          o.ScaleHigh,
+		ScaleUnits:         o.ScaleUnits,
+		DownsampleFactor:   o.DownsampleFactor,
+		DepthLow:           o.DepthLow,
+		DepthHigh:          o.DepthHigh,
+		NoPlots:            o.NoPlots,
+		RA:                 o.RA,
+		Dec:                o.Dec,
+		Radius:             o.Radius,
+		HintRadiusSchedule: o.HintRadiusSchedule,
+		ExpectedRotation:   o.ExpectedRotation,
+		RotationTolerance:  o.RotationTolerance,
+		OverwriteExisting:  o.OverwriteExisting,
+		NoFITS2FITS:        o.NoFITS2FITS,
+		FITSExtension:      o.FITSExtension,
+		Invert:             o.Invert,
+		NoRemoveLines:      o.NoRemoveLines,
+		NoUniformize:       o.NoUniformize,
+		CropPixels:         o.CropPixels,
+		XYListPath:         o.XYListPath,
+		ImageWidth:         o.ImageWidth,
+		ImageHeight:        o.ImageHeight,
+		XColumn:            o.XColumn,
+		YColumn:            o.YColumn,
+		SortColumn:         o.SortColumn,
+		Verbose:            o.Verbose,
+		KeepTempFiles:      o.KeepTempFiles,
+		OutputDir:          o.OutputDir,
+		KeepExtensions:     o.KeepExtensions,
+		Timeout:            jsonDuration(o.Timeout),
+		SkipIfSolved:       o.SkipIfSolved,
+		Force:              o.Force,
+		ExtraArgs:          o.ExtraArgs,
+	}
+}
+
+// apply copies the decoded fields to o, leaving o.Extra alone.
+func (j solveOptionsJSON) apply(o *SolveOptions) {
+	o.ScaleLow, o.ScaleHigh, o.ScaleUnits = j.ScaleLow, j.ScaleHigh, j.ScaleUnits
+	o.DownsampleFactor, o.DepthLow, o.DepthHigh = j.DownsampleFactor, j.DepthLow, j.DepthHigh
+	o.NoPlots, o.RA, o.Dec, o.Radius = j.NoPlots, j.RA, j.Dec, j.Radius
+	o.HintRadiusSchedule = j.HintRadiusSchedule
+	o.ExpectedRotation, o.RotationTolerance = j.ExpectedRotation, j.RotationTolerance
+	o.OverwriteExisting, o.Verbose, o.KeepTempFiles = j.OverwriteExisting, j.Verbose, j.KeepTempFiles
+	o.NoFITS2FITS, o.FITSExtension, o.Invert = j.NoFITS2FITS, j.FITSExtension, j.Invert
+	o.NoRemoveLines, o.NoUniformize, o.CropPixels = j.NoRemoveLines, j.NoUniformize, j.CropPixels
+	o.XYListPath, o.ImageWidth, o.ImageHeight = j.XYListPath, j.ImageWidth, j.ImageHeight
+	o.XColumn, o.YColumn, o.SortColumn = j.XColumn, j.YColumn, j.SortColumn
+	o.OutputDir, o.KeepExtensions = j.OutputDir, j.KeepExtensions
+	o.Timeout, o.SkipIfSolved, o.Force, o.ExtraArgs = time.Duration(j.Timeout), j.SkipIfSolved, j.Force, j.ExtraArgs
+}
+
+// jsonDuration is a time.Duration written as a string such as "1m30s". It
+// also reads the nanosecond integers of version 0.
+type jsonDuration time.Duration
+
+func (d jsonDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *jsonDuration) UnmarshalJSON(data []byte) error {
+	var ns int64
+	if err := json.Unmarshal(data, &ns); err == nil {
+		*d = jsonDuration(ns)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string or nanoseconds: %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = jsonDuration(v)
+	return nil
+}
+
+// optionsFieldKeys maps each SolveOptions field name, the version 0 key, to
+// its version 1 key.
+var optionsFieldKeys = func() map[string]string {
+	keys := make(map[string]string)
+	t := reflect.TypeOf(solveOptionsJSON{})
+	for i := 0; i < t.NumField(); i++ {
+		keys[t.Field(i).Name] = strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+	}
+	return keys
+}()
+
+// MarshalJSON writes the options with options_version and every field,
+// followed by any Extra fields read from a newer version.
+func (o SolveOptions) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(newSolveOptionsJSON(&o))
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range o.Extra {
+		// Known fields win over stale copies in Extra
+		if _, ok := fields[key]; !ok && key != optionsVersionKey {
+			fields[key] = value
+		}
+	}
+	fields[optionsVersionKey] = json.RawMessage(fmt.Sprint(SolveOptionsVersion))
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON reads options written by any version, upgrading older ones
+// like MigrateOptions. Fields missing from data are left unchanged, and
+// fields this version does not know are kept in Extra so MarshalJSON writes
+// them back.
+func (o *SolveOptions) UnmarshalJSON(data []byte) error {
+	_, err := o.decodeJSON(data)
+	return err
+}
+
+// MigrateOptions reads SolveOptions stored as JSON by any version of this
+// package, upgrading older encodings to the current struct. Fields the
+// stored version did not have are zero, which matches how that version
+// behaved. The warnings list fields this version does not know, which are
+// kept in Extra, and any upgrade from or to another version.
+//
+// Example:
+//
+//	opts, warnings, err := client.MigrateOptions(stored)
+//	if err != nil {
+//		return err
+//	}
+//	for _, w := range warnings {
+//		log.Printf("options: %s", w)
+//	}
+//	result, err := c.Solve(ctx, imagePath, opts)
+func MigrateOptions(raw []byte) (*SolveOptions, []string, error) {
+	opts := &SolveOptions{}
+	warnings, err := opts.decodeJSON(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return opts, warnings, nil
+}
+
+// decodeJSON overlays the fields in data on o and returns the migration
+// warnings.
+func (o *SolveOptions) decodeJSON(data []byte) ([]string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("%w: invalid solve options JSON: %v", ErrInvalidInput, err)
+	}
+
+	var warnings []string
+	version := 0
+	if raw, ok := fields[optionsVersionKey]; ok {
+		if err := json.Unmarshal(raw, &version); err != nil {
+			return nil, fmt.Errorf("%w: invalid %s: %v", ErrInvalidInput, optionsVersionKey, err)
+		}
+		delete(fields, optionsVersionKey)
+	}
+	switch {
+	case version == 0:
+		// Version 0 used the Go field names
+		for goName, key := range optionsFieldKeys {
+			if raw, ok := fields[goName]; ok {
+				delete(fields, goName)
+				fields[key] = raw
+			}
+		}
+		warnings = append(warnings, fmt.Sprintf("upgraded unversioned options to %s %d", optionsVersionKey, SolveOptionsVersion))
+	case version > SolveOptionsVersion:
+		warnings = append(warnings, fmt.Sprintf("%s %d is newer than %d; fields added since are kept in Extra but not applied",
+			optionsVersionKey, version, SolveOptionsVersion))
+	}
+
+	known := make(map[string]json.RawMessage)
+	extra := make(map[string]json.RawMessage)
+	keys := make(map[string]bool, len(optionsFieldKeys))
+	for _, key := range optionsFieldKeys {
+		keys[key] = true
+	}
+	for key, raw := range fields {
+		if keys[key] {
+			known[key] = raw
+		} else {
+			extra[key] = raw
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(extra)) {
+		warnings = append(warnings, fmt.Sprintf("unknown field %q kept in Extra", key))
+	}
+
+	encoded := newSolveOptionsJSON(o)
+	knownData, err := json.Marshal(known)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(knownData, &encoded); err != nil {
+		return nil, fmt.Errorf("%w: invalid solve options: %v", ErrInvalidInput, err)
+	}
+
+	encoded.apply(o)
+	for key, raw := range extra {
+		if o.Extra == nil {
+			o.Extra = make(map[string]json.RawMessage)
+		}
+		o.Extra[key] = raw
+	}
+	return warnings, nil
+}
@@ -0,0 +1,136 @@
+package solver
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSolveOptionsJSON_RoundTrip(t *testing.T) {
+	opts := DefaultSolveOptions()
+	opts.ScaleLow, opts.ScaleHigh, opts.ScaleUnits = 1, 3, "degwidth"
+	opts.RA, opts.Dec, opts.Radius = 83.82, -5.39, 2
+	opts.ExpectedRotation, opts.RotationTolerance = 90, 5
+	opts.Timeout = 90 * time.Second
+	opts.ExtraArgs = []string{"--crpix-center"}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"options_version":1`) || !strings.Contains(string(data), `"timeout":"1m30s"`) {
+		t.Errorf("encoded = %s, want options_version and a duration string", data)
+	}
+
+	got, warnings, err := MigrateOptions(data)
+	if err != nil {
+		t.Fatalf("MigrateOptions failed: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %q, want none for current options", warnings)
+	}
+	if !reflect.DeepEqual(got, opts) {
+		t.Errorf("round trip = %+v\nwant %+v", got, opts)
+	}
+}
+
+func TestMigrateOptions_Version0(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "solve-options-v0.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, warnings, err := MigrateOptions(raw)
+	if err != nil {
+		t.Fatalf("MigrateOptions failed: %v", err)
+	}
+	want := &SolveOptions{
+		ScaleLow: 60, ScaleHigh: 180, ScaleUnits: "arcminwidth",
+		DownsampleFactor: 4, DepthLow: 10, DepthHigh: 40, NoPlots: true,
+		RA: 83.82, Dec: -5.39, Radius: 5, Verbose: true,
+		Timeout: 90 * time.Second, SkipIfSolved: true, ExtraArgs: []string{"--crpix-center"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("migrated = %+v\nwant %+v", got, want)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "upgraded unversioned options") {
+		t.Errorf("warnings = %q, want the upgrade noted", warnings)
+	}
+
+	// json.Unmarshal reads the old format too
+	var opts SolveOptions
+	if err := json.Unmarshal(raw, &opts); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !reflect.DeepEqual(&opts, want) {
+		t.Errorf("unmarshalled = %+v\nwant %+v", opts, want)
+	}
+}
+
+func TestSolveOptionsJSON_UnknownFieldsSurvive(t *testing.T) {
+	future := []byte(`{"options_version":7,"scale_low":1,"scale_high":2,"scale_units":"degwidth",` +
+		`"tweak_order":3,"quad_filter":{"min":0.1,"max":0.9}}`)
+
+	opts, warnings, err := MigrateOptions(future)
+	if err != nil {
+		t.Fatalf("MigrateOptions failed: %v", err)
+	}
+	if opts.ScaleLow != 1 || opts.ScaleHigh != 2 || opts.ScaleUnits != "degwidth" {
+		t.Errorf("known fields = %+v, want them applied", opts)
+	}
+	if len(opts.Extra) != 2 {
+		t.Errorf("Extra = %v, want the two unknown fields", opts.Extra)
+	}
+	want := []string{
+		"options_version 7 is newer than 1; fields added since are kept in Extra but not applied",
+		`unknown field "quad_filter" kept in Extra`,
+		`unknown field "tweak_order" kept in Extra`,
+	}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("warnings = %q\nwant %q", warnings, want)
+	}
+
+	// Store and load again: the unknown fields come back byte for byte
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var reloaded map[string]json.RawMessage
+	if err := json.Unmarshal(data, &reloaded); err != nil {
+		t.Fatal(err)
+	}
+	if string(reloaded["tweak_order"]) != "3" || string(reloaded["quad_filter"]) != `{"min":0.1,"max":0.9}` {
+		t.Errorf("stored = %s, want the unknown fields preserved", data)
+	}
+
+	var again SolveOptions
+	if err := json.Unmarshal(data, &again); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !reflect.DeepEqual(&again, opts) {
+		t.Errorf("reloaded = %+v\nwant %+v", again, opts)
+	}
+}
+
+func TestSolveOptionsJSON_Overlay(t *testing.T) {
+	opts := DefaultSolveOptions()
+	if err := json.Unmarshal([]byte(`{"options_version":1,"radius":3}`), opts); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if opts.Radius != 3 || opts.DownsampleFactor != DefaultSolveOptions().DownsampleFactor {
+		t.Errorf("opts = %+v, want radius set and other fields unchanged", opts)
+	}
+}
+
+func TestMigrateOptions_Invalid(t *testing.T) {
+	for _, raw := range []string{`[1, 2]`, `{"options_version":"one"}`, `{"options_version":1,"timeout":"soon"}`} {
+		if _, _, err := MigrateOptions([]byte(raw)); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("MigrateOptions(%s) error = %v, want ErrInvalidInput", raw, err)
+		}
+	}
+}
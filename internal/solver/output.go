@@ -0,0 +1,79 @@
+package solver
+
+import "fmt"
+
+// DefaultMaxOutputBytes is the default cap on captured tool output.
+const DefaultMaxOutputBytes = 4 << 20
+
+// boundedBuffer is an io.Writer that captures at most max bytes of output.
+// It keeps the first half verbatim and the most recent half in a ring, so
+// the command line and the final summary (including "Did not solve" or the
+// solution report) both survive, and String inserts a marker noting how
+// many bytes were dropped in between.
+type boundedBuffer struct {
+	head    []byte
+	tail    []byte // Ring buffer once full; next is the oldest byte
+	next    int
+	dropped int64
+	headMax int
+	tailMax int
+}
+
+// newBoundedBuffer returns a buffer retaining at most max bytes.
+func newBoundedBuffer(max int) *boundedBuffer {
+	if max < 2 {
+		max = 2
+	}
+	return &boundedBuffer{headMax: max / 2, tailMax: max - max/2}
+}
+
+// Write always consumes all of p.
+func (b *boundedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if room := b.headMax - len(b.head); room > 0 {
+		take := min(room, len(p))
+		b.head = append(b.head, p[:take]...)
+		p = p[take:]
+	}
+	if len(p) == 0 {
+		return n, nil
+	}
+
+	// Only the last tailMax bytes of p can survive
+	if len(p) > b.tailMax {
+		b.dropped += int64(len(p) - b.tailMax)
+		p = p[len(p)-b.tailMax:]
+	}
+	if room := b.tailMax - len(b.tail); room > 0 {
+		take := min(room, len(p))
+		b.tail = append(b.tail, p[:take]...)
+		p = p[take:]
+	}
+	for len(p) > 0 {
+		// Ring is full: overwrite the oldest bytes
+		c := copy(b.tail[b.next:], p)
+		b.dropped += int64(c)
+		b.next = (b.next + c) % b.tailMax
+		p = p[c:]
+	}
+	return n, nil
+}
+
+// String returns the retained output, with a truncation marker between head
+// and tail when bytes were dropped.
+func (b *boundedBuffer) String() string {
+	out := make([]byte, 0, len(b.head)+len(b.tail)+64)
+	out = append(out, b.head...)
+	if b.dropped > 0 {
+		out = fmt.Appendf(out, "\n... [%d bytes of output truncated] ...\n", b.dropped)
+	}
+	out = append(out, b.tail[b.next:]...)
+	out = append(out, b.tail[:b.next]...)
+	return string(out)
+}
+
+// Truncated reports whether any output was dropped.
+func (b *boundedBuffer) Truncated() bool {
+	return b.dropped > 0
+}
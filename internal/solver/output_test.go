@@ -0,0 +1,93 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestBoundedBuffer(t *testing.T) {
+	t.Run("under limit", func(t *testing.T) {
+		b := newBoundedBuffer(16)
+		_, _ = b.Write([]byte("hello "))
+		_, _ = b.Write([]byte("world"))
+		if got := b.String(); got != "hello world" || b.Truncated() {
+			t.Errorf("String() = %q, truncated %v", got, b.Truncated())
+		}
+	})
+
+	t.Run("keeps head and tail", func(t *testing.T) {
+		b := newBoundedBuffer(8)
+		for _, s := range []string{"abcd", "efgh", "ijkl", "mn", "opqr"} {
+			if n, err := b.Write([]byte(s)); n != len(s) || err != nil {
+				t.Fatalf("Write(%q) = %d, %v", s, n, err)
+			}
+		}
+		want := "abcd\n... [10 bytes of output truncated] ...\nopqr"
+		if got := b.String(); got != want || !b.Truncated() {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("single large write", func(t *testing.T) {
+		b := newBoundedBuffer(10)
+		_, _ = b.Write([]byte("0123456789abcdefghij"))
+		want := "01234\n... [10 bytes of output truncated] ...\nfghij"
+		if got := b.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestSolve_BoundedOutput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("pumps 500 MB of output")
+	}
+
+	const (
+		maxOutput = 1 << 20
+		total     = 500 << 20
+	)
+	chunk := bytes.Repeat([]byte("quad 1234: tried 5678 matches, none verified\n"), 1500)
+
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		_, _ = io.WriteString(w, "solve-field starting\n")
+		for written := 0; written < total; written += len(chunk) {
+			if _, err := w.Write(chunk); err != nil {
+				return err
+			}
+		}
+		_, _ = io.WriteString(w, "Did not solve (or no WCS file was written).\n")
+		return nil
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.MaxOutputBytes = maxOutput }); err != nil {
+		t.Fatal(err)
+	}
+	imagePath := writeTestPNG(t, 8, 8)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	result, err := client.Solve(context.Background(), imagePath, nil)
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 16*maxOutput {
+		t.Errorf("Solve allocated %d MB capturing output, want bounded near %d MB", allocated>>20, maxOutput>>20)
+	}
+	if len(result.RawOutput) > maxOutput+100 {
+		t.Errorf("RawOutput is %d bytes, want at most about %d", len(result.RawOutput), maxOutput)
+	}
+	if !strings.HasPrefix(result.RawOutput, "solve-field starting") || !strings.Contains(result.RawOutput, "bytes of output truncated") {
+		t.Error("RawOutput lost its head or truncation marker")
+	}
+	// The failure summary is at the end of the output and must survive
+	if log := ParseSolveFieldLog([]byte(result.RawOutput)); log.Solved || !strings.Contains(result.RawOutput, "Did not solve") {
+		t.Error("truncation lost the Did not solve summary")
+	}
+}
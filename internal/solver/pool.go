@@ -0,0 +1,277 @@
+package solver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// containerPool holds the warm pool's run-mode containers, shared by a
+// Client's snapshots. Containers are leased one solve at a time; between
+// solves they idle on tail -f /dev/null.
+type containerPool struct {
+	mu      sync.Mutex
+	idle    []*pooledContainer // Most recently returned last
+	leased  int                // Leased or starting containers
+	closed  bool
+	drained chan struct{} // Closed when the last lease returns after Close
+	reaper  *time.Timer   // Removes containers idle past WarmPoolIdleTimeout
+}
+
+// pooledContainer is one warm pool container.
+type pooledContainer struct {
+	name      string
+	key       string // poolKey of the config it was started with
+	uses      int
+	idleSince time.Time
+}
+
+// poolKey identifies what a pooled container was started with, so
+// containers left over from before an UpdateConfig are not reused.
+func poolKey(config *ClientConfig) string {
+	return strings.Join(slices.Concat([]string{config.DockerImage, config.IndexMountPath, config.TempDir},
+		config.indexPaths(), []string{""}, config.ScratchDirs), "\x00")
+}
+
+// solvePooled runs solve in a leased warm pool container, or in a plain
+// docker run container when there is no pool or every container is leased.
+func (c *Client) solvePooled(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
+	pc := c.leaseContainer(ctx)
+	if pc == nil {
+		return c.solve(ctx, imagePath, opts)
+	}
+	leased := &Client{config: c.config, run: c.run, statfs: c.statfs, probes: c.probes, pool: c.pool, trace: c.trace,
+		retrying: c.retrying, leased: pc}
+	result, err := leased.solve(ctx, imagePath, opts)
+	pc.uses++
+	// A cancelled solve can leave solve-field running in the container
+	c.returnContainer(pc, poisonsContainer(err) || ctx.Err() != nil)
+	return result, err
+}
+
+// leaseContainer takes an idle container from the pool, or starts one
+// while the pool is below WarmPoolSize. It returns nil when the pool is
+// disabled, closed or fully leased, or the container fails to start.
+func (c *Client) leaseContainer(ctx context.Context) *pooledContainer {
+	p := c.pool
+	if p == nil || c.config.UseDockerExec || c.config.WarmPoolSize <= 0 {
+		return nil
+	}
+	key := poolKey(c.config)
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	var stale []*pooledContainer
+	p.idle = slices.DeleteFunc(p.idle, func(pc *pooledContainer) bool {
+		if pc.key != key {
+			stale = append(stale, pc)
+			return true
+		}
+		return false
+	})
+	var pc *pooledContainer
+	start := false
+	if n := len(p.idle); n > 0 {
+		pc = p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.leased++
+	} else if p.leased < c.config.WarmPoolSize {
+		start = true
+		p.leased++
+	}
+	p.mu.Unlock()
+
+	for _, s := range stale {
+		c.removeContainer(context.WithoutCancel(ctx), s.name)
+	}
+	c.observePoolLease(pc != nil)
+	if !start {
+		return pc
+	}
+
+	pc = &pooledContainer{name: newPoolContainerName(), key: key}
+	if err := c.startIdleContainer(ctx, pc.name); err != nil {
+		log.Printf("warning: warm pool container did not start, solving with docker run: %v", err)
+		c.removeContainer(context.WithoutCancel(ctx), pc.name)
+		p.release()
+		return nil
+	}
+	return pc
+}
+
+// returnContainer gives a leased container back to the pool. It is removed
+// instead when broken is set, it has reached WarmPoolMaxUses, the pool has
+// shrunk below it, or the pool is closed.
+func (c *Client) returnContainer(pc *pooledContainer, broken bool) {
+	p := c.pool
+	retire := broken || (c.config.WarmPoolMaxUses > 0 && pc.uses >= c.config.WarmPoolMaxUses)
+
+	p.mu.Lock()
+	retire = retire || p.closed || len(p.idle)+p.leased > c.config.WarmPoolSize
+	if !retire {
+		pc.idleSince = time.Now()
+		p.idle = append(p.idle, pc)
+		if timeout := c.config.WarmPoolIdleTimeout; timeout > 0 && p.reaper == nil {
+			p.reaper = time.AfterFunc(timeout, func() { c.reapIdle(timeout) })
+		}
+	}
+	p.mu.Unlock()
+
+	if retire {
+		c.removeContainer(context.Background(), pc.name)
+	}
+	p.release()
+}
+
+// release ends a lease, waking Close once the last one is returned.
+func (p *containerPool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.leased--
+	if p.leased == 0 && p.drained != nil {
+		close(p.drained)
+		p.drained = nil
+	}
+}
+
+// poisonsContainer reports whether a solve that ended with err may have
+// left its container broken, or still running solve-field.
+func poisonsContainer(err error) bool {
+	return errors.Is(err, ErrTimeout) || errors.Is(err, ErrDockerFailed) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// reapIdle removes containers idle for at least timeout, and schedules
+// itself again for the next container to expire.
+func (c *Client) reapIdle(timeout time.Duration) {
+	p := c.pool
+	now := time.Now()
+	var expired []*pooledContainer
+
+	p.mu.Lock()
+	p.reaper = nil
+	p.idle = slices.DeleteFunc(p.idle, func(pc *pooledContainer) bool {
+		if now.Sub(pc.idleSince) >= timeout {
+			expired = append(expired, pc)
+			return true
+		}
+		return false
+	})
+	if len(p.idle) > 0 && !p.closed {
+		// Returned containers are appended, so the first expires next
+		next := p.idle[0].idleSince.Add(timeout).Sub(now)
+		p.reaper = time.AfterFunc(next, func() { c.reapIdle(timeout) })
+	}
+	p.mu.Unlock()
+
+	for _, pc := range expired {
+		c.removeContainer(context.Background(), pc.name)
+	}
+}
+
+// observePoolLease reports a warm pool hit or cold start to the configured
+// Metrics, when it implements PoolMetrics.
+func (c *Client) observePoolLease(hit bool) {
+	if metrics, ok := c.config.Metrics.(PoolMetrics); ok {
+		metrics.ObservePoolLease(hit)
+	}
+}
+
+// newPoolContainerName returns a unique name for a warm pool container.
+func newPoolContainerName() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b) //nolint:errcheck // crypto/rand.Read never returns an error
+	return fmt.Sprintf("astrometry-go-pool-%s", hex.EncodeToString(b))
+}
+
+// WarmPool starts warm pool containers until WarmPoolSize are running, so
+// the first solves do not pay their start-up cost. The image is pulled
+// first if it is not present locally. Without WarmPoolSize, or in exec
+// mode, it returns ErrInvalidInput. A container that fails to start is
+// removed and its error, wrapping ErrContainerStart, returned.
+func (c *Client) WarmPool(ctx context.Context) error {
+	c = c.snapshot()
+	if c.config.UseDockerExec || c.config.WarmPoolSize <= 0 {
+		return fmt.Errorf("%w: a warm pool requires WarmPoolSize and run mode", ErrInvalidInput)
+	}
+	if err := c.EnsureImage(ctx, nil); err != nil {
+		return err
+	}
+
+	p := c.pool
+	key := poolKey(c.config)
+	for {
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return fmt.Errorf("%w: client is closed", ErrInvalidInput)
+		}
+		if len(p.idle)+p.leased >= c.config.WarmPoolSize {
+			p.mu.Unlock()
+			return nil
+		}
+		p.leased++
+		p.mu.Unlock()
+
+		pc := &pooledContainer{name: newPoolContainerName(), key: key}
+		if err := c.startIdleContainer(ctx, pc.name); err != nil {
+			c.removeContainer(context.WithoutCancel(ctx), pc.name)
+			p.release()
+			return err
+		}
+		c.returnContainer(pc, false)
+	}
+}
+
+// Close removes the client's warm pool containers. Solves already running
+// in a pooled container finish first, and those that start after Close use
+// docker run. Close is a no-op without a warm pool and may be called more
+// than once. The Client remains usable.
+func (c *Client) Close() error {
+	p := c.pool
+	if p == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	p.closed = true
+	if p.reaper != nil {
+		p.reaper.Stop()
+		p.reaper = nil
+	}
+	var drained chan struct{}
+	if p.leased > 0 {
+		if p.drained == nil {
+			p.drained = make(chan struct{})
+		}
+		drained = p.drained
+	}
+	p.mu.Unlock()
+
+	if drained != nil {
+		<-drained
+	}
+
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.mu.Unlock()
+
+	var errs []error
+	for _, pc := range idle {
+		if err := c.forceRemove(context.Background(), pc.name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,306 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// poolDocker is a fake docker for warm pool tests: it tracks the
+// containers started and removed and solves in the exec'd ones.
+type poolDocker struct {
+	mu      sync.Mutex
+	started []string
+	removed []string
+	running map[string]bool
+	execs   []string // Container of each solve-field exec, "" for docker run
+	hits    []bool   // PoolMetrics observations
+
+	// solve is called for each solve-field run; nil solves the image
+	solve func(ctx context.Context) error
+}
+
+func (d *poolDocker) ObserveSolve(time.Duration, bool, string) {}
+
+func (d *poolDocker) ObservePoolLease(hit bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.hits = append(d.hits, hit)
+}
+
+func (d *poolDocker) run(ctx context.Context, name string, args []string, w io.Writer) error {
+	d.mu.Lock()
+	switch {
+	case args[0] == "run" && args[1] == "-d":
+		name := args[slices.Index(args, "--name")+1]
+		d.started = append(d.started, name)
+		d.running[name] = true
+		d.mu.Unlock()
+		return nil
+	case args[0] == "rm":
+		d.removed = append(d.removed, args[2])
+		delete(d.running, args[2])
+		d.mu.Unlock()
+		return nil
+	case !slices.Contains(args, "solve-field"):
+		d.mu.Unlock()
+		return nil
+	}
+	container := ""
+	if args[0] == "exec" {
+		container = args[1]
+		if !d.running[container] {
+			d.mu.Unlock()
+			return errors.New("no such container")
+		}
+	}
+	d.execs = append(d.execs, container)
+	solve := d.solve
+	d.mu.Unlock()
+
+	if solve != nil {
+		if err := solve(ctx); err != nil {
+			return err
+		}
+	}
+	// Exec'd tools see the workspace at its host path
+	dir := argValue(args, "--dir")
+	if container == "" {
+		dir = strings.TrimSuffix(args[slices.IndexFunc(args, func(a string) bool { return strings.HasSuffix(a, ":/data") })], ":/data")
+	}
+	if err := os.WriteFile(filepath.Join(dir, "stars.wcs"), fits.EncodeHeader(wcsCards(0.001)), 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "stars.solved"), []byte{1}, 0644)
+}
+
+// snapshot returns copies of what the fake docker has recorded.
+func (d *poolDocker) snapshot() (started, removed, execs []string, hits []bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return slices.Clone(d.started), slices.Clone(d.removed), slices.Clone(d.execs), slices.Clone(d.hits)
+}
+
+// newPoolClient returns a client with a warm pool of size and a fake docker.
+func newPoolClient(t *testing.T, size int, configure func(*ClientConfig)) (*Client, *poolDocker) {
+	t.Helper()
+	d := &poolDocker{running: make(map[string]bool)}
+	config := &ClientConfig{IndexPath: t.TempDir(), TempDir: t.TempDir(), WarmPoolSize: size, Metrics: d}
+	if configure != nil {
+		configure(config)
+	}
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	client.run = d.run
+	return client, d
+}
+
+func mustSolve(t *testing.T, client *Client, imagePath string) *Result {
+	t.Helper()
+	result, err := client.Solve(context.Background(), imagePath, nil)
+	if err != nil || !result.Solved {
+		t.Fatalf("Solve = %+v, %v; want solved", result, err)
+	}
+	return result
+}
+
+func TestWarmPool_LeaseAndReturn(t *testing.T) {
+	client, d := newPoolClient(t, 1, nil)
+	imagePath := writeTestPNG(t, 8, 8)
+
+	for range 3 {
+		if result := mustSolve(t, client, imagePath); result.DockerImage != DefaultDockerImage {
+			t.Errorf("DockerImage = %q, want %q", result.DockerImage, DefaultDockerImage)
+		}
+	}
+	started, removed, execs, hits := d.snapshot()
+	if len(started) != 1 || len(removed) != 0 {
+		t.Fatalf("started %q and removed %q, want one container kept", started, removed)
+	}
+	if want := slices.Repeat(started, 3); !slices.Equal(execs, want) {
+		t.Errorf("solved in %q, want every solve in %q", execs, started[0])
+	}
+	if want := []bool{false, true, true}; !slices.Equal(hits, want) {
+		t.Errorf("pool hits %v, want %v", hits, want)
+	}
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, removed, _, _ := d.snapshot(); !slices.Equal(removed, started) {
+		t.Errorf("Close removed %q, want %q", removed, started)
+	}
+
+	// A closed pool leaves the client solving with docker run
+	mustSolve(t, client, imagePath)
+	if _, _, execs, _ := d.snapshot(); execs[len(execs)-1] != "" {
+		t.Errorf("solve after Close ran in %q, want docker run", execs[len(execs)-1])
+	}
+}
+
+func TestWarmPool_Replace(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxUses   int
+		solve     func(ctx context.Context) error
+		timeout   time.Duration
+		wantErr   error
+		wantStart int // containers started and removed by the two solves
+		wantRm    int
+	}{
+		{"kept", 0, nil, 0, nil, 1, 0},
+		{"max uses", 1, nil, 0, nil, 2, 2},
+		{"timeout", 0, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, 20 * time.Millisecond, ErrTimeout, 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, d := newPoolClient(t, 1, func(cfg *ClientConfig) { cfg.WarmPoolMaxUses = tt.maxUses })
+			d.solve = tt.solve
+			imagePath := writeTestPNG(t, 8, 8)
+			opts := DefaultSolveOptions()
+			opts.Timeout = tt.timeout
+
+			for range 2 {
+				if _, err := client.Solve(context.Background(), imagePath, opts); !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Solve error = %v, want %v", err, tt.wantErr)
+				}
+			}
+			started, removed, _, _ := d.snapshot()
+			if len(started) != tt.wantStart || len(removed) != tt.wantRm {
+				t.Errorf("started %d and removed %d containers, want %d and %d",
+					len(started), len(removed), tt.wantStart, tt.wantRm)
+			}
+		})
+	}
+}
+
+func TestWarmPool_FullyLeased(t *testing.T) {
+	client, d := newPoolClient(t, 1, nil)
+	entered := make(chan struct{}, 2)
+	release := make(chan struct{})
+	d.solve = func(ctx context.Context) error {
+		entered <- struct{}{}
+		<-release
+		return nil
+	}
+	imagePath := writeTestPNG(t, 8, 8)
+
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if result, err := client.Solve(context.Background(), imagePath, nil); err != nil || !result.Solved {
+				t.Errorf("Solve = %+v, %v; want solved", result, err)
+			}
+		}()
+	}
+	<-entered
+	<-entered
+	close(release)
+	wg.Wait()
+
+	started, _, execs, hits := d.snapshot()
+	slices.Sort(execs)
+	if len(started) != 1 || !slices.Equal(execs, []string{"", started[0]}) {
+		t.Errorf("solved in %q with %q started, want one pooled and one docker run solve", execs, started)
+	}
+	if !slices.Equal(hits, []bool{false, false}) {
+		t.Errorf("pool hits %v, want two cold starts", hits)
+	}
+}
+
+func TestWarmPool_IdleTimeout(t *testing.T) {
+	client, d := newPoolClient(t, 1, func(cfg *ClientConfig) { cfg.WarmPoolIdleTimeout = 10 * time.Millisecond })
+	mustSolve(t, client, writeTestPNG(t, 8, 8))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		started, removed, _, _ := d.snapshot()
+		if slices.Equal(removed, started) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("idle container %q not removed, removed %q", started, removed)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestWarmPool_CloseDrains(t *testing.T) {
+	client, d := newPoolClient(t, 1, nil)
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	d.solve = func(ctx context.Context) error {
+		close(entered)
+		<-release
+		return nil
+	}
+	imagePath := writeTestPNG(t, 8, 8)
+	solved := make(chan struct{})
+	go func() {
+		defer close(solved)
+		if result, err := client.Solve(context.Background(), imagePath, nil); err != nil || !result.Solved {
+			t.Errorf("Solve = %+v, %v; want solved", result, err)
+		}
+	}()
+	<-entered
+
+	closed := make(chan error, 1)
+	go func() { closed <- client.Close() }()
+	select {
+	case <-closed:
+		t.Fatal("Close returned with a solve in its container")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if _, removed, _, _ := d.snapshot(); len(removed) != 0 {
+		t.Errorf("container %q removed while leased", removed)
+	}
+
+	close(release)
+	<-solved
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Errorf("Close failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not return after the solve finished")
+	}
+	if started, removed, _, _ := d.snapshot(); !slices.Equal(removed, started) {
+		t.Errorf("removed %q after Close, want %q", removed, started)
+	}
+}
+
+func TestWarmPool_WarmPool(t *testing.T) {
+	client, d := newPoolClient(t, 2, nil)
+	if err := client.WarmPool(context.Background()); err != nil {
+		t.Fatalf("WarmPool failed: %v", err)
+	}
+	if started, _, _, _ := d.snapshot(); len(started) != 2 {
+		t.Fatalf("WarmPool started %q, want 2 containers", started)
+	}
+	mustSolve(t, client, writeTestPNG(t, 8, 8))
+	if started, _, _, hits := d.snapshot(); len(started) != 2 || !slices.Equal(hits, []bool{true}) {
+		t.Errorf("solve after WarmPool started %d containers with hits %v, want a hit", len(started), hits)
+	}
+
+	plain, _ := newPoolClient(t, 0, nil)
+	if err := plain.WarmPool(context.Background()); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("WarmPool without WarmPoolSize = %v, want ErrInvalidInput", err)
+	}
+}
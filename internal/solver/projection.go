@@ -0,0 +1,77 @@
+package solver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// wcsProjection returns the projection code of header's CTYPE pair, TAN
+// when the header has no CTYPE1 or CTYPE2. It returns
+// ErrUnsupportedProjection for any projection skyWCS does not implement
+// and for non-celestial or mismatched axes.
+func wcsProjection(header map[string]string) (string, error) {
+	ctype1, ctype2 := header["CTYPE1"], header["CTYPE2"]
+	if strings.TrimSpace(ctype1) == "" && strings.TrimSpace(ctype2) == "" {
+		return projectionTAN, nil
+	}
+	proj1, ok1 := ctypeProjection(ctype1, "RA")
+	proj2, ok2 := ctypeProjection(ctype2, "DEC")
+	if !ok1 || !ok2 || proj1 != proj2 {
+		return "", fmt.Errorf("%w: CTYPE %q/%q is not an RA/Dec pair in a known projection", ErrUnsupportedProjection, ctype1, ctype2)
+	}
+	switch proj1 {
+	case projectionTAN, projectionSIN, projectionARC:
+		return proj1, nil
+	}
+	return "", fmt.Errorf("%w: %s (CTYPE %q); only TAN, SIN and ARC are implemented", ErrUnsupportedProjection, proj1, ctype1)
+}
+
+// projectedWCS returns the solution's WCS for PixelToSky and SkyToPixel,
+// failing for unsolved results and unsupported projections.
+func (r *Result) projectedWCS() (skyWCS, error) {
+	if !r.Solved {
+		return skyWCS{}, fmt.Errorf("%w: result has no WCS solution", ErrInvalidInput)
+	}
+	if _, err := wcsProjection(r.wcsHeader()); err != nil {
+		return skyWCS{}, err
+	}
+	wcs, _, _, _ := r.skyWCS()
+	return wcs, nil
+}
+
+// PixelToSky converts FITS pixel coordinates (as CRPIX: the first pixel's
+// center is 1, 1) to RA/Dec in degrees through the full WCS, including SIP
+// distortion. The projection comes from CTYPE1/CTYPE2: TAN (gnomonic, as
+// solve-field writes), SIN (orthographic, without PV slant terms) or ARC
+// (zenithal equidistant); a header without CTYPEs is taken as TAN. Any
+// other projection returns ErrUnsupportedProjection rather than a TAN
+// approximation, and an unsolved result or a pixel beyond the edge of a
+// SIN or ARC projection ErrInvalidInput.
+func (r *Result) PixelToSky(x, y float64) (ra, dec float64, err error) {
+	wcs, err := r.projectedWCS()
+	if err != nil {
+		return 0, 0, err
+	}
+	if !wcs.onSky(x, y) {
+		return 0, 0, fmt.Errorf("%w: pixel (%g, %g) is beyond the edge of the %s projection", ErrInvalidInput, x, y, wcs.projection)
+	}
+	ra, dec = wcs.pixelToSky(x, y)
+	return ra, dec, nil
+}
+
+// SkyToPixel converts RA/Dec in degrees to FITS pixel coordinates, the
+// inverse of PixelToSky with the same projections and errors. The result
+// may lie outside the image. Positions the projection cannot show, such as
+// those more than 90° from CRVAL in TAN or SIN, return ErrInvalidInput.
+func (r *Result) SkyToPixel(ra, dec float64) (x, y float64, err error) {
+	wcs, err := r.projectedWCS()
+	if err != nil {
+		return 0, 0, err
+	}
+	x, y, ok := wcs.skyToPixel(ra, dec)
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: RA %.4f° Dec %.4f° is outside the %s projection around the reference point",
+			ErrInvalidInput, ra, dec, wcs.projection)
+	}
+	return x, y, nil
+}
@@ -0,0 +1,170 @@
+package solver
+
+import (
+	"errors"
+	"io"
+	"math"
+	"testing"
+)
+
+// projectionResult returns a result with a 1°/pixel WCS in projection at
+// CRVAL ra0, dec0, reference pixel (100, 100) and east to the left.
+func projectionResult(t *testing.T, projection string, ra0, dec0 float64) *Result {
+	t.Helper()
+	r, err := ResultFromWCSHeader(map[string]string{
+		"CTYPE1": "RA---" + projection, "CTYPE2": "DEC--" + projection,
+		"CRVAL1": formatFloat(ra0), "CRVAL2": formatFloat(dec0), "CRPIX1": "100", "CRPIX2": "100",
+		"CD1_1": "-1", "CD1_2": "0", "CD2_1": "0", "CD2_2": "1",
+	}, 200, 200)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	return r
+}
+
+func TestPixelToSky_Projections(t *testing.T) {
+	// 30° north of CRVAL in the projection plane is 30° = π/6 rad, which is
+	// atan(π/6) from CRVAL on the sky in TAN, asin(π/6) in SIN and 30° in ARC
+	offset := math.Pi / 6
+	tests := []struct {
+		projection string
+		distance   func(r float64) float64 // radians on the sky for plane radius r
+	}{
+		{"TAN", math.Atan},
+		{"SIN", math.Asin},
+		{"ARC", func(r float64) float64 { return r }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.projection, func(t *testing.T) {
+			r := projectionResult(t, tt.projection, 0, 0)
+			ra, dec, err := r.PixelToSky(100, 130)
+			if err != nil {
+				t.Fatalf("PixelToSky failed: %v", err)
+			}
+			if want := tt.distance(offset) * rad2deg; math.Abs(dec-want) > 1e-9 || math.Abs(ra) > 1e-9 {
+				t.Errorf("30° north = (%.9f, %.9f), want (0, %.9f)", ra, dec, want)
+			}
+
+			// East is left, so 30° to the right is 30° west of RA 0
+			ra, dec, err = r.PixelToSky(130, 100)
+			if err != nil {
+				t.Fatalf("PixelToSky failed: %v", err)
+			}
+			if want := 360 - tt.distance(offset)*rad2deg; math.Abs(ra-want) > 1e-9 || math.Abs(dec) > 1e-9 {
+				t.Errorf("30° right = (%.9f, %.9f), want (%.9f, 0)", ra, dec, want)
+			}
+
+			// Off-axis and away from the equator, the distance from CRVAL
+			// still follows the projection's radial law
+			r = projectionResult(t, tt.projection, 250, 60)
+			ra, dec, err = r.PixelToSky(100+24, 100-18) // 30° from the reference pixel
+			if err != nil {
+				t.Fatalf("PixelToSky failed: %v", err)
+			}
+			if got, want := angularSeparation(250, 60, ra, dec), tt.distance(offset)*rad2deg; math.Abs(got-want) > 1e-9 {
+				t.Errorf("off-axis point is %.9f° from CRVAL, want %.9f°", got, want)
+			}
+		})
+	}
+
+	// ARC is equidistant at any radius
+	ra, dec, err := projectionResult(t, "ARC", 0, 45).PixelToSky(100, 140)
+	if err != nil || math.Abs(dec-85) > 1e-9 || math.Abs(ra) > 1e-9 {
+		t.Errorf("ARC 40° north of Dec 45 = (%g, %g, %v), want (0, 85)", ra, dec, err)
+	}
+}
+
+func TestSkyToPixel_RoundTrip(t *testing.T) {
+	for _, projection := range []string{"TAN", "SIN", "ARC"} {
+		for _, dec0 := range []float64{-89, -30, 0, 45, 89.9} {
+			r := projectionResult(t, projection, 83.82, dec0)
+			// Within 57° of the reference pixel, where SIN's plane ends
+			for _, p := range [][2]float64{{100, 100}, {140, 140}, {150, 80}, {70, 130}} {
+				ra, dec, err := r.PixelToSky(p[0], p[1])
+				if err != nil {
+					t.Fatalf("PixelToSky failed: %v", err)
+				}
+				x, y, err := r.SkyToPixel(ra, dec)
+				if err != nil {
+					t.Fatalf("%s Dec %g: SkyToPixel(%g, %g) failed: %v", projection, dec0, ra, dec, err)
+				}
+				if math.Hypot(x-p[0], y-p[1]) > 1e-6 {
+					t.Errorf("%s Dec %g: pixel %v -> (%g, %g) -> (%g, %g)", projection, dec0, p, ra, dec, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestSkyToPixel_SIP(t *testing.T) {
+	r := projectionResult(t, "TAN", 10, 20)
+	r.WCSHeader["A_ORDER"], r.WCSHeader["B_ORDER"] = "2", "2"
+	r.WCSHeader["A_2_0"], r.WCSHeader["B_0_2"], r.WCSHeader["A_1_1"] = "1e-4", "-2e-4", "5e-5"
+	ra, dec, err := r.PixelToSky(180, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, y, err := r.SkyToPixel(ra, dec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Hypot(x-180, y-30) > 1e-6 {
+		t.Errorf("SIP round trip gave (%g, %g), want (180, 30)", x, y)
+	}
+}
+
+func TestPixelToSky_Errors(t *testing.T) {
+	for _, ctype := range [][2]string{{"RA---ZEA", "DEC--ZEA"}, {"GLON-TAN", "GLAT-TAN"}, {"RA---TAN", "DEC--SIN"}} {
+		r := projectionResult(t, "TAN", 0, 0)
+		r.WCSHeader["CTYPE1"], r.WCSHeader["CTYPE2"] = ctype[0], ctype[1]
+		if _, _, err := r.PixelToSky(1, 1); !errors.Is(err, ErrUnsupportedProjection) {
+			t.Errorf("%v: PixelToSky err = %v, want ErrUnsupportedProjection", ctype, err)
+		}
+		if _, _, err := r.SkyToPixel(0, 0); !errors.Is(err, ErrUnsupportedProjection) {
+			t.Errorf("%v: SkyToPixel err = %v, want ErrUnsupportedProjection", ctype, err)
+		}
+	}
+
+	if _, _, err := (&Result{}).PixelToSky(1, 1); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("unsolved: err = %v, want ErrInvalidInput", err)
+	}
+	// 60° from the reference pixel is off the SIN disk
+	if _, _, err := projectionResult(t, "SIN", 0, 0).PixelToSky(160, 100); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("beyond SIN edge: err = %v, want ErrInvalidInput", err)
+	}
+
+	// The far hemisphere has no TAN or SIN image; ARC reaches it
+	for projection, wantErr := range map[string]bool{"TAN": true, "SIN": true, "ARC": false} {
+		_, _, err := projectionResult(t, projection, 0, 0).SkyToPixel(180, 10)
+		if got := errors.Is(err, ErrInvalidInput); got != wantErr {
+			t.Errorf("%s far side: err = %v, want error %v", projection, err, wantErr)
+		}
+	}
+}
+
+func TestUnsupportedProjection_Derived(t *testing.T) {
+	header := map[string]string{
+		"CTYPE1": "RA---ZEA", "CTYPE2": "DEC--ZEA",
+		"CRVAL1": "10", "CRVAL2": "20", "CRPIX1": "100", "CRPIX2": "100",
+		"CD1_1": "-1", "CD1_2": "0", "CD2_1": "0", "CD2_2": "1",
+	}
+	if _, err := ResultFromWCSHeader(header, 200, 200); !errors.Is(err, ErrUnsupportedProjection) {
+		t.Errorf("ResultFromWCSHeader err = %v, want ErrUnsupportedProjection", err)
+	}
+
+	// A result whose header changed after parsing is not approximated as TAN
+	r := projectionResult(t, "TAN", 10, 20)
+	r.WCSHeader["CTYPE1"], r.WCSHeader["CTYPE2"] = header["CTYPE1"], header["CTYPE2"]
+	if _, ok := r.CornerCoords(); ok {
+		t.Error("CornerCoords ok = true, want false")
+	}
+	if _, _, ok := r.AccurateFieldSize(); ok {
+		t.Error("AccurateFieldSize ok = true, want false")
+	}
+	if err := r.WriteDS9Region(io.Discard); !errors.Is(err, ErrUnsupportedProjection) {
+		t.Errorf("WriteDS9Region err = %v, want ErrUnsupportedProjection", err)
+	}
+	if _, err := r.NovaJSON(); !errors.Is(err, ErrUnsupportedProjection) {
+		t.Errorf("NovaJSON err = %v, want ErrUnsupportedProjection", err)
+	}
+}
@@ -26,24 +26,178 @@ type Result struct {
 	// Rotation is the field rotation in degrees.
 	Rotation float64
 
+	// Parity reports whether the image is mirror-flipped relative to the sky.
+	Parity Parity
+
+	// NorthAngle and EastAngle are the on-image directions of celestial
+	// north and east at the reference pixel, in degrees counter-clockwise
+	// from the +x pixel axis towards +y (FITS pixel convention, y up).
+	// They account for parity, so east is 90° clockwise of north for
+	// ParityPositive and 90° counter-clockwise for ParityNegative.
+	NorthAngle float64
+	EastAngle  float64
+
+	// FlipDetected is set by SolveSequence when the field rotated by about
+	// 180° relative to the previous solved frame, as after a German
+	// equatorial mount's meridian flip.
+	FlipDetected bool
+
+	// FromExisting is set when SolveOptions.SkipIfSolved found a valid WCS
+	// already in the image header or a sidecar .wcs file, so no solve ran.
+	FromExisting bool
+
+	// FromXYList is set when the solve used SolveOptions.XYListPath: stars
+	// came from the given list, and nothing was derived from pixel data
+	// (Extractor is empty).
+	FromXYList bool
+
+	// Extractor names what detected the stars Solve matched:
+	// ExtractorSimplexy for solve-field's built-in extraction, or the Name
+	// of ClientConfig.Extractor. Empty when no extraction ran, as for
+	// FromExisting and SolveXYList results.
+	Extractor string
+
+	// HintRadius is the search radius in degrees of the
+	// SolveOptions.HintRadiusSchedule attempt that produced the result: the
+	// one that solved, or the last. 0 for a blind attempt or without a
+	// schedule.
+	HintRadius float64
+
+	// DockerImage is the solver image that ran the solve in run mode, which
+	// differs from ClientConfig.DockerImage when one of FallbackImages
+	// solved. Empty in exec mode and for FromExisting results.
+	DockerImage string
+
 	// FieldWidth is the field of view width in degrees.
 	FieldWidth float64
 
 	// FieldHeight is the field of view height in degrees.
 	FieldHeight float64
 
+	// ImageWidth and ImageHeight are the solved image dimensions in pixels,
+	// from IMAGEW/IMAGEH. solve-field's .wcs files are header-only, with
+	// NAXIS = 0 and no NAXIS1/NAXIS2, so IMAGEW/IMAGEH are the only source
+	// there; NAXIS1/NAXIS2 are a fallback for WCS headers of images. Zero
+	// when unknown, in which case the field size and corners are unknown
+	// too.
+	ImageWidth  int
+	ImageHeight int
+
+	// PartialField is set when SolveOptions.CropPixels solved only Crop, a
+	// central part of the image. The solution, including the WCS,
+	// ImageWidth/ImageHeight and the field size, then applies to the crop
+	// alone; add Crop.X and Crop.Y to crop pixels for full-image pixels.
+	PartialField bool
+	Crop         CropGeometry
+
 	// WCSHeader contains the raw parsed WCS header fields.
 	WCSHeader map[string]string
 
+	// SolvedFields lists the 1-based field numbers marked solved in the
+	// .solved file. It is [1] for a solved single image, and nil when the
+	// solver image did not write a .solved file.
+	SolvedFields []int
+
 	// OutputFiles contains paths to generated output files (.wcs, .corr, etc.).
 	OutputFiles []string
 
-	// SolveTime is the duration of the solve operation.
+	// Outputs maps each kind of output file in OutputFiles to its path;
+	// WCSPath, CorrPath and the other accessors read it.
+	Outputs map[OutputKind]string
+
+	// MissingOutputs lists the output files a solve must write (.wcs and
+	// .solved) that Solve did not find: for a solved result, typically
+	// .solved from an image too old to write it; for an unsolved result,
+	// .wcs when the .solved marker claims a solution. Nil when nothing is
+	// missing or no solution was found. See CheckOutputs.
+	MissingOutputs []OutputKind
+
+	// Warnings lists SolveOptions.Warnings for the options solved with,
+	// such as scale bounds implausible for their ScaleUnits. Nil when there
+	// are none and for FromExisting results.
+	Warnings []string
+
+	// HookErrors holds a *HookError for each ClientConfig.PostSolveHooks
+	// hook that failed, timed out or panicked. Nil when every hook succeeded.
+	HookErrors []error
+
+	// TotalTime is the end-to-end duration of the solver run in seconds,
+	// including container startup (1-3 s for a cold docker run).
+	// SolverWallTime is the part spent solving.
+	TotalTime float64
+
+	// SolveTime is TotalTime under its original name.
+	//
+	// Deprecated: Use TotalTime, or SolverWallTime for the solver alone.
 	SolveTime float64 // seconds
 
+	// SolverWallTime and SolverCPUTime are the wall-clock and CPU (user
+	// plus system) seconds solve-field reports for itself, excluding
+	// container overhead. 0 when its output does not report them, such
+	// as for SkipIfSolved results. See Overhead.
+	SolverWallTime float64
+	SolverCPUTime  float64
+
+	// RMSResidualArcsec is the RMS residual of the matched stars against
+	// the solution, the best single measure of its quality: reject solves
+	// above a threshold even when solved. Only populated when the Verbose
+	// option is enabled, as solve-field skips the verification that
+	// reports it otherwise; 0 when not reported.
+	RMSResidualArcsec float64
+
 	// RawOutput contains the raw stdout/stderr from solve-field.
 	// Only populated when Verbose option is enabled.
 	RawOutput string
+
+	// ScratchDir is the scratch location the solve workspace was created in.
+	ScratchDir string
+}
+
+// Overhead returns the seconds of TotalTime not spent in solve-field, such
+// as container startup and teardown. It is 0 when the solver did not
+// report its time.
+func (r *Result) Overhead() float64 {
+	if r.SolverWallTime <= 0 {
+		return 0
+	}
+	return max(0, r.TotalTime-r.SolverWallTime)
+}
+
+// setTimes records the end-to-end time and the solver's own times from its
+// output.
+func (r *Result) setTimes(total float64, output string) {
+	r.TotalTime, r.SolveTime = total, total
+	r.SolverWallTime, r.SolverCPUTime = solverTimes(output)
+}
+
+// Parity describes the handedness of a solution, from the sign of the
+// CD matrix determinant.
+type Parity int
+
+const (
+	// ParityUnknown means the result carries no CD matrix.
+	ParityUnknown Parity = iota
+
+	// ParityPositive is the normal sky orientation (negative determinant):
+	// with north up, east is to the left, as seen through a telescope
+	// without a diagonal.
+	ParityPositive
+
+	// ParityNegative is a mirror-flipped image (positive determinant):
+	// with north up, east is to the right.
+	ParityNegative
+)
+
+// String returns "positive", "negative" or "unknown".
+func (p Parity) String() string {
+	switch p {
+	case ParityPositive:
+		return "positive"
+	case ParityNegative:
+		return "negative"
+	default:
+		return "unknown"
+	}
 }
 
 var (
@@ -61,10 +215,114 @@ var (
 
 	// ErrWCSParseFailed indicates failure to parse WCS output.
 	ErrWCSParseFailed = errors.New("failed to parse WCS output")
+
+	// ErrNoScratchSpace indicates that no configured scratch directory has enough free space.
+	ErrNoScratchSpace = errors.New("no scratch directory with enough free space")
+
+	// ErrUnsupportedCompression indicates a compressed image that needs a
+	// tool the solver image does not have.
+	ErrUnsupportedCompression = errors.New("unsupported image compression")
+
+	// ErrMissingOutputs indicates a solve that did not write every output
+	// file a solve must produce, as reported by Result.CheckOutputs.
+	ErrMissingOutputs = errors.New("solve outputs missing")
+
+	// ErrFieldTooWide indicates an all-sky or fisheye field wider than any
+	// index covers or the TAN projection can fit. Solve a central crop.
+	ErrFieldTooWide = errors.New("field too wide to solve")
+
+	// ErrUnsupportedProjection indicates a WCS whose CTYPE projection the
+	// coordinate conversions do not implement (only TAN, SIN and ARC).
+	ErrUnsupportedProjection = errors.New("unsupported WCS projection")
+
+	// ErrImagePull indicates that EnsureImage or StartContainer could not
+	// pull the solver image. It wraps ErrDockerFailed.
+	ErrImagePull = fmt.Errorf("%w: image pull failed", ErrDockerFailed)
+
+	// ErrContainerStart indicates that StartContainer's docker run failed.
+	// It wraps ErrDockerFailed.
+	ErrContainerStart = fmt.Errorf("%w: container start failed", ErrDockerFailed)
+
+	// ErrContainerNotReady indicates a container StartContainer started
+	// that could not run solve-field before the deadline or has no index
+	// files mounted. It wraps ErrDockerFailed.
+	ErrContainerNotReady = fmt.Errorf("%w: container not ready", ErrDockerFailed)
 )
 
+// headerDimension returns the image size in pixels from the key card,
+// falling back to the fallback card only when key is missing or not a
+// positive number. solve-field's .wcs files carry IMAGEW/IMAGEH next to
+// NAXIS1/NAXIS2 = 0 (the file has no pixel data), so NAXIS must never
+// override IMAGEW.
+func headerDimension(header map[string]string, key, fallback string) float64 {
+	for _, k := range []string{key, fallback} {
+		if v, err := strconv.ParseFloat(header[k], 64); err == nil && v > 0 {
+			return v
+		}
+	}
+	return 0
+}
+
+// Clone returns a deep copy of the result. The WCSHeader and Outputs maps
+// and the OutputFiles, MissingOutputs, HookErrors and SolvedFields slices
+// are copied, so the clone can be modified without affecting the original.
+func (r *Result) Clone() *Result {
+	if r == nil {
+		return nil
+	}
+	clone := *r
+	if r.WCSHeader != nil {
+		clone.WCSHeader = make(map[string]string, len(r.WCSHeader))
+		for k, v := range r.WCSHeader {
+			clone.WCSHeader[k] = v
+		}
+	}
+	if r.OutputFiles != nil {
+		clone.OutputFiles = append([]string(nil), r.OutputFiles...)
+	}
+	if r.Outputs != nil {
+		clone.Outputs = make(map[OutputKind]string, len(r.Outputs))
+		for k, v := range r.Outputs {
+			clone.Outputs[k] = v
+		}
+	}
+	if r.MissingOutputs != nil {
+		clone.MissingOutputs = append([]OutputKind(nil), r.MissingOutputs...)
+	}
+	if r.HookErrors != nil {
+		clone.HookErrors = append([]error(nil), r.HookErrors...)
+	}
+	if r.SolvedFields != nil {
+		clone.SolvedFields = append([]int(nil), r.SolvedFields...)
+	}
+	return &clone
+}
+
+// ScaleArcminWidth returns the solved field width in arcminutes for an image
+// imageWidthPx pixels wide: PixelScale × width, in the "arcminwidth" units
+// SolveOptions.ScaleUnits defaults to. Feeding it back as the next frame's
+// scale bounds turns a wide-open first search into a tight one:
+//
+//	w := result.ScaleArcminWidth(0)
+//	opts.ScaleLow, opts.ScaleHigh, opts.ScaleUnits = 0.9*w, 1.1*w, "arcminwidth"
+//
+// Pass the width of the frame that will be solved next if it differs (e.g.
+// after binning or resizing); a non-positive imageWidthPx uses ImageWidth.
+// It returns 0 for an unsolved result or when the width is unknown.
+func (r *Result) ScaleArcminWidth(imageWidthPx int) float64 {
+	if !r.Solved || r.PixelScale <= 0 {
+		return 0
+	}
+	if imageWidthPx <= 0 {
+		imageWidthPx = r.ImageWidth
+	}
+	return r.PixelScale * float64(imageWidthPx) / 60
+}
+
 // ParseWCSFile parses a FITS WCS header file and returns a Result.
 // The WCS file uses FITS header format with fixed 80-character records.
+// As with ResultFromWCSHeader, a projection other than TAN, SIN or ARC
+// returns ErrUnsupportedProjection.
 func ParseWCSFile(wcsPath string) (*Result, error) {
 	file, err := os.Open(wcsPath)
 	if err != nil {
@@ -74,10 +332,7 @@ func ParseWCSFile(wcsPath string) (*Result, error) {
 		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
 	}()
 
-	result := &Result{
-		Solved:    true,
-		WCSHeader: make(map[string]string),
-	}
+	header := make(map[string]string)
 
 	// Read FITS file in 80-character records
 	buf := make([]byte, 80)
@@ -117,12 +372,48 @@ func ParseWCSFile(wcsPath string) (*Result, error) {
 		valuePart = strings.TrimSpace(valuePart)
 
 		// Store in map
-		result.WCSHeader[key] = valuePart
+		header[key] = valuePart
+	}
+
+	return ResultFromWCSHeader(header, 0, 0)
+}
+
+// ResultFromWCSHeader builds a solved Result from WCS header key/value
+// pairs, for solutions obtained elsewhere (nova.astrometry.net, ASTAP) that
+// should get the same derived fields, footprint and export support as a
+// local solve. Values are unquoted strings as they appear in the header,
+// e.g. {"CTYPE1": "RA---TAN", "CRVAL1": "83.8", ...}.
+//
+// The linear transform is read from the CD matrix, or when absent from
+// CDELTi with a PCi_j matrix (identity when missing) or with CROTA2.
+// width and height give the image size in pixels, overriding
+// IMAGEW/IMAGEH (or NAXIS1/NAXIS2) in the header when positive; the field
+// center, field size and rotation need it. The header map is copied into
+// Result.WCSHeader, with IMAGEW/IMAGEH set from the arguments, and is not
+// modified. A header whose CTYPE pair is not RA/Dec in a projection
+// PixelToSky implements returns ErrUnsupportedProjection, since the derived
+// fields would be wrong.
+//
+// ParseWCSFile is this function applied to the cards of a .wcs file.
+func ResultFromWCSHeader(header map[string]string, width, height int) (*Result, error) {
+	projection, err := wcsProjection(header)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{
+		Solved:    true,
+		WCSHeader: make(map[string]string, len(header)+2),
+	}
+	for k, v := range header {
+		result.WCSHeader[k] = v
+	}
+	if width > 0 && height > 0 {
+		result.WCSHeader["IMAGEW"] = strconv.Itoa(width)
+		result.WCSHeader["IMAGEH"] = strconv.Itoa(height)
 	}
 
 	// Parse WCS transformation parameters
 	var crval1, crval2, crpix1, crpix2 float64
-	var cd11, cd12, cd21, cd22 float64
 	var imageW, imageH float64
 	var hasWCS bool
 
@@ -148,46 +439,14 @@ func ParseWCSFile(wcsPath string) (*Result, error) {
 			crpix2 = v
 		}
 	}
-	if val, ok := result.WCSHeader["CD1_1"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			cd11 = v
-		}
-	}
-	if val, ok := result.WCSHeader["CD1_2"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			cd12 = v
-		}
-	}
-	if val, ok := result.WCSHeader["CD2_1"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			cd21 = v
-		}
-	}
-	if val, ok := result.WCSHeader["CD2_2"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			cd22 = v
-		}
-	}
+	cd11, cd12, cd21, cd22 := cdMatrix(result.WCSHeader)
 
 	// Get image dimensions (prefer IMAGEW/IMAGEH, fallback to NAXIS1/NAXIS2)
-	if val, ok := result.WCSHeader["IMAGEW"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			imageW = v
-		}
-	} else if val, ok := result.WCSHeader["NAXIS1"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			imageW = v
-		}
-	}
-	if val, ok := result.WCSHeader["IMAGEH"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			imageH = v
-		}
-	} else if val, ok := result.WCSHeader["NAXIS2"]; ok {
-		if v, err := strconv.ParseFloat(val, 64); err == nil {
-			imageH = v
-		}
-	}
+	imageW = headerDimension(result.WCSHeader, "IMAGEW", "NAXIS1")
+	imageH = headerDimension(result.WCSHeader, "IMAGEH", "NAXIS2")
+
+	result.ImageWidth = int(imageW)
+	result.ImageHeight = int(imageH)
 
 	// Calculate field center coordinates using WCS transformation
 	// The reference pixel (CRPIX) has coordinates CRVAL at that pixel
@@ -197,17 +456,15 @@ func ParseWCSFile(wcsPath string) (*Result, error) {
 		centerX := imageW / 2.0
 		centerY := imageH / 2.0
 
-		// Offset from reference pixel to center
-		dx := centerX - crpix1
-		dy := centerY - crpix2
-
-		// Apply CD matrix transformation to get sky coordinate offsets
-		dRA := cd11*dx + cd12*dy
-		dDec := cd21*dx + cd22*dy
+		// De-project the image center through the projection plane at CRVAL
+		wcs := skyWCS{crval1: crval1, crval2: crval2, crpix1: crpix1, crpix2: crpix2,
+			cd11: cd11, cd12: cd12, cd21: cd21, cd22: cd22}
+		wcs.projection = projection
+		result.RA, result.Dec = wcs.pixelToSky(centerX, centerY)
 
-		// Calculate field center coordinates
-		result.RA = crval1 + dRA
-		result.Dec = crval2 + dDec
+		// Field size is the great-circle extent between opposite edge midpoints
+		result.FieldWidth = wcs.separation(0, centerY, imageW, centerY)
+		result.FieldHeight = wcs.separation(centerX, 0, centerX, imageH)
 
 		// Calculate pixel scale from CD matrix
 		// Pixel scale = sqrt(CD1_1^2 + CD2_1^2) in degrees/pixel
@@ -232,9 +489,9 @@ func ParseWCSFile(wcsPath string) (*Result, error) {
 		result.RA = crval1
 		result.Dec = crval2
 
-		// Fallback pixel scale from CD1_1
-		if cd11 != 0 {
-			result.PixelScale = abs(cd11) * 3600.0
+		// The pixel scale does not depend on the image size
+		if pixelScaleDeg := math.Hypot(cd11, cd21); pixelScaleDeg > 0 {
+			result.PixelScale = pixelScaleDeg * 3600.0
 		}
 
 		// Extract rotation angle if available
@@ -243,31 +500,18 @@ func ParseWCSFile(wcsPath string) (*Result, error) {
 				result.Rotation = v
 			}
 		}
-	}
 
-	// Calculate field dimensions from image size and pixel scale if available
-	// Try IMAGEW/IMAGEH first (astrometry.net specific), then fall back to NAXIS1/NAXIS2
-	if imagew, ok := result.WCSHeader["IMAGEW"]; ok {
-		if w, err := strconv.ParseFloat(imagew, 64); err == nil && result.PixelScale > 0 {
-			result.FieldWidth = (w * result.PixelScale) / 3600.0 // degrees
-		}
-	}
-	if imageh, ok := result.WCSHeader["IMAGEH"]; ok {
-		if h, err := strconv.ParseFloat(imageh, 64); err == nil && result.PixelScale > 0 {
-			result.FieldHeight = (h * result.PixelScale) / 3600.0 // degrees
+		// Calculate field dimensions from image size and pixel scale if available
+		if result.PixelScale > 0 {
+			result.FieldWidth = (imageW * result.PixelScale) / 3600.0  // degrees
+			result.FieldHeight = (imageH * result.PixelScale) / 3600.0 // degrees
 		}
 	}
 
-	// Fall back to NAXIS if IMAGEW/IMAGEH not found
-	if naxis1, ok := result.WCSHeader["NAXIS1"]; ok {
-		if w, err := strconv.ParseFloat(naxis1, 64); err == nil && result.PixelScale > 0 {
-			result.FieldWidth = (w * result.PixelScale) / 3600.0 // degrees
-		}
-	}
-	if naxis2, ok := result.WCSHeader["NAXIS2"]; ok {
-		if h, err := strconv.ParseFloat(naxis2, 64); err == nil && result.PixelScale > 0 {
-			result.FieldHeight = (h * result.PixelScale) / 3600.0 // degrees
-		}
+	// Orientation from the CD matrix, independent of image dimensions
+	if hasWCS {
+		wcs := skyWCS{cd11: cd11, cd12: cd12, cd21: cd21, cd22: cd22}
+		result.Parity, result.NorthAngle, result.EastAngle = wcs.orientation()
 	}
 
 	// Validate that we got essential fields
@@ -278,10 +522,56 @@ func ParseWCSFile(wcsPath string) (*Result, error) {
 	return result, nil
 }
 
-// abs returns the absolute value of a float64.
-func abs(x float64) float64 {
-	if x < 0 {
-		return -x
+// cdMatrix returns the linear pixel-to-intermediate-world transform in
+// degrees per pixel. The CD matrix is used when any CDi_j card is present
+// (missing elements are zero); otherwise CDELTi scales the PCi_j matrix
+// when any PC card is present, or a rotation by CROTA2. Headers with none
+// of these yield a zero matrix.
+func cdMatrix(header map[string]string) (cd11, cd12, cd21, cd22 float64) {
+	get := func(key string, def float64) (float64, bool) {
+		val, ok := header[key]
+		if !ok {
+			return def, false
+		}
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return def, false
+		}
+		return v, true
+	}
+
+	var anyCD bool
+	cd := [4]float64{}
+	for i, key := range []string{"CD1_1", "CD1_2", "CD2_1", "CD2_2"} {
+		v, ok := get(key, 0)
+		cd[i] = v
+		anyCD = anyCD || ok
+	}
+	if anyCD {
+		return cd[0], cd[1], cd[2], cd[3]
+	}
+
+	cdelt1, ok1 := get("CDELT1", 0)
+	cdelt2, ok2 := get("CDELT2", 0)
+	if !ok1 || !ok2 {
+		return 0, 0, 0, 0
+	}
+
+	var anyPC bool
+	pc := [4]float64{}
+	for i, key := range []string{"PC1_1", "PC1_2", "PC2_1", "PC2_2"} {
+		def := 0.0
+		if i == 0 || i == 3 {
+			def = 1
+		}
+		v, ok := get(key, def)
+		pc[i] = v
+		anyPC = anyPC || ok
+	}
+	if !anyPC {
+		crota, _ := get("CROTA2", 0)
+		sin, cos := math.Sincos(crota * math.Pi / 180)
+		return cdelt1 * cos, -cdelt2 * sin, cdelt1 * sin, cdelt2 * cos
 	}
-	return x
+	return cdelt1 * pc[0], cdelt1 * pc[1], cdelt2 * pc[2], cdelt2 * pc[3]
 }
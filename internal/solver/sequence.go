@@ -0,0 +1,99 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+const (
+	// flipTolerance is how far from 180° a rotation change between
+	// consecutive solved frames may be and still count as a meridian flip.
+	flipTolerance = 15.0
+
+	// sequenceScaleMargin is the fractional slack around the previous
+	// frame's pixel scale used as the next frame's scale hint.
+	sequenceScaleMargin = 0.1
+)
+
+// solveFunc runs a single solve. It is Client.Solve outside of tests.
+type solveFunc func(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error)
+
+// SolveSequence solves a time-ordered sequence of frames from one imaging
+// session, using each solved frame's position and scale as the hint for the
+// next. It returns one Result per image, in order; frames that fail to
+// solve have Solved false.
+//
+// The hint only constrains position and pixel scale, never rotation, so a
+// German equatorial mount's meridian flip (the field rotating by ~180°)
+// does not break propagation. A frame whose rotation differs by ~180° from
+// the previous solved frame has FlipDetected set. When a hinted solve
+// fails, the frame is retried with opts unchanged (no carried-over hint)
+// before being marked unsolved, and a flip on that retry is detected the
+// same way.
+//
+// An error other than a missing solution stops the sequence; the results
+// solved so far are returned with it.
+func (c *Client) SolveSequence(ctx context.Context, imagePaths []string, opts *SolveOptions) ([]*Result, error) {
+	return solveSequence(ctx, imagePaths, opts, c.Solve)
+}
+
+func solveSequence(ctx context.Context, imagePaths []string, opts *SolveOptions, solve solveFunc) ([]*Result, error) {
+	if opts == nil {
+		opts = DefaultSolveOptions()
+	}
+
+	results := make([]*Result, 0, len(imagePaths))
+	var last *Result
+	for _, imagePath := range imagePaths {
+		result, err := solve(ctx, imagePath, sequenceHint(opts, last))
+		if last != nil && (errors.Is(err, ErrNoSolution) || (err == nil && !result.Solved)) {
+			// The hint may be stale, e.g. after a slew; retry blind
+			result, err = solve(ctx, imagePath, opts)
+		}
+		if errors.Is(err, ErrNoSolution) {
+			result, err = &Result{Solved: false}, nil
+		}
+		if err != nil {
+			return results, err
+		}
+
+		if result.Solved {
+			if last != nil && isMeridianFlip(last.Rotation, result.Rotation) {
+				result.FlipDetected = true
+			}
+			last = result
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// sequenceHint returns opts with the position and scale of the previous
+// solved frame as hints. Rotation is deliberately not carried over.
+func sequenceHint(opts *SolveOptions, last *Result) *SolveOptions {
+	if last == nil || last.PixelScale <= 0 {
+		return opts
+	}
+	hinted := *opts
+	hinted.RA = last.RA
+	hinted.Dec = last.Dec
+
+	// Allow the mount to drift or dither by up to a field width
+	radius := math.Max(last.FieldWidth, last.FieldHeight)
+	hinted.Radius = math.Max(radius, opts.Radius)
+
+	hinted.ScaleLow = last.PixelScale * (1 - sequenceScaleMargin)
+	hinted.ScaleHigh = last.PixelScale * (1 + sequenceScaleMargin)
+	hinted.ScaleUnits = "arcsecperpix"
+	return &hinted
+}
+
+// isMeridianFlip reports whether the rotation changed by about 180°.
+func isMeridianFlip(prev, next float64) bool {
+	d := math.Abs(math.Mod(next-prev, 360))
+	if d > 180 {
+		d = 360 - d
+	}
+	return d >= 180-flipTolerance
+}
@@ -0,0 +1,151 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// sequenceFrame is a synthesized frame: the solution it yields, and whether
+// it only solves without a hint.
+type sequenceFrame struct {
+	ra, dec, rotation float64
+	blindOnly         bool
+	unsolvable        bool
+}
+
+// fakeSequenceSolver returns synthesized results keyed by image path and
+// records the options each solve was called with.
+type fakeSequenceSolver struct {
+	frames map[string]sequenceFrame
+	calls  []*SolveOptions
+}
+
+func (f *fakeSequenceSolver) solve(_ context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
+	f.calls = append(f.calls, opts)
+	frame := f.frames[imagePath]
+	hinted := opts.ScaleUnits == "arcsecperpix"
+	if frame.unsolvable || (frame.blindOnly && hinted) {
+		return &Result{Solved: false}, nil
+	}
+	return &Result{
+		Solved: true, RA: frame.ra, Dec: frame.dec, Rotation: frame.rotation,
+		PixelScale: 2.5, FieldWidth: 2, FieldHeight: 1.5,
+	}, nil
+}
+
+func TestSolveSequence_MeridianFlip(t *testing.T) {
+	solver := &fakeSequenceSolver{frames: map[string]sequenceFrame{
+		"f1": {ra: 83.80, dec: -5.40, rotation: 92},
+		"f2": {ra: 83.81, dec: -5.40, rotation: 92.3},
+		"f3": {ra: 83.82, dec: -5.41, rotation: 272.1}, // meridian flip
+		"f4": {ra: 83.82, dec: -5.41, rotation: 272.0},
+		"f5": {ra: 83.83, dec: -5.40, rotation: 271.8},
+	}}
+	paths := []string{"f1", "f2", "f3", "f4", "f5"}
+
+	results, err := solveSequence(context.Background(), paths, nil, solver.solve)
+	if err != nil {
+		t.Fatalf("solveSequence failed: %v", err)
+	}
+	if len(results) != len(paths) {
+		t.Fatalf("got %d results, want %d", len(results), len(paths))
+	}
+
+	for i, r := range results {
+		if !r.Solved {
+			t.Errorf("frame %d not solved", i+1)
+		}
+		if want := i == 2; r.FlipDetected != want {
+			t.Errorf("frame %d FlipDetected = %v, want %v", i+1, r.FlipDetected, want)
+		}
+	}
+
+	// One solve per frame: propagation continues through the flip
+	if len(solver.calls) != len(paths) {
+		t.Fatalf("got %d solve calls, want %d", len(solver.calls), len(paths))
+	}
+	if solver.calls[0].ScaleUnits == "arcsecperpix" {
+		t.Error("first frame should not be hinted")
+	}
+	for i, opts := range solver.calls[1:] {
+		prev := results[i]
+		if opts.RA != prev.RA || opts.Dec != prev.Dec {
+			t.Errorf("frame %d hint = (%v, %v), want previous solution (%v, %v)", i+2, opts.RA, opts.Dec, prev.RA, prev.Dec)
+		}
+		if opts.Radius != 2 || opts.ScaleUnits != "arcsecperpix" || opts.ScaleLow != 2.25 || opts.ScaleHigh != 2.75 {
+			t.Errorf("frame %d hint = %+v, want radius 2 and scale 2.25-2.75 arcsec/pix", i+2, opts)
+		}
+	}
+}
+
+func TestSolveSequence_BlindRetryAfterFlip(t *testing.T) {
+	solver := &fakeSequenceSolver{frames: map[string]sequenceFrame{
+		"f1": {ra: 10, dec: 40, rotation: 359},
+		"f2": {ra: 10, dec: 40, rotation: 178, blindOnly: true}, // flip, hint fails
+		"f3": {ra: 10, dec: 40, rotation: 178.5},
+		"f4": {unsolvable: true},
+		"f5": {ra: 10, dec: 40, rotation: 179},
+	}}
+
+	results, err := solveSequence(context.Background(), []string{"f1", "f2", "f3", "f4", "f5"}, nil, solver.solve)
+	if err != nil {
+		t.Fatalf("solveSequence failed: %v", err)
+	}
+
+	wantSolved := []bool{true, true, true, false, true}
+	wantFlip := []bool{false, true, false, false, false}
+	for i, r := range results {
+		if r.Solved != wantSolved[i] || r.FlipDetected != wantFlip[i] {
+			t.Errorf("frame %d: Solved=%v FlipDetected=%v, want %v %v",
+				i+1, r.Solved, r.FlipDetected, wantSolved[i], wantFlip[i])
+		}
+	}
+
+	// f2 and f4 are each retried blind; f5 is hinted from f3
+	if len(solver.calls) != 7 {
+		t.Fatalf("got %d solve calls, want 7", len(solver.calls))
+	}
+	if last := solver.calls[6]; last.RA != 10 || last.ScaleUnits != "arcsecperpix" {
+		t.Errorf("frame after an unsolved frame not hinted from last solution: %+v", last)
+	}
+}
+
+func TestSolveSequence_StopsOnError(t *testing.T) {
+	calls := 0
+	solve := func(_ context.Context, imagePath string, _ *SolveOptions) (*Result, error) {
+		calls++
+		if imagePath == "bad" {
+			return nil, ErrInvalidInput
+		}
+		return &Result{Solved: true, PixelScale: 1}, nil
+	}
+
+	results, err := solveSequence(context.Background(), []string{"ok", "bad", "ok"}, nil, solve)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+	if len(results) != 1 || calls != 2 {
+		t.Errorf("got %d results after %d calls, want 1 after 2", len(results), calls)
+	}
+}
+
+func TestIsMeridianFlip(t *testing.T) {
+	tests := []struct {
+		prev, next float64
+		want       bool
+	}{
+		{90, 270, true},
+		{359, 178, true},
+		{10, 185, true},
+		{10, 200, true},
+		{10, 30, false},
+		{10, 160, false},
+		{0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := isMeridianFlip(tt.prev, tt.next); got != tt.want {
+			t.Errorf("isMeridianFlip(%v, %v) = %v, want %v", tt.prev, tt.next, got, tt.want)
+		}
+	}
+}
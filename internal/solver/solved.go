@@ -0,0 +1,38 @@
+package solver
+
+import (
+	"fmt"
+	"os"
+)
+
+// ParseSolvedFile reads a solve-field .solved file and returns the 1-based
+// numbers of the fields that solved.
+//
+// The file holds one byte per field, indexed from field 1, set to 1 when
+// that field solved. solve-field only writes bytes up to the highest solved
+// field, so fields past the end of the file are unsolved. For a single
+// image the result is [1] when it solved.
+func ParseSolvedFile(path string) ([]int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read solved file: %w", err)
+	}
+
+	var fields []int
+	for i, b := range data {
+		if b != 0 {
+			fields = append(fields, i+1)
+		}
+	}
+	return fields, nil
+}
+
+// fieldSolved reports whether field (1-based) is in fields.
+func fieldSolved(fields []int, field int) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
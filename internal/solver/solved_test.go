@@ -0,0 +1,85 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+func TestParseSolvedFile(t *testing.T) {
+	// Fields 1 and 3 solved, field 2 did not
+	fields, err := ParseSolvedFile("testdata/multi-field.solved")
+	if err != nil {
+		t.Fatalf("ParseSolvedFile failed: %v", err)
+	}
+	if !reflect.DeepEqual(fields, []int{1, 3}) {
+		t.Errorf("solved fields = %v, want [1 3]", fields)
+	}
+	if !fieldSolved(fields, 3) || fieldSolved(fields, 2) || fieldSolved(fields, 4) {
+		t.Error("fieldSolved disagrees with parsed fields")
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.solved")
+	if err := os.WriteFile(empty, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if fields, err := ParseSolvedFile(empty); err != nil || len(fields) != 0 {
+		t.Errorf("empty file = %v, %v; want no fields", fields, err)
+	}
+
+	if _, err := ParseSolvedFile(filepath.Join(t.TempDir(), "missing.solved")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected not-exist error, got %v", err)
+	}
+}
+
+func TestSolve_SolvedMarker(t *testing.T) {
+	wcs := fits.EncodeHeader([]fits.Card{
+		{Key: "CRVAL1", Value: "83.8"}, {Key: "CRVAL2", Value: "-5.4"},
+		{Key: "CRPIX1", Value: "4"}, {Key: "CRPIX2", Value: "4"},
+		{Key: "CD1_1", Value: "-0.001"}, {Key: "CD1_2", Value: "0"},
+		{Key: "CD2_1", Value: "0"}, {Key: "CD2_2", Value: "0.001"},
+		{Key: "IMAGEW", Value: "8"}, {Key: "IMAGEH", Value: "8"},
+	})
+
+	tests := []struct {
+		name       string
+		solved     []byte // nil means no .solved file
+		wantSolved bool
+		wantFields []int
+	}{
+		{"marker set", []byte{1}, true, []int{1}},
+		{"marker unset", []byte{0}, false, nil},
+		{"no marker file", nil, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				if err := os.WriteFile(filepath.Join(workDir, "stars.wcs"), wcs, 0644); err != nil {
+					return err
+				}
+				if tt.solved != nil {
+					return os.WriteFile(filepath.Join(workDir, "stars.solved"), tt.solved, 0644)
+				}
+				return nil
+			})
+
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+			if err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+			if result.Solved != tt.wantSolved {
+				t.Errorf("Solved = %v, want %v", result.Solved, tt.wantSolved)
+			}
+			if !reflect.DeepEqual(result.SolvedFields, tt.wantFields) {
+				t.Errorf("SolvedFields = %v, want %v", result.SolvedFields, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package solver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SolveLog is structured information extracted from solve-field's text output.
+type SolveLog struct {
+	// Solved reports whether the output records a solution.
+	Solved bool
+
+	// StarsDetected is the number of sources found by source extraction.
+	StarsDetected int
+
+	// StarsMatched is the number of field stars matched to index stars in
+	// the accepted solution.
+	StarsMatched int
+
+	// SolveTimeSeconds is the wall-clock time reported by the solver.
+	SolveTimeSeconds float64
+
+	// CPUTimeSeconds is the user plus system CPU time reported by the
+	// solver.
+	CPUTimeSeconds float64
+
+	// RMSResidualArcsec and RMSResidualPixels are the RMS residual of the
+	// matched stars in the units the solver reported it; the other is
+	// zero. Only verbose solve-field output (SolveOptions.Verbose) reports
+	// a residual.
+	RMSResidualArcsec float64
+	RMSResidualPixels float64
+
+	// FailureReason describes why the image did not solve. Empty when solved.
+	FailureReason string
+
+	// Attempts lists each search depth the solver tried, in order.
+	Attempts []AttemptLog
+}
+
+// AttemptLog describes one search depth tried by the solver.
+type AttemptLog struct {
+	// Depth is the number of field objects searched, i.e. the upper end
+	// of the field object range for this attempt.
+	Depth int
+
+	// QuadsTriedAt is the cumulative number of quads tried when the
+	// attempt finished.
+	QuadsTriedAt int
+
+	// Success reports whether this attempt produced the solution.
+	Success bool
+}
+
+var (
+	sourcesPattern  = regexp.MustCompile(`simplexy: found (\d+) sources`)
+	progressPattern = regexp.MustCompile(`object \d+ of (\d+): (\d+) quads tried`)
+	noSolvePattern  = regexp.MustCompile(`did not solve \(index .*, field objects \d+-(\d+)\)`)
+	solvedPattern   = regexp.MustCompile(`Field \d+: solved with index`)
+	matchPattern    = regexp.MustCompile(`log-odds ratio .*?, (\d+) match`)
+	wallTimePattern = regexp.MustCompile(`Spent .* ([\d.]+) s wall time`)
+	cpuTimePattern  = regexp.MustCompile(`Spent .* ([\d.]+) s total`)
+	rmsPattern      = regexp.MustCompile(`(?i)rms (?:residual|error)s?\b[^\d\n]*([\d.]+(?:e[-+]?\d+)?)\s*(arcsec|pix)`)
+)
+
+// failureReasons maps known solve-field failure messages to reasons,
+// checked in order.
+var failureReasons = []struct {
+	pattern *regexp.Regexp
+	reason  string
+}{
+	{regexp.MustCompile(`(?i)cpu time limit`), "CPU time limit reached"},
+	{regexp.MustCompile(`(?i)wall-clock time limit|time limit reached`), "time limit reached"},
+	{regexp.MustCompile(`(?i)no index files|at least one index`), "no index files available"},
+	{regexp.MustCompile(`simplexy: found 0 sources`), "no sources detected"},
+	{regexp.MustCompile(`(?i)failed to (read|open|convert)`), "failed to read image"},
+	{regexp.MustCompile(`Did not solve`), "no solution found with the available indexes"},
+}
+
+// knownFailure reports whether output holds one of solve-field's own
+// failure messages, so a non-zero exit was solve-field giving up.
+func knownFailure(output string) bool {
+	for _, f := range failureReasons {
+		if f.pattern.MatchString(output) {
+			return true
+		}
+	}
+	return noSolvePattern.MatchString(output)
+}
+
+// ParseSolveFieldLog extracts structured information from solve-field's
+// stdout/stderr. Fields that don't appear in the output are left zero.
+func ParseSolveFieldLog(output []byte) *SolveLog {
+	parsed := &SolveLog{}
+	text := string(output)
+
+	depth, quads := 0, 0
+	for _, line := range strings.Split(text, "\n") {
+		if m := sourcesPattern.FindStringSubmatch(line); m != nil {
+			parsed.StarsDetected, _ = strconv.Atoi(m[1])
+		}
+		if m := progressPattern.FindStringSubmatch(line); m != nil {
+			depth, _ = strconv.Atoi(m[1])
+			quads, _ = strconv.Atoi(m[2])
+		}
+		if m := noSolvePattern.FindStringSubmatch(line); m != nil {
+			d, _ := strconv.Atoi(m[1])
+			parsed.Attempts = append(parsed.Attempts, AttemptLog{Depth: d, QuadsTriedAt: quads})
+		}
+		if m := matchPattern.FindStringSubmatch(line); m != nil {
+			parsed.StarsMatched, _ = strconv.Atoi(m[1])
+		}
+		if solvedPattern.MatchString(line) {
+			parsed.Solved = true
+			parsed.Attempts = append(parsed.Attempts, AttemptLog{Depth: depth, QuadsTriedAt: quads, Success: true})
+		}
+		if m := wallTimePattern.FindStringSubmatch(line); m != nil {
+			parsed.SolveTimeSeconds, _ = strconv.ParseFloat(m[1], 64)
+		}
+		if m := cpuTimePattern.FindStringSubmatch(line); m != nil {
+			parsed.CPUTimeSeconds, _ = strconv.ParseFloat(m[1], 64)
+		}
+		if m := rmsPattern.FindStringSubmatch(line); m != nil {
+			// The last residual reported is for the final (tweaked) fit
+			rms, _ := strconv.ParseFloat(m[1], 64)
+			parsed.RMSResidualArcsec, parsed.RMSResidualPixels = 0, 0
+			if strings.EqualFold(m[2], "arcsec") {
+				parsed.RMSResidualArcsec = rms
+			} else {
+				parsed.RMSResidualPixels = rms
+			}
+		}
+	}
+
+	if !parsed.Solved {
+		parsed.StarsMatched = 0
+		parsed.RMSResidualArcsec, parsed.RMSResidualPixels = 0, 0
+		for _, f := range failureReasons {
+			if f.pattern.MatchString(text) {
+				parsed.FailureReason = f.reason
+				break
+			}
+		}
+		if parsed.FailureReason == "" {
+			parsed.FailureReason = "did not solve"
+		}
+	}
+	return parsed
+}
+
+// maxTimingLine bounds the "Spent" line solverTimes parses; solve-field's
+// is under 100 bytes.
+const maxTimingLine = 256
+
+// solverTimes returns the wall-clock and CPU seconds from the last "Spent"
+// line of solve-field's output, as ParseSolveFieldLog reports them. It
+// finds the line in one scan rather than running every pattern over output
+// that may be MaxOutputBytes long.
+func solverTimes(output string) (wall, cpu float64) {
+	i := strings.LastIndex(output, "Spent ")
+	if i < 0 {
+		return 0, 0
+	}
+	line := output[i:]
+	if end := strings.IndexByte(line, '\n'); end >= 0 {
+		line = line[:end]
+	}
+	if len(line) > maxTimingLine {
+		return 0, 0
+	}
+	if m := wallTimePattern.FindStringSubmatch(line); m != nil {
+		wall, _ = strconv.ParseFloat(m[1], 64)
+	}
+	if m := cpuTimePattern.FindStringSubmatch(line); m != nil {
+		cpu, _ = strconv.ParseFloat(m[1], 64)
+	}
+	return wall, cpu
+}
+
+// rmsResidualArcsec returns the RMS residual in a solve log in arcseconds,
+// converting a residual in pixels at pixelScale (arcsec/pixel). It returns
+// 0 when the log has no residual.
+func (l *SolveLog) rmsResidualArcsec(pixelScale float64) float64 {
+	if l.RMSResidualArcsec > 0 {
+		return l.RMSResidualArcsec
+	}
+	return l.RMSResidualPixels * pixelScale
+}
@@ -0,0 +1,188 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// solve-field-solved.log is not a verbatim capture. Its solver figures
+// (index, log-odds, matches, quads and objects tried, scale) are those
+// nova.astrometry.net recorded in ../../testdata/wcs.fits when solving
+// IMG_2820.JPG, and its field summary is testdata/ground_truth.json; the
+// source count and timings are illustrative. Unedited captures from
+// scripts/capture-solve-logs.sh are checked by TestParseSolveFieldLog_Captures.
+func TestParseSolveFieldLog_Solved(t *testing.T) {
+	output, err := os.ReadFile("testdata/solve-field-solved.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := ParseSolveFieldLog(output)
+	want := &SolveLog{
+		Solved:           true,
+		StarsDetected:    1000,
+		StarsMatched:     18,
+		SolveTimeSeconds: 0.19,
+		CPUTimeSeconds:   0.17,
+		Attempts:         []AttemptLog{{Depth: 10, QuadsTriedAt: 20, Success: true}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSolveFieldLog =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+// TestParseSolveFieldLog_Captures parses unedited solve-field output of
+// the ground truth images, written by scripts/capture-solve-logs.sh.
+func TestParseSolveFieldLog_Captures(t *testing.T) {
+	for _, name := range []string{"IMG_2820", "IMG_2820-converted"} {
+		t.Run(name, func(t *testing.T) {
+			output, err := os.ReadFile(filepath.Join("testdata", "solve-field-"+name+".log"))
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Skip("no capture; run scripts/capture-solve-logs.sh")
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := ParseSolveFieldLog(output)
+			if !got.Solved || got.FailureReason != "" {
+				t.Fatalf("capture parsed as unsolved: %+v", got)
+			}
+			if got.StarsDetected <= 0 || got.StarsMatched <= 0 || got.StarsMatched > got.StarsDetected {
+				t.Errorf("stars detected %d, matched %d; want 0 < matched <= detected", got.StarsDetected, got.StarsMatched)
+			}
+			if got.SolveTimeSeconds <= 0 || got.CPUTimeSeconds <= 0 {
+				t.Errorf("solver times %v wall, %v CPU; want both positive", got.SolveTimeSeconds, got.CPUTimeSeconds)
+			}
+			if wall, cpu := solverTimes(string(output)); wall != got.SolveTimeSeconds || cpu != got.CPUTimeSeconds {
+				t.Errorf("solverTimes = %v, %v; want %v, %v", wall, cpu, got.SolveTimeSeconds, got.CPUTimeSeconds)
+			}
+			if n := len(got.Attempts); n == 0 || !got.Attempts[n-1].Success {
+				t.Errorf("attempts %+v; want the last to succeed", got.Attempts)
+			}
+		})
+	}
+}
+
+func TestParseSolveFieldLog_Unsolved(t *testing.T) {
+	output, err := os.ReadFile("testdata/solve-field-unsolved.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := ParseSolveFieldLog(output)
+	want := &SolveLog{
+		StarsDetected:    14,
+		SolveTimeSeconds: 0.51,
+		CPUTimeSeconds:   0.47,
+		FailureReason:    "no solution found with the available indexes",
+		Attempts: []AttemptLog{
+			{Depth: 10, QuadsTriedAt: 6},
+			{Depth: 14, QuadsTriedAt: 31},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSolveFieldLog =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestSolverTimes(t *testing.T) {
+	for _, name := range []string{"solve-field-solved.log", "solve-field-unsolved.log"} {
+		output, err := os.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := ParseSolveFieldLog(output)
+		if wall, cpu := solverTimes(string(output)); wall != want.SolveTimeSeconds || cpu != want.CPUTimeSeconds {
+			t.Errorf("%s: solverTimes = %v, %v; want %v, %v", name, wall, cpu, want.SolveTimeSeconds, want.CPUTimeSeconds)
+		}
+	}
+
+	tests := []struct {
+		output    string
+		wall, cpu float64
+	}{
+		{"", 0, 0},
+		{"Spent 1 s user, 0.1 s system, 1.1 s total, 1.2 s wall time.\nSpent 2 s user, 0.2 s system, 2.2 s total, 2.4 s wall time.\n", 2.4, 2.2},
+		// A line that only starts like the timing line is not scanned to its end
+		{"Spent " + strings.Repeat("x", 1<<20) + " 9 s total, 9 s wall time.\n", 0, 0},
+	}
+	for _, tt := range tests {
+		if wall, cpu := solverTimes(tt.output); wall != tt.wall || cpu != tt.cpu {
+			t.Errorf("solverTimes(%.40q) = %v, %v; want %v, %v", tt.output, wall, cpu, tt.wall, tt.cpu)
+		}
+	}
+}
+
+func TestParseSolveFieldLog_FailureReasons(t *testing.T) {
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{"simplexy: found 0 sources.\nDid not solve (or no WCS file was written).\n", "no sources detected"},
+		{"Total CPU time limit reached!\n", "CPU time limit reached"},
+		{"You must list at least one index in the config file (/etc/astrometry.cfg)\n", "no index files available"},
+		{"", "did not solve"},
+	}
+	for _, tt := range tests {
+		if got := ParseSolveFieldLog([]byte(tt.output)).FailureReason; got != tt.want {
+			t.Errorf("FailureReason for %q = %q, want %q", tt.output, got, tt.want)
+		}
+	}
+}
+
+func TestParseSolveFieldLog_RMSResidual(t *testing.T) {
+	tests := []struct {
+		output              string
+		wantArcsec, wantPix float64
+	}{
+		{"  RMS residual: 0.42 arcsec\nField 1: solved with index index-4107.fits.\n", 0.42, 0},
+		{"  RMS error 0.31 pixels\nField 1: solved with index index-4107.fits.\n", 0, 0.31},
+		// The tweaked fit is reported last
+		{"  RMS residual: 1.9 pix\n  RMS residual: 0.6 pix\nField 1: solved with index index-4107.fits.\n", 0, 0.6},
+		{"  RMS residual: 0.42 arcsec\nDid not solve (or no WCS file was written).\n", 0, 0},
+	}
+	for _, tt := range tests {
+		got := ParseSolveFieldLog([]byte(tt.output))
+		if got.RMSResidualArcsec != tt.wantArcsec || got.RMSResidualPixels != tt.wantPix {
+			t.Errorf("RMS residual for %q = %v arcsec, %v pixels, want %v, %v",
+				tt.output, got.RMSResidualArcsec, got.RMSResidualPixels, tt.wantArcsec, tt.wantPix)
+		}
+	}
+}
+
+func TestSolve_RMSResidual(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		_, _ = fmt.Fprintln(w, "  RMS residual: 0.5 pixels\nField 1: solved with index index-4107.fits.")
+		return os.WriteFile(filepath.Join(workDir, "stars.wcs"), fits.EncodeHeader(wcsCards(0.001)), 0644)
+	})
+
+	opts := DefaultSolveOptions()
+	opts.Verbose = true
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	// 0.001°/pixel is 3.6 arcsec/pixel
+	if want := 0.5 * result.PixelScale; result.RMSResidualArcsec != want || want == 0 {
+		t.Errorf("RMSResidualArcsec = %v, want %v", result.RMSResidualArcsec, want)
+	}
+
+	opts.Verbose = false
+	if result, err = client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.RMSResidualArcsec != 0 {
+		t.Errorf("RMSResidualArcsec = %v without Verbose, want 0", result.RMSResidualArcsec)
+	}
+}
@@ -51,59 +51,329 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 )
 
+// commandRunner executes an external command, streaming its combined
+// stdout/stderr to w. It exists so tests can substitute a fake runtime.
+type commandRunner func(ctx context.Context, name string, args []string, w io.Writer) error
+
+// execRunner runs commands on the host via os/exec.
+func execRunner(ctx context.Context, name string, args []string, w io.Writer) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = w
+	cmd.Stderr = w
+	return cmd.Run()
+}
+
 // Client is the main interface for astrometry.net plate solving.
 type Client struct {
+	mu     sync.RWMutex // Guards config; the ClientConfig it points to is never mutated
 	config *ClientConfig
+	run    commandRunner
+	statfs func(path string) (free uint64, err error)
+	probes *sync.Map   // Tool availability in the solver image, shared by snapshots
+	trace  *solveTrace // Set by DiagnosticsBundle to record the solve
+	pool   *containerPool
+	leased *pooledContainer // Warm pool container Solve runs tools in
+
+	// retrying is set while Solve may still try a fallback image, so hooks
+	// wait for the attempt whose result is returned
+	retrying bool
 }
 
 // NewClient creates a new astrometry Client with the given configuration.
+// The config is copied, so the caller's struct is never modified and later
+// changes to it have no effect; use UpdateConfig to reconfigure the client.
 func NewClient(config *ClientConfig) (*Client, error) {
 	if config == nil {
 		config = DefaultClientConfig()
 	}
+	config = config.clone()
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+
+	return &Client{config: config, run: execRunner, statfs: diskFree, probes: &sync.Map{}, pool: &containerPool{}}, nil
+}
+
+// Config returns a copy of the client's current configuration.
+func (c *Client) Config() ClientConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return *c.config.clone()
+}
+
+// UpdateConfig applies update to a copy of the current configuration,
+// validates it, and swaps it in atomically. Operations already running keep
+// the configuration they started with; later operations use the new one.
+// If validation fails the configuration is left unchanged.
+func (c *Client) UpdateConfig(update func(*ClientConfig)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	next := c.config.clone()
+	update(next)
+	if err := validateConfig(next); err != nil {
+		return err
+	}
 
+	c.config = next
+	return nil
+}
+
+// snapshot returns a client bound to the current configuration, so one
+// operation sees a consistent config even if UpdateConfig runs concurrently.
+func (c *Client) snapshot() *Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return &Client{config: c.config, run: c.run, statfs: c.statfs, probes: c.probes, pool: c.pool}
+}
+
+// clone returns a deep copy of the config.
+func (cfg *ClientConfig) clone() *ClientConfig {
+	cp := *cfg
+	cp.FallbackImages = append([]string(nil), cfg.FallbackImages...)
+	cp.ScratchDirs = append([]string(nil), cfg.ScratchDirs...)
+	cp.IndexPaths = append([]string(nil), cfg.IndexPaths...)
+	cp.ArgTemplate = append([]string(nil), cfg.ArgTemplate...)
+	cp.PostSolveHooks = append([]Hook(nil), cfg.PostSolveHooks...)
+	cp.AllowedFormats = append([]string(nil), cfg.AllowedFormats...)
+	return &cp
+}
+
+// validateConfig checks required fields and fills in defaults.
+func validateConfig(config *ClientConfig) error {
 	// Validate required fields
-	if config.IndexPath == "" {
-		return nil, fmt.Errorf("%w: IndexPath is required", ErrInvalidInput)
+	indexPaths := config.indexPaths()
+	if len(indexPaths) == 0 {
+		return fmt.Errorf("%w: IndexPath is required", ErrInvalidInput)
+	}
+
+	// Check that every index path exists
+	for _, indexPath := range indexPaths {
+		if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+			return fmt.Errorf("%w: IndexPath does not exist: %s", ErrInvalidInput, indexPath)
+		}
+		if config.ValidateIndexes {
+			if err := checkIndexes(indexPath); err != nil {
+				return err
+			}
+		}
 	}
 
-	// Check that index path exists
-	if _, err := os.Stat(config.IndexPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("%w: IndexPath does not exist: %s", ErrInvalidInput, config.IndexPath)
+	if config.UseDockerExec && config.ContainerName == "" {
+		return fmt.Errorf("%w: ContainerName is required when UseDockerExec is true", ErrInvalidInput)
 	}
 
 	// Set defaults
 	if config.DockerImage == "" {
 		config.DockerImage = DefaultDockerImage
 	}
+	if slices.Contains(config.FallbackImages, "") {
+		return fmt.Errorf("%w: FallbackImages entries must not be empty", ErrInvalidInput)
+	}
+	if config.WarmPoolSize < 0 || config.WarmPoolMaxUses < 0 || config.WarmPoolIdleTimeout < 0 {
+		return fmt.Errorf("%w: WarmPoolSize, WarmPoolMaxUses and WarmPoolIdleTimeout must not be negative", ErrInvalidInput)
+	}
 	if config.Timeout == 0 {
 		config.Timeout = 5 * time.Minute
 	}
 	if config.TempDir == "" {
 		config.TempDir = os.TempDir()
 	}
+	if config.DataMountPath == "" {
+		config.DataMountPath = DefaultDataMountPath
+	}
+	if config.IndexMountPath == "" {
+		config.IndexMountPath = DefaultIndexMountPath
+	}
+	if !path.IsAbs(config.DataMountPath) {
+		return fmt.Errorf("%w: DataMountPath must be an absolute container path, got %q", ErrInvalidInput, config.DataMountPath)
+	}
+	if !path.IsAbs(config.IndexMountPath) {
+		return fmt.Errorf("%w: IndexMountPath must be an absolute container path, got %q", ErrInvalidInput, config.IndexMountPath)
+	}
+	if path.Clean(config.DataMountPath) == path.Clean(config.IndexMountPath) {
+		return fmt.Errorf("%w: DataMountPath and IndexMountPath must differ", ErrInvalidInput)
+	}
+	if config.MaxOutputBytes < 0 {
+		return fmt.Errorf("%w: MaxOutputBytes must not be negative", ErrInvalidInput)
+	}
+	if config.MaxOutputBytes == 0 {
+		config.MaxOutputBytes = DefaultMaxOutputBytes
+	}
+	if config.Extractor != nil && len(config.ArgTemplate) > 0 {
+		return fmt.Errorf("%w: Extractor and ArgTemplate cannot both be set", ErrInvalidInput)
+	}
+	if err := validateAllowedFormats(config.AllowedFormats); err != nil {
+		return err
+	}
+	if err := validateImageLimits(config); err != nil {
+		return err
+	}
+	return validateArgTemplate(config.ArgTemplate)
+}
 
-	return &Client{config: config}, nil
+// checkIndexes verifies that indexPath contains at least one index-*.fits file.
+func checkIndexes(indexPath string) error {
+	entries, err := os.ReadDir(indexPath)
+	if err != nil {
+		return fmt.Errorf("failed to list index directory: %w", err)
+	}
+
+	var found []string
+	for _, entry := range entries {
+		if match, _ := filepath.Match("index-*.fits", entry.Name()); match && !entry.IsDir() {
+			return nil
+		}
+		found = append(found, entry.Name())
+	}
+
+	if len(found) == 0 {
+		return fmt.Errorf("%w: no index-*.fits files in IndexPath %s (directory is empty)", ErrInvalidInput, indexPath)
+	}
+	const maxListed = 10
+	if len(found) > maxListed {
+		found = append(found[:maxListed], fmt.Sprintf("... %d more", len(found)-maxListed))
+	}
+	return fmt.Errorf("%w: no index-*.fits files in IndexPath %s (found: %s)",
+		ErrInvalidInput, indexPath, strings.Join(found, ", "))
 }
 
-// Solve performs plate-solving on the given image file.
+// Solve performs plate-solving on the given image file. The duration and
+// outcome are reported to ClientConfig.Metrics when set. Setup problems
+// recognised in solve-field's output, and Docker or solver failures shown
+// by the exit status, are returned as a *SolveError rather than an
+// unsolved Result. Options that are valid but look mistaken, as
+// reported by SolveOptions.Warnings, are recorded in Result.Warnings and
+// logged when Verbose is set.
+//
+// To solve a star list already on disk, leave imagePath empty and set
+// SolveOptions.XYListPath. The list is checked before any container
+// starts.
+//
+// In run mode, a solve that DockerImage does not solve, or that fails with
+// ErrNoSolution or ErrDockerFailed, is retried with each of
+// ClientConfig.FallbackImages in turn. That includes a solution rejected
+// by SolveOptions.RotationTolerance. The first solved attempt, or else
+// the last one, is returned; hooks and metrics see only that attempt.
 func (c *Client) Solve(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
+	c = c.snapshot()
+	if opts != nil && opts.Verbose {
+		for _, warning := range opts.Warnings() {
+			log.Printf("warning: %s", warning)
+		}
+	}
+	start := time.Now()
+	result, err := c.solveWithFallbacks(ctx, imagePath, opts)
+	c.observeSolve(time.Since(start), result, err)
+	return result, err
+}
+
+// solveWithFallbacks runs solve with DockerImage and then, while the
+// outcome is retryable, with each of FallbackImages.
+func (c *Client) solveWithFallbacks(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
+	if c.config.UseDockerExec || len(c.config.FallbackImages) == 0 {
+		return c.solvePooled(ctx, imagePath, opts)
+	}
+
+	images := append([]string{c.config.DockerImage}, c.config.FallbackImages...)
+	var result *Result
+	var err error
+	for i, image := range images {
+		config := c.config.clone()
+		config.DockerImage = image
+		attempt := &Client{config: config, run: c.run, statfs: c.statfs, probes: c.probes, pool: c.pool, trace: c.trace,
+			retrying: i < len(images)-1}
+		if i == 0 {
+			// Pooled containers run DockerImage only
+			result, err = attempt.solvePooled(ctx, imagePath, opts)
+		} else {
+			result, err = attempt.solve(ctx, imagePath, opts)
+		}
+		if !retryableSolve(result, err) {
+			break
+		}
+		if opts != nil && opts.Verbose && i < len(images)-1 {
+			log.Printf("solver image %s did not solve, retrying with %s", image, images[i+1])
+		}
+	}
+	return result, err
+}
+
+// retryableSolve reports whether another solver image might solve where
+// this outcome did not: an unsolved result, or no solution or a Docker
+// failure. Invalid input and timeouts would fail the same way again.
+func retryableSolve(result *Result, err error) bool {
+	if err != nil {
+		return errors.Is(err, ErrNoSolution) || errors.Is(err, ErrDockerFailed)
+	}
+	return result != nil && !result.Solved && !result.FromExisting
+}
+
+// solve runs a single solve on a snapshot client.
+func (c *Client) solve(ctx context.Context, imagePath string, opts *SolveOptions) (result *Result, err error) {
 	if opts == nil {
 		opts = DefaultSolveOptions()
 	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.checkFieldWidth(opts); err != nil {
+		return nil, err
+	}
+
+	// A star list on disk stands in for the image
+	listSolve := opts.XYListPath != ""
+	var listWidth, listHeight, listFields int
+	if listSolve {
+		if imagePath != "" {
+			return nil, fmt.Errorf("%w: set either an image path or SolveOptions.XYListPath, not both", ErrInvalidInput)
+		}
+		imagePath = opts.XYListPath
+		tables, width, height, err := readXYList(imagePath, opts)
+		if err != nil {
+			return nil, err
+		}
+		listWidth, listHeight, listFields = width, height, len(tables)
+	}
 
 	// Validate image exists
-	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
+	imageInfo, err := os.Stat(imagePath)
+	if os.IsNotExist(err) {
 		return nil, fmt.Errorf("%w: image file does not exist: %s", ErrInvalidInput, imagePath)
 	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat image: %w", err)
+	}
+	if !listSolve {
+		if err := c.checkFormat(imagePath); err != nil {
+			return nil, err
+		}
+		if err := c.checkImageLimits(imagePath, imageInfo.Size()); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.SkipIfSolved && !opts.Force && !listSolve {
+		startTime := time.Now()
+		if result := existingSolution(imagePath); result != nil {
+			result.TotalTime = time.Since(startTime).Seconds()
+			result.SolveTime = result.TotalTime
+			c.runPostSolveHooks(ctx, imagePath, result, nil)
+			return result, nil
+		}
+	}
 
 	// Get absolute paths
 	absImagePath, err := filepath.Abs(imagePath)
@@ -111,85 +381,245 @@ func (c *Client) Solve(ctx context.Context, imagePath string, opts *SolveOptions
 		return nil, fmt.Errorf("failed to get absolute image path: %w", err)
 	}
 
-	absIndexPath, err := filepath.Abs(c.config.IndexPath)
+	absIndexPaths, err := c.absIndexPaths()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get absolute index path: %w", err)
+		return nil, err
 	}
 
-	// Create temp directory for this solve operation
-	tempDir, err := os.MkdirTemp(c.config.TempDir, "astrometry-*")
+	// Create temp directory for this solve operation, with room for a
+	// decompressed copy of a compressed FITS image
+	compression := ""
+	if !listSolve {
+		compression = fitsCompression(absImagePath)
+	}
+	ws, err := c.createWorkspace(imageInfo.Size() + uncompressedSize(absImagePath, compression))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+		return nil, err
+	}
+	tempDir := ws.dir
+	if c.trace != nil {
+		c.trace.mu.Lock()
+		c.trace.ws = ws
+		c.trace.mu.Unlock()
+	}
+	if opts.Verbose {
+		log.Printf("solve workspace %s (scratch dir %s)", tempDir, ws.scratch)
 	}
 	if !opts.KeepTempFiles {
 		defer func() {
-			if removeErr := os.RemoveAll(tempDir); removeErr != nil {
+			if removeErr := ws.remove(); removeErr != nil {
 				log.Printf("warning: failed to remove temp directory: %v", removeErr)
 			}
 		}()
 	} else {
 		log.Printf("KeepTempFiles enabled: temp directory preserved at %s", tempDir)
 	}
+	// Hooks run before the workspace is removed, while outputs still exist
+	defer func() {
+		if c.retrying && retryableSolve(result, err) {
+			return
+		}
+		c.runPostSolveHooks(ctx, imagePath, result, err)
+	}()
 
 	// Copy image to temp directory (solve-field writes output alongside input)
-	imageFilename := filepath.Base(absImagePath)
-	tempImagePath := filepath.Join(tempDir, imageFilename)
-	err = copyFile(absImagePath, tempImagePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to copy image to temp directory: %w", err)
+	imageFilename := ws.prefix + filepath.Base(absImagePath)
+	switch {
+	case listSolve:
+		// Staged as .xyls so solve-field's own .axy cannot overwrite it
+		imageFilename = ws.prefix + strings.TrimSuffix(filepath.Base(absImagePath), filepath.Ext(absImagePath)) + ".xyls"
+		if err := copyFile(absImagePath, filepath.Join(tempDir, imageFilename), c.config.AllowContainerRead); err != nil {
+			return nil, fmt.Errorf("failed to copy xylist to temp directory: %w", err)
+		}
+	case compression != "":
+		imageFilename = ws.prefix + decompressedName(filepath.Base(absImagePath))
+		if err := c.stageDecompressed(ctx, absImagePath, compression, tempDir, imageFilename, absIndexPaths); err != nil {
+			return nil, err
+		}
+	default:
+		tempImagePath := filepath.Join(tempDir, imageFilename)
+		linked, err := stageImage(absImagePath, tempImagePath, ws.scratch, c.config.AllowContainerRead)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy image to temp directory: %w", err)
+		}
+		if linked && opts.Verbose {
+			log.Printf("image already on scratch device, linked instead of copied")
+		}
 	}
 
-	// Build solve-field command arguments
-	args := c.buildSolveArgs(imageFilename, tempDir, opts)
+	// Solve only the center of an all-sky frame
+	var crop CropGeometry
+	if opts.CropPixels > 0 {
+		cropName := croppedName(imageFilename)
+		cropped, ok, err := cropCentral(filepath.Join(tempDir, imageFilename), filepath.Join(tempDir, cropName),
+			opts.CropPixels, c.config.AllowContainerRead)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			crop, imageFilename = cropped, cropName
+		}
+	}
+	defer func() {
+		if result != nil && crop.Width > 0 {
+			result.PartialField, result.Crop = true, crop
+		}
+	}()
 
-	// Build Docker command based on mode
-	var dockerArgs []string
-	if c.config.UseDockerExec {
-		// Docker exec mode: use existing container
-		dockerArgs = []string{"exec", c.config.ContainerName}
-		dockerArgs = append(dockerArgs, args...)
-	} else {
-		// Docker run mode: spawn new container
-		dockerArgs = []string{
-			"run", "--rm",
-			"-v", fmt.Sprintf("%s:/data", tempDir),
-			"-v", fmt.Sprintf("%s:/usr/local/astrometry/data", absIndexPath),
-			c.config.DockerImage,
+	// Build solve-field command arguments; argsFor is called for each
+	// attempt of a HintRadiusSchedule
+	var argsFor func(o *SolveOptions) []string
+	extractor := ExtractorSimplexy
+	simplexy := false
+	switch {
+	case listSolve:
+		extractor = ""
+		argsFor = func(o *SolveOptions) []string {
+			xyOpts := *o
+			xyOpts.ExtraArgs = xylistArgs(o, listWidth, listHeight)
+			if listFields > 1 {
+				xyOpts.ExtraArgs = append(xyOpts.ExtraArgs, "--fields", "1")
+			}
+			xyOpts.ExtraArgs = append(xyOpts.ExtraArgs, o.ExtraArgs...)
+			return c.buildSolveArgs(imageFilename, tempDir, &xyOpts)
+		}
+	case c.config.Extractor != nil:
+		extractor = c.config.Extractor.Name()
+		xyName, width, height, err := c.writeExtractedList(ctx, imageFilename, tempDir)
+		if err != nil {
+			return nil, err
+		}
+		argsFor = func(o *SolveOptions) []string {
+			return c.sourceListArgs(xyName, tempDir, width, height, o)
+		}
+	case len(c.config.ArgTemplate) > 0:
+		argsFor = func(o *SolveOptions) []string {
+			return c.templateSolveArgs(imageFilename, tempDir, o)
+		}
+	default:
+		simplexy = true
+		argsFor = func(o *SolveOptions) []string {
+			return c.buildSolveArgs(imageFilename, tempDir, o)
 		}
-		dockerArgs = append(dockerArgs, args...)
 	}
 
-	// Create context with timeout
-	solveCtx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	// Several index directories need a config that searches them all
+	var indexFlags []string
+	if len(c.config.ArgTemplate) == 0 {
+		if indexFlags, err = c.writeIndexConfig(tempDir, ws.prefix+indexConfigName); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create context with timeout; the caller's deadline wins if sooner.
+	// Every attempt of a HintRadiusSchedule counts against it.
+	timeout := c.config.Timeout
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+	solveCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// Execute Docker command
+	// The output files (.wcs, .solved) are the source of truth for a
+	// solution. Without one, the exit status tells a miss from a Docker or
+	// solver failure, with the output only refining it (classifyUnsolved)
+
+	radii := hintRadii(opts)
+	baseName := strings.TrimSuffix(imageFilename, filepath.Ext(imageFilename))
 	startTime := time.Now()
-	cmd := exec.CommandContext(solveCtx, "docker", dockerArgs...)
-	output, _ := cmd.CombinedOutput() //nolint:errcheck // Ignore exit code - we check for .wcs file existence instead
-	rawOutput := string(output)
+	var rawOutput string
+	var outputs map[OutputKind]string
+	var parseErr, solvedErr, runErr error
+	var solvedFields []int
+	hintRadius := 0.0
+	for attempt := 0; attempt < max(1, len(radii)); attempt++ {
+		attemptOpts := opts
+		if radii != nil {
+			hintRadius = radii[attempt]
+			attemptOpts = withHintRadius(opts, hintRadius)
+		}
+		if attempt > 0 {
+			// Solve the stars solve-field already found rather than the image
+			if simplexy {
+				xyName := baseName + ".xyls"
+				if width, height, err := c.reuseSources(outputs[OutputAXY], filepath.Join(tempDir, xyName)); err == nil {
+					argsFor = func(o *SolveOptions) []string {
+						return c.sourceListArgs(xyName, tempDir, width, height, o)
+					}
+				} else if opts.Verbose {
+					log.Printf("cannot reuse detected stars, extracting again: %v", err)
+				}
+				simplexy = false
+			}
+			// solve-field will not overwrite the last attempt's outputs
+			for _, file := range outputs {
+				if removeErr := os.Remove(file); removeErr != nil && !os.IsNotExist(removeErr) {
+					return nil, fmt.Errorf("failed to remove output of previous attempt: %w", removeErr)
+				}
+			}
+			if opts.Verbose {
+				log.Printf("no solution at radius %g°, retrying at %g°", radii[attempt-1], hintRadius)
+			}
+		}
 
-	if solveCtx.Err() == context.DeadlineExceeded {
-		return nil, ErrTimeout
-	}
+		// Execute Docker command
+		output := newBoundedBuffer(c.config.MaxOutputBytes)
+		runErr = c.runDocker(solveCtx, tempDir, absIndexPaths, withFlags(argsFor(attemptOpts), indexFlags), output)
+		rawOutput = output.String()
 
-	// Note: solve-field returns non-zero exit code even when it simply didn't find a solution
-	// We can't rely on exit codes or error messages to distinguish "no solution" from actual errors
-	// Instead, we check for the presence of output files (.wcs, .solved) as the source of truth
+		if solveCtx.Err() == context.DeadlineExceeded {
+			return nil, ErrTimeout
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 
-	solveTime := time.Since(startTime).Seconds()
+		// Parse WCS file - this is the definitive indicator of solve success
+		outputs = collectOutputs(tempDir, baseName)
+		wcsPath, ok := outputs[OutputWCS]
+		if !ok {
+			wcsPath = filepath.Join(tempDir, baseName+string(OutputWCS))
+		}
+		result, parseErr = ParseWCSFile(wcsPath)
+
+		// When present, the .solved marker must also have the field's byte set;
+		// older images that don't write it fall back to the .wcs check alone
+		solvedFields, solvedErr = ParseSolvedFile(outputs[OutputSolved])
+		if parseErr == nil && solvedErr == nil && !fieldSolved(solvedFields, 1) {
+			parseErr = fmt.Errorf("%w: .solved marker not set", os.ErrNotExist)
+		}
 
-	// Parse WCS file - this is the definitive indicator of solve success
-	wcsPath := filepath.Join(tempDir, strings.TrimSuffix(imageFilename, filepath.Ext(imageFilename))+".wcs")
-	result, parseErr := ParseWCSFile(wcsPath)
+		// Only a miss is worth a wider radius; setup problems fail at every radius
+		if !errors.Is(parseErr, os.ErrNotExist) || classifyUnsolved(runErr, rawOutput) != nil ||
+			(solvedErr == nil && fieldSolved(solvedFields, 1)) {
+			break
+		}
+	}
+	solveTime := time.Since(startTime).Seconds()
 
 	if parseErr != nil {
 		// If WCS file doesn't exist, image wasn't solved (not an error, just no solution found)
 		if errors.Is(parseErr, os.ErrNotExist) {
 			result = &Result{
-				Solved:    false,
-				SolveTime: solveTime,
-				RawOutput: rawOutput, // Always include output when solve fails for debugging
+				Solved:     false,
+				RawOutput:  rawOutput, // Always include output when solve fails for debugging
+				ScratchDir: ws.scratch,
+				Extractor:  extractor,
+				FromXYList: listSolve,
+				HintRadius: hintRadius,
+				Warnings:   opts.Warnings(),
+			}
+			if !c.config.UseDockerExec {
+				result.DockerImage = c.config.DockerImage
+			}
+			result.setTimes(solveTime, rawOutput)
+			// A solved marker without its .wcs is a broken solve, not a miss
+			if solvedErr == nil && fieldSolved(solvedFields, 1) {
+				result.MissingOutputs = missingOutputs(outputs)
+			}
+			// Setup problems and failures are errors, with a hint on how to fix them
+			if failErr := classifyUnsolved(runErr, rawOutput); failErr != nil {
+				return nil, failErr
 			}
 			return result, nil
 		}
@@ -198,15 +628,54 @@ func (c *Client) Solve(ctx context.Context, imagePath string, opts *SolveOptions
 	}
 
 	// Successfully parsed WCS file - solve succeeded
-	result.SolveTime = solveTime
+	result.setTimes(solveTime, rawOutput)
+	if solvedErr == nil {
+		result.SolvedFields = solvedFields
+	}
+	if result.ImageWidth == 0 || result.ImageHeight == 0 {
+		if listSolve {
+			result.ImageWidth, result.ImageHeight = listWidth, listHeight
+		} else if w, h, dimErr := imageDimensions(filepath.Join(tempDir, imageFilename)); dimErr == nil {
+			result.ImageWidth, result.ImageHeight = w, h
+		}
+	}
+	result.ScratchDir = ws.scratch
+	result.Extractor = extractor
+	result.FromXYList = listSolve
+	result.HintRadius = hintRadius
+	result.Warnings = opts.Warnings()
+	if !c.config.UseDockerExec {
+		result.DockerImage = c.config.DockerImage
+	}
+
+	// A fixed camera rules out solutions at the wrong angle
+	if rotErr := checkRotation(result, opts, rawOutput, exitStatus(runErr)); rotErr != nil {
+		return nil, rotErr
+	}
 
 	// Include raw output only if verbose mode enabled (success case doesn't need it by default)
 	if opts.Verbose {
 		result.RawOutput = rawOutput
+		result.RMSResidualArcsec = ParseSolveFieldLog([]byte(rawOutput)).rmsResidualArcsec(result.PixelScale)
 	}
 
-	// Collect output files
-	result.OutputFiles = c.collectOutputFiles(tempDir, imageFilename)
+	// Collect output files, copying or pruning them to the requested types
+	result.MissingOutputs = missingOutputs(outputs)
+	kept, dropped := filterArtifacts(outputs, opts.KeepExtensions)
+	switch {
+	case opts.OutputDir != "":
+		if kept, err = exportArtifacts(kept, ws.prefix, opts.OutputDir); err != nil {
+			return nil, err
+		}
+	case opts.KeepTempFiles:
+		for _, file := range dropped {
+			if removeErr := os.Remove(file); removeErr != nil {
+				log.Printf("warning: failed to remove %s: %v", file, removeErr)
+			}
+		}
+	}
+	result.Outputs = kept
+	result.OutputFiles = outputFiles(kept)
 
 	return result, nil
 }
@@ -214,6 +683,16 @@ func (c *Client) Solve(ctx context.Context, imagePath string, opts *SolveOptions
 // SolveBytes performs plate-solving on image data provided as bytes.
 // The data is written to a temporary file, solved, and cleaned up.
 func (c *Client) SolveBytes(ctx context.Context, data []byte, format string, opts *SolveOptions) (*Result, error) {
+	c = c.snapshot()
+	if opts != nil && opts.XYListPath != "" {
+		return nil, fmt.Errorf("%w: SolveBytes solves image data; use Solve with an empty image path for XYListPath", ErrInvalidInput)
+	}
+	if opts != nil {
+		if err := opts.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create temp file with appropriate extension
 	tempFile, err := os.CreateTemp(c.config.TempDir, fmt.Sprintf("image-*.%s", format))
 	if err != nil {
@@ -244,7 +723,7 @@ func (c *Client) buildSolveArgs(imageFilename, tempDir string, opts *SolveOption
 	if opts.ScaleLow > 0 && opts.ScaleHigh > 0 {
 		args = append(args, "-L", fmt.Sprintf("%.6f", opts.ScaleLow))
 		args = append(args, "-H", fmt.Sprintf("%.6f", opts.ScaleHigh))
-		args = append(args, "-u", opts.ScaleUnits)
+		args = append(args, "-u", string(opts.ScaleUnits))
 	}
 
 	// Downsample
@@ -276,52 +755,92 @@ func (c *Client) buildSolveArgs(imageFilename, tempDir string, opts *SolveOption
 		args = append(args, "--overwrite")
 	}
 
+	// Preprocessing
+	if opts.NoFITS2FITS {
+		args = append(args, "--no-fits2fits")
+	}
+	if opts.FITSExtension > 0 {
+		args = append(args, "--extension", fmt.Sprintf("%d", opts.FITSExtension))
+	}
+	if opts.Invert {
+		args = append(args, "--invert")
+	}
+	if opts.NoRemoveLines {
+		args = append(args, "--no-remove-lines")
+	}
+	if opts.NoUniformize {
+		args = append(args, "--uniformize", "0")
+	}
+
 	// Verbose
 	if !opts.Verbose {
 		args = append(args, "--no-verify")
 	}
 
-	// Determine paths based on execution mode
-	var workDir, imagePath string
-	if c.config.UseDockerExec {
-		// In exec mode, use the actual shared volume path
-		workDir = tempDir
-		imagePath = filepath.Join(tempDir, imageFilename)
-	} else {
-		// In run mode, paths are relative to /data mount
-		workDir = "/data"
-		imagePath = fmt.Sprintf("/data/%s", imageFilename)
-	}
+	// Caller-supplied flags
+	args = append(args, opts.ExtraArgs...)
 
 	// Output directory
-	args = append(args, "--dir", workDir)
+	args = append(args, "--dir", c.containerPath(tempDir, ""))
 
 	// Image path
-	args = append(args, imagePath)
+	args = append(args, c.containerPath(tempDir, imageFilename))
 
 	return args
 }
 
-// collectOutputFiles finds all output files generated by solve-field.
-func (c *Client) collectOutputFiles(tempDir, imageFilename string) []string {
-	baseName := strings.TrimSuffix(imageFilename, filepath.Ext(imageFilename))
-	extensions := []string{".wcs", ".corr", ".solved", ".match", ".rdls", ".axy", "-indx.xyls"}
+// dockerArgs wraps a tool command line in the docker invocation for the
+// configured execution mode. In run mode a non-empty name is given to the
+// container with --name, and every index directory is mounted.
+func (c *Client) dockerArgs(name, tempDir string, absIndexPaths []string, args []string) []string {
+	var dockerArgs []string
+	if c.usesExec() {
+		// Docker exec mode or a warm pool container: use existing container
+		dockerArgs = []string{"exec", c.execContainer()}
+	} else {
+		// Docker run mode: spawn new container
+		dockerArgs = []string{"run", "--rm"}
+		if name != "" {
+			dockerArgs = append(dockerArgs, "--name", name)
+		}
+		dockerArgs = append(dockerArgs, "-v", fmt.Sprintf("%s:%s", tempDir, c.config.DataMountPath))
+		dockerArgs = append(dockerArgs, c.indexMountArgs(absIndexPaths, "")...)
+		dockerArgs = append(dockerArgs, c.config.DockerImage)
+	}
+	return append(dockerArgs, args...)
+}
 
-	var files []string
-	for _, ext := range extensions {
-		path := filepath.Join(tempDir, baseName+ext)
-		if _, err := os.Stat(path); err == nil {
-			files = append(files, path)
+// containerPath returns the path of a file in the working directory as seen
+// by the tool running in the container. An empty filename returns the
+// directory itself.
+func (c *Client) containerPath(tempDir, filename string) string {
+	if c.usesExec() {
+		// In exec mode, use the actual shared volume path
+		if filename == "" {
+			return tempDir
 		}
+		return filepath.Join(tempDir, filename)
+	}
+	// In run mode, paths are relative to the data mount
+	if filename == "" {
+		return c.config.DataMountPath
 	}
-	return files
+	return path.Join(c.config.DataMountPath, filename)
 }
 
-// copyFile copies a file from src to dst.
-func copyFile(src, dst string) error {
+// copyFile copies a file from src into a workspace at dst, keeping its
+// modification time and its permissions as set by stageAttrs.
+func copyFile(src, dst string, containerRead bool) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
 	data, err := os.ReadFile(src)
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(dst, data, 0644)
+	if err := os.WriteFile(dst, data, stagedPerm(info.Mode(), containerRead)); err != nil {
+		return err
+	}
+	return stageAttrs(info, dst, containerRead)
 }
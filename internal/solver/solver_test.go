@@ -1,11 +1,21 @@
 package solver
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
 )
 
 func TestDefaultClientConfig(t *testing.T) {
@@ -36,6 +46,145 @@ func TestDefaultSolveOptions(t *testing.T) {
 	}
 }
 
+func TestSolveOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(o *SolveOptions)
+		wantErr string // "" when valid
+	}{
+		{"defaults", func(o *SolveOptions) {}, ""},
+		{"scale range", func(o *SolveOptions) { o.ScaleLow, o.ScaleHigh, o.ScaleUnits = 1, 3, "degwidth" }, ""},
+		{"scale abbreviation", func(o *SolveOptions) { o.ScaleLow, o.ScaleHigh, o.ScaleUnits = 1, 3, "app" }, ""},
+		{"plots allowed", func(o *SolveOptions) { o.NoPlots, o.ExtraArgs = false, []string{"--plot-scale", "0.5"} }, ""},
+		{"other extra args", func(o *SolveOptions) { o.ExtraArgs = []string{"--cpulimit", "30"} }, ""},
+		{"hint", func(o *SolveOptions) { o.RA, o.Dec, o.Radius = 359.9, -90, 5 }, ""},
+		{"negative timeout", func(o *SolveOptions) { o.Timeout = -time.Second }, "Timeout"},
+		{"negative FITS extension", func(o *SolveOptions) { o.FITSExtension = -1 }, "FITSExtension"},
+		{"negative crop", func(o *SolveOptions) { o.CropPixels = -1 }, "CropPixels"},
+		{"negative downsample", func(o *SolveOptions) { o.DownsampleFactor = -2 }, "DownsampleFactor"},
+		{"negative scale", func(o *SolveOptions) { o.ScaleLow, o.ScaleHigh = -1, 3 }, "must not be negative"},
+		{"only scale low", func(o *SolveOptions) { o.ScaleLow = 1 }, "set together"},
+		{"only scale high", func(o *SolveOptions) { o.ScaleHigh = 3 }, "set together"},
+		{"scale bounds reversed", func(o *SolveOptions) { o.ScaleLow, o.ScaleHigh = 3, 1 }, "above ScaleHigh"},
+		{"unknown scale units", func(o *SolveOptions) { o.ScaleLow, o.ScaleHigh, o.ScaleUnits = 1, 3, "degrees" }, "ScaleUnits"},
+		{"misspelled scale units", func(o *SolveOptions) { o.ScaleLow, o.ScaleHigh, o.ScaleUnits = 1, 3, "arcsecperpixel" }, "ScaleUnits"},
+		{"only depth low", func(o *SolveOptions) { o.DepthHigh = 0 }, "set together"},
+		{"depth bounds reversed", func(o *SolveOptions) { o.DepthLow, o.DepthHigh = 20, 10 }, "above DepthHigh"},
+		{"dec out of range", func(o *SolveOptions) { o.Dec = 91 }, "Dec"},
+		{"ra out of range", func(o *SolveOptions) { o.RA = 360 }, "RA"},
+		{"negative radius", func(o *SolveOptions) { o.RA, o.Radius = 10, -1 }, "Radius"},
+		{"rotation constraint", func(o *SolveOptions) { o.ExpectedRotation, o.RotationTolerance = -90, 5 }, ""},
+		{"negative rotation tolerance", func(o *SolveOptions) { o.RotationTolerance = -5 }, "RotationTolerance"},
+		{"rotation not finite", func(o *SolveOptions) { o.ExpectedRotation, o.RotationTolerance = math.NaN(), 5 }, "ExpectedRotation"},
+		{"plot flag with NoPlots", func(o *SolveOptions) { o.ExtraArgs = []string{"--plot-scale", "0.5"} }, "NoPlots"},
+		{"plot flag with value", func(o *SolveOptions) { o.ExtraArgs = []string{"--plot-bg=sky.png"} }, "NoPlots"},
+		{"crop with star list", func(o *SolveOptions) { o.XYListPath, o.CropPixels = "stars.xyls", 1024 }, "XYListPath"},
+		{"unknown keep extension", func(o *SolveOptions) { o.KeepExtensions = []string{".png"} }, "KeepExtensions"},
+		{"radius schedule", func(o *SolveOptions) { o.HintRadiusSchedule = []float64{2, 10, 0} }, ""},
+		{"negative schedule radius", func(o *SolveOptions) { o.HintRadiusSchedule = []float64{-1, 10} }, "negative"},
+		{"blind before last", func(o *SolveOptions) { o.HintRadiusSchedule = []float64{2, 0, 10} }, "blind"},
+		{"narrowing schedule", func(o *SolveOptions) { o.HintRadiusSchedule = []float64{10, 2} }, "increase"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultSolveOptions()
+			tt.modify(opts)
+			err := opts.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want ErrInvalidInput mentioning %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSolveOptionsWarnings(t *testing.T) {
+	tests := []struct {
+		name      string
+		low, high float64
+		units     ScaleUnits
+		want      string // "" for no warning
+	}{
+		{"no scale", 0, 0, UnitArcminWidth, ""},
+		{"arcminwidth", 60, 120, UnitArcminWidth, ""},
+		{"arcsec/pixel as arcminwidth", 1.2, 1.8, UnitArcminWidth, "set ScaleUnits to arcsecperpix"},
+		{"arcsec/pixel as abbreviated arcminwidth", 1.2, 1.8, "amw", "set ScaleUnits to arcsecperpix"},
+		{"arcsec width as arcminwidth", 20000, 30000, UnitArcminWidth, "divide by 60"},
+		{"degwidth", 1, 200, UnitDegWidth, ""},
+		{"arcmin as degwidth", 240, 300, UnitDegWidth, "set ScaleUnits to arcminwidth"},
+		{"arcsecperpix", 1.2, 1.8, UnitArcsecPerPix, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultSolveOptions()
+			opts.ScaleLow, opts.ScaleHigh, opts.ScaleUnits = tt.low, tt.high, tt.units
+			warnings := opts.Warnings()
+			if tt.want == "" {
+				if len(warnings) != 0 {
+					t.Errorf("Warnings() = %q, want none", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.want) {
+				t.Errorf("Warnings() = %q, want one suggesting %q", warnings, tt.want)
+			}
+		})
+	}
+}
+
+func TestScaleUnitsValid(t *testing.T) {
+	for _, u := range []ScaleUnits{UnitDegWidth, UnitArcminWidth, UnitArcsecPerPix, UnitFocalMM, "degw", "dw", "amw", "aw", "app"} {
+		if !u.Valid() {
+			t.Errorf("%q not valid", u)
+		}
+	}
+	for _, u := range []ScaleUnits{"", "arcsecperpixel", "degrees", "DEGWIDTH"} {
+		if u.Valid() {
+			t.Errorf("%q valid", u)
+		}
+	}
+}
+
+func TestSolve_ScaleUnitsWarning(t *testing.T) {
+	var workDir string
+	client := newArtifactClient(t, &workDir)
+	opts := DefaultSolveOptions()
+	opts.ScaleLow, opts.ScaleHigh = 1.2, 1.8
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "arcsecperpix") {
+		t.Errorf("Warnings = %q, want the arcsecperpix suggestion", result.Warnings)
+	}
+}
+
+func TestSolve_ValidatesOptions(t *testing.T) {
+	ran := false
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		ran = true
+		return nil
+	})
+	opts := DefaultSolveOptions()
+	opts.ScaleLow = 2
+
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Solve error = %v, want ErrInvalidInput", err)
+	}
+	if _, err := client.SolveBytes(context.Background(), []byte("data"), "png", opts); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("SolveBytes error = %v, want ErrInvalidInput", err)
+	}
+	if ran {
+		t.Error("solve-field ran with contradictory options")
+	}
+}
+
 func TestNewClient_MissingIndexPath(t *testing.T) {
 	config := &ClientConfig{
 		DockerImage: "diarmuidk/astrometry-dockerised-solver",
@@ -89,6 +238,53 @@ func TestNewClient_Success(t *testing.T) {
 	}
 }
 
+func TestNewClient_ValidateIndexes(t *testing.T) {
+	emptyDir := t.TempDir()
+
+	noIndexDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(noIndexDir, "README.txt"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	populatedDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(populatedDir, "index-4110.fits"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		indexPath string
+		wantErr   string
+	}{
+		{name: "empty directory", indexPath: emptyDir, wantErr: "directory is empty"},
+		{name: "no index files", indexPath: noIndexDir, wantErr: "found: README.txt"},
+		{name: "populated directory", indexPath: populatedDir},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(&ClientConfig{IndexPath: tt.indexPath, ValidateIndexes: true})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Fatalf("expected ErrInvalidInput, got: %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error %q does not contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Validation is opt-in
+	if _, err := NewClient(&ClientConfig{IndexPath: emptyDir}); err != nil {
+		t.Errorf("empty index dir should be accepted without ValidateIndexes: %v", err)
+	}
+}
+
 func TestParseWCSFile_Nonexistent(t *testing.T) {
 	_, err := ParseWCSFile("/nonexistent/file.wcs")
 	if err == nil {
@@ -140,6 +336,17 @@ func TestParseWCSFile_Success(t *testing.T) {
 		t.Fatalf("unexpected error parsing WCS file: %v", err)
 	}
 
+	// The same cards as an in-memory map derive an identical result
+	header := make(map[string]string)
+	for _, line := range lines {
+		if card, ok := fits.ParseCard(line); ok {
+			header[card.Key] = card.Value
+		}
+	}
+	if fromMap, err := ResultFromWCSHeader(header, 0, 0); err != nil || !reflect.DeepEqual(fromMap, result) {
+		t.Errorf("ResultFromWCSHeader = %+v, %v; want %+v", fromMap, err, result)
+	}
+
 	if !result.Solved {
 		t.Error("expected Solved to be true")
 	}
@@ -182,6 +389,82 @@ func TestParseWCSFile_Success(t *testing.T) {
 	}
 }
 
+func TestParseWCSFile_HeaderOnly(t *testing.T) {
+	// A solve-field .wcs as written: header-only (NAXIS = 0, no NAXIS1 or
+	// NAXIS2), with the image size in IMAGEW/IMAGEH and SIP distortion
+	cards := []string{
+		"SIMPLE  = T", "BITPIX  = 8", "NAXIS   = 0", "EXTEND  = T",
+		"WCSAXES = 2", "CTYPE1  = 'RA---TAN-SIP'", "CTYPE2  = 'DEC--TAN-SIP'",
+		"EQUINOX = 2000.0", "LONPOLE = 180.0", "LATPOLE = 0.0",
+		"CRVAL1  = 83.8221245588", "CRVAL2  = -5.39112218424",
+		"CRPIX1  = 1521.57397461", "CRPIX2  = 1010.15612793",
+		"CUNIT1  = 'deg     '", "CUNIT2  = 'deg     '",
+		"CD1_1   = -0.000388236512818", "CD1_2   = 3.9158436306E-06",
+		"CD2_1   = -3.8830311015E-06", "CD2_2   = -0.000388333553339",
+		"IMAGEW  = 3000", "IMAGEH  = 2000",
+		"A_ORDER = 2", "A_0_2   = 1.2E-07", "A_1_1   = -3.1E-07", "A_2_0   = 2.2E-07",
+		"B_ORDER = 2", "B_0_2   = -1.5E-07", "B_1_1   = 2.6E-07", "B_2_0   = 1.1E-07",
+		"AP_ORDER= 0", "BP_ORDER= 0",
+		"END",
+	}
+	writeWCS := func(cards []string) string {
+		var data []byte
+		for _, card := range cards {
+			data = append(data, fmt.Sprintf("%-80s", card)...)
+		}
+		for len(data)%2880 != 0 {
+			data = append(data, ' ')
+		}
+		path := filepath.Join(t.TempDir(), "field.wcs")
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatalf("failed to write WCS file: %v", err)
+		}
+		return path
+	}
+
+	result, err := ParseWCSFile(writeWCS(cards))
+	if err != nil {
+		t.Fatalf("ParseWCSFile failed: %v", err)
+	}
+	if result.ImageWidth != 3000 || result.ImageHeight != 2000 {
+		t.Errorf("image size = %dx%d, want 3000x2000 from IMAGEW/IMAGEH", result.ImageWidth, result.ImageHeight)
+	}
+	if math.Abs(result.FieldWidth-1.165) > 0.005 || math.Abs(result.FieldHeight-0.777) > 0.005 {
+		t.Errorf("field size = %.4f x %.4f deg, want about 1.165 x 0.777", result.FieldWidth, result.FieldHeight)
+	}
+	if _, ok := result.CornerCoords(); !ok {
+		t.Error("expected corners for a header-only file with IMAGEW/IMAGEH")
+	}
+
+	// Without IMAGEW/IMAGEH the size is unknown: zero, never NaN or Inf
+	var sizeless []string
+	for _, card := range cards {
+		if !strings.HasPrefix(card, "IMAGE") {
+			sizeless = append(sizeless, card)
+		}
+	}
+	result, err = ParseWCSFile(writeWCS(sizeless))
+	if err != nil {
+		t.Fatalf("ParseWCSFile failed: %v", err)
+	}
+	if result.ImageWidth != 0 || result.ImageHeight != 0 || result.FieldWidth != 0 || result.FieldHeight != 0 {
+		t.Errorf("got %dx%d px, %v x %v deg; want all zero", result.ImageWidth, result.ImageHeight,
+			result.FieldWidth, result.FieldHeight)
+	}
+	for name, v := range map[string]float64{"RA": result.RA, "Dec": result.Dec, "PixelScale": result.PixelScale,
+		"Rotation": result.Rotation, "ScaleArcminWidth": result.ScaleArcminWidth(0)} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Errorf("%s = %v", name, v)
+		}
+	}
+	if math.Abs(result.PixelScale-1.3977) > 0.001 {
+		t.Errorf("PixelScale = %.4f, want 1.3977 from the CD matrix", result.PixelScale)
+	}
+	if _, ok := result.CornerCoords(); ok {
+		t.Error("expected no corners without an image size")
+	}
+}
+
 func TestBuildSolveArgs(t *testing.T) {
 	tempDir := t.TempDir()
 	config := &ClientConfig{
@@ -228,3 +511,541 @@ func TestBuildSolveArgs(t *testing.T) {
 		t.Error("expected image path argument")
 	}
 }
+
+func TestBuildSolveArgs_ExtraArgs(t *testing.T) {
+	tempDir := t.TempDir()
+	client, _ := NewClient(&ClientConfig{IndexPath: tempDir})
+
+	opts := DefaultSolveOptions()
+	opts.ExtraArgs = []string{"--odds-to-solve", "1e6"}
+	args := client.buildSolveArgs("test.jpg", tempDir, opts)
+
+	argsStr := strings.Join(args, " ")
+	if !strings.Contains(argsStr, "--no-plots --no-verify --odds-to-solve 1e6 --dir /data") {
+		t.Errorf("extra args not placed before the output dir and image: %s", argsStr)
+	}
+	if args[len(args)-1] != "/data/test.jpg" {
+		t.Errorf("image path is not last: %s", argsStr)
+	}
+}
+
+func TestBuildSolveArgs_Preprocessing(t *testing.T) {
+	tempDir := t.TempDir()
+	client, _ := NewClient(&ClientConfig{IndexPath: tempDir})
+
+	opts := DefaultSolveOptions()
+	if args := strings.Join(client.buildSolveArgs("test.fits", tempDir, opts), " "); strings.Contains(args, "--no-fits2fits") ||
+		strings.Contains(args, "--extension") || strings.Contains(args, "--invert") ||
+		strings.Contains(args, "--no-remove-lines") || strings.Contains(args, "--uniformize") {
+		t.Errorf("default options add preprocessing flags: %s", args)
+	}
+
+	opts.NoFITS2FITS = true
+	opts.FITSExtension = 1
+	opts.Invert = true
+	opts.NoRemoveLines = true
+	opts.NoUniformize = true
+	args := strings.Join(client.buildSolveArgs("test.fits", tempDir, opts), " ")
+	if !strings.Contains(args, "--no-fits2fits --extension 1 --invert --no-remove-lines --uniformize 0 --no-verify") {
+		t.Errorf("preprocessing flags missing or out of place: %s", args)
+	}
+}
+
+// TestBuildSolveArgs_Order pins the full argument list for every solve
+// option, so runs with the same options are reproducible: solve-field has
+// no seed flag, and the argument order is all a caller controls.
+func TestBuildSolveArgs_Order(t *testing.T) {
+	tempDir := t.TempDir()
+	client, err := NewClient(&ClientConfig{IndexPath: tempDir})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	opts := &SolveOptions{
+		ScaleLow: 1, ScaleHigh: 3, ScaleUnits: "degwidth",
+		DownsampleFactor: 2, DepthLow: 10, DepthHigh: 20, NoPlots: true,
+		RA: 83.82, Dec: -5.39, Radius: 5, NoFITS2FITS: true, FITSExtension: 1,
+		Invert: true, NoRemoveLines: true, NoUniformize: true,
+		ExtraArgs: []string{"--odds-to-solve", "1e6", "--tweak-order", "3"},
+	}
+	want := []string{
+		"solve-field",
+		"-L", "1.000000", "-H", "3.000000", "-u", "degwidth",
+		"--downsample", "2",
+		"--depth", "10-20",
+		"--no-plots",
+		"--ra", "83.820000", "--dec", "-5.390000", "--radius", "5.000000",
+		"--no-fits2fits", "--extension", "1", "--invert", "--no-remove-lines", "--uniformize", "0",
+		"--no-verify",
+		"--odds-to-solve", "1e6", "--tweak-order", "3",
+		"--dir", "/data", "/data/test.fits",
+	}
+	if got := client.buildSolveArgs("test.fits", tempDir, opts); !slices.Equal(got, want) {
+		t.Errorf("args =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestSolve_NegativeFITSExtension(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error { return nil })
+	opts := DefaultSolveOptions()
+	opts.FITSExtension = -1
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("err = %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestSolve_CustomMountPaths(t *testing.T) {
+	var got []string
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		got = args
+		return nil
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) {
+		cfg.DataMountPath = "/work"
+		cfg.IndexMountPath = "/opt/astrometry/indexes"
+	}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	argsStr := strings.Join(got, " ")
+	if !strings.Contains(argsStr, ":/work -v ") || !strings.Contains(argsStr, ":/opt/astrometry/indexes "+DefaultDockerImage) {
+		t.Errorf("custom mounts missing from docker args: %s", argsStr)
+	}
+	if strings.Contains(argsStr, "/data") || strings.Contains(argsStr, DefaultIndexMountPath) {
+		t.Errorf("default mount paths still used: %s", argsStr)
+	}
+	if !strings.Contains(argsStr, "--dir /work ") || !strings.HasPrefix(got[len(got)-1], "/work/") {
+		t.Errorf("solve-field paths not under the custom data mount: %s", argsStr)
+	}
+}
+
+func TestNewClient_MountPaths(t *testing.T) {
+	client, err := NewClient(&ClientConfig{IndexPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if cfg := client.Config(); cfg.DataMountPath != DefaultDataMountPath || cfg.IndexMountPath != DefaultIndexMountPath {
+		t.Errorf("default mounts = %q, %q", cfg.DataMountPath, cfg.IndexMountPath)
+	}
+
+	tests := []struct {
+		name        string
+		data, index string
+		wantErr     string
+	}{
+		{"relative data mount", "data", "", "DataMountPath must be an absolute container path"},
+		{"relative index mount", "", "indexes/", "IndexMountPath must be an absolute container path"},
+		{"same mount", "/data/", "/data", "DataMountPath and IndexMountPath must differ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), DataMountPath: tt.data, IndexMountPath: tt.index})
+			if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewClient error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResultClone(t *testing.T) {
+	original := &Result{
+		Solved:       true,
+		RA:           83.82,
+		Dec:          -5.39,
+		WCSHeader:    map[string]string{"CRVAL1": "83.82"},
+		OutputFiles:  []string{"/tmp/image.wcs", "/tmp/image.corr"},
+		SolvedFields: []int{1},
+		Outputs:      map[OutputKind]string{OutputWCS: "/tmp/image.wcs"},
+	}
+
+	clone := original.Clone()
+	clone.Outputs[OutputWCS] = "/tmp/other.wcs"
+	clone.SolvedFields[0] = 2
+	clone.RA = 10
+	clone.WCSHeader["CRVAL1"] = "10"
+	clone.WCSHeader["NEW"] = "value"
+	clone.OutputFiles[0] = "/tmp/other.wcs"
+	clone.OutputFiles = append(clone.OutputFiles, "/tmp/extra")
+
+	if original.RA != 83.82 {
+		t.Errorf("original RA changed to %f", original.RA)
+	}
+	if len(original.WCSHeader) != 1 || original.WCSHeader["CRVAL1"] != "83.82" {
+		t.Errorf("original WCSHeader changed: %v", original.WCSHeader)
+	}
+	if len(original.OutputFiles) != 2 || original.OutputFiles[0] != "/tmp/image.wcs" {
+		t.Errorf("original OutputFiles changed: %v", original.OutputFiles)
+	}
+	if original.SolvedFields[0] != 1 {
+		t.Errorf("original SolvedFields changed: %v", original.SolvedFields)
+	}
+	if original.WCSPath() != "/tmp/image.wcs" {
+		t.Errorf("original Outputs changed: %v", original.Outputs)
+	}
+
+	if (*Result)(nil).Clone() != nil {
+		t.Error("Clone of nil result should be nil")
+	}
+}
+
+func TestResultScaleArcminWidth(t *testing.T) {
+	r := &Result{Solved: true, PixelScale: 1.5, ImageWidth: 6000}
+
+	// 1.5"/px over 6000 px is 9000" = 150'
+	if got := r.ScaleArcminWidth(0); got != 150 {
+		t.Errorf("ScaleArcminWidth(0) = %v, want 150", got)
+	}
+	// An explicit width overrides ImageWidth
+	if got := r.ScaleArcminWidth(3000); got != 75 {
+		t.Errorf("ScaleArcminWidth(3000) = %v, want 75", got)
+	}
+
+	for _, r := range []*Result{
+		{Solved: false, PixelScale: 1.5, ImageWidth: 6000},
+		{Solved: true, PixelScale: 1.5},
+		{Solved: true, ImageWidth: 6000},
+	} {
+		if got := r.ScaleArcminWidth(0); got != 0 {
+			t.Errorf("%+v: ScaleArcminWidth(0) = %v, want 0", r, got)
+		}
+	}
+}
+
+func TestNewClient_DoesNotModifyConfig(t *testing.T) {
+	config := &ClientConfig{IndexPath: t.TempDir(), ScratchDirs: []string{"/scratch"}}
+
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	if config.DockerImage != "" || config.Timeout != 0 || config.TempDir != "" {
+		t.Errorf("NewClient filled defaults into the caller's config: %+v", config)
+	}
+
+	// Later changes by the caller don't reach the client
+	config.ScratchDirs[0] = "/changed"
+	config.Timeout = time.Second
+	got := client.Config()
+	if got.ScratchDirs[0] != "/scratch" || got.Timeout != 5*time.Minute {
+		t.Errorf("client config changed through caller's struct: %+v", got)
+	}
+
+	// Nor do changes to a returned snapshot
+	got.ScratchDirs[0] = "/snapshot"
+	if client.Config().ScratchDirs[0] != "/scratch" {
+		t.Error("client config changed through Config() snapshot")
+	}
+}
+
+func TestUpdateConfig(t *testing.T) {
+	client, err := NewClient(&ClientConfig{IndexPath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+
+	if err := client.UpdateConfig(func(cfg *ClientConfig) {
+		cfg.DockerImage = "dm90/astrometry"
+		cfg.Timeout = time.Minute
+	}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if got := client.Config(); got.DockerImage != "dm90/astrometry" || got.Timeout != time.Minute {
+		t.Errorf("config not updated: %+v", got)
+	}
+
+	tests := []struct {
+		name   string
+		update func(*ClientConfig)
+	}{
+		{"missing index path", func(cfg *ClientConfig) { cfg.IndexPath = "/nonexistent/path/to/indexes" }},
+		{"exec mode without container", func(cfg *ClientConfig) { cfg.UseDockerExec = true }},
+		{"container cleared in exec mode", func(cfg *ClientConfig) { cfg.UseDockerExec, cfg.ContainerName = true, "" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := client.Config()
+			err := client.UpdateConfig(tt.update)
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Fatalf("expected ErrInvalidInput, got %v", err)
+			}
+			if after := client.Config(); after.IndexPath != before.IndexPath || after.UseDockerExec != before.UseDockerExec {
+				t.Errorf("rejected update was applied: %+v", after)
+			}
+		})
+	}
+}
+
+func TestUpdateConfig_ConcurrentSolve(t *testing.T) {
+	images := []string{"image-a", "image-b"}
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		// The image name follows --name and the two -v mounts in run mode
+		image := args[8]
+		if image != images[0] && image != images[1] {
+			t.Errorf("unexpected docker image %q", image)
+		}
+		return nil
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.DockerImage = images[0] }); err != nil {
+		t.Fatal(err)
+	}
+	imagePath := writeTestPNG(t, 8, 8)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Solve(context.Background(), imagePath, nil); err != nil {
+				t.Errorf("Solve failed: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			err := client.UpdateConfig(func(cfg *ClientConfig) {
+				cfg.DockerImage = images[i%2]
+				cfg.Timeout = time.Duration(i+1) * time.Minute
+			})
+			if err != nil {
+				t.Errorf("UpdateConfig failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSolve_KeepsConfigSnapshot(t *testing.T) {
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	var seen []string
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		seen = append(seen, args[8])
+		if len(seen) == 1 {
+			close(started)
+			<-proceed
+		}
+		return nil
+	})
+	imagePath := writeTestPNG(t, 8, 8)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := client.Solve(context.Background(), imagePath, nil); err != nil {
+			t.Errorf("Solve failed: %v", err)
+		}
+	}()
+
+	<-started
+	if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.DockerImage = "dm90/astrometry" }); err != nil {
+		t.Fatal(err)
+	}
+	close(proceed)
+	<-done
+
+	if _, err := client.Solve(context.Background(), imagePath, nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if seen[0] != DefaultDockerImage || seen[1] != "dm90/astrometry" {
+		t.Errorf("docker images used = %q, want [%s dm90/astrometry]", seen, DefaultDockerImage)
+	}
+}
+
+func TestSolve_FallbackImages(t *testing.T) {
+	tests := []struct {
+		name      string
+		solvedBy  string // Image that writes a solution, "" for none
+		output    string // Output of the images that do not solve
+		wantRuns  []string
+		wantImage string
+		wantErr   error
+		wantHooks int
+	}{
+		{
+			name:      "second image solves",
+			solvedBy:  "dm90/astrometry",
+			wantRuns:  []string{DefaultDockerImage, "dm90/astrometry"},
+			wantImage: "dm90/astrometry",
+			wantHooks: 1,
+		},
+		{
+			name:      "first image solves",
+			solvedBy:  DefaultDockerImage,
+			wantRuns:  []string{DefaultDockerImage},
+			wantImage: DefaultDockerImage,
+			wantHooks: 1,
+		},
+		{
+			name:      "no image solves",
+			output:    "Did not solve (or no WCS file was written).",
+			wantRuns:  []string{DefaultDockerImage, "dm90/astrometry", "example/solver:old"},
+			wantImage: "example/solver:old",
+			wantHooks: 1,
+		},
+		{
+			name:     "docker failure is retried",
+			wantRuns: []string{DefaultDockerImage, "dm90/astrometry", "example/solver:old"},
+			wantErr:  ErrDockerFailed,
+		},
+		{
+			name:     "setup problem is not retried",
+			output:   "solve-field: no index files were found",
+			wantRuns: []string{DefaultDockerImage},
+			wantErr:  ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var runs []string
+			client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+				image := args[8]
+				runs = append(runs, image)
+				if image != tt.solvedBy {
+					_, _ = io.WriteString(w, tt.output)
+					return exitError(1)
+				}
+				if err := os.WriteFile(filepath.Join(workDir, "stars.wcs"), fits.EncodeHeader(wcsCards(0.001)), 0644); err != nil {
+					return err
+				}
+				return os.WriteFile(filepath.Join(workDir, "stars.solved"), []byte{1}, 0644)
+			})
+			hooks := 0
+			if err := client.UpdateConfig(func(cfg *ClientConfig) {
+				cfg.FallbackImages = []string{"dm90/astrometry", "example/solver:old"}
+				cfg.PostSolveHooks = []Hook{NamedHook{OnFailure: true, Hook: HookFunc(
+					func(ctx context.Context, imagePath string, r *Result) error {
+						hooks++
+						return nil
+					})}}
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+			if !slices.Equal(runs, tt.wantRuns) {
+				t.Errorf("images run = %q, want %q", runs, tt.wantRuns)
+			}
+			if hooks != tt.wantHooks {
+				t.Errorf("hooks ran %d times, want %d", hooks, tt.wantHooks)
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Solve error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+			if result.Solved != (tt.solvedBy != "") {
+				t.Errorf("Solved = %v, want %v", result.Solved, tt.solvedBy != "")
+			}
+			if result.DockerImage != tt.wantImage {
+				t.Errorf("DockerImage = %q, want %q", result.DockerImage, tt.wantImage)
+			}
+		})
+	}
+}
+
+func TestSolve_FallbackImagesIgnoredInExecMode(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		calls++
+		_, _ = io.WriteString(w, "Did not solve (or no WCS file was written).")
+		return exitError(1)
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) {
+		cfg.UseDockerExec, cfg.ContainerName = true, "solver"
+		cfg.FallbackImages = []string{"dm90/astrometry"}
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if calls != 1 || result.Solved || result.DockerImage != "" {
+		t.Errorf("exec mode ran %d solves, DockerImage %q; want 1 unsolved with no image", calls, result.DockerImage)
+	}
+}
+
+func TestNewClient_EmptyFallbackImage(t *testing.T) {
+	_, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), FallbackImages: []string{"dm90/astrometry", ""}})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("NewClient error = %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestNewClient_ExecModeRequiresContainerName(t *testing.T) {
+	_, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), UseDockerExec: true})
+	if !errors.Is(err, ErrInvalidInput) || !strings.Contains(err.Error(), "ContainerName") {
+		t.Errorf("NewClient error = %v, want ErrInvalidInput naming ContainerName", err)
+	}
+
+	if _, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), UseDockerExec: true, ContainerName: "astrometry"}); err != nil {
+		t.Errorf("NewClient with ContainerName failed: %v", err)
+	}
+}
+
+func TestSolve_TimeoutPrecedence(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      time.Duration
+		opts        time.Duration
+		ctxDeadline time.Duration
+		want        time.Duration
+	}{
+		{"config timeout", 5 * time.Minute, 0, 0, 5 * time.Minute},
+		{"shorter per-call timeout", 5 * time.Minute, 30 * time.Second, 0, 30 * time.Second},
+		{"longer per-call timeout", 5 * time.Minute, 20 * time.Minute, 0, 20 * time.Minute},
+		{"context deadline sooner", 5 * time.Minute, time.Minute, 10 * time.Second, 10 * time.Second},
+		{"per-call timeout sooner than context", 5 * time.Minute, time.Minute, time.Hour, time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, nil)
+			if err := client.UpdateConfig(func(cfg *ClientConfig) { cfg.Timeout = tt.config }); err != nil {
+				t.Fatal(err)
+			}
+			var remaining time.Duration
+			client.run = func(ctx context.Context, name string, args []string, w io.Writer) error {
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					t.Error("solve context has no deadline")
+				}
+				remaining = time.Until(deadline)
+				return nil
+			}
+
+			ctx := context.Background()
+			if tt.ctxDeadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxDeadline)
+				defer cancel()
+			}
+			opts := DefaultSolveOptions()
+			opts.Timeout = tt.opts
+
+			if _, err := client.Solve(ctx, writeTestPNG(t, 8, 8), opts); err != nil {
+				t.Fatalf("Solve failed: %v", err)
+			}
+			if remaining > tt.want || remaining < tt.want-5*time.Second {
+				t.Errorf("solve deadline in %v, want about %v", remaining, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolve_NegativeTimeout(t *testing.T) {
+	client := newTestClient(t, nil)
+	opts := DefaultSolveOptions()
+	opts.Timeout = -time.Second
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), opts); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
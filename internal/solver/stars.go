@@ -0,0 +1,155 @@
+package solver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// fwhmRadius is the radius in pixels of the box around each star used to
+// measure its FWHM. It fits well-focused and moderately defocused stars;
+// stars closer than this to the image edge are not measured.
+const fwhmRadius = 8
+
+// gaussianFWHM converts a Gaussian's standard deviation to its FWHM.
+var gaussianFWHM = 2 * math.Sqrt(2*math.Ln2)
+
+// StarStats summarises the stars detected in an image, for autofocus and
+// guiding tools that need star counts and sizes rather than a solution.
+type StarStats struct {
+	Count      int     // Number of detected stars
+	MedianFWHM float64 // Median FWHM in pixels over the measured stars; 0 if none could be measured
+	MedianFlux float64 // Median background-subtracted flux
+	Background float64 // Median sky background level at the stars
+}
+
+// MeasureStars detects stars with image2xy and measures them without
+// solving, which takes a fraction of the time of a solve. FWHM comes from
+// the extractor's table when it has an FWHM column, and otherwise is
+// estimated from each star's second moments in the image (as a Gaussian,
+// FWHM = 2.355σ), skipping stars too close to the edge.
+//
+// Example (autofocus):
+//
+//	stats, err := c.MeasureStars(ctx, "focus-step-12.fits", nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%d stars, HFR proxy %.2f px\n", stats.Count, stats.MedianFWHM/2)
+func (c *Client) MeasureStars(ctx context.Context, imagePath string, opts *ExtractOptions) (StarStats, error) {
+	list, err := c.snapshot().extractSources(ctx, imagePath, opts, measureFWHM)
+	if err != nil {
+		return StarStats{}, err
+	}
+	return starStats(list), nil
+}
+
+// measureFWHM fills in the FWHM of sources that have none, measured from
+// the FITS image image2xy ran on.
+func measureFWHM(fitsPath string, list *SourceList) error {
+	file, err := os.Open(fitsPath)
+	if err != nil {
+		return fmt.Errorf("failed to open staged image: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	img, err := fits.ReadImage(bufio.NewReader(file))
+	if err != nil {
+		return fmt.Errorf("failed to read staged image: %w", err)
+	}
+	for i := range list.Sources {
+		if s := &list.Sources[i]; s.FWHM == 0 {
+			s.FWHM = starFWHM(img, s.X, s.Y)
+		}
+	}
+	return nil
+}
+
+// starFWHM estimates the FWHM of the star at FITS pixel (x, y), 1-based,
+// from the intensity-weighted second moments of the pixels within
+// fwhmRadius after subtracting the median level on the box edge. It
+// returns 0 when the box leaves the image or holds no signal.
+func starFWHM(img *fits.Image, x, y float64) float64 {
+	cx, cy := int(math.Round(x-1)), int(math.Round(y-1))
+	if cx < fwhmRadius || cy < fwhmRadius || cx+fwhmRadius >= img.Width || cy+fwhmRadius >= img.Height {
+		return 0
+	}
+
+	edge := make([]float64, 0, 8*fwhmRadius)
+	for d := -fwhmRadius; d < fwhmRadius; d++ {
+		edge = append(edge,
+			img.At(cx+d, cy-fwhmRadius), img.At(cx+fwhmRadius, cy+d),
+			img.At(cx-d, cy+fwhmRadius), img.At(cx-fwhmRadius, cy-d))
+	}
+	background := median(edge)
+
+	var sum, sumX, sumY, sumXX, sumYY float64
+	for dy := -fwhmRadius; dy <= fwhmRadius; dy++ {
+		for dx := -fwhmRadius; dx <= fwhmRadius; dx++ {
+			if dx*dx+dy*dy > fwhmRadius*fwhmRadius {
+				continue
+			}
+			v := img.At(cx+dx, cy+dy) - background
+			if v <= 0 {
+				continue
+			}
+			fx, fy := float64(dx), float64(dy)
+			sum += v
+			sumX += v * fx
+			sumY += v * fy
+			sumXX += v * fx * fx
+			sumYY += v * fy * fy
+		}
+	}
+	if sum == 0 {
+		return 0
+	}
+	mx, my := sumX/sum, sumY/sum
+	variance := (sumXX/sum - mx*mx + sumYY/sum - my*my) / 2
+	if variance <= 0 {
+		return 0
+	}
+	return gaussianFWHM * math.Sqrt(variance)
+}
+
+// starStats reduces a source list to StarStats.
+func starStats(list *SourceList) StarStats {
+	stats := StarStats{Count: len(list.Sources)}
+	if stats.Count == 0 {
+		return stats
+	}
+	var fwhm, flux, background []float64
+	for _, s := range list.Sources {
+		if s.FWHM > 0 {
+			fwhm = append(fwhm, s.FWHM)
+		}
+		flux = append(flux, s.Flux)
+		background = append(background, s.Background)
+	}
+	stats.MedianFWHM = median(fwhm)
+	stats.MedianFlux = median(flux)
+	stats.Background = median(background)
+	return stats
+}
+
+// median returns the median of values, or 0 for none. values is not
+// modified.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,111 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+func TestStarStats_FixtureTable(t *testing.T) {
+	// A source-extractor style table that already carries FWHM
+	var buf bytes.Buffer
+	if err := fits.WriteTables(&buf, nil, fits.TableHDU{
+		Columns: []string{"X", "Y", "FLUX", "BACKGROUND", "FWHM_IMAGE"},
+		Data: [][]float64{
+			{10, 20, 30, 40},
+			{15, 25, 35, 45},
+			{1000, 400, 2500, 800},
+			{100, 104, 98, 102},
+			{3.5, 2.5, 4, 3},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	list, err := readSourceList(&buf)
+	if err != nil {
+		t.Fatalf("readSourceList failed: %v", err)
+	}
+
+	got := starStats(list)
+	want := StarStats{Count: 4, MedianFWHM: 3.25, MedianFlux: 900, Background: 101}
+	if got != want {
+		t.Errorf("starStats = %+v, want %+v", got, want)
+	}
+
+	if got := starStats(&SourceList{}); got != (StarStats{}) {
+		t.Errorf("starStats of no sources = %+v, want zero", got)
+	}
+}
+
+// writeGaussianStarsPNG draws Gaussian stars of standard deviation sigma
+// at the given pixel centers on a flat background.
+func writeGaussianStarsPNG(t *testing.T, width, height int, sigma float64, centers [][2]int) string {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := 20.0
+			for _, c := range centers {
+				dx, dy := float64(x-c[0]), float64(y-c[1])
+				v += 200 * math.Exp(-(dx*dx+dy*dy)/(2*sigma*sigma))
+			}
+			img.SetGray(x, y, color.Gray{Y: uint8(math.Min(255, math.Round(v)))})
+		}
+	}
+	path := filepath.Join(t.TempDir(), "stars.png")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestMeasureStars(t *testing.T) {
+	const width, height, sigma = 64, 64, 1.5
+	centers := [][2]int{{16, 16}, {40, 20}, {24, 44}, {60, 60}} // the last is too close to the edge
+	imagePath := writeGaussianStarsPNG(t, width, height, sigma, centers)
+
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		// FITS coordinates are 1-based with the PNG's bottom row first
+		xs, ys, flux, bg := []float64{}, []float64{}, []float64{}, []float64{}
+		for _, c := range centers {
+			xs = append(xs, float64(c[0]+1))
+			ys = append(ys, float64(height-c[1]))
+			flux = append(flux, 2800)
+			bg = append(bg, 20)
+		}
+		out, err := os.Create(filepath.Join(workDir, "stars.xy"))
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		return fits.WriteTables(out, nil, fits.TableHDU{
+			Columns: []string{"X", "Y", "FLUX", "BACKGROUND"},
+			Data:    [][]float64{xs, ys, flux, bg},
+		})
+	})
+
+	stats, err := client.MeasureStars(context.Background(), imagePath, nil)
+	if err != nil {
+		t.Fatalf("MeasureStars failed: %v", err)
+	}
+	if stats.Count != 4 || stats.MedianFlux != 2800 || stats.Background != 20 {
+		t.Errorf("stats = %+v, want 4 stars, flux 2800, background 20", stats)
+	}
+	if want := gaussianFWHM * sigma; math.Abs(stats.MedianFWHM-want) > 0.05*want {
+		t.Errorf("MedianFWHM = %.3f, want %.3f ±5%%", stats.MedianFWHM, want)
+	}
+}
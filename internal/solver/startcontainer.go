@@ -0,0 +1,285 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultReadyTimeout is how long StartContainer waits for solve-field to
+// run in a new container when StartOptions.ReadyTimeout is zero.
+const DefaultReadyTimeout = time.Minute
+
+// readyPollInterval is the pause between StartContainer's readiness probes.
+const readyPollInterval = 500 * time.Millisecond
+
+// PullProgress reports the progress of a docker pull to EnsureImage's
+// callback, once per line of docker's output.
+type PullProgress struct {
+	// Line is docker's output line, such as "a1b2c3: Pull complete".
+	Line string
+
+	// LayersTotal is the number of image layers seen so far, and
+	// LayersDone how many of them are complete or already present. The
+	// total grows as docker announces layers, so the fraction is only
+	// meaningful once the first layers have started downloading.
+	LayersTotal int
+	LayersDone  int
+}
+
+// StartOptions configures StartContainer.
+type StartOptions struct {
+	// Progress is called with docker pull progress when the image has to
+	// be pulled first. Calls come from a single goroutine.
+	// Default: nil (no progress reported)
+	Progress func(PullProgress)
+
+	// ReadyTimeout bounds the wait after docker run for solve-field to
+	// run in the container.
+	// Default: DefaultReadyTimeout
+	ReadyTimeout time.Duration
+}
+
+// EnsureImage pulls ClientConfig.DockerImage unless it is already present
+// locally, calling progress (if not nil) for each line docker pull writes.
+// Pulling the solver image can take minutes on a fresh host, so pass a
+// progress callback to show that something is happening. It returns an
+// error wrapping ErrImagePull if the pull fails.
+func (c *Client) EnsureImage(ctx context.Context, progress func(PullProgress)) error {
+	c = c.snapshot()
+	image := c.config.DockerImage
+	if c.run(ctx, "docker", []string{"image", "inspect", image}, io.Discard) == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	output := newBoundedBuffer(c.config.MaxOutputBytes)
+	var w io.Writer = output
+	var lw *logLineWriter
+	if progress != nil {
+		lw = &logLineWriter{w: &pullProgressWriter{report: progress, layers: make(map[string]bool)}}
+		w = io.MultiWriter(output, lw)
+	}
+	err := c.run(ctx, "docker", []string{"pull", image}, w)
+	if lw != nil {
+		_ = lw.flush() //nolint:errcheck // pullProgressWriter never fails
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%w: %s: %v\nOutput: %s", ErrImagePull, image, err, output.String())
+	}
+	return nil
+}
+
+// StartContainer starts the long-lived container that exec mode solves
+// in, named ClientConfig.ContainerName, and returns once it can solve:
+//
+//  1. The image is pulled with EnsureImage if it is not present locally.
+//  2. docker run -d starts the container with IndexPath mounted read-only
+//     at IndexMountPath (and any IndexPaths at IndexMountPath-2, -3, ...),
+//     and TempDir and every ScratchDirs entry mounted at their host paths,
+//     where exec mode expects its working files.
+//  3. solve-field --help is run in the container until it succeeds or
+//     opts.ReadyTimeout passes.
+//  4. Each index mount is listed inside the container, and must hold at
+//     least one index-*.fits file.
+//
+// Each stage fails with its own error: ErrImagePull, ErrContainerStart
+// (including when a container with the name already exists) or
+// ErrContainerNotReady, all of which wrap ErrDockerFailed. A container
+// that started but did not become ready is removed again. StartContainer
+// returns ErrInvalidInput unless UseDockerExec is enabled with a
+// ContainerName.
+//
+// Example:
+//
+//	err := c.StartContainer(ctx, &client.StartOptions{
+//		Progress: func(p client.PullProgress) {
+//			log.Printf("pulling image: %d/%d layers", p.LayersDone, p.LayersTotal)
+//		},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer c.StopContainer(context.Background())
+func (c *Client) StartContainer(ctx context.Context, opts *StartOptions) error {
+	c = c.snapshot()
+	if !c.config.UseDockerExec || c.config.ContainerName == "" {
+		return fmt.Errorf("%w: starting a container requires UseDockerExec and ContainerName", ErrInvalidInput)
+	}
+	if opts == nil {
+		opts = &StartOptions{}
+	}
+	readyTimeout := opts.ReadyTimeout
+	if readyTimeout <= 0 {
+		readyTimeout = DefaultReadyTimeout
+	}
+
+	if err := c.EnsureImage(ctx, opts.Progress); err != nil {
+		return err
+	}
+
+	name := c.config.ContainerName
+	if err := c.startIdleContainer(ctx, name); err != nil {
+		return err
+	}
+
+	if err := c.waitReady(ctx, readyTimeout); err != nil {
+		c.removeContainer(context.WithoutCancel(ctx), name)
+		return err
+	}
+	return nil
+}
+
+// startIdleContainer runs a detached container named name that idles for
+// docker exec, with every index directory mounted read-only, and TempDir
+// and every ScratchDirs entry mounted at their host paths.
+func (c *Client) startIdleContainer(ctx context.Context, name string) error {
+	absIndexPaths, err := c.absIndexPaths()
+	if err != nil {
+		return err
+	}
+	args := append([]string{"run", "-d", "--name", name}, c.indexMountArgs(absIndexPaths, ":ro")...)
+	for _, dir := range append([]string{c.config.TempDir}, c.config.ScratchDirs...) {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return fmt.Errorf("failed to get absolute path of %s: %w", dir, err)
+		}
+		args = append(args, "-v", fmt.Sprintf("%s:%s", absDir, absDir))
+	}
+	args = append(args, c.config.DockerImage, "tail", "-f", "/dev/null")
+
+	output := newBoundedBuffer(c.config.MaxOutputBytes)
+	if err := c.run(ctx, "docker", args, output); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%w: %s: %v\nOutput: %s", ErrContainerStart, name, err, output.String())
+	}
+	return nil
+}
+
+// waitReady runs solve-field --help in the exec container until it
+// succeeds or timeout passes, then checks that every index directory is
+// mounted and not empty.
+func (c *Client) waitReady(ctx context.Context, timeout time.Duration) error {
+	name := c.config.ContainerName
+	readyCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastErr error
+	for {
+		output := newBoundedBuffer(c.config.MaxOutputBytes)
+		lastErr = c.run(readyCtx, "docker", []string{"exec", name, "solve-field", "--help"}, output)
+		if lastErr == nil {
+			break
+		}
+		lastErr = fmt.Errorf("%v: %s", lastErr, strings.TrimSpace(output.String()))
+
+		timer := time.NewTimer(readyPollInterval)
+		select {
+		case <-readyCtx.Done():
+			timer.Stop()
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("%w: solve-field did not run in %s within %v: %v", ErrContainerNotReady, name, timeout, lastErr)
+		case <-timer.C:
+		}
+	}
+
+	for i, indexPath := range c.config.indexPaths() {
+		if err := c.checkIndexMount(ctx, name, c.config.indexMountPath(i), indexPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIndexMount lists mountPath in the container name, which must hold
+// at least one index-*.fits file from the host directory indexPath.
+func (c *Client) checkIndexMount(ctx context.Context, name, mountPath, indexPath string) error {
+	var listing strings.Builder
+	if err := c.run(ctx, "docker", []string{"exec", name, "ls", "-1", mountPath}, &listing); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%w: cannot list %s in %s: %v: %s", ErrContainerNotReady, mountPath, name, err,
+			strings.TrimSpace(listing.String()))
+	}
+	for _, file := range strings.Fields(listing.String()) {
+		if ok, _ := path.Match("index-*.fits", file); ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no index-*.fits files in %s inside %s; check that IndexPath %s holds index files and is mounted there",
+		ErrContainerNotReady, mountPath, name, indexPath)
+}
+
+// StopContainer force-removes the exec-mode container, such as one started
+// by StartContainer. A container that does not exist is not an error. It
+// returns ErrInvalidInput unless UseDockerExec is enabled with a
+// ContainerName.
+func (c *Client) StopContainer(ctx context.Context) error {
+	c = c.snapshot()
+	if !c.config.UseDockerExec || c.config.ContainerName == "" {
+		return fmt.Errorf("%w: stopping a container requires UseDockerExec and ContainerName", ErrInvalidInput)
+	}
+	var output strings.Builder
+	if err := c.run(ctx, "docker", []string{"rm", "-f", c.config.ContainerName}, &output); err != nil &&
+		!strings.Contains(output.String(), "No such container") {
+		return fmt.Errorf("%w: docker rm %s: %v: %s", ErrDockerFailed, c.config.ContainerName, err,
+			strings.TrimSpace(output.String()))
+	}
+	return nil
+}
+
+// pullProgressWriter turns docker pull output lines into PullProgress
+// reports, counting layers by their "<id>: <status>" lines.
+type pullProgressWriter struct {
+	report func(PullProgress)
+	layers map[string]bool // layer ID to whether it is done
+	done   int
+}
+
+func (pw *pullProgressWriter) Write(line []byte) (int, error) {
+	text := strings.TrimSpace(string(line))
+	if id, status, ok := strings.Cut(text, ": "); ok && isLayerID(id) && !strings.HasPrefix(status, "Pulling from") {
+		if _, seen := pw.layers[id]; !seen {
+			pw.layers[id] = false
+		}
+		switch status {
+		case "Pull complete", "Already exists":
+			if !pw.layers[id] {
+				pw.layers[id] = true
+				pw.done++
+			}
+		}
+	}
+	if text != "" {
+		pw.report(PullProgress{Line: text, LayersTotal: len(pw.layers), LayersDone: pw.done})
+	}
+	return len(line), nil
+}
+
+// isLayerID reports whether s looks like the short hex ID docker pull
+// prefixes layer lines with, rather than "Digest", "Status" or a tag.
+func isLayerID(s string) bool {
+	if len(s) < 12 {
+		return false
+	}
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return false
+		}
+	}
+	return true
+}
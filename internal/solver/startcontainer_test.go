@@ -0,0 +1,209 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDocker answers the docker commands StartContainer runs. Each field
+// is the failure of its stage, nil for success.
+type fakeDocker struct {
+	mu         sync.Mutex
+	calls      [][]string
+	missing    bool  // image not present locally
+	pullErr    error // docker pull
+	runErr     error // docker run
+	readyAfter int   // failed solve-field --help probes before success; -1 never
+	indexes    string
+	probes     int
+}
+
+func (f *fakeDocker) run(ctx context.Context, name string, args []string, w io.Writer) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, args)
+	switch {
+	case args[0] == "image":
+		if f.missing {
+			return errors.New("exit status 1")
+		}
+	case args[0] == "pull":
+		fmt.Fprint(w, "latest: Pulling from diarmuidk/astrometry-dockerised-solver\n"+
+			"0123456789ab: Already exists\n"+
+			"123456789abc: Pulling fs layer\n"+
+			"123456789abc: Download complete\n"+
+			"123456789abc: Pull complete\n"+
+			"Digest: sha256:feed\n")
+		return f.pullErr
+	case args[0] == "run":
+		return f.runErr
+	case args[0] == "exec" && args[2] == "solve-field":
+		f.probes++
+		if f.readyAfter < 0 || f.probes <= f.readyAfter {
+			fmt.Fprint(w, "OCI runtime exec failed")
+			return errors.New("exit status 126")
+		}
+	case args[0] == "exec" && args[2] == "ls":
+		fmt.Fprint(w, f.indexes)
+	}
+	return nil
+}
+
+func (f *fakeDocker) ran(command string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.ContainsFunc(f.calls, func(args []string) bool { return args[0] == command })
+}
+
+func newExecTestClient(t *testing.T, f *fakeDocker) *Client {
+	t.Helper()
+	client, err := NewClient(&ClientConfig{
+		IndexPath:     t.TempDir(),
+		TempDir:       t.TempDir(),
+		UseDockerExec: true,
+		ContainerName: "solver",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	client.run = f.run
+	return client
+}
+
+func TestStartContainer(t *testing.T) {
+	f := &fakeDocker{missing: true, readyAfter: 1, indexes: "index-4107.fits\nindex-4108.fits\n"}
+	client := newExecTestClient(t, f)
+
+	var progress []PullProgress
+	err := client.StartContainer(context.Background(), &StartOptions{
+		Progress: func(p PullProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("StartContainer failed: %v", err)
+	}
+
+	if len(progress) != 6 {
+		t.Fatalf("got %d progress reports, want 6: %+v", len(progress), progress)
+	}
+	if last := progress[len(progress)-1]; last.LayersTotal != 2 || last.LayersDone != 2 {
+		t.Errorf("final progress = %d/%d layers, want 2/2", last.LayersDone, last.LayersTotal)
+	}
+	if progress[0].LayersTotal != 0 {
+		t.Errorf("tag line counted as a layer: %+v", progress[0])
+	}
+	if f.probes != 2 {
+		t.Errorf("ran %d readiness probes, want 2", f.probes)
+	}
+
+	var run []string
+	for _, args := range f.calls {
+		if args[0] == "run" {
+			run = args
+		}
+	}
+	cfg := client.Config()
+	for _, want := range []string{
+		"--name solver",
+		fmt.Sprintf("-v %s:%s:ro", cfg.IndexPath, DefaultIndexMountPath),
+		fmt.Sprintf("-v %s:%s", cfg.TempDir, cfg.TempDir),
+	} {
+		if !strings.Contains(strings.Join(run, " "), want) {
+			t.Errorf("docker run args %q missing %q", run, want)
+		}
+	}
+}
+
+func TestStartContainer_ImagePresent(t *testing.T) {
+	f := &fakeDocker{indexes: "index-4107.fits\n"}
+	client := newExecTestClient(t, f)
+
+	called := false
+	err := client.StartContainer(context.Background(), &StartOptions{
+		Progress: func(PullProgress) { called = true },
+	})
+	if err != nil {
+		t.Fatalf("StartContainer failed: %v", err)
+	}
+	if f.ran("pull") || called {
+		t.Error("pulled an image that was already present")
+	}
+}
+
+func TestStartContainer_Failures(t *testing.T) {
+	tests := []struct {
+		name    string
+		docker  *fakeDocker
+		want    error
+		removed bool
+	}{
+		{
+			name:   "pull",
+			docker: &fakeDocker{missing: true, pullErr: errors.New("exit status 1")},
+			want:   ErrImagePull,
+		},
+		{
+			name:   "start",
+			docker: &fakeDocker{runErr: errors.New("exit status 125")},
+			want:   ErrContainerStart,
+		},
+		{
+			name:    "readiness timeout",
+			docker:  &fakeDocker{readyAfter: -1},
+			want:    ErrContainerNotReady,
+			removed: true,
+		},
+		{
+			name:    "no indexes",
+			docker:  &fakeDocker{indexes: "README\n"},
+			want:    ErrContainerNotReady,
+			removed: true,
+		},
+	}
+
+	stages := []error{ErrImagePull, ErrContainerStart, ErrContainerNotReady}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newExecTestClient(t, tt.docker)
+			err := client.StartContainer(context.Background(), &StartOptions{ReadyTimeout: 50 * time.Millisecond})
+			if !errors.Is(err, tt.want) || !errors.Is(err, ErrDockerFailed) {
+				t.Fatalf("got %v, want %v wrapping ErrDockerFailed", err, tt.want)
+			}
+			for _, stage := range stages {
+				if stage != tt.want && errors.Is(err, stage) {
+					t.Errorf("error %v also matches %v", err, stage)
+				}
+			}
+			if removed := tt.docker.ran("rm"); removed != tt.removed {
+				t.Errorf("container removed = %v, want %v", removed, tt.removed)
+			}
+		})
+	}
+}
+
+func TestStartContainer_RequiresExecMode(t *testing.T) {
+	client := newTestClient(t, func(string, []string, io.Writer) error {
+		t.Error("docker should not run")
+		return nil
+	})
+	if err := client.StartContainer(context.Background(), nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("got %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestStopContainer(t *testing.T) {
+	f := &fakeDocker{}
+	client := newExecTestClient(t, f)
+	if err := client.StopContainer(context.Background()); err != nil {
+		t.Fatalf("StopContainer failed: %v", err)
+	}
+	if want := []string{"rm", "-f", "solver"}; !slices.Equal(f.calls[0], want) {
+		t.Errorf("ran %q, want %q", f.calls[0], want)
+	}
+}
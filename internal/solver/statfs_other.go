@@ -0,0 +1,16 @@
+//go:build !unix
+
+package solver
+
+import "math"
+
+// diskFree is not implemented on this platform; scratch directories are
+// assumed to have enough space.
+func diskFree(path string) (uint64, error) {
+	return math.MaxUint64, nil
+}
+
+// sameDevice is not implemented on this platform, so images are always copied.
+func sameDevice(a, b string) bool {
+	return false
+}
@@ -0,0 +1,68 @@
+package solver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// templatePlaceholder matches a {name} placeholder in ArgTemplate.
+var templatePlaceholder = regexp.MustCompile(`\{[a-z_]+\}`)
+
+// templatePlaceholders lists the ArgTemplate placeholders and how each is
+// filled from the working directory, image name and SolveOptions.
+var templatePlaceholders = map[string]func(c *Client, imageFilename, tempDir string, opts *SolveOptions) string{
+	"{image}": func(c *Client, imageFilename, tempDir string, _ *SolveOptions) string {
+		return c.containerPath(tempDir, imageFilename)
+	},
+	"{dir}": func(c *Client, _, tempDir string, _ *SolveOptions) string {
+		return c.containerPath(tempDir, "")
+	},
+	"{scale_low}":   func(_ *Client, _, _ string, o *SolveOptions) string { return fmt.Sprintf("%.6f", o.ScaleLow) },
+	"{scale_high}":  func(_ *Client, _, _ string, o *SolveOptions) string { return fmt.Sprintf("%.6f", o.ScaleHigh) },
+	"{scale_units}": func(_ *Client, _, _ string, o *SolveOptions) string { return string(o.ScaleUnits) },
+	"{downsample}":  func(_ *Client, _, _ string, o *SolveOptions) string { return strconv.Itoa(o.DownsampleFactor) },
+	"{depth_low}":   func(_ *Client, _, _ string, o *SolveOptions) string { return strconv.Itoa(o.DepthLow) },
+	"{depth_high}":  func(_ *Client, _, _ string, o *SolveOptions) string { return strconv.Itoa(o.DepthHigh) },
+	"{ra}":          func(_ *Client, _, _ string, o *SolveOptions) string { return fmt.Sprintf("%.6f", o.RA) },
+	"{dec}":         func(_ *Client, _, _ string, o *SolveOptions) string { return fmt.Sprintf("%.6f", o.Dec) },
+	"{radius}":      func(_ *Client, _, _ string, o *SolveOptions) string { return fmt.Sprintf("%.6f", o.Radius) },
+}
+
+// validateArgTemplate checks that template names the image and uses only
+// known placeholders. An empty template is valid and means "not set".
+func validateArgTemplate(template []string) error {
+	if len(template) == 0 {
+		return nil
+	}
+	hasImage := false
+	for _, arg := range template {
+		for _, p := range templatePlaceholder.FindAllString(arg, -1) {
+			if _, ok := templatePlaceholders[p]; !ok {
+				return fmt.Errorf("%w: ArgTemplate has unknown placeholder %s", ErrInvalidInput, p)
+			}
+			hasImage = hasImage || p == "{image}"
+		}
+	}
+	if !hasImage {
+		return fmt.Errorf("%w: ArgTemplate must contain {image}", ErrInvalidInput)
+	}
+	return nil
+}
+
+// templateSolveArgs builds the solve-field command line from
+// ClientConfig.ArgTemplate, substituting each placeholder.
+func (c *Client) templateSolveArgs(imageFilename, tempDir string, opts *SolveOptions) []string {
+	pairs := make([]string, 0, 2*len(templatePlaceholders))
+	for p, value := range templatePlaceholders {
+		pairs = append(pairs, p, value(c, imageFilename, tempDir, opts))
+	}
+	r := strings.NewReplacer(pairs...)
+
+	args := make([]string, len(c.config.ArgTemplate))
+	for i, arg := range c.config.ArgTemplate {
+		args[i] = r.Replace(arg)
+	}
+	return args
+}
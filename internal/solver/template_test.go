@@ -0,0 +1,89 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestTemplateSolveArgs(t *testing.T) {
+	tempDir := t.TempDir()
+	client, err := NewClient(&ClientConfig{
+		IndexPath: tempDir,
+		ArgTemplate: []string{
+			"solve-field", "-L", "{scale_low}", "-H", "{scale_high}", "-u", "{scale_units}",
+			"--downsample", "{downsample}", "--depth", "{depth_low}-{depth_high}",
+			"--ra", "{ra}", "--dec", "{dec}", "--radius", "{radius}",
+			"--cpulimit", "30", "--dir", "{dir}", "{image}",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	opts := &SolveOptions{
+		ScaleLow: 1, ScaleHigh: 3, ScaleUnits: "degwidth",
+		DownsampleFactor: 4, DepthLow: 10, DepthHigh: 40,
+		RA: 83.82, Dec: -5.39, Radius: 2,
+		ExtraArgs: []string{"--ignored"},
+	}
+	got := client.templateSolveArgs("m42.jpg", tempDir, opts)
+	want := []string{
+		"solve-field", "-L", "1.000000", "-H", "3.000000", "-u", "degwidth",
+		"--downsample", "4", "--depth", "10-40",
+		"--ra", "83.820000", "--dec", "-5.390000", "--radius", "2.000000",
+		"--cpulimit", "30", "--dir", "/data", "/data/m42.jpg",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("args = %q\nwant   %q", got, want)
+	}
+
+	client.config.UseDockerExec = true
+	if got := client.templateSolveArgs("m42.jpg", tempDir, opts); got[len(got)-1] != tempDir+"/m42.jpg" {
+		t.Errorf("exec mode image path = %q, want host path", got[len(got)-1])
+	}
+}
+
+func TestSolve_ArgTemplate(t *testing.T) {
+	var gotArgs []string
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		gotArgs = args
+		return nil
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) {
+		cfg.ArgTemplate = []string{"solve-field", "--cpulimit", "30", "{image}"}
+	}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	if _, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil); err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if i := slices.Index(gotArgs, "solve-field"); i == -1 || !slices.Equal(gotArgs[i:], []string{"solve-field", "--cpulimit", "30", "/data/stars.png"}) {
+		t.Errorf("docker args = %q, want the substituted template", gotArgs)
+	}
+}
+
+func TestValidateArgTemplate(t *testing.T) {
+	tests := []struct {
+		template []string
+		ok       bool
+	}{
+		{nil, true},
+		{[]string{"solve-field", "{image}"}, true},
+		{[]string{"solve-field", "--dir", "{dir}", "{dir}/{image}"}, true},
+		{[]string{"solve-field", "--dir", "{dir}"}, false},
+		{[]string{"solve-field", "-L", "{scale_lo}", "{image}"}, false},
+	}
+	for _, tt := range tests {
+		_, err := NewClient(&ClientConfig{IndexPath: t.TempDir(), ArgTemplate: tt.template})
+		if tt.ok && err != nil {
+			t.Errorf("ArgTemplate %q: unexpected error %v", tt.template, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("ArgTemplate %q: expected ErrInvalidInput, got %v", tt.template, err)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package solver
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"os"
+	"slices"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// ThresholdExtractor is a pure Go reference Extractor: it subtracts a
+// background estimated per cell, keeps connected groups of pixels above
+// Sigma times the local noise, and returns their flux-weighted centroids.
+// It has no deblending, so it is a baseline rather than a replacement for
+// source-extractor or SEP. Zero fields take the defaults shown.
+type ThresholdExtractor struct {
+	// Sigma is the detection threshold in units of the background noise.
+	// Default: 5
+	Sigma float64
+
+	// MinPixels and MaxPixels bound the size of a detection; smaller groups
+	// are noise and larger ones nebulosity or saturated blobs.
+	// Default: 3 and 1000
+	MinPixels int
+	MaxPixels int
+
+	// CellSize is the side in pixels of the cells the background and noise
+	// are estimated in. It should be several star widths but smaller than
+	// the scale of nebulosity.
+	// Default: 64
+	CellSize int
+
+	// MaxSources caps how many of the brightest sources are returned.
+	// Default: 1000
+	MaxSources int
+}
+
+var _ Extractor = (*ThresholdExtractor)(nil)
+
+// Name returns "threshold".
+func (e *ThresholdExtractor) Name() string { return "threshold" }
+
+// Extract detects stars in a FITS, JPEG, PNG or GIF image.
+func (e *ThresholdExtractor) Extract(ctx context.Context, imagePath string) ([]Source, error) {
+	plane, err := readPlane(imagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	sigma := orDefault(e.Sigma, 5)
+	minPixels, maxPixels := orDefault(e.MinPixels, 3), orDefault(e.MaxPixels, 1000)
+	cellSize, maxSources := orDefault(e.CellSize, 64), orDefault(e.MaxSources, 1000)
+
+	bg := estimateBackground(plane, cellSize)
+	above := func(x, y int) bool {
+		level, noise := bg.at(x, y)
+		return plane.at(x, y) > level+sigma*noise
+	}
+
+	visited := make([]bool, plane.width*plane.height)
+	var sources []Source
+	var stack [][2]int
+	for y := 0; y < plane.height; y++ {
+		if y%256 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		for x := 0; x < plane.width; x++ {
+			if visited[y*plane.width+x] || !above(x, y) {
+				continue
+			}
+
+			// Flood fill the 8-connected group above threshold
+			var pixels int
+			var flux, sumX, sumY, background float64
+			visited[y*plane.width+x] = true
+			stack = append(stack[:0], [2]int{x, y})
+			for len(stack) > 0 {
+				p := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				level, _ := bg.at(p[0], p[1])
+				v := plane.at(p[0], p[1]) - level
+				pixels++
+				flux += v
+				sumX += v * float64(p[0])
+				sumY += v * float64(p[1])
+				background += level
+
+				for dy := -1; dy <= 1; dy++ {
+					for dx := -1; dx <= 1; dx++ {
+						nx, ny := p[0]+dx, p[1]+dy
+						if nx < 0 || ny < 0 || nx >= plane.width || ny >= plane.height || visited[ny*plane.width+nx] {
+							continue
+						}
+						if above(nx, ny) {
+							visited[ny*plane.width+nx] = true
+							stack = append(stack, [2]int{nx, ny})
+						}
+					}
+				}
+			}
+
+			if pixels < minPixels || pixels > maxPixels || flux <= 0 {
+				continue
+			}
+			sources = append(sources, Source{
+				X:          sumX/flux + 1,
+				Y:          sumY/flux + 1,
+				Flux:       flux,
+				Background: background / float64(pixels),
+			})
+		}
+	}
+
+	slices.SortFunc(sources, func(a, b Source) int { return cmp.Compare(b.Flux, a.Flux) })
+	if len(sources) > maxSources {
+		sources = sources[:maxSources]
+	}
+	return sources, nil
+}
+
+// plane is a single-channel image read for source extraction, addressed
+// by 0-based column and row in file order.
+type plane struct {
+	width, height int
+	at            func(x, y int) float64
+}
+
+// readPlane reads a FITS image's primary plane, or the luminance of a
+// JPEG, PNG or GIF image.
+func readPlane(path string) (*plane, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open image: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	}()
+
+	if isFITS(path) {
+		img, err := fits.ReadImage(bufio.NewReader(file))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read FITS image: %v", ErrInvalidInput, err)
+		}
+		return &plane{width: img.Width, height: img.Height, at: img.At}, nil
+	}
+
+	img, _, err := image.Decode(bufio.NewReader(file))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unsupported image format: %v", ErrInvalidInput, err)
+	}
+	b := img.Bounds()
+	p := &plane{width: b.Dx(), height: b.Dy()}
+	if ycc, ok := img.(*image.YCbCr); ok {
+		// The JPEG luma plane is already grayscale
+		p.at = func(x, y int) float64 { return float64(ycc.Y[ycc.YOffset(b.Min.X+x, b.Min.Y+y)]) }
+		return p, nil
+	}
+	gray := image.NewGray(image.Rect(0, 0, p.width, p.height))
+	for y := 0; y < p.height; y++ {
+		for x := 0; x < p.width; x++ {
+			gray.SetGray(x, y, color.GrayModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.Gray))
+		}
+	}
+	p.at = func(x, y int) float64 { return float64(gray.Pix[y*gray.Stride+x]) }
+	return p, nil
+}
+
+// backgroundMesh holds the background level and noise of each cell.
+type backgroundMesh struct {
+	cellSize, cols, rows int
+	level, noise         []float64
+}
+
+// at returns the background level at pixel (x, y), interpolated bilinearly
+// between cell centers so gradients do not step at cell edges, and the
+// noise of the pixel's cell.
+func (m *backgroundMesh) at(x, y int) (level, noise float64) {
+	fx := math.Max(0, math.Min(float64(m.cols-1), (float64(x)+0.5)/float64(m.cellSize)-0.5))
+	fy := math.Max(0, math.Min(float64(m.rows-1), (float64(y)+0.5)/float64(m.cellSize)-0.5))
+	x0, y0 := int(fx), int(fy)
+	x1, y1 := min(x0+1, m.cols-1), min(y0+1, m.rows-1)
+	tx, ty := fx-float64(x0), fy-float64(y0)
+	top := m.level[y0*m.cols+x0]*(1-tx) + m.level[y0*m.cols+x1]*tx
+	bottom := m.level[y1*m.cols+x0]*(1-tx) + m.level[y1*m.cols+x1]*tx
+	return top*(1-ty) + bottom*ty, m.noise[(y/m.cellSize)*m.cols+x/m.cellSize]
+}
+
+// estimateBackground computes the median and the MAD-based noise of every
+// cellSize square cell, sampling every other pixel. Each cell's noise is
+// floored at half the median over cells, so flat cells of integer data do
+// not turn every count above the median into a detection.
+func estimateBackground(p *plane, cellSize int) *backgroundMesh {
+	cols := (p.width + cellSize - 1) / cellSize
+	rows := (p.height + cellSize - 1) / cellSize
+	m := &backgroundMesh{cellSize: cellSize, cols: cols, rows: rows, level: make([]float64, cols*rows), noise: make([]float64, cols*rows)}
+
+	samples := make([]float64, 0, cellSize*cellSize/4)
+	for cy := 0; cy < rows; cy++ {
+		for cx := 0; cx < cols; cx++ {
+			samples = samples[:0]
+			for y := cy * cellSize; y < min((cy+1)*cellSize, p.height); y += 2 {
+				for x := cx * cellSize; x < min((cx+1)*cellSize, p.width); x += 2 {
+					samples = append(samples, p.at(x, y))
+				}
+			}
+			level := median(samples)
+			for i, v := range samples {
+				samples[i] = math.Abs(v - level)
+			}
+			m.level[cy*cols+cx] = level
+			m.noise[cy*cols+cx] = 1.4826 * median(samples)
+		}
+	}
+
+	floor := median(m.noise) / 2
+	if floor == 0 {
+		floor = 1
+	}
+	for i, n := range m.noise {
+		m.noise[i] = max(n, floor)
+	}
+	return m
+}
+
+// orDefault returns v, or def when v is zero or negative.
+func orDefault[T int | float64](v, def T) T {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
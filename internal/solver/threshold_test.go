@@ -0,0 +1,172 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// thresholdStar is a synthetic star for the ThresholdExtractor tests.
+type thresholdStar struct {
+	x, y      float64 // 0-based pixel center, y from the top row
+	amplitude float64
+}
+
+// writeNebulousPNG draws Gaussian stars over a noisy background that brightens
+// from left to right, like a frame with nebulosity or gradient.
+func writeNebulousPNG(t *testing.T, width, height int, stars []thresholdStar) string {
+	t.Helper()
+	rng := rand.New(rand.NewPCG(1, 2))
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := 20 + 40*float64(x)/float64(width) + rng.NormFloat64()*2
+			for _, s := range stars {
+				dx, dy := float64(x)-s.x, float64(y)-s.y
+				v += s.amplitude * math.Exp(-(dx*dx+dy*dy)/(2*1.5*1.5))
+			}
+			img.SetGray(x, y, color.Gray{Y: uint8(math.Max(0, math.Min(255, math.Round(v))))})
+		}
+	}
+	path := filepath.Join(t.TempDir(), "stars.png")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestThresholdExtractor(t *testing.T) {
+	stars := []thresholdStar{
+		{x: 150, y: 40, amplitude: 180},
+		{x: 30.5, y: 100, amplitude: 130},
+		{x: 90, y: 20.5, amplitude: 90},
+		{x: 170, y: 170, amplitude: 60},
+	}
+	imagePath := writeNebulousPNG(t, 200, 200, stars)
+
+	sources, err := (&ThresholdExtractor{}).Extract(context.Background(), imagePath)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(sources) != len(stars) {
+		t.Fatalf("got %d sources, want %d: %+v", len(sources), len(stars), sources)
+	}
+	// Sources come brightest first, in 1-based coordinates from the top row
+	for i, s := range stars {
+		got := sources[i]
+		if math.Abs(got.X-(s.x+1)) > 0.25 || math.Abs(got.Y-(s.y+1)) > 0.25 {
+			t.Errorf("source %d at (%.2f, %.2f), want (%.2f, %.2f)", i, got.X, got.Y, s.x+1, s.y+1)
+		}
+	}
+}
+
+func TestThresholdExtractor_MaxSources(t *testing.T) {
+	imagePath := writeNebulousPNG(t, 100, 100, []thresholdStar{
+		{x: 20, y: 20, amplitude: 50},
+		{x: 70, y: 30, amplitude: 120},
+		{x: 40, y: 80, amplitude: 80},
+	})
+
+	sources, err := (&ThresholdExtractor{MaxSources: 2}).Extract(context.Background(), imagePath)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(sources) != 2 || math.Round(sources[0].X) != 71 || math.Round(sources[1].X) != 41 {
+		t.Errorf("sources = %+v, want the two brightest", sources)
+	}
+}
+
+func TestSolve_Extractor(t *testing.T) {
+	var gotArgs []string
+	var xyList *SourceList
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		gotArgs = args
+		file, err := os.Open(filepath.Join(workDir, "stars.xyls"))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		xyList, err = readSourceList(file)
+		return err
+	})
+	if err := client.UpdateConfig(func(cfg *ClientConfig) {
+		cfg.Extractor = &ThresholdExtractor{}
+	}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	imagePath := writeNebulousPNG(t, 120, 80, []thresholdStar{{x: 40, y: 30, amplitude: 120}})
+	result, err := client.Solve(context.Background(), imagePath, nil)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.Extractor != "threshold" {
+		t.Errorf("Extractor = %q, want threshold", result.Extractor)
+	}
+	if xyList == nil || len(xyList.Sources) != 1 || math.Abs(xyList.Sources[0].X-41) > 0.25 {
+		t.Errorf("xylist = %+v, want the one detected star", xyList)
+	}
+	joined := strings.Join(gotArgs, " ")
+	if !strings.Contains(joined, "--width 120 --height 80") || !strings.HasSuffix(joined, "/data/stars.xyls") {
+		t.Errorf("docker args = %q, want an xylist solve", gotArgs)
+	}
+}
+
+func TestSolve_ExtractorDefault(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		if slices.ContainsFunc(args, func(a string) bool { return strings.HasSuffix(a, ".xyls") }) {
+			t.Errorf("docker args = %q, want the image solved directly", args)
+		}
+		return nil
+	})
+
+	result, err := client.Solve(context.Background(), writeTestPNG(t, 8, 8), nil)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.Extractor != ExtractorSimplexy {
+		t.Errorf("Extractor = %q, want %q", result.Extractor, ExtractorSimplexy)
+	}
+}
+
+func TestSolve_ExtractorError(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		t.Error("solve-field should not run when extraction fails")
+		return nil
+	})
+	client.config.Extractor = &ThresholdExtractor{}
+
+	imagePath := filepath.Join(t.TempDir(), "stars.fits")
+	if err := os.WriteFile(imagePath, []byte("not a FITS file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Solve(context.Background(), imagePath, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("error = %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestNewClient_ExtractorWithArgTemplate(t *testing.T) {
+	_, err := NewClient(&ClientConfig{
+		IndexPath:   t.TempDir(),
+		Extractor:   &ThresholdExtractor{},
+		ArgTemplate: []string{"solve-field", "{image}"},
+	})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("error = %v, want ErrInvalidInput", err)
+	}
+}
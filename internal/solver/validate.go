@@ -0,0 +1,200 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// fieldSizeTolerance is the relative difference allowed between the reported
+// field size and the size implied by pixel scale and image dimensions.
+const fieldSizeTolerance = 0.05
+
+// Bounds on a plausible WCS pixel scale in arcsec/pixel, from large
+// telescopes to all-sky cameras.
+const (
+	minWCSPixelScale = 0.001
+	maxWCSPixelScale = 3600
+)
+
+// wcsProjections are the celestial projection codes ValidateWCS accepts,
+// from the FITS WCS standard (Calabretta & Greisen 2002), plus TPV.
+var wcsProjections = map[string]bool{
+	"TAN": true, "SIN": true, "ARC": true, "STG": true, "ZEA": true, "ZPN": true,
+	"AZP": true, "SZP": true, "AIR": true, "CAR": true, "CEA": true, "MER": true,
+	"SFL": true, "PAR": true, "MOL": true, "AIT": true, "TPV": true,
+}
+
+// Severity classifies a ValidationIssue.
+type Severity string
+
+const (
+	// SeverityWarning marks a suspicious but possibly valid result.
+	SeverityWarning Severity = "warning"
+
+	// SeverityError marks a result that should not be trusted.
+	SeverityError Severity = "error"
+)
+
+// ValidationIssue describes one failed sanity check.
+type ValidationIssue struct {
+	Severity Severity
+	Message  string
+}
+
+// ValidationOptions configures the sanity checks run by ValidateResult.
+// Zero values disable the corresponding check.
+type ValidationOptions struct {
+	// MinPixelScale and MaxPixelScale bound the plausible pixel scale in arcsec/pixel.
+	MinPixelScale float64
+	MaxPixelScale float64
+
+	// MinFieldDeg and MaxFieldDeg bound the plausible field width in degrees.
+	MinFieldDeg float64
+	MaxFieldDeg float64
+
+	// ExpectedRA and ExpectedDec are the hinted field center in degrees.
+	// ExpectedRadius is the maximum distance in degrees the solved center
+	// may lie from the hint.
+	ExpectedRA     float64
+	ExpectedDec    float64
+	ExpectedRadius float64
+}
+
+// ValidateResult runs sanity checks on a solved Result and returns any issues
+// found. astrometry.net occasionally produces plausible-looking but wrong
+// solutions; these checks catch the common symptoms. An empty slice means
+// every enabled check passed.
+func ValidateResult(r *Result, opts ValidationOptions) []ValidationIssue {
+	var issues []ValidationIssue
+	add := func(severity Severity, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Severity: severity, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if r == nil || !r.Solved {
+		add(SeverityError, "result is not solved")
+		return issues
+	}
+
+	// Pixel scale range
+	if opts.MinPixelScale > 0 && r.PixelScale < opts.MinPixelScale {
+		add(SeverityError, "pixel scale %.3f arcsec/px is below minimum %.3f", r.PixelScale, opts.MinPixelScale)
+	}
+	if opts.MaxPixelScale > 0 && r.PixelScale > opts.MaxPixelScale {
+		add(SeverityError, "pixel scale %.3f arcsec/px is above maximum %.3f", r.PixelScale, opts.MaxPixelScale)
+	}
+
+	// Field width range
+	if opts.MinFieldDeg > 0 && r.FieldWidth < opts.MinFieldDeg {
+		add(SeverityError, "field width %.3f° is below minimum %.3f°", r.FieldWidth, opts.MinFieldDeg)
+	}
+	if opts.MaxFieldDeg > 0 && r.FieldWidth > opts.MaxFieldDeg {
+		add(SeverityError, "field width %.3f° is above maximum %.3f°", r.FieldWidth, opts.MaxFieldDeg)
+	}
+
+	// Field size consistent with pixel scale and image dimensions
+	if r.PixelScale > 0 && r.ImageWidth > 0 && r.ImageHeight > 0 {
+		check := func(name string, got float64, pixels int) {
+			want := tangentExtent(pixels, r.PixelScale)
+			if math.Abs(got-want) > fieldSizeTolerance*want {
+				add(SeverityWarning, "field %s %.3f° inconsistent with %d px at %.3f arcsec/px (expected %.3f°)",
+					name, got, pixels, r.PixelScale, want)
+			}
+		}
+		check("width", r.FieldWidth, r.ImageWidth)
+		check("height", r.FieldHeight, r.ImageHeight)
+	}
+
+	// Solved center within the hinted search radius
+	if opts.ExpectedRadius > 0 {
+		sep := angularSeparation(opts.ExpectedRA, opts.ExpectedDec, r.RA, r.Dec)
+		if sep > opts.ExpectedRadius {
+			add(SeverityError, "solved center is %.3f° from expected position, outside radius %.3f°", sep, opts.ExpectedRadius)
+		}
+	}
+
+	return issues
+}
+
+// tangentExtent returns the angular extent in degrees of a span of pixels
+// centered on the tangent point of a TAN projection.
+func tangentExtent(pixels int, pixelScale float64) float64 {
+	half := float64(pixels) / 2 * pixelScale / 3600 * deg2rad
+	return 2 * math.Atan(half) * rad2deg
+}
+
+// ValidateWCS checks that the parsed WCS header is internally consistent
+// before its solution is trusted: an RA/Dec CTYPE pair using the same
+// known projection (TAN, SIN, ZEA, ..., with an optional -SIP suffix),
+// finite CRVAL and CRPIX values with Dec within ±90°, a non-singular CD
+// matrix (or CDELT) whose pixel scale is plausible, and a PixelScale that
+// agrees with it. It returns nil for a consistent header, and otherwise
+// every inconsistency found, each wrapping ErrWCSParseFailed.
+func (r *Result) ValidateWCS() error {
+	header := r.WCSHeader
+	if len(header) == 0 {
+		return fmt.Errorf("%w: result has no WCS header", ErrWCSParseFailed)
+	}
+
+	var errs []error
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]any{ErrWCSParseFailed}, args...)...))
+	}
+
+	ctype1, ctype2 := header["CTYPE1"], header["CTYPE2"]
+	proj1, ok1 := ctypeProjection(ctype1, "RA")
+	proj2, ok2 := ctypeProjection(ctype2, "DEC")
+	switch {
+	case !ok1 || !ok2:
+		add("CTYPE pair %q/%q is not RA/Dec with a known projection", ctype1, ctype2)
+	case proj1 != proj2:
+		add("CTYPE1 %q and CTYPE2 %q use different projections", ctype1, ctype2)
+	}
+
+	for _, key := range []string{"CRVAL1", "CRVAL2", "CRPIX1", "CRPIX2"} {
+		val, ok := header[key]
+		if !ok {
+			add("%s is missing", key)
+			continue
+		}
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			add("%s %q is not a finite number", key, val)
+			continue
+		}
+		if key == "CRVAL2" && math.Abs(v) > 90 {
+			add("CRVAL2 %g is outside ±90°", v)
+		}
+	}
+
+	cd11, cd12, cd21, cd22 := cdMatrix(header)
+	det := cd11*cd22 - cd12*cd21
+	switch scale := math.Sqrt(math.Abs(det)) * 3600; {
+	case math.IsNaN(det) || math.IsInf(det, 0):
+		add("CD matrix [%g %g; %g %g] is not finite", cd11, cd12, cd21, cd22)
+	case det == 0:
+		add("CD matrix [%g %g; %g %g] is singular", cd11, cd12, cd21, cd22)
+	case scale < minWCSPixelScale || scale > maxWCSPixelScale:
+		add("CD matrix pixel scale %g arcsec/px is outside %g-%g", scale, float64(minWCSPixelScale), float64(maxWCSPixelScale))
+	case r.PixelScale <= 0:
+		add("PixelScale %g is not positive", r.PixelScale)
+	case math.Abs(r.PixelScale-scale) > 0.01*scale:
+		add("PixelScale %.4f arcsec/px disagrees with the CD matrix's %.4f", r.PixelScale, scale)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ctypeProjection returns the projection code of a CTYPE value for the
+// given axis, such as TAN for "RA---TAN" or "RA---TAN-SIP", and whether
+// the value names that axis with a known projection.
+func ctypeProjection(ctype, axis string) (string, bool) {
+	ctype = strings.TrimSuffix(strings.TrimSpace(ctype), "-SIP")
+	if len(ctype) != 8 || !strings.HasPrefix(ctype, axis) || strings.Trim(ctype[len(axis):5], "-") != "" {
+		return "", false
+	}
+	projection := ctype[5:]
+	return projection, wcsProjections[projection]
+}
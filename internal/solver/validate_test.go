@@ -0,0 +1,201 @@
+package solver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// validResult returns a self-consistent solved result: 6000x4000 px at
+// 4 arcsec/px centered on M42.
+func validResult() *Result {
+	return &Result{
+		Solved:      true,
+		RA:          83.82,
+		Dec:         -5.39,
+		PixelScale:  4.0,
+		FieldWidth:  tangentExtent(6000, 4.0),
+		FieldHeight: tangentExtent(4000, 4.0),
+		ImageWidth:  6000,
+		ImageHeight: 4000,
+	}
+}
+
+func TestValidateResult(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(r *Result)
+		opts         ValidationOptions
+		wantSeverity Severity
+		wantMessage  string
+	}{
+		{
+			name:         "pixel scale below minimum",
+			opts:         ValidationOptions{MinPixelScale: 5, MaxPixelScale: 10},
+			wantSeverity: SeverityError,
+			wantMessage:  "below minimum",
+		},
+		{
+			name:         "pixel scale above maximum",
+			opts:         ValidationOptions{MinPixelScale: 1, MaxPixelScale: 2},
+			wantSeverity: SeverityError,
+			wantMessage:  "above maximum",
+		},
+		{
+			name:         "field width below minimum",
+			opts:         ValidationOptions{MinFieldDeg: 10},
+			wantSeverity: SeverityError,
+			wantMessage:  "field width",
+		},
+		{
+			name:         "field width above maximum",
+			opts:         ValidationOptions{MaxFieldDeg: 2},
+			wantSeverity: SeverityError,
+			wantMessage:  "field width",
+		},
+		{
+			name:         "field size inconsistent with pixel scale",
+			mutate:       func(r *Result) { r.FieldHeight *= 1.5 },
+			wantSeverity: SeverityWarning,
+			wantMessage:  "field height",
+		},
+		{
+			name:         "center outside expected radius",
+			opts:         ValidationOptions{ExpectedRA: 90, ExpectedDec: 10, ExpectedRadius: 5},
+			wantSeverity: SeverityError,
+			wantMessage:  "outside radius",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validResult()
+			if tt.mutate != nil {
+				tt.mutate(r)
+			}
+
+			issues := ValidateResult(r, tt.opts)
+			if len(issues) != 1 {
+				t.Fatalf("got %d issues %v, want exactly 1", len(issues), issues)
+			}
+			if issues[0].Severity != tt.wantSeverity {
+				t.Errorf("Severity = %s, want %s", issues[0].Severity, tt.wantSeverity)
+			}
+			if !strings.Contains(issues[0].Message, tt.wantMessage) {
+				t.Errorf("Message %q does not contain %q", issues[0].Message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestValidateResult_Valid(t *testing.T) {
+	opts := ValidationOptions{
+		MinPixelScale:  3,
+		MaxPixelScale:  5,
+		MinFieldDeg:    5,
+		MaxFieldDeg:    8,
+		ExpectedRA:     83.5,
+		ExpectedDec:    -5.0,
+		ExpectedRadius: 2,
+	}
+	if issues := ValidateResult(validResult(), opts); len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+}
+
+func TestValidateResult_Unsolved(t *testing.T) {
+	issues := ValidateResult(&Result{Solved: false}, ValidationOptions{})
+	if len(issues) != 1 || issues[0].Severity != SeverityError {
+		t.Errorf("expected a single error for unsolved result, got %v", issues)
+	}
+}
+
+func TestResultValidateWCS(t *testing.T) {
+	header := func() map[string]string {
+		return map[string]string{
+			"CTYPE1": "RA---TAN-SIP", "CTYPE2": "DEC--TAN-SIP",
+			"CRVAL1": "83.82", "CRVAL2": "-5.39", "CRPIX1": "3000.5", "CRPIX2": "2000.5",
+			"CD1_1": "-1.1111111111E-03", "CD1_2": "0", "CD2_1": "0", "CD2_2": "1.1111111111E-03",
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(h map[string]string)
+		scale  float64
+		want   []string
+	}{
+		{name: "valid", scale: 4},
+		{name: "sin projection", mutate: func(h map[string]string) { h["CTYPE1"], h["CTYPE2"] = "RA---SIN", "DEC--SIN" }, scale: 4},
+		{
+			name: "singular CD matrix",
+			mutate: func(h map[string]string) {
+				h["CD1_1"], h["CD1_2"], h["CD2_1"], h["CD2_2"] = "1E-3", "2E-3", "2E-3", "4E-3"
+			},
+			scale: 4,
+			want:  []string{"singular"},
+		},
+		{
+			name:   "unknown CTYPE",
+			mutate: func(h map[string]string) { h["CTYPE1"], h["CTYPE2"] = "RA---XYZ", "DEC--XYZ" },
+			scale:  4,
+			want:   []string{`"RA---XYZ"/"DEC--XYZ" is not RA/Dec with a known projection`},
+		},
+		{
+			name:   "galactic axes",
+			mutate: func(h map[string]string) { h["CTYPE1"], h["CTYPE2"] = "GLON-TAN", "GLAT-TAN" },
+			scale:  4,
+			want:   []string{"not RA/Dec"},
+		},
+		{
+			name:   "mixed projections",
+			mutate: func(h map[string]string) { h["CTYPE2"] = "DEC--SIN" },
+			scale:  4,
+			want:   []string{"different projections"},
+		},
+		{
+			name: "partial header",
+			mutate: func(h map[string]string) {
+				delete(h, "CRPIX2")
+				h["CRVAL2"] = "95"
+			},
+			scale: 4,
+			want:  []string{"CRPIX2 is missing", "CRVAL2 95 is outside"},
+		},
+		{
+			name:   "implausible scale",
+			mutate: func(h map[string]string) { h["CD1_1"], h["CD2_2"] = "-2", "2" },
+			scale:  7200,
+			want:   []string{"outside 0.001-3600"},
+		},
+		{name: "non-positive PixelScale", scale: 0, want: []string{"PixelScale 0 is not positive"}},
+		{name: "PixelScale disagrees", scale: 5, want: []string{"disagrees with the CD matrix's 4.0000"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := header()
+			if tt.mutate != nil {
+				tt.mutate(h)
+			}
+			r := &Result{Solved: true, PixelScale: tt.scale, WCSHeader: h}
+			err := r.ValidateWCS()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Errorf("ValidateWCS() = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrWCSParseFailed) {
+				t.Fatalf("ValidateWCS() = %v, want ErrWCSParseFailed", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("ValidateWCS() = %v, want it to mention %q", err, want)
+				}
+			}
+		})
+	}
+
+	if err := (&Result{Solved: true, PixelScale: 4}).ValidateWCS(); !errors.Is(err, ErrWCSParseFailed) {
+		t.Errorf("no header: err = %v, want ErrWCSParseFailed", err)
+	}
+}
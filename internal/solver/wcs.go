@@ -0,0 +1,221 @@
+package solver
+
+import "math"
+
+const (
+	deg2rad = math.Pi / 180.0
+	rad2deg = 180.0 / math.Pi
+)
+
+// Projection codes from the CTYPE keywords that skyWCS implements.
+const (
+	projectionTAN = "TAN" // gnomonic, as solve-field writes
+	projectionSIN = "SIN" // orthographic, common in radio data
+	projectionARC = "ARC" // zenithal equidistant, for wide fields
+)
+
+// skyWCS is a zenithal world coordinate system defined by a reference
+// point, reference pixel, CD matrix and projection.
+type skyWCS struct {
+	crval1, crval2 float64 // Reference point RA/Dec (degrees)
+	crpix1, crpix2 float64 // Reference pixel
+	cd11, cd12     float64 // CD matrix (degrees/pixel)
+	cd21, cd22     float64
+	sip            *sipDistortion // Optional forward SIP distortion
+	projection     string         // TAN, SIN or ARC; "" is TAN
+}
+
+// sipDistortion holds Simple Imaging Polynomial coefficients, keyed by the
+// powers (p, q) of the pixel offsets u and v: A_p_q and B_p_q.
+type sipDistortion struct {
+	a, b map[[2]int]float64
+}
+
+// apply returns the distortion-corrected pixel offsets u + f(u, v), v + g(u, v).
+func (s *sipDistortion) apply(u, v float64) (float64, float64) {
+	eval := func(coeffs map[[2]int]float64) float64 {
+		sum := 0.0
+		for pq, c := range coeffs {
+			sum += c * math.Pow(u, float64(pq[0])) * math.Pow(v, float64(pq[1]))
+		}
+		return sum
+	}
+	return u + eval(s.a), v + eval(s.b)
+}
+
+// invert returns the pixel offsets u, v that apply maps to (du, dv), by
+// fixed-point iteration; SIP terms are small corrections, so it converges
+// quickly.
+func (s *sipDistortion) invert(du, dv float64) (float64, float64) {
+	u, v := du, dv
+	for i := 0; i < 50; i++ {
+		fu, fv := s.apply(u, v)
+		nu, nv := u-(fu-du), v-(fv-dv)
+		if math.Abs(nu-u) < 1e-10 && math.Abs(nv-v) < 1e-10 {
+			return nu, nv
+		}
+		u, v = nu, nv
+	}
+	return u, v
+}
+
+// pixelToSky converts pixel coordinates to RA/Dec in degrees.
+//
+// The pixel offset from CRPIX is mapped through the CD matrix to
+// intermediate world coordinates (x, y) in the projection plane, which
+// are then de-projected onto the sphere around CRVAL (FITS WCS paper II,
+// Calabretta & Greisen 2002). Unlike adding the offsets to CRVAL directly,
+// this is correct at high declination and for wide fields.
+func (w skyWCS) pixelToSky(x, y float64) (ra, dec float64) {
+	dx := x - w.crpix1
+	dy := y - w.crpix2
+	if w.sip != nil {
+		dx, dy = w.sip.apply(dx, dy)
+	}
+	ix := (w.cd11*dx + w.cd12*dy) * deg2rad
+	iy := (w.cd21*dx + w.cd22*dy) * deg2rad
+
+	// Native spherical coordinates: azimuth phi and elevation theta above
+	// the projection plane, which touches the sphere at CRVAL
+	r := math.Hypot(ix, iy)
+	phi := math.Atan2(ix, -iy)
+	var theta float64
+	switch w.projection {
+	case projectionSIN:
+		theta = math.Acos(math.Min(r, 1))
+	case projectionARC:
+		theta = math.Pi/2 - r
+	default:
+		theta = math.Atan2(1, r)
+	}
+
+	// Rotate to celestial coordinates with the native pole at LONPOLE 180°
+	ra0, dec0 := w.crval1*deg2rad, w.crval2*deg2rad
+	raRad := ra0 + math.Atan2(math.Cos(theta)*math.Sin(phi),
+		math.Sin(theta)*math.Cos(dec0)+math.Cos(theta)*math.Sin(dec0)*math.Cos(phi))
+	decRad := math.Asin(math.Max(-1, math.Min(1,
+		math.Sin(theta)*math.Sin(dec0)-math.Cos(theta)*math.Cos(dec0)*math.Cos(phi))))
+
+	return normalizeRA(raRad * rad2deg), decRad * rad2deg
+}
+
+// onSky reports whether pixel x, y maps to the sky: the projection plane
+// of SIN ends at radius 1 (90° from CRVAL) and that of ARC at π (the point
+// opposite CRVAL), while TAN covers the whole plane.
+func (w skyWCS) onSky(x, y float64) bool {
+	dx := x - w.crpix1
+	dy := y - w.crpix2
+	if w.sip != nil {
+		dx, dy = w.sip.apply(dx, dy)
+	}
+	r := math.Hypot(w.cd11*dx+w.cd12*dy, w.cd21*dx+w.cd22*dy) * deg2rad
+	switch w.projection {
+	case projectionSIN:
+		return r <= 1
+	case projectionARC:
+		return r <= math.Pi
+	}
+	return true
+}
+
+// skyToPixel converts RA/Dec in degrees to pixel coordinates, inverting
+// pixelToSky. ok is false for positions the projection cannot show: the
+// hemisphere behind CRVAL for TAN and SIN, or the point opposite it for
+// ARC.
+func (w skyWCS) skyToPixel(ra, dec float64) (x, y float64, ok bool) {
+	ra0, dec0 := w.crval1*deg2rad, w.crval2*deg2rad
+	dra := ra*deg2rad - ra0
+	decRad := dec * deg2rad
+
+	// Native coordinates of the position relative to CRVAL
+	sinTheta := math.Sin(decRad)*math.Sin(dec0) + math.Cos(decRad)*math.Cos(dec0)*math.Cos(dra)
+	theta := math.Asin(math.Max(-1, math.Min(1, sinTheta)))
+	phi := math.Atan2(math.Cos(decRad)*math.Sin(dra),
+		-(math.Sin(decRad)*math.Cos(dec0) - math.Cos(decRad)*math.Sin(dec0)*math.Cos(dra)))
+
+	var r float64
+	switch w.projection {
+	case projectionSIN:
+		if theta < 0 {
+			return 0, 0, false
+		}
+		r = math.Cos(theta)
+	case projectionARC:
+		if theta <= -math.Pi/2 {
+			return 0, 0, false
+		}
+		r = math.Pi/2 - theta
+	default:
+		if theta <= 0 {
+			return 0, 0, false
+		}
+		r = math.Cos(theta) / math.Sin(theta)
+	}
+	ix, iy := r*math.Sin(phi)*rad2deg, -r*math.Cos(phi)*rad2deg
+
+	// inverse(CD) = 1/det * [[cd22, -cd12], [-cd21, cd11]]
+	det := w.cd11*w.cd22 - w.cd12*w.cd21
+	if det == 0 {
+		return 0, 0, false
+	}
+	dx := (w.cd22*ix - w.cd12*iy) / det
+	dy := (-w.cd21*ix + w.cd11*iy) / det
+	if w.sip != nil {
+		dx, dy = w.sip.invert(dx, dy)
+	}
+	return dx + w.crpix1, dy + w.crpix2, true
+}
+
+// separation returns the great-circle distance in degrees between two pixels.
+func (w skyWCS) separation(x1, y1, x2, y2 float64) float64 {
+	ra1, dec1 := w.pixelToSky(x1, y1)
+	ra2, dec2 := w.pixelToSky(x2, y2)
+	return angularSeparation(ra1, dec1, ra2, dec2)
+}
+
+// orientation returns the parity of the CD matrix and the on-image
+// directions of north and east in degrees, counter-clockwise from +x.
+//
+// North (0, 1) and east (1, 0) in intermediate world coordinates are mapped
+// back to pixel offsets through the inverse CD matrix, so both parities are
+// handled without special cases.
+func (w skyWCS) orientation() (parity Parity, north, east float64) {
+	det := w.cd11*w.cd22 - w.cd12*w.cd21
+	if det == 0 {
+		return ParityUnknown, 0, 0
+	}
+	parity = ParityPositive
+	if det > 0 {
+		parity = ParityNegative
+	}
+
+	// inverse(CD) = 1/det * [[cd22, -cd12], [-cd21, cd11]]
+	north = normalizeRA(math.Atan2(w.cd11/det, -w.cd12/det) * rad2deg)
+	east = normalizeRA(math.Atan2(-w.cd21/det, w.cd22/det) * rad2deg)
+	return parity, north, east
+}
+
+// angularSeparation returns the great-circle distance in degrees between two
+// sky positions given in degrees, using the Vincenty formula which is stable
+// at all separations.
+func angularSeparation(ra1, dec1, ra2, dec2 float64) float64 {
+	dRA := (ra2 - ra1) * deg2rad
+	phi1 := dec1 * deg2rad
+	phi2 := dec2 * deg2rad
+
+	num := math.Hypot(
+		math.Cos(phi2)*math.Sin(dRA),
+		math.Cos(phi1)*math.Sin(phi2)-math.Sin(phi1)*math.Cos(phi2)*math.Cos(dRA),
+	)
+	den := math.Sin(phi1)*math.Sin(phi2) + math.Cos(phi1)*math.Cos(phi2)*math.Cos(dRA)
+	return math.Atan2(num, den) * rad2deg
+}
+
+// normalizeRA wraps an RA value into the range [0, 360).
+func normalizeRA(ra float64) float64 {
+	ra = math.Mod(ra, 360)
+	if ra < 0 {
+		ra += 360
+	}
+	return ra
+}
@@ -0,0 +1,520 @@
+package solver
+
+import (
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// referenceDeproject computes the gnomonic inverse with 3D vectors: the
+// tangent-plane point CRVAL + xi*east + eta*north is projected back onto the
+// unit sphere. It is independent of the spherical-trig formulas in pixelToSky.
+func referenceDeproject(ra0, dec0, xiDeg, etaDeg float64) (ra, dec float64) {
+	a, d := ra0*deg2rad, dec0*deg2rad
+	xi, eta := xiDeg*deg2rad, etaDeg*deg2rad
+
+	p := [3]float64{math.Cos(d) * math.Cos(a), math.Cos(d) * math.Sin(a), math.Sin(d)}
+	east := [3]float64{-math.Sin(a), math.Cos(a), 0}
+	north := [3]float64{-math.Sin(d) * math.Cos(a), -math.Sin(d) * math.Sin(a), math.Cos(d)}
+
+	var v [3]float64
+	for i := range v {
+		v[i] = p[i] + xi*east[i] + eta*north[i]
+	}
+	norm := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+
+	ra = math.Atan2(v[1], v[0]) * rad2deg
+	if ra < 0 {
+		ra += 360
+	}
+	return ra, math.Asin(v[2]/norm) * rad2deg
+}
+
+// writeWCSFile writes header cards as a FITS WCS file and returns its path.
+func writeWCSFile(t *testing.T, cards []fits.Card) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.wcs")
+	if err := os.WriteFile(path, fits.EncodeHeader(cards), 0644); err != nil {
+		t.Fatalf("failed to write WCS file: %v", err)
+	}
+	return path
+}
+
+// parseWCSCards parses cards both from a .wcs file with ParseWCSFile and
+// as a map with ResultFromWCSHeader, failing unless the two agree.
+func parseWCSCards(t *testing.T, cards []fits.Card) *Result {
+	t.Helper()
+	fromFile, err := ParseWCSFile(writeWCSFile(t, cards))
+	if err != nil {
+		t.Fatalf("ParseWCSFile failed: %v", err)
+	}
+
+	header := make(map[string]string, len(cards))
+	for _, c := range cards {
+		header[c.Key] = strings.TrimSpace(strings.Trim(c.Value, "'"))
+	}
+	fromMap, err := ResultFromWCSHeader(header, 0, 0)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	if !reflect.DeepEqual(fromFile, fromMap) {
+		t.Fatalf("ParseWCSFile and ResultFromWCSHeader disagree:\n%+v\n%+v", fromFile, fromMap)
+	}
+	return fromMap
+}
+
+func TestPixelToSky_MatchesReference(t *testing.T) {
+	// 4 arcsec/pixel, 20° rotation, reference pixel half a 6000x4000 frame away
+	scale := 4.0 / 3600.0
+	rot := 20 * deg2rad
+	cd11, cd12 := -scale*math.Cos(rot), scale*math.Sin(rot)
+	cd21, cd22 := scale*math.Sin(rot), scale*math.Cos(rot)
+
+	for _, dec0 := range []float64{0, 60, 85} {
+		w := skyWCS{crval1: 120, crval2: dec0, crpix1: 1, crpix2: 1,
+			cd11: cd11, cd12: cd12, cd21: cd21, cd22: cd22}
+
+		x, y := 3000.0, 2000.0
+		dx, dy := x-w.crpix1, y-w.crpix2
+		wantRA, wantDec := referenceDeproject(w.crval1, w.crval2, cd11*dx+cd12*dy, cd21*dx+cd22*dy)
+
+		ra, dec := w.pixelToSky(x, y)
+		if sep := angularSeparation(ra, dec, wantRA, wantDec) * 3600; sep > 1e-6 {
+			t.Errorf("Dec %.0f°: pixelToSky = (%.8f, %.8f), reference (%.8f, %.8f), off by %.2e arcsec",
+				dec0, ra, dec, wantRA, wantDec, sep)
+		}
+	}
+}
+
+func TestParseWCSFile_HighDeclinationCenter(t *testing.T) {
+	// At Dec 70° a flat CRVAL + CD offset misplaces the center by arcminutes
+	scale := 4.0 / 3600.0
+	result := parseWCSCards(t, []fits.Card{
+		{Key: "CRVAL1", Value: "200.0"},
+		{Key: "CRVAL2", Value: "70.0"},
+		{Key: "CRPIX1", Value: "1.0"},
+		{Key: "CRPIX2", Value: "1.0"},
+		{Key: "CD1_1", Value: formatFloat(-scale)},
+		{Key: "CD1_2", Value: "0.0"},
+		{Key: "CD2_1", Value: "0.0"},
+		{Key: "CD2_2", Value: formatFloat(scale)},
+		{Key: "IMAGEW", Value: "6000"},
+		{Key: "IMAGEH", Value: "4000"},
+	})
+
+	wantRA, wantDec := referenceDeproject(200, 70, -scale*2999, scale*1999)
+	if sep := angularSeparation(result.RA, result.Dec, wantRA, wantDec) * 3600; sep > 0.001 {
+		t.Errorf("center (%.6f, %.6f) is %.4f arcsec from reference (%.6f, %.6f)",
+			result.RA, result.Dec, sep, wantRA, wantDec)
+	}
+
+	// Field width is the great-circle distance between the edge midpoints
+	leftRA, leftDec := referenceDeproject(200, 70, -scale*(0-1), scale*1999)
+	rightRA, rightDec := referenceDeproject(200, 70, -scale*(6000-1), scale*1999)
+	if want := angularSeparation(leftRA, leftDec, rightRA, rightDec); math.Abs(result.FieldWidth-want) > 1e-9 {
+		t.Errorf("FieldWidth = %.9f, want %.9f", result.FieldWidth, want)
+	}
+
+	// The flat approximation is far off here
+	flatRA, flatDec := 200-scale*2999, 70+scale*1999
+	if sep := angularSeparation(flatRA, flatDec, wantRA, wantDec) * 60; sep < 1 {
+		t.Errorf("expected flat approximation to be off by arcminutes, got %.3f arcmin", sep)
+	}
+}
+
+func TestParseWCSFile_LowDeclinationUnchanged(t *testing.T) {
+	// Near the equator with the reference pixel near the center of a narrow
+	// field, the projection must agree with the previous flat calculation.
+	cd11, cd12, cd21, cd22 := -0.0010995, 0.00046, -0.00045, -0.0011
+	result := parseWCSCards(t, []fits.Card{
+		{Key: "CRVAL1", Value: "83.423"},
+		{Key: "CRVAL2", Value: "0.1"},
+		{Key: "CRPIX1", Value: "297.5"},
+		{Key: "CRPIX2", Value: "201.25"},
+		{Key: "CD1_1", Value: formatFloat(cd11)},
+		{Key: "CD1_2", Value: formatFloat(cd12)},
+		{Key: "CD2_1", Value: formatFloat(cd21)},
+		{Key: "CD2_2", Value: formatFloat(cd22)},
+		{Key: "IMAGEW", Value: "600"},
+		{Key: "IMAGEH", Value: "400"},
+	})
+
+	dx, dy := 300-297.5, 200-201.25
+	oldRA := 83.423 + cd11*dx + cd12*dy
+	oldDec := 0.1 + cd21*dx + cd22*dy
+	if math.Abs(result.RA-oldRA)*3600 > 0.001 || math.Abs(result.Dec-oldDec)*3600 > 0.001 {
+		t.Errorf("center (%.9f, %.9f) differs from flat result (%.9f, %.9f) by more than 1 mas",
+			result.RA, result.Dec, oldRA, oldDec)
+	}
+
+	// Field size agrees with pixel scale × pixels for a narrow field
+	oldWidth := 600 * math.Sqrt(cd11*cd11+cd21*cd21)
+	if math.Abs(result.FieldWidth-oldWidth)/oldWidth > 1e-4 {
+		t.Errorf("FieldWidth = %.6f, want ≈ %.6f", result.FieldWidth, oldWidth)
+	}
+}
+
+func TestAngularSeparation(t *testing.T) {
+	tests := []struct {
+		name                 string
+		ra1, dec1, ra2, dec2 float64
+		want                 float64
+	}{
+		{"same point", 10, 20, 10, 20, 0},
+		{"along equator", 0, 0, 90, 0, 90},
+		{"pole to equator", 0, 90, 123, 0, 90},
+		{"RA wrap", 359.5, 0, 0.5, 0, 1},
+		{"RA shrinks with declination", 0, 60, 2, 60, 0.99996},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := angularSeparation(tt.ra1, tt.dec1, tt.ra2, tt.dec2)
+			if math.Abs(got-tt.want) > 1e-4 {
+				t.Errorf("angularSeparation = %.6f, want %.6f", got, tt.want)
+			}
+		})
+	}
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'E', 15, 64)
+}
+
+func TestOrientation(t *testing.T) {
+	s := 1.0 / 3600
+
+	tests := []struct {
+		name       string
+		cd         [4]float64 // CD1_1, CD1_2, CD2_1, CD2_2
+		wantParity Parity
+		wantNorth  float64
+		wantEast   float64
+	}{
+		// Normal sky, north up (+y), east left (-x)
+		{"positive north up", [4]float64{-s, 0, 0, s}, ParityPositive, 90, 180},
+		// Mirrored, north up, east right
+		{"negative north up", [4]float64{s, 0, 0, s}, ParityNegative, 90, 0},
+		// Normal sky rotated so north points along +x, east along +y
+		{"positive north right", [4]float64{0, s, s, 0}, ParityPositive, 0, 90},
+		// Mirrored with north along +x, east along -y
+		{"negative north right", [4]float64{0, -s, s, 0}, ParityNegative, 0, 270},
+		// Normal sky upside down: north down, east right
+		{"positive north down", [4]float64{s, 0, 0, -s}, ParityPositive, 270, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := skyWCS{cd11: tt.cd[0], cd12: tt.cd[1], cd21: tt.cd[2], cd22: tt.cd[3]}
+			parity, north, east := w.orientation()
+			if parity != tt.wantParity {
+				t.Errorf("parity = %s, want %s", parity, tt.wantParity)
+			}
+			if math.Abs(north-tt.wantNorth) > 1e-9 {
+				t.Errorf("north = %.6f, want %.6f", north, tt.wantNorth)
+			}
+			if math.Abs(east-tt.wantEast) > 1e-9 {
+				t.Errorf("east = %.6f, want %.6f", east, tt.wantEast)
+			}
+		})
+	}
+}
+
+func TestOrientation_Rotated(t *testing.T) {
+	// Rotating a north-up frame by theta counter-clockwise on the image
+	// rotates both arrows by theta; parity decides which side east is on.
+	s := 1.0 / 3600
+	for _, theta := range []float64{15, 60, 135, 200, 330} {
+		r := theta * deg2rad
+		c, sn := math.Cos(r), math.Sin(r)
+
+		// North-up frame rotated by theta; flip = -1 mirrors xi for normal parity
+		for _, flip := range []float64{-1, 1} {
+			cd11, cd12 := flip*s*c, flip*s*sn
+			cd21, cd22 := -s*sn, s*c
+			w := skyWCS{cd11: cd11, cd12: cd12, cd21: cd21, cd22: cd22}
+
+			parity, north, east := w.orientation()
+			wantNorth := normalizeRA(90 + theta)
+			wantEast := normalizeRA(wantNorth + 90)
+			wantParity := ParityPositive
+			if flip > 0 {
+				wantEast = normalizeRA(wantNorth - 90)
+				wantParity = ParityNegative
+			}
+
+			if parity != wantParity {
+				t.Errorf("theta %.0f flip %.0f: parity = %s, want %s", theta, flip, parity, wantParity)
+			}
+			if math.Abs(angleDiff(north, wantNorth)) > 1e-9 {
+				t.Errorf("theta %.0f flip %.0f: north = %.6f, want %.6f", theta, flip, north, wantNorth)
+			}
+			if math.Abs(angleDiff(east, wantEast)) > 1e-9 {
+				t.Errorf("theta %.0f flip %.0f: east = %.6f, want %.6f", theta, flip, east, wantEast)
+			}
+		}
+	}
+}
+
+// angleDiff returns a-b wrapped into (-180, 180].
+func angleDiff(a, b float64) float64 {
+	d := math.Mod(a-b, 360)
+	if d > 180 {
+		d -= 360
+	}
+	if d <= -180 {
+		d += 360
+	}
+	return d
+}
+
+func TestParseWCSFile_Parity(t *testing.T) {
+	result := parseWCSCards(t, []fits.Card{
+		{Key: "CRVAL1", Value: "10.0"},
+		{Key: "CRVAL2", Value: "20.0"},
+		{Key: "CRPIX1", Value: "500"},
+		{Key: "CRPIX2", Value: "500"},
+		{Key: "CD1_1", Value: formatFloat(1.0 / 3600)},
+		{Key: "CD1_2", Value: "0.0"},
+		{Key: "CD2_1", Value: "0.0"},
+		{Key: "CD2_2", Value: formatFloat(1.0 / 3600)},
+		{Key: "IMAGEW", Value: "1000"},
+		{Key: "IMAGEH", Value: "1000"},
+	})
+	if result.Parity != ParityNegative {
+		t.Errorf("Parity = %s, want negative", result.Parity)
+	}
+	if result.NorthAngle != 90 || result.EastAngle != 0 {
+		t.Errorf("NorthAngle, EastAngle = %.3f, %.3f, want 90, 0", result.NorthAngle, result.EastAngle)
+	}
+}
+
+func TestAccurateFieldSize(t *testing.T) {
+	tests := []struct {
+		name       string
+		pixelScale float64 // arcsec/pixel
+		width      int
+		height     int
+		wantDiffer bool // whether pixels × scale is noticeably wrong
+	}{
+		{name: "narrow field", pixelScale: 1.0, width: 3000, height: 2000, wantDiffer: false},
+		{name: "wide field", pixelScale: 40.0, width: 6000, height: 4000, wantDiffer: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &Result{
+				Solved:      true,
+				RA:          150,
+				Dec:         30,
+				PixelScale:  tt.pixelScale,
+				ImageWidth:  tt.width,
+				ImageHeight: tt.height,
+			}
+			width, height, ok := result.AccurateFieldSize()
+			if !ok {
+				t.Fatal("AccurateFieldSize returned !ok")
+			}
+
+			// A TAN projection centered on the image spans 2·atan(half-width)
+			wantW := tangentExtent(tt.width, tt.pixelScale)
+			wantH := tangentExtent(tt.height, tt.pixelScale)
+			if math.Abs(width-wantW) > 1e-9 || math.Abs(height-wantH) > 1e-9 {
+				t.Errorf("AccurateFieldSize = %.6f x %.6f, want %.6f x %.6f", width, height, wantW, wantH)
+			}
+
+			flat := float64(tt.width) * tt.pixelScale / 3600
+			relDiff := math.Abs(flat-width) / width
+			if tt.wantDiffer && relDiff < 0.01 {
+				t.Errorf("flat width %.4f° within 1%% of accurate %.4f° for a wide field", flat, width)
+			}
+			if !tt.wantDiffer && relDiff > 1e-4 {
+				t.Errorf("flat width %.6f° differs from accurate %.6f° for a narrow field", flat, width)
+			}
+		})
+	}
+}
+
+func TestAccurateFieldSize_SIP(t *testing.T) {
+	header := map[string]string{
+		"CRVAL1": "150", "CRVAL2": "30",
+		"CRPIX1": "1500", "CRPIX2": "1000",
+		"CD1_1": formatFloat(-1.0 / 3600), "CD1_2": "0",
+		"CD2_1": "0", "CD2_2": formatFloat(1.0 / 3600),
+		"IMAGEW": "3000", "IMAGEH": "2000",
+	}
+	plain := &Result{Solved: true, WCSHeader: header}
+	plainW, plainH, _ := plain.AccurateFieldSize()
+
+	// A_1_0 stretches x by 1% and leaves y alone
+	distorted := map[string]string{"A_ORDER": "1", "A_1_0": "0.01", "B_ORDER": "1"}
+	for k, v := range header {
+		distorted[k] = v
+	}
+	sipW, sipH, _ := (&Result{Solved: true, WCSHeader: distorted}).AccurateFieldSize()
+
+	if math.Abs(sipW/plainW-1.01) > 1e-4 {
+		t.Errorf("SIP width ratio = %.6f, want 1.01", sipW/plainW)
+	}
+	if math.Abs(sipH-plainH) > 1e-9 {
+		t.Errorf("SIP height %.6f differs from plain %.6f", sipH, plainH)
+	}
+}
+
+func TestResultFromWCSHeader_LinearTransforms(t *testing.T) {
+	// The same 20°-rotated 4"/px solution written three ways
+	scale := 4.0 / 3600
+	rot := 20 * deg2rad
+	base := map[string]string{
+		"CTYPE1": "RA---TAN", "CTYPE2": "DEC--TAN",
+		"CRVAL1": "150.0", "CRVAL2": "45.0", "CRPIX1": "100.5", "CRPIX2": "80.5",
+	}
+	with := func(cards map[string]string) map[string]string {
+		h := make(map[string]string)
+		for k, v := range base {
+			h[k] = v
+		}
+		for k, v := range cards {
+			h[k] = v
+		}
+		return h
+	}
+	cd := with(map[string]string{
+		"CD1_1": formatFloat(-scale * math.Cos(rot)), "CD1_2": formatFloat(-scale * math.Sin(rot)),
+		"CD2_1": formatFloat(-scale * math.Sin(rot)), "CD2_2": formatFloat(scale * math.Cos(rot)),
+	})
+	pc := with(map[string]string{
+		"CDELT1": formatFloat(-scale), "CDELT2": formatFloat(scale),
+		"PC1_1": formatFloat(math.Cos(rot)), "PC1_2": formatFloat(math.Sin(rot)),
+		"PC2_1": formatFloat(-math.Sin(rot)), "PC2_2": formatFloat(math.Cos(rot)),
+	})
+	crota := with(map[string]string{
+		"CDELT1": formatFloat(-scale), "CDELT2": formatFloat(scale), "CROTA2": "20.0",
+	})
+
+	want, err := ResultFromWCSHeader(cd, 200, 160)
+	if err != nil {
+		t.Fatalf("CD header: %v", err)
+	}
+	for name, header := range map[string]map[string]string{"PC": pc, "CROTA2": crota} {
+		got, err := ResultFromWCSHeader(header, 200, 160)
+		if err != nil {
+			t.Fatalf("%s header: %v", name, err)
+		}
+		if sep := angularSeparation(got.RA, got.Dec, want.RA, want.Dec) * 3600; sep > 1e-6 {
+			t.Errorf("%s: center off by %.2e arcsec", name, sep)
+		}
+		if math.Abs(got.PixelScale-want.PixelScale) > 1e-9 || math.Abs(angleDiff(got.Rotation, want.Rotation)) > 1e-9 {
+			t.Errorf("%s: scale %.9f rotation %.9f, want %.9f, %.9f",
+				name, got.PixelScale, got.Rotation, want.PixelScale, want.Rotation)
+		}
+		if got.Parity != want.Parity || math.Abs(got.FieldWidth-want.FieldWidth) > 1e-12 {
+			t.Errorf("%s: parity %s width %.12f, want %s, %.12f", name, got.Parity, got.FieldWidth, want.Parity, want.FieldWidth)
+		}
+	}
+}
+
+func TestResultFromWCSHeader_ImageSize(t *testing.T) {
+	header := map[string]string{
+		"CRVAL1": "10.0", "CRVAL2": "20.0", "CRPIX1": "50", "CRPIX2": "50",
+		"CD1_1": "-0.001", "CD1_2": "0", "CD2_1": "0", "CD2_2": "0.001",
+		"NAXIS1": "10", "NAXIS2": "10",
+	}
+
+	result, err := ResultFromWCSHeader(header, 100, 100)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	if result.ImageWidth != 100 || result.ImageHeight != 100 {
+		t.Errorf("image size = %dx%d, want the 100x100 passed in", result.ImageWidth, result.ImageHeight)
+	}
+	// CRPIX is the center, so the center is CRVAL
+	if math.Abs(result.RA-10) > 1e-9 || math.Abs(result.Dec-20) > 1e-9 {
+		t.Errorf("center = (%v, %v), want (10, 20)", result.RA, result.Dec)
+	}
+	if _, ok := header["IMAGEW"]; ok {
+		t.Error("caller's header map was modified")
+	}
+	if _, ok := result.CornerCoords(); !ok {
+		t.Error("expected a footprint from the in-memory header")
+	}
+
+	// Without an override the header's NAXIS values are used
+	result, err = ResultFromWCSHeader(header, 0, 0)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	if result.ImageWidth != 10 {
+		t.Errorf("ImageWidth = %d, want 10 from NAXIS1", result.ImageWidth)
+	}
+
+	if _, err := ResultFromWCSHeader(map[string]string{"CTYPE1": "RA---TAN"}, 100, 100); err == nil {
+		t.Error("expected error for header without a solution")
+	}
+}
+
+func TestResultFromWCSHeader_ImageSizePrecedence(t *testing.T) {
+	// solve-field's .wcs files have no pixel data, so NAXIS1/NAXIS2 are 0
+	// or absent and only IMAGEW/IMAGEH give the image size
+	header := map[string]string{
+		"CRVAL1": "10.0", "CRVAL2": "20.0", "CRPIX1": "500.5", "CRPIX2": "400.5",
+		"CD1_1": "-0.001", "CD1_2": "0", "CD2_1": "0", "CD2_2": "0.001",
+		"IMAGEW": "1000", "IMAGEH": "800",
+	}
+	for _, naxis := range [][2]string{{"0", "0"}, {"10", "20"}} {
+		header["NAXIS1"], header["NAXIS2"] = naxis[0], naxis[1]
+		result, err := ResultFromWCSHeader(header, 0, 0)
+		if err != nil {
+			t.Fatalf("ResultFromWCSHeader failed: %v", err)
+		}
+		if result.ImageWidth != 1000 || result.ImageHeight != 800 {
+			t.Errorf("NAXIS %v: image size = %dx%d, want 1000x800 from IMAGEW/IMAGEH",
+				naxis, result.ImageWidth, result.ImageHeight)
+		}
+		if math.Abs(result.FieldWidth-1.0) > 1e-3 || math.Abs(result.FieldHeight-0.8) > 1e-3 {
+			t.Errorf("NAXIS %v: field size = %.4f x %.4f deg, want 1 x 0.8", naxis, result.FieldWidth, result.FieldHeight)
+		}
+	}
+
+	// An unusable IMAGEW falls back to NAXIS1
+	header["IMAGEW"], header["NAXIS1"] = "0", "500"
+	result, err := ResultFromWCSHeader(header, 0, 0)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	if result.ImageWidth != 500 || result.ImageHeight != 800 {
+		t.Errorf("image size = %dx%d, want 500 from NAXIS1 and 800 from IMAGEH", result.ImageWidth, result.ImageHeight)
+	}
+}
+
+func FuzzResultFromWCSHeader(f *testing.F) {
+	f.Add("CRVAL1=83.8;CRVAL2=-5.4;CRPIX1=4;CRPIX2=4;CD1_1=-0.001;CD2_2=0.001", 8, 8)
+	f.Add("CRVAL1=0;CRVAL2=90;CDELT1=1e-300;CDELT2=0;CROTA2=NaN", 1, 1)
+	f.Add("CRVAL1=Inf;CRVAL2=-Inf;PC1_1=1e308;CDELT1=1e308;CDELT2=-1e308;IMAGEW=-5", 0, 0)
+	f.Add("A_ORDER=3;B_ORDER=5;CRVAL1=1;CD1_1=1;CD2_2=1;IMAGEW=1e10;IMAGEH=nan", -1, 7)
+
+	f.Fuzz(func(t *testing.T, cards string, width, height int) {
+		header := make(map[string]string)
+		for _, card := range strings.Split(cards, ";") {
+			if key, value, ok := strings.Cut(card, "="); ok {
+				header[key] = value
+			}
+		}
+		result, err := ResultFromWCSHeader(header, width, height)
+		if err == nil && result == nil {
+			t.Fatal("nil result without error")
+		}
+		if err == nil {
+			// Derived helpers must not panic on whatever was accepted
+			result.CornerCoords()
+			result.AccurateFieldSize()
+			_ = result.WriteDS9Region(io.Discard)
+		}
+	})
+}
@@ -0,0 +1,197 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// sharedWorkDirName is the directory reused by every operation when
+// ClientConfig.ReuseWorkDir is in effect.
+const sharedWorkDirName = "astrometry-shared"
+
+// workspace is the per-solve working directory and the scratch location it
+// was created in. In a shared working directory, prefix is prepended to
+// every file the operation stages so solve-field's outputs are isolated by
+// name rather than by directory.
+type workspace struct {
+	dir     string
+	scratch string
+	prefix  string
+	shared  bool
+}
+
+// remove deletes the workspace: the whole directory, or only this
+// operation's prefixed files in a shared directory.
+func (ws *workspace) remove() error {
+	if !ws.shared {
+		return os.RemoveAll(ws.dir)
+	}
+	matches, err := filepath.Glob(filepath.Join(ws.dir, ws.prefix+"*"))
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, path := range matches {
+		if err := os.RemoveAll(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// The reservation file created by newWorkDir
+	if err := os.Remove(filepath.Join(ws.dir, strings.TrimSuffix(ws.prefix, "_"))); err != nil && !os.IsNotExist(err) {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// reuseWorkDir reports whether operations share one working directory.
+func (c *Client) reuseWorkDir() bool {
+	return c.config.ReuseWorkDir && c.config.UseDockerExec
+}
+
+// newWorkDir creates a per-operation directory under parent, or in shared
+// mode reserves a unique file prefix in parent's shared directory. The
+// prefix is reserved by creating an empty file with its name, so concurrent
+// operations cannot pick the same one.
+func (c *Client) newWorkDir(parent string) (*workspace, error) {
+	if !c.reuseWorkDir() {
+		dir, err := os.MkdirTemp(parent, "astrometry-*")
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(dir, c.workDirPerm()); err != nil {
+			_ = os.Remove(dir) //nolint:errcheck // Best effort cleanup on error path
+			return nil, err
+		}
+		return &workspace{dir: dir, scratch: parent}, nil
+	}
+
+	dir := filepath.Join(parent, sharedWorkDirName)
+	if err := os.MkdirAll(dir, c.workDirPerm()); err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(dir, c.workDirPerm()); err != nil {
+		return nil, err
+	}
+	reservation, err := os.CreateTemp(dir, "job-*")
+	if err != nil {
+		return nil, err
+	}
+	if err := reservation.Close(); err != nil {
+		return nil, err
+	}
+	return &workspace{
+		dir:     dir,
+		scratch: parent,
+		prefix:  filepath.Base(reservation.Name()) + "_",
+		shared:  true,
+	}, nil
+}
+
+// createWorkspace creates a per-operation working directory, or a
+// prefixed slot in a shared one when ReuseWorkDir applies.
+//
+// When ScratchDirs is configured, each directory is tried in order and the
+// first with at least requiredFreeBytes available is used. Otherwise the
+// workspace is created in TempDir without a free-space check.
+func (c *Client) createWorkspace(imageSize int64) (*workspace, error) {
+	if len(c.config.ScratchDirs) == 0 {
+		ws, err := c.newWorkDir(c.config.TempDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temp directory: %w", err)
+		}
+		return ws, nil
+	}
+
+	required := c.requiredFreeBytes(imageSize)
+	var skipped []string
+	for _, scratch := range c.config.ScratchDirs {
+		free, err := c.statfs(scratch)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s (%v)", scratch, err))
+			continue
+		}
+		if free < required {
+			skipped = append(skipped, fmt.Sprintf("%s (%d bytes free)", scratch, free))
+			continue
+		}
+		ws, err := c.newWorkDir(scratch)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s (%v)", scratch, err))
+			continue
+		}
+		return ws, nil
+	}
+	return nil, fmt.Errorf("%w: need %d bytes, tried %s", ErrNoScratchSpace, required, strings.Join(skipped, ", "))
+}
+
+// requiredFreeBytes returns the free space a scratch directory needs to host
+// a solve: the configured minimum, or room for the image plus its outputs.
+func (c *Client) requiredFreeBytes(imageSize int64) uint64 {
+	required := uint64(2 * imageSize)
+	if c.config.ScratchMinFreeBytes > required {
+		required = c.config.ScratchMinFreeBytes
+	}
+	return required
+}
+
+// stageImage places the image in the workspace. When the source already
+// resides under the workspace's scratch directory on the same device it is
+// hard-linked instead of copied, and keeps the source's own mode since it
+// is the same file. It reports whether a link was used.
+func stageImage(src, dst, scratch string, containerRead bool) (linked bool, err error) {
+	if isUnder(src, scratch) && sameDevice(src, filepath.Dir(dst)) {
+		if err := os.Link(src, dst); err == nil {
+			return true, nil
+		}
+	}
+	return false, copyFile(src, dst, containerRead)
+}
+
+// stagedPerm returns the permissions of a file staged from one with mode
+// src: the source's owner permissions, at most 0600, so private images are
+// never exposed more widely in a shared temp directory. containerRead
+// (ClientConfig.AllowContainerRead) adds read access for everyone.
+func stagedPerm(src os.FileMode, containerRead bool) os.FileMode {
+	perm := src.Perm() & 0600
+	if containerRead {
+		perm |= 0444
+	}
+	return perm
+}
+
+// stageAttrs sets dst's permissions to stagedPerm and its modification time
+// to the source's, so tools that record the input's mtime (e.g. in DATE
+// keywords) produce the same output on every run. The permissions are set
+// explicitly because the umask applies at creation.
+func stageAttrs(src os.FileInfo, dst string, containerRead bool) error {
+	if err := os.Chmod(dst, stagedPerm(src.Mode(), containerRead)); err != nil {
+		return err
+	}
+	return os.Chtimes(dst, time.Time{}, src.ModTime())
+}
+
+// workDirPerm returns the permissions of workspace directories: private to
+// the owner, or readable and searchable by everyone with AllowContainerRead.
+func (c *Client) workDirPerm() os.FileMode {
+	if c.config.AllowContainerRead {
+		return 0755
+	}
+	return 0700
+}
+
+// isUnder reports whether path is inside dir.
+func isUnder(path, dir string) bool {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(absDir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
@@ -0,0 +1,355 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// fakeStatfs reports the given free space per directory.
+func fakeStatfs(free map[string]uint64) func(string) (uint64, error) {
+	return func(path string) (uint64, error) {
+		n, ok := free[path]
+		if !ok {
+			return 0, errors.New("no such filesystem")
+		}
+		return n, nil
+	}
+}
+
+func TestCreateWorkspace_FallsBackWhenFull(t *testing.T) {
+	full, roomy := t.TempDir(), t.TempDir()
+	client := newTestClient(t, nil)
+	client.config.ScratchDirs = []string{full, roomy}
+	client.config.ScratchMinFreeBytes = 1 << 30
+	client.statfs = fakeStatfs(map[string]uint64{full: 1 << 20, roomy: 10 << 30})
+
+	ws, err := client.createWorkspace(1024)
+	if err != nil {
+		t.Fatalf("createWorkspace failed: %v", err)
+	}
+	if ws.scratch != roomy {
+		t.Errorf("scratch = %s, want %s", ws.scratch, roomy)
+	}
+	if filepath.Dir(ws.dir) != roomy {
+		t.Errorf("workspace %s not created under %s", ws.dir, roomy)
+	}
+}
+
+func TestCreateWorkspace_NoScratchSpace(t *testing.T) {
+	a, b := t.TempDir(), t.TempDir()
+	client := newTestClient(t, nil)
+	client.config.ScratchDirs = []string{a, b, "/missing"}
+	client.statfs = fakeStatfs(map[string]uint64{a: 100, b: 500})
+
+	// Default threshold is twice the image size
+	_, err := client.createWorkspace(1000)
+	if !errors.Is(err, ErrNoScratchSpace) {
+		t.Fatalf("expected ErrNoScratchSpace, got %v", err)
+	}
+
+	ws, err := client.createWorkspace(200)
+	if err != nil {
+		t.Fatalf("createWorkspace failed: %v", err)
+	}
+	if ws.scratch != b {
+		t.Errorf("scratch = %s, want %s", ws.scratch, b)
+	}
+}
+
+func TestCreateWorkspace_DefaultsToTempDir(t *testing.T) {
+	client := newTestClient(t, nil)
+	client.statfs = func(string) (uint64, error) {
+		t.Error("statfs should not be called without ScratchDirs")
+		return 0, nil
+	}
+
+	ws, err := client.createWorkspace(1 << 40)
+	if err != nil {
+		t.Fatalf("createWorkspace failed: %v", err)
+	}
+	if ws.scratch != client.config.TempDir {
+		t.Errorf("scratch = %s, want TempDir %s", ws.scratch, client.config.TempDir)
+	}
+}
+
+func TestStageImage(t *testing.T) {
+	scratch := t.TempDir()
+	src := filepath.Join(scratch, "image.jpg")
+	if err := os.WriteFile(src, []byte("jpeg data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	wsDir, err := os.MkdirTemp(scratch, "astrometry-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Source under the scratch dir is linked, not copied
+	dst := filepath.Join(wsDir, "image.jpg")
+	linked, err := stageImage(src, dst, scratch, false)
+	if err != nil {
+		t.Fatalf("stageImage failed: %v", err)
+	}
+	if !linked {
+		t.Error("expected image under scratch dir to be linked")
+	}
+	srcInfo, _ := os.Stat(src)
+	dstInfo, _ := os.Stat(dst)
+	if !os.SameFile(srcInfo, dstInfo) {
+		t.Error("expected staged image to be the same file as the source")
+	}
+
+	// Source elsewhere is copied
+	other := filepath.Join(t.TempDir(), "other.jpg")
+	if err := os.WriteFile(other, []byte("jpeg data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	linked, err = stageImage(other, filepath.Join(wsDir, "other.jpg"), scratch, false)
+	if err != nil {
+		t.Fatalf("stageImage failed: %v", err)
+	}
+	if linked {
+		t.Error("expected image outside scratch dir to be copied")
+	}
+}
+
+func TestStageImage_PreservesAttrs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("POSIX permissions")
+	}
+	mtime := time.Date(2021, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		srcPerm       os.FileMode
+		containerRead bool
+		want          os.FileMode
+	}{
+		{"group readable source", 0640, false, 0600},
+		{"world readable source", 0644, false, 0600},
+		{"read-only source", 0400, false, 0400},
+		{"container read", 0600, true, 0644},
+		{"container read, read-only source", 0400, true, 0444},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := filepath.Join(t.TempDir(), "image.jpg")
+			if err := os.WriteFile(src, []byte("jpeg data"), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chmod(src, tt.srcPerm); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chtimes(src, mtime, mtime); err != nil {
+				t.Fatal(err)
+			}
+
+			dst := filepath.Join(t.TempDir(), "image.jpg")
+			if _, err := stageImage(src, dst, t.TempDir(), tt.containerRead); err != nil {
+				t.Fatalf("stageImage failed: %v", err)
+			}
+			info, err := os.Stat(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Mode().Perm() != tt.want {
+				t.Errorf("mode = %v, want %v", info.Mode().Perm(), tt.want)
+			}
+			if !info.ModTime().Equal(mtime) {
+				t.Errorf("mtime = %v, want %v", info.ModTime(), mtime)
+			}
+		})
+	}
+}
+
+func TestStageFITS_PreservesAttrs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("POSIX permissions")
+	}
+	mtime := time.Date(2021, 3, 14, 15, 9, 26, 0, time.UTC)
+	src := writeTestPNG(t, 8, 8)
+	if err := os.Chmod(src, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(src, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	// Converted, not copied, but the staged file still matches the source
+	dst := filepath.Join(t.TempDir(), "stars.fits")
+	if err := stageFITS(src, dst, false); err != nil {
+		t.Fatalf("stageFITS failed: %v", err)
+	}
+	info, err := os.Stat(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 || !info.ModTime().Equal(mtime) {
+		t.Errorf("mode %v, mtime %v; want 0600, %v", info.Mode().Perm(), info.ModTime(), mtime)
+	}
+}
+
+func TestCreateWorkspace_Permissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("POSIX permissions")
+	}
+	for _, tt := range []struct {
+		name          string
+		shared        bool
+		containerRead bool
+		want          os.FileMode
+	}{
+		{"per-call", false, false, 0700},
+		{"per-call, container read", false, true, 0755},
+		{"shared", true, false, 0700},
+		{"shared, container read", true, true, 0755},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, nil)
+			client.config.UseDockerExec = tt.shared
+			client.config.ReuseWorkDir = tt.shared
+			client.config.AllowContainerRead = tt.containerRead
+
+			ws, err := client.createWorkspace(1024)
+			if err != nil {
+				t.Fatalf("createWorkspace failed: %v", err)
+			}
+			info, err := os.Stat(ws.dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Mode().Perm() != tt.want {
+				t.Errorf("workspace mode = %v, want %v", info.Mode().Perm(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSolve_RecordsScratchDir(t *testing.T) {
+	scratch := t.TempDir()
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		if filepath.Dir(workDir) != scratch {
+			t.Errorf("workspace %s not under scratch dir %s", workDir, scratch)
+		}
+		return nil
+	})
+	client.config.ScratchDirs = []string{scratch}
+	client.statfs = fakeStatfs(map[string]uint64{scratch: 1 << 30})
+
+	imagePath := writeTestPNG(t, 8, 8)
+	result, err := client.Solve(context.Background(), imagePath, nil)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if result.ScratchDir != scratch {
+		t.Errorf("ScratchDir = %s, want %s", result.ScratchDir, scratch)
+	}
+}
+
+// newSharedClient returns an exec-mode client with ReuseWorkDir set.
+func newSharedClient(t testing.TB, handler func(args []string) error) *Client {
+	client, err := NewClient(&ClientConfig{
+		IndexPath:     t.TempDir(),
+		TempDir:       t.TempDir(),
+		UseDockerExec: true,
+		ContainerName: "astrometry",
+		ReuseWorkDir:  true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	client.run = func(ctx context.Context, name string, args []string, w io.Writer) error {
+		return handler(args)
+	}
+	return client
+}
+
+func TestSolve_ReuseWorkDir(t *testing.T) {
+	var mu sync.Mutex
+	dirs := make(map[string]bool)
+	client := newSharedClient(t, func(args []string) error {
+		// In exec mode the image argument is the host path
+		image := args[len(args)-1]
+		mu.Lock()
+		dirs[filepath.Dir(image)] = true
+		mu.Unlock()
+		wcs := strings.TrimSuffix(image, filepath.Ext(image)) + ".wcs"
+		return os.WriteFile(wcs, fits.EncodeHeader([]fits.Card{
+			{Key: "CRVAL1", Value: "83.8"}, {Key: "CRVAL2", Value: "-5.4"},
+			{Key: "CRPIX1", Value: "4"}, {Key: "CRPIX2", Value: "4"},
+			{Key: "CD1_1", Value: "-0.001"}, {Key: "CD1_2", Value: "0"},
+			{Key: "CD2_1", Value: "0"}, {Key: "CD2_2", Value: "0.001"},
+		}), 0644)
+	})
+	imagePath := writeTestPNG(t, 8, 8)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := client.Solve(context.Background(), imagePath, nil)
+			if err == nil && !result.Solved {
+				err = errors.New("not solved")
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent solve failed: %v", err)
+		}
+	}
+
+	shared := filepath.Join(client.config.TempDir, sharedWorkDirName)
+	if len(dirs) != 1 || !dirs[shared] {
+		t.Errorf("solves ran in %v, want only %s", dirs, shared)
+	}
+	entries, err := os.ReadDir(shared)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("shared directory not cleaned up: %d entries left", len(entries))
+	}
+}
+
+func BenchmarkWorkspace(b *testing.B) {
+	image := make([]byte, 64<<10)
+	for _, reuse := range []bool{false, true} {
+		name := "per-call-dir"
+		if reuse {
+			name = "shared-dir"
+		}
+		b.Run(name, func(b *testing.B) {
+			client := newSharedClient(b, nil)
+			client.config.ReuseWorkDir = reuse
+			for b.Loop() {
+				ws, err := client.createWorkspace(int64(len(image)))
+				if err != nil {
+					b.Fatal(err)
+				}
+				for _, ext := range []string{".png", ".wcs", ".axy", ".solved"} {
+					if err := os.WriteFile(filepath.Join(ws.dir, ws.prefix+"stars"+ext), image, 0644); err != nil {
+						b.Fatal(err)
+					}
+				}
+				if err := ws.remove(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// SolveXYList plate-solves a star list instead of an image. The xylist is a
+// FITS file with one binary table extension per field, each holding X and Y
+// columns (plus optional FLUX) and IMAGEW/IMAGEH header cards giving the
+// size of the image the stars came from, as written by image2xy or
+// professional extraction pipelines.
+//
+// Every field is solved in a single solve-field invocation, which is far
+// cheaper than one container per field. It returns one Result per field in
+// extension order; fields that did not solve have Solved false. The .solved
+// marker decides which fields solved, and each solved field's WCS is read
+// from its own file, as astrometry-engine expands %i in the --wcs filename
+// to the field number.
+//
+// The columns and image size can be given in opts (XColumn, YColumn,
+// SortColumn, ImageWidth and ImageHeight) for lists from other tools;
+// XYListPath is ignored.
+func (c *Client) SolveXYList(ctx context.Context, xylistPath string, opts *SolveOptions) ([]*Result, error) {
+	c = c.snapshot()
+	if opts == nil {
+		opts = DefaultSolveOptions()
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	tables, width, height, err := readXYList(xylistPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(xylistPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat xylist: %w", err)
+	}
+	absIndexPaths, err := c.absIndexPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	ws, err := c.createWorkspace(info.Size())
+	if err != nil {
+		return nil, err
+	}
+	tempDir := ws.dir
+	if !opts.KeepTempFiles {
+		defer func() {
+			if removeErr := ws.remove(); removeErr != nil {
+				log.Printf("warning: failed to remove temp directory: %v", removeErr)
+			}
+		}()
+	} else {
+		log.Printf("KeepTempFiles enabled: temp directory preserved at %s", tempDir)
+	}
+
+	baseName := ws.prefix + strings.TrimSuffix(filepath.Base(xylistPath), filepath.Ext(xylistPath))
+	xyName := baseName + ".xyls"
+	if err := copyFile(xylistPath, filepath.Join(tempDir, xyName), c.config.AllowContainerRead); err != nil {
+		return nil, fmt.Errorf("failed to copy xylist to temp directory: %w", err)
+	}
+
+	xyOpts := *opts
+	xyOpts.ExtraArgs = append(xylistArgs(opts, width, height),
+		"--fields", fmt.Sprintf("1-%d", len(tables)),
+		"--wcs", c.containerPath(tempDir, baseName+"-%i.wcs"))
+	xyOpts.ExtraArgs = append(xyOpts.ExtraArgs, opts.ExtraArgs...)
+	args := c.buildSolveArgs(xyName, tempDir, &xyOpts)
+	indexFlags, err := c.writeIndexConfig(tempDir, ws.prefix+indexConfigName)
+	if err != nil {
+		return nil, err
+	}
+	args = withFlags(args, indexFlags)
+
+	timeout := c.config.Timeout
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+	solveCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	startTime := time.Now()
+	output := newBoundedBuffer(c.config.MaxOutputBytes)
+	_ = c.runDocker(solveCtx, tempDir, absIndexPaths, args, output) //nolint:errcheck // Output files decide success, as in Solve
+	if solveCtx.Err() == context.DeadlineExceeded {
+		return nil, ErrTimeout
+	}
+	solveTime := time.Since(startTime).Seconds()
+	rawOutput := output.String()
+
+	// A missing .solved file means no field solved
+	solvedFields, err := ParseSolvedFile(filepath.Join(tempDir, baseName+".solved"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	results := make([]*Result, len(tables))
+	for i := range tables {
+		field := i + 1
+		unsolved := &Result{RawOutput: rawOutput, ScratchDir: ws.scratch}
+		unsolved.setTimes(solveTime, rawOutput)
+		if !fieldSolved(solvedFields, field) {
+			results[i] = unsolved
+			continue
+		}
+
+		wcsPath := filepath.Join(tempDir, fmt.Sprintf("%s-%d.wcs", baseName, field))
+		result, err := ParseWCSFile(wcsPath)
+		if errors.Is(err, os.ErrNotExist) {
+			results[i] = unsolved
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field %d: %w\nSolve output: %s", field, err, rawOutput)
+		}
+
+		result.setTimes(solveTime, rawOutput)
+		result.SolvedFields = []int{field}
+		if result.ImageWidth == 0 || result.ImageHeight == 0 {
+			result.ImageWidth, result.ImageHeight = width, height
+		}
+		result.ScratchDir = ws.scratch
+		if opts.Verbose {
+			result.RawOutput = rawOutput
+		}
+		if opts.KeepTempFiles {
+			result.OutputFiles = []string{wcsPath}
+			result.Outputs = map[OutputKind]string{OutputWCS: wcsPath}
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// readXYList reads the star list at path and checks it before any
+// container is started: it must be a FITS file with at least one binary
+// table extension, and every field must pass xylistSize.
+func readXYList(path string, opts *SolveOptions) (tables []*fits.Table, width, height int, err error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, 0, 0, fmt.Errorf("%w: xylist file does not exist: %s", ErrInvalidInput, path)
+	}
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to open xylist: %w", err)
+	}
+	tables, err = fits.ReadTables(file)
+	_ = file.Close() //nolint:errcheck // Read-only file, close error not critical
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%w: invalid xylist: %v", ErrInvalidInput, err)
+	}
+	if len(tables) == 0 {
+		return nil, 0, 0, fmt.Errorf("%w: xylist %s has no table extension", ErrInvalidInput, path)
+	}
+	width, height, err = xylistSize(tables, opts)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return tables, width, height, nil
+}
+
+// xylistColumns returns the X, Y and sort column names opts selects.
+func xylistColumns(opts *SolveOptions) (x, y, sort string) {
+	x, y = "X", "Y"
+	if opts.XColumn != "" {
+		x = opts.XColumn
+	}
+	if opts.YColumn != "" {
+		y = opts.YColumn
+	}
+	return x, y, opts.SortColumn
+}
+
+// xylistArgs returns the solve-field flags describing a star list: the
+// image size and any column names other than solve-field's defaults.
+func xylistArgs(opts *SolveOptions, width, height int) []string {
+	args := []string{"--width", strconv.Itoa(width), "--height", strconv.Itoa(height)}
+	x, y, sort := xylistColumns(opts)
+	if x != "X" {
+		args = append(args, "--x-column", x)
+	}
+	if y != "Y" {
+		args = append(args, "--y-column", y)
+	}
+	if sort != "" {
+		args = append(args, "--sort-column", sort)
+	}
+	return args
+}
+
+// xylistSize checks that every field has the X, Y and sort columns opts
+// selects and returns the image size: opts.ImageWidth and ImageHeight when
+// set, or else the IMAGEW/IMAGEH cards, which must agree across fields
+// since solve-field takes a single --width/--height.
+func xylistSize(tables []*fits.Table, opts *SolveOptions) (width, height int, err error) {
+	if opts.ImageWidth < 0 || opts.ImageHeight < 0 || (opts.ImageWidth > 0) != (opts.ImageHeight > 0) {
+		return 0, 0, fmt.Errorf("%w: ImageWidth and ImageHeight must both be positive or both zero", ErrInvalidInput)
+	}
+	x, y, sort := xylistColumns(opts)
+	for i, t := range tables {
+		for _, column := range []string{x, y, sort} {
+			if _, ok := t.Columns[column]; !ok && column != "" && t.Rows > 0 {
+				return 0, 0, fmt.Errorf("%w: xylist field %d has no %s column", ErrInvalidInput, i+1, column)
+			}
+		}
+		if opts.ImageWidth > 0 {
+			continue
+		}
+		w, wok := t.Header.Int("IMAGEW")
+		h, hok := t.Header.Int("IMAGEH")
+		if !wok || !hok || w <= 0 || h <= 0 {
+			return 0, 0, fmt.Errorf("%w: xylist field %d is missing IMAGEW/IMAGEH; set SolveOptions.ImageWidth and ImageHeight",
+				ErrInvalidInput, i+1)
+		}
+		if i > 0 && (w != width || h != height) {
+			return 0, 0, fmt.Errorf("%w: xylist field %d is %dx%d, field 1 is %dx%d",
+				ErrInvalidInput, i+1, w, h, width, height)
+		}
+		width, height = w, h
+	}
+	if opts.ImageWidth > 0 {
+		return opts.ImageWidth, opts.ImageHeight, nil
+	}
+	return width, height, nil
+}
@@ -0,0 +1,253 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/fits"
+)
+
+// writeTestXYList writes a multi-field xylist with one extension per field.
+func writeTestXYList(t *testing.T, cards []fits.Card, fields int) string {
+	t.Helper()
+	hdus := make([]fits.TableHDU, fields)
+	for i := range hdus {
+		hdus[i] = fits.TableHDU{
+			Cards:   cards,
+			Columns: []string{"X", "Y", "FLUX"},
+			Data:    [][]float64{{10, 20, 30}, {15, 25, 35}, {900, 800, 700}},
+		}
+	}
+	path := filepath.Join(t.TempDir(), "fields.xyls")
+	out, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	if err := fits.WriteTables(out, nil, hdus...); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSolveXYList(t *testing.T) {
+	size := []fits.Card{{Key: "IMAGEW", Value: "640"}, {Key: "IMAGEH", Value: "480"}}
+	xylist := writeTestXYList(t, size, 3)
+
+	wcs := func(ra string) []byte {
+		return fits.EncodeHeader([]fits.Card{
+			{Key: "CRVAL1", Value: ra}, {Key: "CRVAL2", Value: "20"},
+			{Key: "CRPIX1", Value: "320"}, {Key: "CRPIX2", Value: "240"},
+			{Key: "CD1_1", Value: "-0.001"}, {Key: "CD1_2", Value: "0"},
+			{Key: "CD2_1", Value: "0"}, {Key: "CD2_2", Value: "0.001"},
+		})
+	}
+
+	var gotArgs []string
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		gotArgs = args
+		if _, err := os.Stat(filepath.Join(workDir, "fields.xyls")); err != nil {
+			t.Error("xylist was not staged in the working directory")
+		}
+		// Fields 1 and 3 solve; field 2 does not
+		for name, data := range map[string][]byte{
+			"fields-1.wcs":  wcs("10"),
+			"fields-3.wcs":  wcs("30"),
+			"fields.solved": {1, 0, 1},
+		} {
+			if err := os.WriteFile(filepath.Join(workDir, name), data, 0644); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	results, err := client.SolveXYList(context.Background(), xylist, nil)
+	if err != nil {
+		t.Fatalf("SolveXYList failed: %v", err)
+	}
+
+	argsStr := strings.Join(gotArgs, " ")
+	for _, want := range []string{"--width 640 --height 480", "--fields 1-3", "--wcs /data/fields-%i.wcs", "/data/fields.xyls"} {
+		if !strings.Contains(argsStr, want) {
+			t.Errorf("solve-field arguments missing %q: %s", want, argsStr)
+		}
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	var solved []bool
+	for _, r := range results {
+		solved = append(solved, r.Solved)
+	}
+	if !reflect.DeepEqual(solved, []bool{true, false, true}) {
+		t.Fatalf("solved = %v, want [true false true]", solved)
+	}
+	if results[0].RA != 10 || results[2].RA != 30 {
+		t.Errorf("RA = %v, %v; want 10, 30", results[0].RA, results[2].RA)
+	}
+	if !reflect.DeepEqual(results[2].SolvedFields, []int{3}) {
+		t.Errorf("SolvedFields = %v, want [3]", results[2].SolvedFields)
+	}
+	if results[0].ImageWidth != 640 || results[0].ImageHeight != 480 {
+		t.Errorf("image size = %dx%d, want 640x480", results[0].ImageWidth, results[0].ImageHeight)
+	}
+}
+
+func TestSolveXYList_NothingSolved(t *testing.T) {
+	size := []fits.Card{{Key: "IMAGEW", Value: "640"}, {Key: "IMAGEH", Value: "480"}}
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		_, _ = io.WriteString(w, "Did not solve (or no WCS file was written).\n")
+		return errors.New("exit status 1")
+	})
+
+	results, err := client.SolveXYList(context.Background(), writeTestXYList(t, size, 2), nil)
+	if err != nil {
+		t.Fatalf("SolveXYList failed: %v", err)
+	}
+	if len(results) != 2 || results[0].Solved || results[1].Solved {
+		t.Fatalf("results = %+v, want two unsolved", results)
+	}
+	if !strings.Contains(results[1].RawOutput, "Did not solve") {
+		t.Error("unsolved field is missing the solve output")
+	}
+}
+
+func TestSolveXYList_InvalidInput(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		t.Error("solve-field should not run for invalid input")
+		return nil
+	})
+
+	noSize := writeTestXYList(t, nil, 2)
+	if _, err := client.SolveXYList(context.Background(), noSize, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("missing IMAGEW/IMAGEH: expected ErrInvalidInput, got %v", err)
+	}
+
+	image := writeTestPNG(t, 8, 8)
+	if _, err := client.SolveXYList(context.Background(), image, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("non-FITS input: expected ErrInvalidInput, got %v", err)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing.xyls")
+	if _, err := client.SolveXYList(context.Background(), missing, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("missing file: expected ErrInvalidInput, got %v", err)
+	}
+}
+
+// writeSirilList writes a single-field star list with Siril-style column
+// names and no IMAGEW/IMAGEH cards.
+func writeSirilList(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stars.axy")
+	out, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	if err := fits.WriteTables(out, nil, fits.TableHDU{
+		Columns: []string{"XCENTROID", "YCENTROID", "MAG_FLUX"},
+		Data:    [][]float64{{10, 20, 30}, {15, 25, 35}, {900, 800, 700}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSolve_XYListPath(t *testing.T) {
+	var gotArgs []string
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		gotArgs = args
+		if _, err := os.Stat(filepath.Join(workDir, "stars.xyls")); err != nil {
+			t.Error("star list was not staged as stars.xyls")
+		}
+		return os.WriteFile(filepath.Join(workDir, "stars.wcs"), fits.EncodeHeader([]fits.Card{
+			{Key: "CRVAL1", Value: "10"}, {Key: "CRVAL2", Value: "20"},
+			{Key: "CRPIX1", Value: "320"}, {Key: "CRPIX2", Value: "240"},
+			{Key: "CD1_1", Value: "-0.001"}, {Key: "CD1_2", Value: "0"},
+			{Key: "CD2_1", Value: "0"}, {Key: "CD2_2", Value: "0.001"},
+		}), 0644)
+	})
+
+	opts := DefaultSolveOptions()
+	opts.XYListPath = writeSirilList(t)
+	opts.ImageWidth, opts.ImageHeight = 640, 480
+	opts.XColumn, opts.YColumn, opts.SortColumn = "XCENTROID", "YCENTROID", "MAG_FLUX"
+	result, err := client.Solve(context.Background(), "", opts)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+
+	argsStr := strings.Join(gotArgs, " ")
+	for _, want := range []string{"--width 640 --height 480", "--x-column XCENTROID", "--y-column YCENTROID",
+		"--sort-column MAG_FLUX", "/data/stars.xyls"} {
+		if !strings.Contains(argsStr, want) {
+			t.Errorf("solve-field arguments missing %q: %s", want, argsStr)
+		}
+	}
+	if strings.Contains(argsStr, "--fields") {
+		t.Errorf("single-field list solved with --fields: %s", argsStr)
+	}
+	if !result.Solved || !result.FromXYList || result.Extractor != "" {
+		t.Errorf("Solved %v, FromXYList %v, Extractor %q; want a solved list result with no extractor",
+			result.Solved, result.FromXYList, result.Extractor)
+	}
+	if result.ImageWidth != 640 || result.ImageHeight != 480 {
+		t.Errorf("image size = %dx%d, want 640x480", result.ImageWidth, result.ImageHeight)
+	}
+}
+
+func TestSolve_XYListPathInvalid(t *testing.T) {
+	client := newTestClient(t, func(workDir string, args []string, w io.Writer) error {
+		t.Error("solve-field should not run for invalid input")
+		return nil
+	})
+	siril := writeSirilList(t)
+
+	tests := []struct {
+		name      string
+		imagePath string
+		setup     func(*SolveOptions)
+	}{
+		{"image and list", writeTestPNG(t, 8, 8), func(o *SolveOptions) {
+			o.XColumn, o.YColumn, o.ImageWidth, o.ImageHeight = "XCENTROID", "YCENTROID", 640, 480
+		}},
+		{"no image size", "", func(o *SolveOptions) { o.XColumn, o.YColumn = "XCENTROID", "YCENTROID" }},
+		{"width only", "", func(o *SolveOptions) {
+			o.XColumn, o.YColumn, o.ImageWidth = "XCENTROID", "YCENTROID", 640
+		}},
+		{"default columns", "", func(o *SolveOptions) { o.ImageWidth, o.ImageHeight = 640, 480 }},
+		{"missing sort column", "", func(o *SolveOptions) {
+			o.XColumn, o.YColumn, o.SortColumn, o.ImageWidth, o.ImageHeight = "XCENTROID", "YCENTROID", "FLUX", 640, 480
+		}},
+		{"crop", "", func(o *SolveOptions) {
+			o.XColumn, o.YColumn, o.ImageWidth, o.ImageHeight, o.CropPixels = "XCENTROID", "YCENTROID", 640, 480, 100
+		}},
+		{"not a table", "", func(o *SolveOptions) {
+			o.XYListPath, o.ImageWidth, o.ImageHeight = writeTestPNG(t, 8, 8), 640, 480
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultSolveOptions()
+			opts.XYListPath = siril
+			tt.setup(opts)
+			if _, err := client.Solve(context.Background(), tt.imagePath, opts); !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+
+	opts := DefaultSolveOptions()
+	opts.XYListPath = siril
+	if _, err := client.SolveBytes(context.Background(), []byte("data"), "jpg", opts); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("SolveBytes with XYListPath: expected ErrInvalidInput, got %v", err)
+	}
+}
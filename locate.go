@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/fov"
+)
+
+// Locate solves an image and returns only where it points: the RA and Dec
+// of its center in degrees (J2000). The scale search is narrowed from the
+// camera and focal length in the image's EXIF data when fov recognises
+// them, and is blind otherwise. It returns ErrNoSolution when the image
+// does not solve, and ErrFieldTooWide without solving when the EXIF shows
+// a fisheye lens or all-sky camera, or a field wider than
+// ClientConfig.MaxFieldDegrees; use SolveCentralCrop for those. Use Solve
+// for anything more than the center.
+//
+// Example:
+//
+//	ra, dec, err := c.Locate(ctx, "IMG_2820.JPG")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Pointing at RA %.4f°, Dec %.4f°\n", ra, dec)
+func (c *Client) Locate(ctx context.Context, imagePath string) (ra, dec float64, err error) {
+	return locate(ctx, c.Solve, imagePath, c.Config().MaxFieldDegrees)
+}
+
+// locate implements Locate with solve in place of Client.Solve, rejecting
+// fields wider than maxFieldDegrees (fov.DefaultMaxFieldDegrees when 0).
+func locate(ctx context.Context, solve solveFunc, imagePath string, maxFieldDegrees float64) (ra, dec float64, err error) {
+	opts := DefaultSolveOptions()
+	if info, err := fov.AnalyzeImage(imagePath); err == nil {
+		if info.TooWide(maxFieldDegrees) {
+			return 0, 0, fmt.Errorf("%w: %s is a %.0f° fisheye or all-sky field; "+
+				"use SolveCentralCrop to solve its center", ErrFieldTooWide, imagePath, info.FOV.WidthDegrees)
+		}
+		if info.ScaleLow > 0 {
+			opts.ScaleLow, opts.ScaleHigh, opts.ScaleUnits = info.ScaleLow, info.ScaleHigh, "arcminwidth"
+		}
+	}
+
+	result, err := solve(ctx, imagePath, opts)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !result.Solved {
+		return 0, 0, fmt.Errorf("%w: %s", ErrNoSolution, imagePath)
+	}
+	return result.RA, result.Dec, nil
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocate(t *testing.T) {
+	var gotOpts *SolveOptions
+	solve := func(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
+		gotOpts = opts
+		return &Result{Solved: true, RA: 83.82, Dec: -5.39, PixelScale: 9.5}, nil
+	}
+
+	ra, dec, err := locate(context.Background(), solve, filepath.Join("images", "IMG_2820.JPG"), 0)
+	if err != nil {
+		t.Fatalf("locate failed: %v", err)
+	}
+	if ra != 83.82 || dec != -5.39 {
+		t.Errorf("locate = (%v, %v), want (83.82, -5.39)", ra, dec)
+	}
+	// The image's EXIF gives a camera and focal length to scale the search
+	if gotOpts.ScaleLow <= 0 || gotOpts.ScaleHigh <= gotOpts.ScaleLow || gotOpts.ScaleUnits != "arcminwidth" {
+		t.Errorf("opts scale = %v-%v %s, want bounds from EXIF", gotOpts.ScaleLow, gotOpts.ScaleHigh, gotOpts.ScaleUnits)
+	}
+}
+
+func TestLocate_BlindWithoutEXIF(t *testing.T) {
+	var gotOpts *SolveOptions
+	solve := func(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
+		gotOpts = opts
+		return &Result{Solved: true, RA: 10, Dec: 20}, nil
+	}
+
+	if _, _, err := locate(context.Background(), solve, filepath.Join(t.TempDir(), "frame.fits"), 0); err != nil {
+		t.Fatalf("locate failed: %v", err)
+	}
+	if gotOpts.ScaleLow != 0 || gotOpts.ScaleHigh != 0 {
+		t.Errorf("opts scale = %v-%v, want a blind search", gotOpts.ScaleLow, gotOpts.ScaleHigh)
+	}
+}
+
+func TestLocate_NoSolution(t *testing.T) {
+	unsolved := func(context.Context, string, *SolveOptions) (*Result, error) {
+		return &Result{Solved: false}, nil
+	}
+	if _, _, err := locate(context.Background(), unsolved, "frame.fits", 0); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("error = %v, want ErrNoSolution", err)
+	}
+
+	failed := func(context.Context, string, *SolveOptions) (*Result, error) {
+		return nil, ErrTimeout
+	}
+	if _, _, err := locate(context.Background(), failed, "frame.fits", 0); !errors.Is(err, ErrTimeout) {
+		t.Errorf("error = %v, want ErrTimeout", err)
+	}
+}
+
+func TestLocate_FieldTooWide(t *testing.T) {
+	runs := 0
+	solve := func(context.Context, string, *SolveOptions) (*Result, error) {
+		runs++
+		return &Result{Solved: true}, nil
+	}
+	// IMG_2820.JPG is a 6.38° wide field
+	imagePath := filepath.Join("images", "IMG_2820.JPG")
+	if _, _, err := locate(context.Background(), solve, imagePath, 6.4); err != nil {
+		t.Errorf("field within the limit: %v", err)
+	}
+	if _, _, err := locate(context.Background(), solve, imagePath, 6.3); !errors.Is(err, ErrFieldTooWide) {
+		t.Errorf("field over the limit: err = %v, want ErrFieldTooWide", err)
+	}
+	if runs != 1 {
+		t.Errorf("solver ran %d times, want 1", runs)
+	}
+}
+
+func TestSolveCentralCrop(t *testing.T) {
+	var gotOpts *SolveOptions
+	solve := func(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error) {
+		gotOpts = opts
+		return &Result{Solved: true, PartialField: true}, nil
+	}
+
+	// IMG_2820.JPG: 200 mm on APS-C Canon, about 939 pixels per degree
+	if _, err := solveCentralCrop(context.Background(), solve, filepath.Join("images", "IMG_2820.JPG"), 2, nil); err != nil {
+		t.Fatalf("solveCentralCrop failed: %v", err)
+	}
+	if gotOpts.CropPixels < 1870 || gotOpts.CropPixels > 1890 {
+		t.Errorf("CropPixels = %d, want about 1878 for 2°", gotOpts.CropPixels)
+	}
+	if gotOpts.ScaleUnits != "degwidth" || gotOpts.ScaleLow >= 2 || gotOpts.ScaleHigh <= 2 {
+		t.Errorf("scale = %v-%v %s, want bounds around 2 degwidth", gotOpts.ScaleLow, gotOpts.ScaleHigh, gotOpts.ScaleUnits)
+	}
+
+	// Caller scale bounds are kept
+	opts := DefaultSolveOptions()
+	opts.ScaleLow, opts.ScaleHigh = 100, 140
+	if _, err := solveCentralCrop(context.Background(), solve, filepath.Join("images", "IMG_2820.JPG"), 2, opts); err != nil {
+		t.Fatalf("solveCentralCrop failed: %v", err)
+	}
+	if gotOpts.ScaleLow != 100 || gotOpts.ScaleUnits != "arcminwidth" || opts.CropPixels != 0 {
+		t.Errorf("caller options changed or ignored: %+v", gotOpts)
+	}
+
+	// Without metadata the crop cannot be sized
+	if _, err := solveCentralCrop(context.Background(), solve, filepath.Join(t.TempDir(), "frame.fits"), 2, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("no metadata: err = %v, want ErrInvalidInput", err)
+	}
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+)
+
+// Priority orders jobs in a PriorityQueue. Higher values run first.
+type Priority int
+
+const (
+	// PriorityLow is for bulk batch jobs that can wait.
+	PriorityLow Priority = -10
+
+	// PriorityNormal is the default priority.
+	PriorityNormal Priority = 0
+
+	// PriorityHigh is for interactive requests that should jump the queue.
+	PriorityHigh Priority = 10
+)
+
+// ErrQueueClosed is returned for jobs submitted after PriorityQueue.Close.
+var ErrQueueClosed = errors.New("solve queue is closed")
+
+// SolveRequest is a solve job submitted to a PriorityQueue.
+type SolveRequest struct {
+	// ImagePath is the image to solve.
+	ImagePath string
+
+	// Options are the solve options. Nil uses DefaultSolveOptions.
+	Options *SolveOptions
+
+	// Priority orders the job in the queue. Jobs with equal priority run
+	// in submission order.
+	Priority Priority
+}
+
+// SolveResponse is the outcome of a queued solve.
+type SolveResponse struct {
+	Result *Result
+	Err    error
+}
+
+// solveFunc runs a single solve. It is Solver.Solve outside of tests.
+type solveFunc func(ctx context.Context, imagePath string, opts *SolveOptions) (*Result, error)
+
+// PriorityQueue dispatches solves to a bounded pool of workers, highest
+// priority first. It lets interactive uploads overtake queued batch work
+// without starving the worker pool.
+//
+// Example:
+//
+//	q := client.NewPriorityQueue(c, 2)
+//	defer q.Close()
+//
+//	resp := <-q.Submit(ctx, client.SolveRequest{
+//		ImagePath: "upload.jpg",
+//		Priority:  client.PriorityHigh,
+//	})
+//	if resp.Err != nil {
+//		log.Fatal(resp.Err)
+//	}
+type PriorityQueue struct {
+	solve solveFunc
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	jobs    jobHeap
+	seq     uint64
+	closed  bool
+	workers sync.WaitGroup
+}
+
+// queuedJob is a pending solve in the heap.
+type queuedJob struct {
+	ctx   context.Context
+	req   SolveRequest
+	seq   uint64
+	index int // Position in the heap, -1 once dequeued
+	stop  func() bool
+	done  chan SolveResponse
+}
+
+// NewPriorityQueue starts a queue that runs at most workers solves
+// concurrently on s, usually a *Client. workers below 1 is treated as 1.
+func NewPriorityQueue(s Solver, workers int) *PriorityQueue {
+	return newPriorityQueue(s.Solve, workers)
+}
+
+func newPriorityQueue(solve solveFunc, workers int) *PriorityQueue {
+	if workers < 1 {
+		workers = 1
+	}
+	q := &PriorityQueue{solve: solve}
+	q.cond = sync.NewCond(&q.mu)
+	q.workers.Add(workers)
+	for range workers {
+		go q.work()
+	}
+	return q
+}
+
+// Submit queues a solve and returns a channel that receives exactly one
+// response when the job finishes. If ctx is cancelled while the job is
+// still queued, it is removed from the queue and the response carries the
+// context error.
+func (q *PriorityQueue) Submit(ctx context.Context, req SolveRequest) <-chan SolveResponse {
+	job := &queuedJob{ctx: ctx, req: req, done: make(chan SolveResponse, 1)}
+
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		job.done <- SolveResponse{Err: ErrQueueClosed}
+		return job.done
+	}
+	job.seq = q.seq
+	q.seq++
+	heap.Push(&q.jobs, job)
+
+	// Drop the job if its context ends before a worker picks it up
+	job.stop = context.AfterFunc(ctx, func() {
+		q.mu.Lock()
+		queued := job.index >= 0
+		if queued {
+			heap.Remove(&q.jobs, job.index)
+		}
+		q.mu.Unlock()
+		if queued {
+			job.done <- SolveResponse{Err: ctx.Err()}
+		}
+	})
+	q.mu.Unlock()
+	q.cond.Signal()
+	return job.done
+}
+
+// Len returns the number of jobs waiting for a worker.
+func (q *PriorityQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.jobs.Len()
+}
+
+// Close stops accepting jobs and waits for queued and running jobs to
+// finish.
+func (q *PriorityQueue) Close() {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	q.cond.Broadcast()
+	q.workers.Wait()
+}
+
+// work runs jobs until the queue is closed and drained.
+func (q *PriorityQueue) work() {
+	defer q.workers.Done()
+	for {
+		q.mu.Lock()
+		for q.jobs.Len() == 0 && !q.closed {
+			q.cond.Wait()
+		}
+		if q.jobs.Len() == 0 {
+			q.mu.Unlock()
+			return
+		}
+		job := heap.Pop(&q.jobs).(*queuedJob)
+		q.mu.Unlock()
+		job.stop()
+
+		result, err := q.solve(job.ctx, job.req.ImagePath, job.req.Options)
+		job.done <- SolveResponse{Result: result, Err: err}
+	}
+}
+
+// jobHeap is a max-heap on priority, then a min-heap on submission order.
+type jobHeap []*queuedJob
+
+func (h jobHeap) Len() int { return len(h) }
+
+func (h jobHeap) Less(i, j int) bool {
+	if h[i].req.Priority != h[j].req.Priority {
+		return h[i].req.Priority > h[j].req.Priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h jobHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *jobHeap) Push(x any) {
+	job := x.(*queuedJob)
+	job.index = len(*h)
+	*h = append(*h, job)
+}
+
+func (h *jobHeap) Pop() any {
+	old := *h
+	job := old[len(old)-1]
+	old[len(old)-1] = nil
+	job.index = -1
+	*h = old[:len(old)-1]
+	return job
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingSolver blocks each solve until released and records the order
+// images were solved in.
+type recordingSolver struct {
+	mu      sync.Mutex
+	order   []string
+	started chan string
+	release chan struct{}
+}
+
+func newRecordingSolver() *recordingSolver {
+	return &recordingSolver{started: make(chan string, 16), release: make(chan struct{})}
+}
+
+func (s *recordingSolver) solve(ctx context.Context, imagePath string, _ *SolveOptions) (*Result, error) {
+	s.started <- imagePath
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	s.mu.Lock()
+	s.order = append(s.order, imagePath)
+	s.mu.Unlock()
+	return &Result{Solved: true}, nil
+}
+
+func TestPriorityQueue_HighPriorityFirst(t *testing.T) {
+	s := newRecordingSolver()
+	q := newPriorityQueue(s.solve, 1)
+	defer q.Close()
+	ctx := context.Background()
+
+	// Occupy the only worker, then queue low-priority work behind it
+	var responses []<-chan SolveResponse
+	responses = append(responses, q.Submit(ctx, SolveRequest{ImagePath: "running", Priority: PriorityLow}))
+	<-s.started
+
+	responses = append(responses,
+		q.Submit(ctx, SolveRequest{ImagePath: "batch-1", Priority: PriorityLow}),
+		q.Submit(ctx, SolveRequest{ImagePath: "batch-2", Priority: PriorityLow}),
+		q.Submit(ctx, SolveRequest{ImagePath: "normal", Priority: PriorityNormal}),
+		q.Submit(ctx, SolveRequest{ImagePath: "interactive", Priority: PriorityHigh}),
+	)
+	if got := q.Len(); got != 4 {
+		t.Fatalf("Len() = %d, want 4", got)
+	}
+
+	close(s.release)
+	for i, ch := range responses {
+		resp := <-ch
+		if resp.Err != nil || resp.Result == nil || !resp.Result.Solved {
+			t.Errorf("response %d = %+v", i, resp)
+		}
+	}
+
+	want := []string{"running", "interactive", "normal", "batch-1", "batch-2"}
+	if len(s.order) != len(want) {
+		t.Fatalf("solve order = %v, want %v", s.order, want)
+	}
+	for i := range want {
+		if s.order[i] != want[i] {
+			t.Fatalf("solve order = %v, want %v", s.order, want)
+		}
+	}
+}
+
+func TestPriorityQueue_CancelWhileQueued(t *testing.T) {
+	s := newRecordingSolver()
+	q := newPriorityQueue(s.solve, 1)
+	defer q.Close()
+
+	running := q.Submit(context.Background(), SolveRequest{ImagePath: "running"})
+	<-s.started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queued := q.Submit(ctx, SolveRequest{ImagePath: "queued"})
+	cancel()
+
+	select {
+	case resp := <-queued:
+		if !errors.Is(resp.Err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", resp.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cancelled job was not removed from the queue")
+	}
+	if got := q.Len(); got != 0 {
+		t.Errorf("Len() = %d after cancel, want 0", got)
+	}
+
+	close(s.release)
+	if resp := <-running; resp.Err != nil {
+		t.Errorf("running job failed: %v", resp.Err)
+	}
+}
+
+func TestPriorityQueue_Close(t *testing.T) {
+	s := newRecordingSolver()
+	close(s.release)
+	q := newPriorityQueue(s.solve, 2)
+
+	pending := q.Submit(context.Background(), SolveRequest{ImagePath: "a"})
+	q.Close()
+
+	if resp := <-pending; resp.Err != nil {
+		t.Errorf("job queued before Close failed: %v", resp.Err)
+	}
+	if resp := <-q.Submit(context.Background(), SolveRequest{ImagePath: "b"}); !errors.Is(resp.Err, ErrQueueClosed) {
+		t.Errorf("expected ErrQueueClosed, got %v", resp.Err)
+	}
+}
@@ -0,0 +1,25 @@
+#!/bin/bash
+# Capture unedited solve-field output for the ParseSolveFieldLog fixtures.
+# Run from the repository root after `make test-integration-setup`.
+
+set -e
+
+SOLVER_IMAGE=${SOLVER_IMAGE:-diarmuidk/astrometry-dockerised-solver:0.97}
+OUT_DIR=internal/solver/testdata
+
+# IMG_2820-converted.jpg is made by make test-integration-setup
+for image in IMG_2820.JPG IMG_2820-converted.jpg; do
+    name="${image%.*}"
+    out="$OUT_DIR/solve-field-$name.log"
+    echo "Solving images/$image -> $out"
+    docker run --rm \
+        -v "$(pwd)/images:/data" \
+        -v "$(pwd)/astrometry-data:/usr/local/astrometry/data" \
+        "$SOLVER_IMAGE" \
+        solve-field --no-plots --overwrite \
+        --scale-units degwidth --scale-low 1.0 --scale-high 180.0 \
+        --downsample 2 --dir /tmp "/data/$image" > "$out" 2>&1
+done
+
+echo ""
+echo "Captures written to $OUT_DIR; commit them unedited."
@@ -3,16 +3,297 @@ package client
 // This file re-exports solver types for public API
 
 import (
+	"io"
+
 	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
 )
 
 // SolveOptions holds parameters for a plate-solving operation.
 type SolveOptions = solver.SolveOptions
 
+// ScaleUnits are the units of SolveOptions.ScaleLow and ScaleHigh. Plain
+// strings such as "degwidth" still work.
+type ScaleUnits = solver.ScaleUnits
+
+// The units solve-field accepts for the scale bounds.
+const (
+	UnitDegWidth     = solver.UnitDegWidth
+	UnitArcminWidth  = solver.UnitArcminWidth
+	UnitArcsecPerPix = solver.UnitArcsecPerPix
+	UnitFocalMM      = solver.UnitFocalMM
+)
+
 // Result holds the plate-solving results.
 type Result = solver.Result
 
+// FITSCard is one card of a FITS header, as returned by
+// Result.ToFITSHeader. Value is the value field as written: strings in
+// single quotes, numbers and logicals bare.
+type FITSCard = solver.FITSCard
+
+// WriteFITSHeader writes cards as a FITS header, followed by END and padded
+// to a 2880-byte block.
+func WriteFITSHeader(w io.Writer, cards []FITSCard) error {
+	return solver.WriteFITSHeader(w, cards)
+}
+
 // DefaultSolveOptions returns SolveOptions with sensible defaults.
 func DefaultSolveOptions() *SolveOptions {
 	return solver.DefaultSolveOptions()
 }
+
+// SolveOptionsVersion is the options_version SolveOptions.MarshalJSON writes.
+const SolveOptionsVersion = solver.SolveOptionsVersion
+
+// MigrateOptions reads SolveOptions stored as JSON by any version of this
+// package, upgrading older encodings. The warnings list unknown fields,
+// which are kept in SolveOptions.Extra, and any version upgrade.
+func MigrateOptions(raw []byte) (*SolveOptions, []string, error) {
+	return solver.MigrateOptions(raw)
+}
+
+// Source is a single star detected by source extraction.
+type Source = solver.Source
+
+// SourceList holds the sources detected in an image.
+type SourceList = solver.SourceList
+
+// ExtractOptions holds parameters for a source extraction operation.
+type ExtractOptions = solver.ExtractOptions
+
+// Extractor detects stars for Solve in place of solve-field's simplexy.
+type Extractor = solver.Extractor
+
+// ThresholdExtractor is a pure Go reference Extractor using
+// background-subtracted thresholding and centroiding.
+type ThresholdExtractor = solver.ThresholdExtractor
+
+// ExtractorSimplexy is the Result.Extractor value when solve-field
+// detected the stars itself.
+const ExtractorSimplexy = solver.ExtractorSimplexy
+
+// MaxHealPixOrder is the highest order Result.HealPix supports.
+const MaxHealPixOrder = solver.MaxHealPixOrder
+
+// StartOptions configures StartContainer.
+type StartOptions = solver.StartOptions
+
+// PullProgress reports docker pull progress to EnsureImage and
+// StartContainer callbacks.
+type PullProgress = solver.PullProgress
+
+// DefaultReadyTimeout is how long StartContainer waits for a new container
+// to run solve-field by default.
+const DefaultReadyTimeout = solver.DefaultReadyTimeout
+
+// CoverageMap counts how often each HEALPix pixel of the sky was imaged.
+type CoverageMap = solver.CoverageMap
+
+// CoverageCell is the frame count and summed exposure of one CoverageMap
+// pixel.
+type CoverageCell = solver.CoverageCell
+
+// NewCoverageMap returns an empty CoverageMap at the given HEALPix order.
+func NewCoverageMap(order int) (*CoverageMap, error) {
+	return solver.NewCoverageMap(order)
+}
+
+// SkyCoverage builds a CoverageMap from the footprints of a batch of
+// results, skipping those without a WCS solution.
+func SkyCoverage(results []*Result, order int) (*CoverageMap, error) {
+	return solver.SkyCoverage(results, order)
+}
+
+// StarStats summarises the stars detected by MeasureStars.
+type StarStats = solver.StarStats
+
+// DefaultExtractOptions returns ExtractOptions with sensible defaults.
+func DefaultExtractOptions() *ExtractOptions {
+	return solver.DefaultExtractOptions()
+}
+
+// SolveError is returned by Solve for setup problems recognised in
+// solve-field's output and for Docker or solver failures shown by the exit
+// status, with a hint on how to fix them. It wraps ErrInvalidInput,
+// ErrNoSolution or ErrDockerFailed.
+type SolveError = solver.SolveError
+
+// CropGeometry is the part of an image a SolveOptions.CropPixels solve
+// covered, reported in Result.Crop when Result.PartialField is set.
+type CropGeometry = solver.CropGeometry
+
+// OutputKind identifies a solve-field output file by its suffix, as used
+// by Result.Outputs and Result.MissingOutputs.
+type OutputKind = solver.OutputKind
+
+// Solve-field output file kinds.
+const (
+	OutputWCS       = solver.OutputWCS
+	OutputSolved    = solver.OutputSolved
+	OutputCorr      = solver.OutputCorr
+	OutputMatch     = solver.OutputMatch
+	OutputRDLS      = solver.OutputRDLS
+	OutputAXY       = solver.OutputAXY
+	OutputIndexXYLS = solver.OutputIndexXYLS
+	OutputNew       = solver.OutputNew
+	OutputKMZ       = solver.OutputKMZ
+)
+
+// Hook is an action run after Solve, configured in
+// ClientConfig.PostSolveHooks.
+type Hook = solver.Hook
+
+// HookFunc adapts a function to the Hook interface.
+type HookFunc = solver.HookFunc
+
+// NamedHook registers a Hook with a name, its own timeout, and whether it
+// also runs for unsolved results.
+type NamedHook = solver.NamedHook
+
+// HookError records a failed post-solve hook in Result.HookErrors.
+type HookError = solver.HookError
+
+// DefaultHookTimeout bounds each post-solve hook without its own timeout.
+const DefaultHookTimeout = solver.DefaultHookTimeout
+
+// CopyArtifactsHook returns a Hook that copies the solve's output files to
+// dir, named after the image.
+func CopyArtifactsHook(dir string) Hook {
+	return solver.CopyArtifactsHook(dir)
+}
+
+// WebhookHook returns a Hook that POSTs the result as JSON to url.
+func WebhookHook(url string) Hook {
+	return solver.WebhookHook(url)
+}
+
+// Parity describes whether a solution is mirror-flipped.
+type Parity = solver.Parity
+
+// Solution parities.
+const (
+	ParityUnknown  = solver.ParityUnknown
+	ParityPositive = solver.ParityPositive
+	ParityNegative = solver.ParityNegative
+)
+
+// ValidationOptions configures the sanity checks run by ValidateResult.
+type ValidationOptions = solver.ValidationOptions
+
+// ValidationIssue describes one failed sanity check.
+type ValidationIssue = solver.ValidationIssue
+
+// Severity classifies a ValidationIssue.
+type Severity = solver.Severity
+
+// Validation issue severities.
+const (
+	SeverityWarning = solver.SeverityWarning
+	SeverityError   = solver.SeverityError
+)
+
+// ValidateResult runs sanity checks on a solved Result and returns any issues found.
+func ValidateResult(r *Result, opts ValidationOptions) []ValidationIssue {
+	return solver.ValidateResult(r, opts)
+}
+
+// Metrics receives the duration and outcome of every Solve call. reason is
+// empty for solved images and otherwise one of "no_solution", "timeout",
+// "canceled", "invalid_input", "no_scratch_space", "wcs_parse_failed" or
+// "error", so it can be used directly as a label.
+//
+// The library does not depend on a metrics package. To export to
+// Prometheus, adapt a histogram and counter:
+//
+//	type promMetrics struct {
+//		duration *prometheus.HistogramVec // labels: solved
+//		failures *prometheus.CounterVec   // labels: reason
+//	}
+//
+//	func (m promMetrics) ObserveSolve(d time.Duration, solved bool, reason string) {
+//		m.duration.WithLabelValues(strconv.FormatBool(solved)).Observe(d.Seconds())
+//		if !solved {
+//			m.failures.WithLabelValues(reason).Inc()
+//		}
+//	}
+//
+//	c, err := client.NewClient(&client.ClientConfig{
+//		IndexPath: "/data/indexes",
+//		Metrics:   promMetrics{duration: solveDuration, failures: solveFailures},
+//	})
+type Metrics = solver.Metrics
+
+// TimingMetrics is a Metrics that also receives solve-field's own wall and
+// CPU time per solve, separating container overhead from solver work.
+type TimingMetrics = solver.TimingMetrics
+
+// PoolMetrics is a Metrics that also learns whether each Solve was a warm
+// pool hit or a cold start, when ClientConfig.WarmPoolSize is set.
+type PoolMetrics = solver.PoolMetrics
+
+// NopMetrics is a Metrics that discards all observations.
+type NopMetrics = solver.NopMetrics
+
+// SolveLog is structured information extracted from solve-field's text output.
+type SolveLog = solver.SolveLog
+
+// AttemptLog describes one search depth tried by the solver.
+type AttemptLog = solver.AttemptLog
+
+// ParseSolveFieldLog extracts structured information from solve-field's output,
+// such as Result.RawOutput.
+func ParseSolveFieldLog(output []byte) *SolveLog {
+	return solver.ParseSolveFieldLog(output)
+}
+
+// ImageHash returns the hex SHA-256 of an image file's content, for
+// deduplication and cache keying.
+func ImageHash(imagePath string) (string, error) {
+	return solver.ImageHash(imagePath)
+}
+
+// ImageHashFromReader returns the hex SHA-256 of everything read from r.
+func ImageHashFromReader(r io.Reader) (string, error) {
+	return solver.ImageHashFromReader(r)
+}
+
+// ImageHashFromHeader returns the hex SHA-256 of a FITS file's first header
+// block only. It is much faster than ImageHash on large FITS files but does
+// not see pixel changes.
+func ImageHashFromHeader(imagePath string) (string, error) {
+	return solver.ImageHashFromHeader(imagePath)
+}
+
+// Comparison holds the differences between two solutions of the same
+// field, as returned by Compare.
+type Comparison = solver.Comparison
+
+// Compare returns the differences between a solution and a reference
+// solution of the same field, with the position difference measured as a
+// true angular separation on the sky.
+func Compare(got, want *Result) Comparison {
+	return solver.Compare(got, want)
+}
+
+// ParseSolvedFile reads a solve-field .solved file and returns the 1-based
+// numbers of the fields that solved.
+func ParseSolvedFile(path string) ([]int, error) {
+	return solver.ParseSolvedFile(path)
+}
+
+// ResultFromWCSHeader builds a solved Result from WCS header key/value pairs
+// obtained elsewhere (nova.astrometry.net, ASTAP), deriving the same fields
+// as a local solve. The linear transform may be given as a CD matrix, as
+// PCi_j with CDELTi, or as CDELTi with CROTA2. width and height, when
+// positive, override the image size in the header. Projections other than
+// TAN, SIN and ARC return ErrUnsupportedProjection.
+func ResultFromWCSHeader(header map[string]string, width, height int) (*Result, error) {
+	return solver.ResultFromWCSHeader(header, width, height)
+}
+
+// ParseWCSFile reads a FITS WCS header file, such as the .wcs file
+// solve-field writes or a wcs.fits downloaded from nova.astrometry.net,
+// and returns the solved Result it describes.
+func ParseWCSFile(wcsPath string) (*Result, error) {
+	return solver.ParseWCSFile(wcsPath)
+}
@@ -0,0 +1,148 @@
+// Package solvertest provides test doubles for client.Solver, so code that
+// depends on plate solving can be tested without Docker or index files.
+package solvertest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	client "github.com/DiarmuidKelly/astrometry-go-client"
+)
+
+// SolverFunc adapts a solve function to the client.Solver interface.
+// SolveBytes writes the data to a temporary file and calls the function
+// with its path. ExtractSources is not supported.
+type SolverFunc func(ctx context.Context, imagePath string, opts *client.SolveOptions) (*client.Result, error)
+
+var _ client.Solver = SolverFunc(nil)
+
+// Solve calls f.
+func (f SolverFunc) Solve(ctx context.Context, imagePath string, opts *client.SolveOptions) (*client.Result, error) {
+	return f(ctx, imagePath, opts)
+}
+
+// SolveBytes writes data to a temporary file with the given extension and
+// calls f with its path.
+func (f SolverFunc) SolveBytes(ctx context.Context, data []byte, format string, opts *client.SolveOptions) (*client.Result, error) {
+	file, err := os.CreateTemp("", "solvertest-*."+format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(file.Name()) //nolint:errcheck // Cleanup operation, error not critical
+	}()
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close() //nolint:errcheck // Best effort cleanup on error path
+		return nil, fmt.Errorf("failed to write image data: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close temp file: %w", err)
+	}
+	return f(ctx, file.Name(), opts)
+}
+
+// ExtractSources returns an error wrapping errors.ErrUnsupported.
+func (f SolverFunc) ExtractSources(context.Context, string, *client.ExtractOptions) (*client.SourceList, error) {
+	return nil, fmt.Errorf("solvertest: SolverFunc does not implement ExtractSources: %w", errors.ErrUnsupported)
+}
+
+// Call is one recorded call to a Recorder.
+type Call struct {
+	// Method is "Solve", "SolveBytes" or "ExtractSources".
+	Method string
+
+	// ImagePath is the image passed to Solve or ExtractSources.
+	ImagePath string
+
+	// Data and Format are the arguments passed to SolveBytes.
+	Data   []byte
+	Format string
+
+	// SolveOptions and ExtractOptions are the options passed, if any.
+	SolveOptions   *client.SolveOptions
+	ExtractOptions *client.ExtractOptions
+}
+
+// Recorder is a fake client.Solver that records every call and returns
+// canned responses. The zero value returns unsolved results. It is safe for
+// concurrent use.
+//
+// Example:
+//
+//	fake := &solvertest.Recorder{Result: &client.Result{Solved: true, RA: 83.8, Dec: -5.4}}
+//	q := client.NewPriorityQueue(fake, 1)
+//	resp := <-q.Submit(ctx, client.SolveRequest{ImagePath: "m42.jpg"})
+//	calls := fake.Calls()
+type Recorder struct {
+	// Result is returned, cloned, by Solve and SolveBytes. Nil returns an
+	// unsolved result.
+	Result *client.Result
+
+	// Sources is returned by ExtractSources. Nil returns an empty list.
+	Sources *client.SourceList
+
+	// Err, when set, is returned by every method instead of a result.
+	Err error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ client.Solver = (*Recorder)(nil)
+
+// Solve records the call and returns Result or Err.
+func (r *Recorder) Solve(_ context.Context, imagePath string, opts *client.SolveOptions) (*client.Result, error) {
+	r.record(Call{Method: "Solve", ImagePath: imagePath, SolveOptions: opts})
+	return r.result()
+}
+
+// SolveBytes records the call and returns Result or Err.
+func (r *Recorder) SolveBytes(_ context.Context, data []byte, format string, opts *client.SolveOptions) (*client.Result, error) {
+	r.record(Call{Method: "SolveBytes", Data: append([]byte(nil), data...), Format: format, SolveOptions: opts})
+	return r.result()
+}
+
+// ExtractSources records the call and returns Sources or Err.
+func (r *Recorder) ExtractSources(_ context.Context, imagePath string, opts *client.ExtractOptions) (*client.SourceList, error) {
+	r.record(Call{Method: "ExtractSources", ImagePath: imagePath, ExtractOptions: opts})
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	if r.Sources == nil {
+		return &client.SourceList{}, nil
+	}
+	return r.Sources, nil
+}
+
+// Calls returns a copy of the calls recorded so far, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// Reset clears the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+func (r *Recorder) record(call Call) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+func (r *Recorder) result() (*client.Result, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	if r.Result == nil {
+		return &client.Result{Solved: false}, nil
+	}
+	return r.Result.Clone(), nil
+}
@@ -0,0 +1,115 @@
+package solvertest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	client "github.com/DiarmuidKelly/astrometry-go-client"
+)
+
+func TestSolverFunc(t *testing.T) {
+	var gotPath string
+	var gotData []byte
+	f := SolverFunc(func(ctx context.Context, imagePath string, opts *client.SolveOptions) (*client.Result, error) {
+		gotPath = imagePath
+		gotData, _ = os.ReadFile(imagePath)
+		return &client.Result{Solved: true}, nil
+	})
+
+	if r, err := f.Solve(context.Background(), "frame.jpg", nil); err != nil || !r.Solved || gotPath != "frame.jpg" {
+		t.Errorf("Solve = %+v, %v with path %q", r, err, gotPath)
+	}
+
+	if _, err := f.SolveBytes(context.Background(), []byte("pixels"), "png", nil); err != nil {
+		t.Fatalf("SolveBytes failed: %v", err)
+	}
+	if filepath.Ext(gotPath) != ".png" || !bytes.Equal(gotData, []byte("pixels")) {
+		t.Errorf("SolveBytes passed %q with data %q", gotPath, gotData)
+	}
+	if _, err := os.Stat(gotPath); !os.IsNotExist(err) {
+		t.Errorf("SolveBytes temp file not removed: %v", err)
+	}
+
+	if _, err := f.ExtractSources(context.Background(), "frame.jpg", nil); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("expected errors.ErrUnsupported, got %v", err)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	r := &Recorder{Result: &client.Result{Solved: true, RA: 10, WCSHeader: map[string]string{"CRVAL1": "10"}}}
+	opts := client.DefaultSolveOptions()
+
+	res, err := r.Solve(context.Background(), "a.jpg", opts)
+	if err != nil || !res.Solved || res.RA != 10 {
+		t.Fatalf("Solve = %+v, %v", res, err)
+	}
+	// Results are cloned, so callers cannot corrupt the canned result
+	res.WCSHeader["CRVAL1"] = "changed"
+	if r.Result.WCSHeader["CRVAL1"] != "10" {
+		t.Error("Recorder returned its canned result without cloning")
+	}
+
+	data := []byte{1, 2, 3}
+	if _, err := r.SolveBytes(context.Background(), data, "fits", nil); err != nil {
+		t.Fatal(err)
+	}
+	data[0] = 9
+	if _, err := r.ExtractSources(context.Background(), "b.fits", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := r.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("got %d calls, want 3", len(calls))
+	}
+	if calls[0].Method != "Solve" || calls[0].ImagePath != "a.jpg" || calls[0].SolveOptions != opts {
+		t.Errorf("call 0 = %+v", calls[0])
+	}
+	if calls[1].Method != "SolveBytes" || calls[1].Format != "fits" || calls[1].Data[0] != 1 {
+		t.Errorf("call 1 = %+v", calls[1])
+	}
+	if calls[2].Method != "ExtractSources" || calls[2].ImagePath != "b.fits" {
+		t.Errorf("call 2 = %+v", calls[2])
+	}
+
+	r.Reset()
+	if len(r.Calls()) != 0 {
+		t.Error("Reset did not clear calls")
+	}
+}
+
+func TestRecorder_ZeroValueAndErr(t *testing.T) {
+	var r Recorder
+	if res, err := r.Solve(context.Background(), "a.jpg", nil); err != nil || res.Solved {
+		t.Errorf("zero Recorder Solve = %+v, %v; want unsolved", res, err)
+	}
+	if list, err := r.ExtractSources(context.Background(), "a.jpg", nil); err != nil || list == nil {
+		t.Errorf("zero Recorder ExtractSources = %v, %v; want empty list", list, err)
+	}
+
+	r.Err = client.ErrTimeout
+	if _, err := r.Solve(context.Background(), "a.jpg", nil); !errors.Is(err, client.ErrTimeout) {
+		t.Errorf("expected ErrTimeout, got %v", err)
+	}
+}
+
+func TestRecorder_Concurrent(t *testing.T) {
+	var r Recorder
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = r.Solve(context.Background(), "a.jpg", nil)
+		}()
+	}
+	wg.Wait()
+	if got := len(r.Calls()); got != 20 {
+		t.Errorf("recorded %d calls, want 20", got)
+	}
+}
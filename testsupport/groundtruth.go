@@ -0,0 +1,191 @@
+// Package testsupport checks plate-solving results against reference
+// solutions ("ground truth"), so projects embedding the client can validate
+// their own images the way this repository's integration tests do.
+//
+// Ground truth is stored as JSON keyed by image filename:
+//
+//	{
+//	  "IMG_2820.JPG": {
+//	    "description": "Orion Nebula (M42) region",
+//	    "solution": {"ra": 83.423, "dec": -5.893, "pixel_scale_arcsec_per_pixel": 3.96, ...},
+//	    "tolerance": {"position_arcsec": 10, "pixel_scale_percent": 5, ...}
+//	  }
+//	}
+package testsupport
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/DiarmuidKelly/astrometry-go-client/internal/solver"
+)
+
+// GroundTruth is the expected solution for a reference image and the
+// tolerances a solve must meet.
+type GroundTruth struct {
+	Description string    `json:"description"`
+	Source      string    `json:"source"`
+	Camera      Camera    `json:"camera"`
+	Solution    Solution  `json:"solution"`
+	Tolerance   Tolerance `json:"tolerance"`
+	Notes       []string  `json:"notes"`
+}
+
+// Camera describes the equipment a reference image was taken with. It is
+// informational only.
+type Camera struct {
+	LensMM                 float64 `json:"lens_mm"`
+	Mount                  string  `json:"mount"`
+	Sensor                 string  `json:"sensor"`
+	CropFactor             float64 `json:"crop_factor"`
+	EffectiveFocalLengthMM float64 `json:"effective_focal_length_mm"`
+	Notes                  string  `json:"notes"`
+}
+
+// Solution is the reference solution, typically from astrometry.net's web
+// service. Zero values are not checked.
+type Solution struct {
+	RA                       float64 `json:"ra"`
+	Dec                      float64 `json:"dec"`
+	PixelScaleArcsecPerPixel float64 `json:"pixel_scale_arcsec_per_pixel"`
+	RotationDegrees          float64 `json:"rotation_degrees"`
+	FieldWidthDegrees        float64 `json:"field_width_degrees"`
+	FieldHeightDegrees       float64 `json:"field_height_degrees"`
+	ImageWidthPixels         int     `json:"image_width_pixels"`
+	ImageHeightPixels        int     `json:"image_height_pixels"`
+}
+
+// Tolerance bounds the differences allowed from the Solution. A zero
+// tolerance disables the corresponding check.
+type Tolerance struct {
+	// PositionArcsec bounds the great-circle distance between the solved
+	// and expected field centers.
+	PositionArcsec    float64 `json:"position_arcsec"`
+	PixelScalePercent float64 `json:"pixel_scale_percent"`
+	RotationDegrees   float64 `json:"rotation_degrees"`
+	FieldSizePercent  float64 `json:"field_size_percent"`
+}
+
+// LoadGroundTruths reads a ground truth file, keyed by image filename.
+func LoadGroundTruths(path string) (map[string]GroundTruth, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ground truth file: %w", err)
+	}
+
+	var groundTruths map[string]GroundTruth
+	if err := json.Unmarshal(data, &groundTruths); err != nil {
+		return nil, fmt.Errorf("failed to parse ground truth JSON: %w", err)
+	}
+	return groundTruths, nil
+}
+
+// LoadGroundTruth reads the ground truth for imageFilename from the file at
+// path.
+func LoadGroundTruth(path, imageFilename string) (*GroundTruth, error) {
+	groundTruths, err := LoadGroundTruths(path)
+	if err != nil {
+		return nil, err
+	}
+	gt, ok := groundTruths[imageFilename]
+	if !ok {
+		return nil, fmt.Errorf("no ground truth found for %s in %s", imageFilename, path)
+	}
+	return &gt, nil
+}
+
+// Expected returns the ground truth solution as a Result, for comparing
+// with client.Compare.
+func (gt *GroundTruth) Expected() *solver.Result {
+	return &solver.Result{
+		Solved:      true,
+		RA:          gt.Solution.RA,
+		Dec:         gt.Solution.Dec,
+		PixelScale:  gt.Solution.PixelScaleArcsecPerPixel,
+		Rotation:    gt.Solution.RotationDegrees,
+		FieldWidth:  gt.Solution.FieldWidthDegrees,
+		FieldHeight: gt.Solution.FieldHeightDegrees,
+		ImageWidth:  gt.Solution.ImageWidthPixels,
+		ImageHeight: gt.Solution.ImageHeightPixels,
+	}
+}
+
+// Check compares result with the ground truth and returns the differences
+// and a description of each one outside tolerance. The position is checked
+// as a true angular separation, so an RA difference that looks large near
+// the poles passes when the positions are in fact close; the per-axis
+// offsets in the comparison help tell which axis is off.
+func (gt *GroundTruth) Check(result *solver.Result) (solver.Comparison, []string) {
+	if result == nil || !result.Solved {
+		return solver.Comparison{}, []string{"image was not solved"}
+	}
+
+	c := solver.Compare(result, gt.Expected())
+	var failures []string
+	fail := func(format string, args ...any) {
+		failures = append(failures, fmt.Sprintf(format, args...))
+	}
+	tol := gt.Tolerance
+
+	if tol.PositionArcsec > 0 && c.SeparationArcsec > tol.PositionArcsec {
+		fail("position differs by %.2f arcsec, exceeds tolerance %.2f arcsec "+
+			"(RA offset %.2f arcsec on sky, Dec offset %.2f arcsec; got %.6f, %.6f, expected %.6f, %.6f)",
+			c.SeparationArcsec, tol.PositionArcsec, c.RAOffsetArcsec, c.DecOffsetArcsec,
+			result.RA, result.Dec, gt.Solution.RA, gt.Solution.Dec)
+	}
+	if tol.PixelScalePercent > 0 && gt.Solution.PixelScaleArcsecPerPixel != 0 && math.Abs(c.PixelScalePercent) > tol.PixelScalePercent {
+		fail("pixel scale difference %.2f%% exceeds tolerance %.2f%% (got %.2f, expected %.2f)",
+			math.Abs(c.PixelScalePercent), tol.PixelScalePercent, result.PixelScale, gt.Solution.PixelScaleArcsecPerPixel)
+	}
+	if tol.RotationDegrees > 0 && math.Abs(c.RotationDegrees) > tol.RotationDegrees {
+		fail("rotation difference %.2f° exceeds tolerance %.2f° (got %.2f, expected %.2f)",
+			math.Abs(c.RotationDegrees), tol.RotationDegrees, result.Rotation, gt.Solution.RotationDegrees)
+	}
+	if tol.FieldSizePercent > 0 && gt.Solution.FieldWidthDegrees != 0 && math.Abs(c.FieldWidthPercent) > tol.FieldSizePercent {
+		fail("field width difference %.2f%% exceeds tolerance %.2f%% (got %.4f, expected %.4f)",
+			math.Abs(c.FieldWidthPercent), tol.FieldSizePercent, result.FieldWidth, gt.Solution.FieldWidthDegrees)
+	}
+	if tol.FieldSizePercent > 0 && gt.Solution.FieldHeightDegrees != 0 && math.Abs(c.FieldHeightPercent) > tol.FieldSizePercent {
+		fail("field height difference %.2f%% exceeds tolerance %.2f%% (got %.4f, expected %.4f)",
+			math.Abs(c.FieldHeightPercent), tol.FieldSizePercent, result.FieldHeight, gt.Solution.FieldHeightDegrees)
+	}
+	return c, failures
+}
+
+// Validate checks result against gt, reporting each difference outside
+// tolerance as a test error and logging the per-axis diagnostics. It stops
+// the test if the image was not solved.
+//
+// Example:
+//
+//	gt, err := testsupport.LoadGroundTruth("testdata/ground_truth.json", "m31.jpg")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	result, err := c.Solve(ctx, "testdata/m31.jpg", nil)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	testsupport.Validate(t, result, gt)
+func Validate(t testing.TB, result *solver.Result, gt *GroundTruth) solver.Comparison {
+	t.Helper()
+
+	c, failures := gt.Check(result)
+	if result == nil || !result.Solved {
+		t.Fatal("Image was not solved")
+	}
+	for _, f := range failures {
+		t.Error(f)
+	}
+
+	t.Logf("  RA:          %.6f° (diff: %.2f arcsec, %.2f arcsec on sky)", result.RA, c.RADiffArcsec, c.RAOffsetArcsec)
+	t.Logf("  Dec:         %.6f° (diff: %.2f arcsec)", result.Dec, c.DecOffsetArcsec)
+	t.Logf("  Separation:  %.2f arcsec", c.SeparationArcsec)
+	t.Logf("  Pixel scale: %.2f arcsec/px (diff: %.2f%%)", result.PixelScale, c.PixelScalePercent)
+	t.Logf("  Rotation:    %.2f° (diff: %.2f°)", result.Rotation, c.RotationDegrees)
+	t.Logf("  Field size:  %.4f° x %.4f°", result.FieldWidth, result.FieldHeight)
+	return c
+}
@@ -0,0 +1,141 @@
+package testsupport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	client "github.com/DiarmuidKelly/astrometry-go-client"
+)
+
+// highDecResult returns a synthetic 1000x800 px solution at 4"/px centered
+// on RA 150°, Dec 75°, as a solver would report it.
+func highDecResult(t *testing.T) *client.Result {
+	t.Helper()
+	result, err := client.ResultFromWCSHeader(map[string]string{
+		"CTYPE1": "RA---TAN", "CTYPE2": "DEC--TAN",
+		"CRVAL1": "150.0", "CRVAL2": "75.0", "CRPIX1": "500", "CRPIX2": "400",
+		"CD1_1": fmt.Sprint(-4.0 / 3600), "CD1_2": "0",
+		"CD2_1": "0", "CD2_2": fmt.Sprint(4.0 / 3600),
+	}, 1000, 800)
+	if err != nil {
+		t.Fatalf("ResultFromWCSHeader failed: %v", err)
+	}
+	return result
+}
+
+// writeGroundTruth writes a ground truth file for "field.fits" matching r
+// apart from the given center, with a 10" position tolerance.
+func writeGroundTruth(t *testing.T, r *client.Result, ra, dec float64) string {
+	t.Helper()
+	data := fmt.Sprintf(`{
+  "field.fits": {
+    "description": "synthetic high-Dec field",
+    "source": "ResultFromWCSHeader",
+    "camera": {"lens_mm": 200, "sensor": "APS-C"},
+    "solution": {
+      "ra": %v, "dec": %v,
+      "pixel_scale_arcsec_per_pixel": %v,
+      "rotation_degrees": %v,
+      "field_width_degrees": %v, "field_height_degrees": %v,
+      "image_width_pixels": 1000, "image_height_pixels": 800
+    },
+    "tolerance": {
+      "position_arcsec": 10.0,
+      "pixel_scale_percent": 5.0,
+      "rotation_degrees": 2.0,
+      "field_size_percent": 5.0
+    },
+    "notes": ["RA offsets are 1/cos(75°) ≈ 3.9x larger than on-sky offsets"]
+  }
+}`, ra, dec, r.PixelScale, r.Rotation, r.FieldWidth, r.FieldHeight)
+	path := filepath.Join(t.TempDir(), "ground_truth.json")
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write ground truth: %v", err)
+	}
+	return path
+}
+
+func TestCheck_HighDec(t *testing.T) {
+	result := highDecResult(t)
+
+	// 30" in RA at Dec 75 is under 8" on the sky: a per-axis check
+	// against the 10" tolerance would fail, the separation passes
+	gt, err := LoadGroundTruth(writeGroundTruth(t, result, 150+30.0/3600, 75), "field.fits")
+	if err != nil {
+		t.Fatalf("LoadGroundTruth failed: %v", err)
+	}
+	c, failures := gt.Check(result)
+	if len(failures) != 0 {
+		t.Errorf("Check failures = %v, want none", failures)
+	}
+	if c.RADiffArcsec > -29.99 || c.RADiffArcsec < -30.01 {
+		t.Errorf("RADiffArcsec = %.2f, want -30", c.RADiffArcsec)
+	}
+	if c.SeparationArcsec < 7.7 || c.SeparationArcsec > 7.8 {
+		t.Errorf("SeparationArcsec = %.2f, want 7.76", c.SeparationArcsec)
+	}
+	Validate(t, result, gt)
+
+	// 15" in Dec is 15" on the sky at any RA
+	gt, err = LoadGroundTruth(writeGroundTruth(t, result, 150, 75+15.0/3600), "field.fits")
+	if err != nil {
+		t.Fatalf("LoadGroundTruth failed: %v", err)
+	}
+	if _, failures := gt.Check(result); len(failures) != 1 || !strings.Contains(failures[0], "position differs by 15.00 arcsec") {
+		t.Errorf("Check failures = %v, want one position failure", failures)
+	}
+}
+
+func TestCheck_Tolerances(t *testing.T) {
+	result := highDecResult(t)
+	gt, err := LoadGroundTruth(writeGroundTruth(t, result, 150, 75), "field.fits")
+	if err != nil {
+		t.Fatalf("LoadGroundTruth failed: %v", err)
+	}
+
+	off := *result
+	off.PixelScale *= 1.1
+	off.Rotation += 3
+	off.FieldWidth *= 0.9
+	off.FieldHeight *= 1.06
+	_, failures := gt.Check(&off)
+	for _, want := range []string{"pixel scale", "rotation", "field width", "field height"} {
+		found := false
+		for _, f := range failures {
+			found = found || strings.HasPrefix(f, want)
+		}
+		if !found {
+			t.Errorf("Check failures = %v, want a %s failure", failures, want)
+		}
+	}
+
+	gt.Tolerance = Tolerance{}
+	if _, failures := gt.Check(&off); len(failures) != 0 {
+		t.Errorf("Check with zero tolerances = %v, want none", failures)
+	}
+
+	if _, failures := gt.Check(&client.Result{}); len(failures) != 1 || failures[0] != "image was not solved" {
+		t.Errorf("Check unsolved = %v", failures)
+	}
+}
+
+func TestLoadGroundTruth(t *testing.T) {
+	path := filepath.Join("..", "testdata", "ground_truth.json")
+	gt, err := LoadGroundTruth(path, "IMG_2820.JPG")
+	if err != nil {
+		t.Fatalf("LoadGroundTruth failed: %v", err)
+	}
+	if gt.Solution.RA != 83.423 || gt.Tolerance.PositionArcsec != 10 || gt.Camera.LensMM != 200 {
+		t.Errorf("LoadGroundTruth = %+v", gt)
+	}
+
+	if _, err := LoadGroundTruth(path, "missing.jpg"); err == nil || !strings.Contains(err.Error(), "no ground truth found for missing.jpg") {
+		t.Errorf("missing image error = %v", err)
+	}
+	if _, err := LoadGroundTruth(filepath.Join(t.TempDir(), "none.json"), "IMG_2820.JPG"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}